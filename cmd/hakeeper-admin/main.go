@@ -0,0 +1,403 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+const (
+	jsonFormat  = "json"
+	tableFormat = "table"
+)
+
+var (
+	addressFlag = flag.String("address", "127.0.0.1:32003",
+		"address of the HAKeeper admin endpoint served by the log service")
+	timeoutFlag = flag.Duration("timeout", 10*time.Second, "request timeout")
+	tokenFlag   = flag.String("token", os.Getenv("HAKEEPER_ADMIN_TOKEN"),
+		"bearer token of the admin endpoint, defaults to $HAKEEPER_ADMIN_TOKEN")
+)
+
+type command struct {
+	usage string
+	run   func(c *client, args []string) error
+}
+
+var commands = map[string]command{
+	"state": {
+		usage: "dump the HAKeeper state",
+		run:   runState,
+	},
+	"operators": {
+		usage: "list unfinished operators and their step status",
+		run:   runOperators,
+	},
+	hakeeper.AddReplicaOp: {
+		usage: "add a replica of the shard to the store",
+		run:   runAddReplica,
+	},
+	hakeeper.RemoveReplicaOp: {
+		usage: "remove the replica of the shard from the store",
+		run:   runRemoveReplica,
+	},
+	hakeeper.TransferLeaderOp: {
+		usage: "transfer the leadership of the log shard to the replica",
+		run:   runTransferLeader,
+	},
+	"cancel": {
+		usage: "cancel the operator",
+		run:   runCancel,
+	},
+	"store": {
+		usage: "show the state of the store and whether it is safe to stop",
+		run:   runStore,
+	},
+	hakeeper.DrainStoreAction: {
+		usage: "mark the store as draining for planned maintenance",
+		run:   runStoreAction(hakeeper.DrainStoreAction),
+	},
+	hakeeper.ActivateStoreAction: {
+		usage: "mark the draining store as active again",
+		run:   runStoreAction(hakeeper.ActivateStoreAction),
+	},
+	"export": {
+		usage: "export the log shard replicated on the store to its backup directory",
+		run:   runExport,
+	},
+	"import": {
+		usage: "seed the log shard from an export in the backup directory",
+		run:   runImport,
+	},
+}
+
+var commandNames = []string{
+	"state", "operators", hakeeper.AddReplicaOp, hakeeper.RemoveReplicaOp,
+	hakeeper.TransferLeaderOp, "cancel", "store", hakeeper.DrainStoreAction,
+	hakeeper.ActivateStoreAction, "export", "import",
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [flags] command [command flags]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	for _, name := range commandNames {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", name, commands[name].usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) < 1 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", args[0])
+		usage()
+		os.Exit(2)
+	}
+	c := &client{
+		address: *addressFlag,
+		token:   *tokenFlag,
+		http:    &http.Client{Timeout: *timeoutFlag},
+	}
+	if err := cmd.run(c, args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s failed, %v\n", args[0], err)
+		os.Exit(1)
+	}
+}
+
+func parseFormat(fs *flag.FlagSet) *string {
+	return fs.String("format", tableFormat, "output format, json or table")
+}
+
+func checkFormat(format string) error {
+	if format != jsonFormat && format != tableFormat {
+		return fmt.Errorf("unknown format %q", format)
+	}
+	return nil
+}
+
+func runState(c *client, args []string) error {
+	fs := flag.NewFlagSet("state", flag.ExitOnError)
+	format := parseFormat(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+	var state hapb.HAKeeperState
+	if err := c.do(http.MethodGet, hakeeper.AdminStatePath, nil, &state); err != nil {
+		return err
+	}
+	if *format == jsonFormat {
+		return printJSON(os.Stdout, state)
+	}
+	return printState(os.Stdout, state)
+}
+
+func runOperators(c *client, args []string) error {
+	fs := flag.NewFlagSet("operators", flag.ExitOnError)
+	format := parseFormat(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+	var ops []hakeeper.OperatorStatus
+	if err := c.do(http.MethodGet, hakeeper.AdminOperatorsPath, nil, &ops); err != nil {
+		return err
+	}
+	if *format == jsonFormat {
+		return printJSON(os.Stdout, ops)
+	}
+	return printOperators(os.Stdout, ops)
+}
+
+func runAddReplica(c *client, args []string) error {
+	fs := flag.NewFlagSet(hakeeper.AddReplicaOp, flag.ExitOnError)
+	service := fs.String("service", hakeeper.LogServiceName, "service type, log or dn")
+	uuid := fs.String("uuid", "", "UUID of the store")
+	shardID := fs.Uint64("shard", 0, "shard ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return c.addOperator(hakeeper.OperatorRequest{
+		Type:    hakeeper.AddReplicaOp,
+		Service: *service,
+		UUID:    *uuid,
+		ShardID: *shardID,
+	})
+}
+
+func runRemoveReplica(c *client, args []string) error {
+	fs := flag.NewFlagSet(hakeeper.RemoveReplicaOp, flag.ExitOnError)
+	service := fs.String("service", hakeeper.LogServiceName, "service type, log or dn")
+	uuid := fs.String("uuid", "", "UUID of the store")
+	shardID := fs.Uint64("shard", 0, "shard ID")
+	replicaID := fs.Uint64("replica", 0, "replica ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return c.addOperator(hakeeper.OperatorRequest{
+		Type:      hakeeper.RemoveReplicaOp,
+		Service:   *service,
+		UUID:      *uuid,
+		ShardID:   *shardID,
+		ReplicaID: *replicaID,
+	})
+}
+
+func runTransferLeader(c *client, args []string) error {
+	fs := flag.NewFlagSet(hakeeper.TransferLeaderOp, flag.ExitOnError)
+	shardID := fs.Uint64("shard", 0, "log shard ID")
+	replicaID := fs.Uint64("replica", 0, "ID of the replica to become the leader")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return c.addOperator(hakeeper.OperatorRequest{
+		Type:      hakeeper.TransferLeaderOp,
+		Service:   hakeeper.LogServiceName,
+		ShardID:   *shardID,
+		ReplicaID: *replicaID,
+	})
+}
+
+func runCancel(c *client, args []string) error {
+	fs := flag.NewFlagSet("cancel", flag.ExitOnError)
+	id := fs.Uint64("id", 0, "operator ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == 0 {
+		return fmt.Errorf("operator ID not set")
+	}
+	path := fmt.Sprintf("%s/%d", hakeeper.AdminOperatorsPath, *id)
+	if err := c.do(http.MethodDelete, path, nil, nil); err != nil {
+		return err
+	}
+	fmt.Printf("operator %d canceled\n", *id)
+	return nil
+}
+
+func runStore(c *client, args []string) error {
+	fs := flag.NewFlagSet("store", flag.ExitOnError)
+	uuid := fs.String("uuid", "", "UUID of the store")
+	format := parseFormat(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+	if len(*uuid) == 0 {
+		return fmt.Errorf("UUID not set")
+	}
+	var status hakeeper.StoreStatus
+	path := fmt.Sprintf("%s/%s", hakeeper.AdminStoresPath, *uuid)
+	if err := c.do(http.MethodGet, path, nil, &status); err != nil {
+		return err
+	}
+	if *format == jsonFormat {
+		return printJSON(os.Stdout, status)
+	}
+	return printStoreStatus(os.Stdout, status)
+}
+
+// runStoreAction returns the command changing the state of the store, the
+// status of the store is printed after the change.
+func runStoreAction(action string) func(c *client, args []string) error {
+	return func(c *client, args []string) error {
+		fs := flag.NewFlagSet(action, flag.ExitOnError)
+		uuid := fs.String("uuid", "", "UUID of the store")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if len(*uuid) == 0 {
+			return fmt.Errorf("UUID not set")
+		}
+		var status hakeeper.StoreStatus
+		path := fmt.Sprintf("%s/%s/%s", hakeeper.AdminStoresPath, *uuid, action)
+		if err := c.do(http.MethodPost, path, nil, &status); err != nil {
+			return err
+		}
+		return printStoreStatus(os.Stdout, status)
+	}
+}
+
+// runExport exports the log shard, large shards may need a longer -timeout.
+func runExport(c *client, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	shardID := fs.Uint64("shard", 0, "log shard ID")
+	firstIndex := fs.Uint64("first-index", 1, "first index of the exported records")
+	path := fs.String("path", "", "path of the export in the backup directory")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	req := hakeeper.ExportRequest{
+		ShardID:    *shardID,
+		FirstIndex: *firstIndex,
+		Path:       *path,
+	}
+	if err := hakeeper.ValidateBackupPath(req.Path); err != nil {
+		return err
+	}
+	if err := c.do(http.MethodPost, hakeeper.AdminExportPath, req, nil); err != nil {
+		return err
+	}
+	fmt.Printf("shard %d exported to %s\n", req.ShardID, req.Path)
+	return nil
+}
+
+// runImport imports the log shard, large shards may need a longer -timeout.
+func runImport(c *client, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	shardID := fs.Uint64("shard", 0, "log shard ID")
+	path := fs.String("path", "", "path of the export in the backup directory")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	req := hakeeper.ImportRequest{
+		ShardID: *shardID,
+		Path:    *path,
+	}
+	if err := hakeeper.ValidateBackupPath(req.Path); err != nil {
+		return err
+	}
+	var resp hakeeper.ImportResponse
+	if err := c.do(http.MethodPost, hakeeper.AdminImportPath, req, &resp); err != nil {
+		return err
+	}
+	fmt.Printf("shard %d imported from %s, last lsn %d\n", req.ShardID, req.Path, resp.Lsn)
+	return nil
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// client is the client of the HAKeeper admin endpoint.
+type client struct {
+	address string
+	token   string
+	http    *http.Client
+}
+
+func (c *client) addOperator(req hakeeper.OperatorRequest) error {
+	// validate locally to report invalid flags early
+	if _, err := req.Operator(); err != nil {
+		return err
+	}
+	var resp hakeeper.AddOperatorResponse
+	if err := c.do(http.MethodPost, hakeeper.AdminOperatorsPath, req, &resp); err != nil {
+		return err
+	}
+	fmt.Printf("operator %d added\n", resp.ID)
+	return nil
+}
+
+func (c *client) do(method string, path string,
+	body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, "http://"+c.address+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if len(c.token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
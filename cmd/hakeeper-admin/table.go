@@ -0,0 +1,139 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+func newTabWriter(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+}
+
+func printState(w io.Writer, state hapb.HAKeeperState) error {
+	tw := newTabWriter(w)
+	fmt.Fprintf(tw, "Tick: %d\n", state.Tick)
+
+	fmt.Fprintf(tw, "\nLOG STORES\nUUID\tSTATE\tTICK\tSERVICE ADDRESS\tREPLICAS\n")
+	uuids := make([]string, 0, len(state.LogState.Stores))
+	for uuid := range state.LogState.Stores {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	for _, uuid := range uuids {
+		info := state.LogState.Stores[uuid]
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%d\n", uuid,
+			state.ClusterInfo.StoreStates[uuid], info.Tick,
+			info.ServiceAddress, len(info.Replicas))
+	}
+
+	fmt.Fprintf(tw, "\nLOG SHARDS\nSHARD\tEPOCH\tLEADER\tTERM\tREPLICAS\n")
+	shardIDs := make([]uint64, 0, len(state.LogState.Shards))
+	for shardID := range state.LogState.Shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	for _, shardID := range shardIDs {
+		info := state.LogState.Shards[shardID]
+		replicaIDs := make([]uint64, 0, len(info.Replicas))
+		for replicaID := range info.Replicas {
+			replicaIDs = append(replicaIDs, replicaID)
+		}
+		sort.Slice(replicaIDs, func(i, j int) bool { return replicaIDs[i] < replicaIDs[j] })
+		replicas := make([]string, 0, len(replicaIDs))
+		for _, replicaID := range replicaIDs {
+			replicas = append(replicas,
+				fmt.Sprintf("%d@%s", replicaID, info.Replicas[replicaID]))
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%s\n", shardID, info.Epoch,
+			info.LeaderID, info.Term, strings.Join(replicas, ","))
+	}
+
+	fmt.Fprintf(tw, "\nDN STORES\nUUID\tSTATE\tTICK\tSHARDS\n")
+	uuids = uuids[:0]
+	for uuid := range state.DNState.Stores {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	for _, uuid := range uuids {
+		info := state.DNState.Stores[uuid]
+		shards := make([]string, 0, len(info.Shards))
+		for _, shard := range info.Shards {
+			shards = append(shards, fmt.Sprintf("%d:%d", shard.ShardID, shard.ReplicaID))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", uuid,
+			state.ClusterInfo.StoreStates[uuid], info.Tick, strings.Join(shards, ","))
+	}
+
+	fmt.Fprintf(tw, "\nCN STORES\nUUID\tTICK\tSQL ADDRESS\tVERSION\tCONNECTIONS\n")
+	uuids = uuids[:0]
+	for uuid := range state.CNState.Stores {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	for _, uuid := range uuids {
+		info := state.CNState.Stores[uuid]
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%d\n", uuid, info.Tick,
+			info.SQLAddress, info.Version, info.Load.Connections)
+	}
+
+	fmt.Fprintf(tw, "\nSCHEDULE COMMANDS\nUUID\tSERVICE\tCHANGE\tSHARD\tREPLICA\n")
+	uuids = uuids[:0]
+	for uuid := range state.ScheduleCommands {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	for _, uuid := range uuids {
+		for _, cmd := range state.ScheduleCommands[uuid].Commands {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\n", uuid, cmd.ServiceType,
+				cmd.ConfigChange.ChangeType, cmd.ConfigChange.Replica.ShardID,
+				cmd.ConfigChange.Replica.ReplicaID)
+		}
+	}
+	return tw.Flush()
+}
+
+func printOperators(w io.Writer, ops []hakeeper.OperatorStatus) error {
+	tw := newTabWriter(w)
+	fmt.Fprintf(tw, "ID\tBRIEF\tSTEP\tUUID\tCHANGE\tSHARD\tREPLICA\tFINISHED\n")
+	for _, op := range ops {
+		for i, step := range op.Steps {
+			id, brief := "", ""
+			if i == 0 {
+				id, brief = fmt.Sprintf("%d", op.ID), op.Brief
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%d\t%d\t%t\n", id, brief, i,
+				step.UUID, step.ConfigChange.ChangeType,
+				step.ConfigChange.Replica.ShardID,
+				step.ConfigChange.Replica.ReplicaID, op.Finished[i])
+		}
+	}
+	return tw.Flush()
+}
+
+func printStoreStatus(w io.Writer, status hakeeper.StoreStatus) error {
+	tw := newTabWriter(w)
+	fmt.Fprintf(tw, "UUID\tSTATE\tLOG REPLICAS\tDN SHARDS\tSAFE TO STOP\n")
+	fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%t\n", status.UUID, status.State,
+		status.LogReplicas, status.DNShards, status.SafeToStop)
+	return tw.Flush()
+}
@@ -47,6 +47,10 @@ const (
 	ErrTxnAborted = 10000 + iota
 	// ErrTxnClosed read and write a transaction after Commit or Rollback method called.
 	ErrTxnClosed
+	// ErrTxnWriteConflict write conflict error for concurrent transactions
+	ErrTxnWriteConflict
+	// ErrDNShardNotFound the target DNShard of the request is not handled by the DN.
+	ErrDNShardNotFound
 )
 
 type Error struct {
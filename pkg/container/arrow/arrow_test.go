@@ -0,0 +1,279 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newVector(typ types.T, col interface{}, nullRows ...uint64) *vector.Vector {
+	v := vector.New(typ.ToType())
+	v.Col = col
+	nulls.Add(v.Nsp, nullRows...)
+	return v
+}
+
+func newBytes(vs ...string) *types.Bytes {
+	bs := &types.Bytes{}
+	for _, v := range vs {
+		bs.Offsets = append(bs.Offsets, uint32(len(bs.Data)))
+		bs.Lengths = append(bs.Lengths, uint32(len(v)))
+		bs.Data = append(bs.Data, v...)
+	}
+	return bs
+}
+
+func newTestBatch() *batch.Batch {
+	d1, _ := types.ParseDate("1969-12-31")
+	d2, _ := types.ParseDate("2022-07-01")
+	dt1, _ := types.ParseDatetime("1960-01-02 03:04:05.999999", 6)
+	dt2, _ := types.ParseDatetime("2022-07-01 12:00:00.5", 6)
+	dec1, _ := types.ParseStringToDecimal64("-12.34", 10, 2)
+	dec2, _ := types.ParseStringToDecimal128("12345678901234567890.5", 30, 1)
+
+	bat := batch.New(true, []string{"b", "i8", "i16", "i32", "i64", "u8", "u16", "u32", "u64",
+		"f32", "f64", "d64", "d128", "date", "datetime", "timestamp", "varchar", "char", "const"})
+	bat.Vecs[0] = newVector(types.T_bool, []bool{true, false, true}, 2)
+	bat.Vecs[1] = newVector(types.T_int8, []int8{-1, 0, 1})
+	bat.Vecs[2] = newVector(types.T_int16, []int16{-300, 0, 300}, 1)
+	bat.Vecs[3] = newVector(types.T_int32, []int32{-70000, 0, 70000})
+	bat.Vecs[4] = newVector(types.T_int64, []int64{-1 << 40, 0, 1 << 40}, 0)
+	bat.Vecs[5] = newVector(types.T_uint8, []uint8{255, 0, 1})
+	bat.Vecs[6] = newVector(types.T_uint16, []uint16{65535, 0, 1})
+	bat.Vecs[7] = newVector(types.T_uint32, []uint32{1 << 31, 0, 1})
+	bat.Vecs[8] = newVector(types.T_uint64, []uint64{1 << 63, 0, 1}, 1)
+	bat.Vecs[9] = newVector(types.T_float32, []float32{-1.5, 0, 1.5})
+	bat.Vecs[10] = newVector(types.T_float64, []float64{-2.5, 0, 2.5}, 2)
+	bat.Vecs[11] = newVector(types.T_decimal64, []types.Decimal64{dec1, 0, 1})
+	bat.Vecs[11].Typ.Width, bat.Vecs[11].Typ.Scale = 10, 2
+	bat.Vecs[12] = newVector(types.T_decimal128, []types.Decimal128{dec2, {}, {}}, 2)
+	bat.Vecs[12].Typ.Width, bat.Vecs[12].Typ.Scale = 30, 1
+	bat.Vecs[13] = newVector(types.T_date, []types.Date{d1, d2, 0}, 2)
+	bat.Vecs[14] = newVector(types.T_datetime, []types.Datetime{dt1, dt2, 0}, 2)
+	bat.Vecs[15] = newVector(types.T_timestamp, []types.Timestamp{types.Timestamp(dt1), types.Timestamp(dt2), 0}, 2)
+	bat.Vecs[16] = newVector(types.T_varchar, newBytes("abc", "", "\u4e2d\u6587"), 1)
+	bat.Vecs[17] = newVector(types.T_char, newBytes("x", "y", "z"))
+	bat.Vecs[18] = vector.NewConst(types.T_int64.ToType())
+	bat.Vecs[18].Col = []int64{42}
+	bat.Vecs[18].Length = 3
+	bat.InitZsOne(3)
+	return bat
+}
+
+// valueAt returns the value of the row of the vector
+func valueAt(v *vector.Vector, row int64) interface{} {
+	if v.IsScalar() {
+		row = 0
+	}
+	if vs, ok := v.Col.(*types.Bytes); ok {
+		return string(vs.Get(row))
+	}
+	return reflect.ValueOf(v.Col).Index(int(row)).Interface()
+}
+
+// requireBatchEqual checks the batch read equals to the rows of the batch written
+func requireBatchEqual(t *testing.T, expected, actual *batch.Batch, rows []int64) {
+	require.Equal(t, len(rows), actual.Length())
+	for i, ev := range expected.Vecs {
+		av := actual.Vecs[i]
+		for j, row := range rows {
+			isNull := nulls.Contains(ev.Nsp, uint64(row))
+			if ev.IsScalar() {
+				isNull = ev.IsScalarNull()
+			}
+			require.Equal(t, isNull, nulls.Contains(av.Nsp, uint64(j)), expected.Attrs[i])
+			if !isNull {
+				require.Equal(t, valueAt(ev, row), valueAt(av, int64(j)), expected.Attrs[i])
+			}
+		}
+	}
+}
+
+func TestWriteAndRead(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w := NewWriter(&buf, bat.Attrs)
+	require.NoError(t, w.Write(bat))
+	// the second batch only has the rows 2 and 0
+	bat.Sels = []int64{2, 0}
+	bat.Zs = []int64{1, 1}
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+
+	data := buf.Bytes()
+	require.Equal(t, "ARROW1", string(data[:6]))
+	require.Equal(t, "ARROW1", string(data[len(data)-6:]))
+
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, bat.Attrs, r.Attrs())
+	typs := r.Types()
+	require.Equal(t, types.T_varchar, typs[17].Oid)
+	require.Equal(t, types.T_decimal64, typs[11].Oid)
+	require.Equal(t, int32(2), typs[11].Scale)
+	require.Equal(t, types.T_decimal128, typs[12].Oid)
+	require.Equal(t, int32(1), typs[12].Scale)
+	require.Equal(t, types.T_datetime, typs[14].Oid)
+	require.Equal(t, types.T_timestamp, typs[15].Oid)
+
+	for _, rows := range [][]int64{{0, 1, 2}, {2, 0}} {
+		rbat, err := r.Read()
+		require.NoError(t, err)
+		requireBatchEqual(t, bat, rbat, rows)
+	}
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestFileLayout(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w := NewWriter(&buf, bat.Attrs)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	// the footer locates the record batches
+	size := int(le.Uint32(data[len(data)-10:]))
+	footerPos := len(data) - 10 - size
+	footer := fbRoot(data[footerPos : len(data)-10])
+	require.Equal(t, int16(metadataV5), footer.int16(0, 0))
+	schema, ok := footer.table(1)
+	require.True(t, ok)
+	fields, err := decodeSchema(schema)
+	require.NoError(t, err)
+	require.Equal(t, len(bat.Attrs), len(fields))
+	pos, n := footer.vector(3)
+	require.Equal(t, 2, n)
+	require.Equal(t, 0, pos%8)
+	for i := 0; i < n; i++ {
+		offset := int(le.Uint64(footer.buf[pos+24*i:]))
+		metaLen := int(le.Uint32(footer.buf[pos+24*i+8:]))
+		bodyLen := int(le.Uint64(footer.buf[pos+24*i+16:]))
+		require.Equal(t, 0, offset%8)
+		require.Equal(t, 0, metaLen%8)
+		require.Equal(t, continuation, le.Uint32(data[offset:]))
+		require.Equal(t, metaLen-8, int(le.Uint32(data[offset+4:])))
+		msg := fbRoot(data[offset+8 : offset+metaLen])
+		require.Equal(t, uint8(headerRecordBatch), msg.uint8(1, 0))
+		require.Equal(t, int64(bodyLen), msg.int64(3, 0))
+		require.LessOrEqual(t, offset+metaLen+bodyLen, footerPos)
+	}
+
+	// the stream without the header and the footer can be read too
+	stream := data[8:footerPos]
+	r, err := NewReader(bytes.NewReader(stream))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		rbat, err := r.Read()
+		require.NoError(t, err)
+		requireBatchEqual(t, bat, rbat, []int64{0, 1, 2})
+	}
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestEmptyFile(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, []string{"a", "b"})
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, r.Attrs())
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+
+	_, err = NewReader(bytes.NewReader(nil))
+	require.Equal(t, ErrNoSchema, err)
+}
+
+func TestNullColumn(t *testing.T) {
+	v := vector.New(types.T_any.ToType())
+	v.Length = 2
+	bat := batch.New(true, []string{"n"})
+	bat.Vecs[0] = v
+	bat.InitZsOne(2)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, bat.Attrs)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	rbat, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, 2, rbat.Length())
+	require.True(t, nulls.Contains(rbat.Vecs[0].Nsp, 0))
+	require.True(t, nulls.Contains(rbat.Vecs[0].Nsp, 1))
+}
+
+func TestCorruptedFile(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w := NewWriter(&buf, bat.Attrs)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	for _, n := range []int{16, 100, 500, len(data) / 2} {
+		r, err := NewReader(bytes.NewReader(data[:n]))
+		if err == nil {
+			_, err = r.Read()
+		}
+		require.Error(t, err)
+		require.NotEqual(t, io.EOF, err)
+	}
+
+	// garbage in the metadata
+	broken := append([]byte{}, data...)
+	for i := 16; i < 48; i++ {
+		broken[i] = 0xEE
+	}
+	_, err := NewReader(bytes.NewReader(broken))
+	require.Error(t, err)
+}
+
+func TestOversizedMessage(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w := NewWriter(&buf, bat.Attrs)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	// the metadata size of the schema message is beyond the limit
+	broken := append([]byte{}, data...)
+	le.PutUint32(broken[12:], math.MaxUint32-1)
+	_, err := NewReader(bytes.NewReader(broken))
+	require.Equal(t, ErrInvalidFile, err)
+
+	// the size is within the limit, but the file is truncated
+	le.PutUint32(broken[12:], maxMetadataSize)
+	_, err = NewReader(bytes.NewReader(broken[:64]))
+	require.Equal(t, ErrInvalidFile, err)
+}
@@ -0,0 +1,378 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+var (
+	// unixEpochDays, the days of 1970-01-01 since 0001-01-01
+	unixEpochDays = int64(types.FromCalendar(1970, 1, 1))
+	// unixEpochSeconds, the seconds of 1970-01-01 00:00:00 since 0001-01-01 00:00:00
+	unixEpochSeconds = unixEpochDays * 24 * 60 * 60
+)
+
+const (
+	microsPerSecond = 1000000
+	millisPerDay    = 24 * 60 * 60 * 1000
+)
+
+// column is the arrow array of a vector in a record batch
+type column struct {
+	length    int64
+	nullCount int64
+	// buffers, the validity bitmap followed by the offsets and the values
+	buffers [][]byte
+}
+
+func bitmapSize(n int) int {
+	return (n + 7) / 8
+}
+
+// floorDiv returns the quotient rounded to negative infinity
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// datetimeToMicros converts the datetime to the microseconds since unix epoch
+func datetimeToMicros(dt types.Datetime) int64 {
+	return (int64(dt)>>20-unixEpochSeconds)*microsPerSecond + int64(dt)&0xfffff
+}
+
+func microsToDatetime(us int64) types.Datetime {
+	sec := floorDiv(us, microsPerSecond)
+	return types.Datetime((sec+unixEpochSeconds)<<20 | (us - sec*microsPerSecond))
+}
+
+func gather[T, R any](vs []T, n int, index func(int) int64, conv func(T) R) []R {
+	rs := make([]R, n)
+	for i := range rs {
+		rs[i] = conv(vs[index(i)])
+	}
+	return rs
+}
+
+func same[T any](v T) T {
+	return v
+}
+
+func encodeFixed[T, R any](v *vector.Vector, n int, index func(int) int64, conv func(T) R) []byte {
+	var r R
+	return encoding.EncodeFixedSlice(gather(v.Col.([]T), n, index, conv), int(unsafe.Sizeof(r)))
+}
+
+// encodeVector converts the rows of the vector to the arrow array,
+// rows is nil if all rows of the vector are converted.
+func encodeVector(v *vector.Vector, rows []int64, n int) (column, error) {
+	index := func(i int) int64 {
+		if v.IsScalar() {
+			return 0
+		}
+		if rows != nil {
+			return rows[i]
+		}
+		return int64(i)
+	}
+	col := column{length: int64(n)}
+	if v.Typ.Oid == types.T_any {
+		col.nullCount = col.length
+		return col, nil
+	}
+
+	var validity []byte
+	if nulls.Any(v.Nsp) {
+		validity = make([]byte, bitmapSize(n))
+		for i := 0; i < n; i++ {
+			if nulls.Contains(v.Nsp, uint64(index(i))) {
+				col.nullCount++
+			} else {
+				validity[i>>3] |= 1 << (i & 7)
+			}
+		}
+		if col.nullCount == 0 {
+			validity = nil
+		}
+	}
+	if col.nullCount == col.length && v.IsScalarNull() {
+		// the values of a scalar null are not set
+		index = func(int) int64 { return -1 }
+	}
+
+	var data []byte
+	switch v.Typ.Oid {
+	case types.T_bool:
+		data = make([]byte, bitmapSize(n))
+		if col.nullCount < col.length {
+			vs := v.Col.([]bool)
+			for i := 0; i < n; i++ {
+				if vs[index(i)] {
+					data[i>>3] |= 1 << (i & 7)
+				}
+			}
+		}
+	case types.T_char, types.T_varchar:
+		offsets := make([]int32, n+1)
+		var buf []byte
+		if col.nullCount < col.length {
+			vs := v.Col.(*types.Bytes)
+			for i := 0; i < n; i++ {
+				buf = append(buf, vs.Get(index(i))...)
+				if len(buf) > math.MaxInt32 {
+					return col, ErrUnsupportedType
+				}
+				offsets[i+1] = int32(len(buf))
+			}
+		}
+		col.buffers = [][]byte{validity, encoding.EncodeInt32Slice(offsets), buf}
+		return col, nil
+	default:
+		if col.nullCount == col.length {
+			data = make([]byte, n*fixedSize(v.Typ))
+			break
+		}
+		switch v.Typ.Oid {
+		case types.T_int8:
+			data = encodeFixed(v, n, index, same[int8])
+		case types.T_int16:
+			data = encodeFixed(v, n, index, same[int16])
+		case types.T_int32:
+			data = encodeFixed(v, n, index, same[int32])
+		case types.T_int64:
+			data = encodeFixed(v, n, index, same[int64])
+		case types.T_uint8:
+			data = encodeFixed(v, n, index, same[uint8])
+		case types.T_uint16:
+			data = encodeFixed(v, n, index, same[uint16])
+		case types.T_uint32:
+			data = encodeFixed(v, n, index, same[uint32])
+		case types.T_uint64:
+			data = encodeFixed(v, n, index, same[uint64])
+		case types.T_float32:
+			data = encodeFixed(v, n, index, same[float32])
+		case types.T_float64:
+			data = encodeFixed(v, n, index, same[float64])
+		case types.T_decimal64:
+			data = encodeFixed(v, n, index, func(d types.Decimal64) types.Decimal128 {
+				return types.Decimal128{Lo: int64(d), Hi: int64(d) >> 63}
+			})
+		case types.T_decimal128:
+			data = encodeFixed(v, n, index, same[types.Decimal128])
+		case types.T_date:
+			data = encodeFixed(v, n, index, func(d types.Date) int32 {
+				return int32(int64(d) - unixEpochDays)
+			})
+		case types.T_datetime:
+			data = encodeFixed(v, n, index, datetimeToMicros)
+		case types.T_timestamp:
+			data = encodeFixed(v, n, index, func(ts types.Timestamp) int64 {
+				return datetimeToMicros(types.Datetime(ts))
+			})
+		default:
+			return col, ErrUnsupportedType
+		}
+	}
+	col.buffers = [][]byte{validity, data}
+	return col, nil
+}
+
+// fixedSize returns the size of the arrow value of the fixed length type
+func fixedSize(typ types.Type) int {
+	switch typ.Oid {
+	case types.T_decimal64, types.T_decimal128:
+		return 16
+	case types.T_date:
+		return 4
+	case types.T_datetime, types.T_timestamp:
+		return 8
+	}
+	return typ.Oid.TypeLen()
+}
+
+// bufferCount returns the number of the buffers of the arrow type
+func bufferCount(typeType uint8) int {
+	switch typeType {
+	case typeNull:
+		return 0
+	case typeUtf8, typeBinary, typeLargeUtf8, typeLargeBinary:
+		return 3
+	}
+	return 2
+}
+
+func decodeFixed[T, R any](data []byte, n int, conv func(T) R) ([]R, error) {
+	var t T
+	sz := int(unsafe.Sizeof(t))
+	if len(data) < n*sz {
+		return nil, ErrInvalidFile
+	}
+	vs := make([]R, n)
+	if n == 0 {
+		return vs, nil
+	}
+	for i, x := range encoding.DecodeFixedSlice[T](data[:n*sz], sz) {
+		vs[i] = conv(x)
+	}
+	return vs, nil
+}
+
+func decodeBytes[T int32 | int64](offsets []byte, data []byte, n int) (*types.Bytes, error) {
+	var t T
+	sz := int(unsafe.Sizeof(t))
+	if len(offsets) < (n+1)*sz {
+		return nil, ErrInvalidFile
+	}
+	offs := encoding.DecodeFixedSlice[T](offsets[:(n+1)*sz], sz)
+	if offs[0] < 0 || offs[n] < offs[0] || int64(offs[n]) > int64(len(data)) {
+		return nil, ErrInvalidFile
+	}
+	vs := &types.Bytes{
+		Data:    make([]byte, offs[n]-offs[0]),
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+	copy(vs.Data, data[offs[0]:offs[n]])
+	for i := 0; i < n; i++ {
+		if offs[i+1] < offs[i] {
+			return nil, ErrInvalidFile
+		}
+		vs.Offsets[i] = uint32(offs[i] - offs[0])
+		vs.Lengths[i] = uint32(offs[i+1] - offs[i])
+	}
+	return vs, nil
+}
+
+// decodeVector converts the arrow array to a vector
+func decodeVector(f field, col column) (*vector.Vector, error) {
+	n := int(col.length)
+	v := vector.New(f.typ)
+	if f.typeType == typeNull {
+		v.Col = &types.Bytes{
+			Offsets: make([]uint32, n),
+			Lengths: make([]uint32, n),
+		}
+		for i := 0; i < n; i++ {
+			nulls.Add(v.Nsp, uint64(i))
+		}
+		return v, nil
+	}
+
+	if validity := col.buffers[0]; col.nullCount > 0 && len(validity) > 0 {
+		if len(validity) < bitmapSize(n) {
+			return nil, ErrInvalidFile
+		}
+		for i := 0; i < n; i++ {
+			if validity[i>>3]&(1<<(i&7)) == 0 {
+				nulls.Add(v.Nsp, uint64(i))
+			}
+		}
+	}
+
+	var err error
+	data := col.buffers[len(col.buffers)-1]
+	switch f.typeType {
+	case typeUtf8, typeBinary:
+		v.Col, err = decodeBytes[int32](col.buffers[1], data, n)
+	case typeLargeUtf8, typeLargeBinary:
+		v.Col, err = decodeBytes[int64](col.buffers[1], data, n)
+	case typeBool:
+		if len(data) < bitmapSize(n) {
+			return nil, ErrInvalidFile
+		}
+		vs := make([]bool, n)
+		for i := range vs {
+			vs[i] = data[i>>3]&(1<<(i&7)) != 0
+		}
+		v.Col = vs
+	case typeDate:
+		if f.unit == dateDay {
+			v.Col, err = decodeFixed(data, n, func(d int32) types.Date {
+				return types.Date(int64(d) + unixEpochDays)
+			})
+		} else {
+			v.Col, err = decodeFixed(data, n, func(ms int64) types.Date {
+				return types.Date(floorDiv(ms, millisPerDay) + unixEpochDays)
+			})
+		}
+	case typeTimestamp:
+		toMicros := func(t int64) int64 {
+			switch f.unit {
+			case timeSecond:
+				return t * microsPerSecond
+			case timeMillisecond:
+				return t * 1000
+			case timeNanosecond:
+				return floorDiv(t, 1000)
+			}
+			return t
+		}
+		if f.typ.Oid == types.T_datetime {
+			v.Col, err = decodeFixed(data, n, func(t int64) types.Datetime {
+				return microsToDatetime(toMicros(t))
+			})
+		} else {
+			v.Col, err = decodeFixed(data, n, func(t int64) types.Timestamp {
+				return types.Timestamp(microsToDatetime(toMicros(t)))
+			})
+		}
+	case typeDecimal:
+		if f.typ.Oid == types.T_decimal64 {
+			v.Col, err = decodeFixed(data, n, func(d types.Decimal128) types.Decimal64 {
+				return types.Decimal64(d.Lo)
+			})
+		} else {
+			v.Col, err = decodeFixed(data, n, same[types.Decimal128])
+		}
+	default:
+		switch f.typ.Oid {
+		case types.T_int8:
+			v.Col, err = decodeFixed(data, n, same[int8])
+		case types.T_int16:
+			v.Col, err = decodeFixed(data, n, same[int16])
+		case types.T_int32:
+			v.Col, err = decodeFixed(data, n, same[int32])
+		case types.T_int64:
+			v.Col, err = decodeFixed(data, n, same[int64])
+		case types.T_uint8:
+			v.Col, err = decodeFixed(data, n, same[uint8])
+		case types.T_uint16:
+			v.Col, err = decodeFixed(data, n, same[uint16])
+		case types.T_uint32:
+			v.Col, err = decodeFixed(data, n, same[uint32])
+		case types.T_uint64:
+			v.Col, err = decodeFixed(data, n, same[uint64])
+		case types.T_float32:
+			v.Col, err = decodeFixed(data, n, same[float32])
+		case types.T_float64:
+			v.Col, err = decodeFixed(data, n, same[float64])
+		default:
+			return nil, ErrUnsupportedType
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
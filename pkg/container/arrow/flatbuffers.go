@@ -0,0 +1,283 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// The metadata of arrow IPC is serialized by flatbuffers. Only the small
+// subset needed by the schema, record batch and footer messages is
+// implemented here: tables of scalars, strings, tables and vectors of
+// tables or structs.
+
+var le = binary.LittleEndian
+
+// fbObject is an object of flatbuffers which can be referenced by offset
+type fbObject interface {
+	// write appends the object into the builder, and returns the position
+	// referenced by the offsets to the object
+	write(b *fbBuilder) int
+}
+
+type fbBuilder struct {
+	buf []byte
+}
+
+func (b *fbBuilder) pad(align int) {
+	for len(b.buf)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+func (b *fbBuilder) grow(n int) int {
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, n)...)
+	return pos
+}
+
+// finish serializes the root table, the size of the result is
+// a multiple of 8.
+func fbFinish(root *fbTable) []byte {
+	b := &fbBuilder{}
+	b.grow(4)
+	pos := root.write(b)
+	le.PutUint32(b.buf, uint32(pos))
+	b.pad(8)
+	return b.buf
+}
+
+type fbField struct {
+	slot  int
+	size  int
+	value uint64
+	// obj, the object referenced by the field, nil for scalars
+	obj fbObject
+}
+
+// fbTable is a table to serialize
+type fbTable struct {
+	fields []fbField
+}
+
+func (t *fbTable) addInt64(slot int, v int64) {
+	t.fields = append(t.fields, fbField{slot: slot, size: 8, value: uint64(v)})
+}
+
+func (t *fbTable) addInt32(slot int, v int32) {
+	t.fields = append(t.fields, fbField{slot: slot, size: 4, value: uint64(uint32(v))})
+}
+
+func (t *fbTable) addInt16(slot int, v int16) {
+	t.fields = append(t.fields, fbField{slot: slot, size: 2, value: uint64(uint16(v))})
+}
+
+func (t *fbTable) addUint8(slot int, v uint8) {
+	t.fields = append(t.fields, fbField{slot: slot, size: 1, value: uint64(v)})
+}
+
+func (t *fbTable) addBool(slot int, v bool) {
+	if v {
+		t.addUint8(slot, 1)
+	} else {
+		t.addUint8(slot, 0)
+	}
+}
+
+func (t *fbTable) addObject(slot int, obj fbObject) {
+	t.fields = append(t.fields, fbField{slot: slot, size: 4, obj: obj})
+}
+
+// write lays out the vtable, then the table with the inline fields sorted
+// by size, and then the objects referenced by the table.
+func (t *fbTable) write(b *fbBuilder) int {
+	fields := make([]fbField, len(t.fields))
+	copy(fields, t.fields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].size > fields[j].size
+	})
+	slots := 0
+	offsets := make([]int, len(fields))
+	size := 4
+	for i, f := range fields {
+		if f.slot+1 > slots {
+			slots = f.slot + 1
+		}
+		for size%f.size != 0 {
+			size++
+		}
+		offsets[i] = size
+		size += f.size
+	}
+
+	b.pad(2)
+	vtable := b.grow(4 + 2*slots)
+	le.PutUint16(b.buf[vtable:], uint16(4+2*slots))
+	le.PutUint16(b.buf[vtable+2:], uint16(size))
+	for i, f := range fields {
+		le.PutUint16(b.buf[vtable+4+2*f.slot:], uint16(offsets[i]))
+	}
+
+	b.pad(8)
+	pos := b.grow(size)
+	le.PutUint32(b.buf[pos:], uint32(int32(pos-vtable)))
+	for i, f := range fields {
+		p := pos + offsets[i]
+		switch f.size {
+		case 1:
+			b.buf[p] = byte(f.value)
+		case 2:
+			le.PutUint16(b.buf[p:], uint16(f.value))
+		case 4:
+			le.PutUint32(b.buf[p:], uint32(f.value))
+		case 8:
+			le.PutUint64(b.buf[p:], f.value)
+		}
+	}
+	for i, f := range fields {
+		if f.obj != nil {
+			p := pos + offsets[i]
+			child := f.obj.write(b)
+			le.PutUint32(b.buf[p:], uint32(child-p))
+		}
+	}
+	return pos
+}
+
+type fbString string
+
+func (s fbString) write(b *fbBuilder) int {
+	b.pad(4)
+	pos := b.grow(4)
+	le.PutUint32(b.buf[pos:], uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+	return pos
+}
+
+// fbTables is a vector of tables
+type fbTables []*fbTable
+
+func (ts fbTables) write(b *fbBuilder) int {
+	b.pad(4)
+	pos := b.grow(4 + 4*len(ts))
+	le.PutUint32(b.buf[pos:], uint32(len(ts)))
+	for i, t := range ts {
+		p := pos + 4 + 4*i
+		child := t.write(b)
+		le.PutUint32(b.buf[p:], uint32(child-p))
+	}
+	return pos
+}
+
+// fbStructs is a vector of structs aligned to 8 bytes
+type fbStructs struct {
+	n    int
+	data []byte
+}
+
+func (s fbStructs) write(b *fbBuilder) int {
+	for (len(b.buf)+4)%8 != 0 {
+		b.buf = append(b.buf, 0)
+	}
+	pos := b.grow(4)
+	le.PutUint32(b.buf[pos:], uint32(s.n))
+	b.buf = append(b.buf, s.data...)
+	return pos
+}
+
+// fbView reads a serialized table
+type fbView struct {
+	buf []byte
+	pos int
+}
+
+func fbRoot(buf []byte) fbView {
+	return fbView{buf: buf, pos: int(le.Uint32(buf))}
+}
+
+// offset returns the position of the field in the table, 0 if it is absent
+func (v fbView) offset(slot int) int {
+	vtable := v.pos - int(int32(le.Uint32(v.buf[v.pos:])))
+	if o := 4 + 2*slot; o < int(le.Uint16(v.buf[vtable:])) {
+		if off := int(le.Uint16(v.buf[vtable+o:])); off != 0 {
+			return v.pos + off
+		}
+	}
+	return 0
+}
+
+func (v fbView) int64(slot int, def int64) int64 {
+	if p := v.offset(slot); p != 0 {
+		return int64(le.Uint64(v.buf[p:]))
+	}
+	return def
+}
+
+func (v fbView) int32(slot int, def int32) int32 {
+	if p := v.offset(slot); p != 0 {
+		return int32(le.Uint32(v.buf[p:]))
+	}
+	return def
+}
+
+func (v fbView) int16(slot int, def int16) int16 {
+	if p := v.offset(slot); p != 0 {
+		return int16(le.Uint16(v.buf[p:]))
+	}
+	return def
+}
+
+func (v fbView) uint8(slot int, def uint8) uint8 {
+	if p := v.offset(slot); p != 0 {
+		return v.buf[p]
+	}
+	return def
+}
+
+func (v fbView) deref(p int) int {
+	return p + int(le.Uint32(v.buf[p:]))
+}
+
+func (v fbView) table(slot int) (fbView, bool) {
+	if p := v.offset(slot); p != 0 {
+		return fbView{buf: v.buf, pos: v.deref(p)}, true
+	}
+	return fbView{}, false
+}
+
+func (v fbView) string(slot int) string {
+	if p := v.offset(slot); p != 0 {
+		p = v.deref(p)
+		n := int(le.Uint32(v.buf[p:]))
+		return string(v.buf[p+4 : p+4+n])
+	}
+	return ""
+}
+
+// vector returns the position of the first element and the length of the vector
+func (v fbView) vector(slot int) (int, int) {
+	if p := v.offset(slot); p != 0 {
+		p = v.deref(p)
+		return p + 4, int(le.Uint32(v.buf[p:]))
+	}
+	return 0, 0
+}
+
+// tableAt returns the i-th table of the vector of tables starting at pos
+func (v fbView) tableAt(pos, i int) fbView {
+	return fbView{buf: v.buf, pos: v.deref(pos + 4*i)}
+}
@@ -0,0 +1,411 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+/*
+ * arrow IPC file format:
+ *		magic || padding || schema message || record batch messages || eos || footer || footer size || magic
+ * message:
+ *		continuation || metadata size || metadata || padding || body
+ */
+
+// block is the position of a record batch in the file
+type block struct {
+	offset  int64
+	metaLen int32
+	bodyLen int64
+}
+
+// Writer writes batches into an arrow IPC file
+type Writer struct {
+	w     io.Writer
+	attrs []string
+	// typs, the types of the columns, nil until the schema is written
+	typs []types.Type
+	// pos, the bytes written
+	pos    int64
+	blocks []block
+}
+
+func NewWriter(w io.Writer, attrs []string) *Writer {
+	return &Writer{w: w, attrs: attrs}
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.pos += int64(n)
+	return err
+}
+
+// begin writes the header and the schema of the file
+func (w *Writer) begin(typs []types.Type) error {
+	schema, err := encodeSchema(w.attrs, typs)
+	if err != nil {
+		return err
+	}
+	w.typs = typs
+	if err := w.write([]byte("ARROW1\x00\x00")); err != nil {
+		return err
+	}
+	_, err = w.writeMessage(headerSchema, schema, nil)
+	return err
+}
+
+func (w *Writer) writeMessage(headerType uint8, header *fbTable, body []byte) (block, error) {
+	msg := &fbTable{}
+	msg.addInt16(0, metadataV5)
+	msg.addUint8(1, headerType)
+	msg.addObject(2, header)
+	msg.addInt64(3, int64(len(body)))
+	meta := fbFinish(msg)
+
+	blk := block{offset: w.pos, metaLen: int32(8 + len(meta)), bodyLen: int64(len(body))}
+	prefix := make([]byte, 8)
+	le.PutUint32(prefix, continuation)
+	le.PutUint32(prefix[4:], uint32(len(meta)))
+	if err := w.write(prefix); err != nil {
+		return blk, err
+	}
+	if err := w.write(meta); err != nil {
+		return blk, err
+	}
+	return blk, w.write(body)
+}
+
+// batchRows returns the rows of the batch to write, nil if all rows are written
+func batchRows(bat *batch.Batch) ([]int64, int) {
+	n := vector.Length(bat.Vecs[0])
+	if len(bat.Sels) != 0 {
+		n = len(bat.Sels)
+	} else {
+		all := true
+		for j := 0; j < n && j < len(bat.Zs); j++ {
+			if bat.Zs[j] <= 0 {
+				all = false
+				break
+			}
+		}
+		if all {
+			return nil, n
+		}
+	}
+	rows := make([]int64, 0, n)
+	for j := 0; j < n; j++ {
+		if j < len(bat.Zs) && bat.Zs[j] <= 0 {
+			continue
+		}
+		if len(bat.Sels) != 0 {
+			rows = append(rows, bat.Sels[j])
+		} else {
+			rows = append(rows, int64(j))
+		}
+	}
+	return rows, len(rows)
+}
+
+// Write converts the batch to a record batch of the file. The schema of
+// the file is decided by the types of the first batch.
+func (w *Writer) Write(bat *batch.Batch) error {
+	if len(bat.Vecs) != len(w.attrs) {
+		return fmt.Errorf("arrow writer expects %d columns, but got %d", len(w.attrs), len(bat.Vecs))
+	}
+	if w.typs == nil {
+		typs := make([]types.Type, len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			typs[i] = vec.Typ
+		}
+		if err := w.begin(typs); err != nil {
+			return err
+		}
+	}
+	if len(bat.Vecs) == 0 {
+		return nil
+	}
+
+	rows, n := batchRows(bat)
+	var body []byte
+	var nodes, buffers []byte
+	for i, vec := range bat.Vecs {
+		if vec.Typ.Oid != w.typs[i].Oid {
+			return fmt.Errorf("arrow writer expects %s for column '%s', but got %s", w.typs[i], w.attrs[i], vec.Typ)
+		}
+		col, err := encodeVector(vec, rows, n)
+		if err != nil {
+			return err
+		}
+		nodes = le.AppendUint64(nodes, uint64(col.length))
+		nodes = le.AppendUint64(nodes, uint64(col.nullCount))
+		for _, buf := range col.buffers {
+			buffers = le.AppendUint64(buffers, uint64(len(body)))
+			buffers = le.AppendUint64(buffers, uint64(len(buf)))
+			body = append(body, buf...)
+			for len(body)%8 != 0 {
+				body = append(body, 0)
+			}
+		}
+	}
+	rb := &fbTable{}
+	rb.addInt64(0, int64(n))
+	rb.addObject(1, fbStructs{n: len(nodes) / 16, data: nodes})
+	rb.addObject(2, fbStructs{n: len(buffers) / 16, data: buffers})
+	blk, err := w.writeMessage(headerRecordBatch, rb, body)
+	if err != nil {
+		return err
+	}
+	w.blocks = append(w.blocks, blk)
+	return nil
+}
+
+// Close writes the footer of the file. If no batch is written, the columns
+// of the file are of the null type.
+func (w *Writer) Close() error {
+	if w.typs == nil {
+		typs := make([]types.Type, len(w.attrs))
+		for i := range typs {
+			typs[i] = types.T_any.ToType()
+		}
+		if err := w.begin(typs); err != nil {
+			return err
+		}
+	}
+	// end of stream
+	if err := w.write([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0}); err != nil {
+		return err
+	}
+
+	schema, err := encodeSchema(w.attrs, w.typs)
+	if err != nil {
+		return err
+	}
+	var blocks []byte
+	for _, blk := range w.blocks {
+		blocks = le.AppendUint64(blocks, uint64(blk.offset))
+		blocks = le.AppendUint32(blocks, uint32(blk.metaLen))
+		blocks = le.AppendUint32(blocks, 0)
+		blocks = le.AppendUint64(blocks, uint64(blk.bodyLen))
+	}
+	footer := &fbTable{}
+	footer.addInt16(0, metadataV5)
+	footer.addObject(1, schema)
+	footer.addObject(2, fbStructs{})
+	footer.addObject(3, fbStructs{n: len(w.blocks), data: blocks})
+	data := fbFinish(footer)
+	data = le.AppendUint32(data, uint32(len(data)))
+	return w.write(append(data, magic...))
+}
+
+// Reader reads the batches from an arrow IPC file or stream
+type Reader struct {
+	r io.Reader
+	// file, true if it is the file format, where the footer follows the record batches
+	file   bool
+	fields []field
+}
+
+// NewReader reads the schema of the arrow IPC file or stream
+func NewReader(r io.Reader) (*Reader, error) {
+	head := make([]byte, 8)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, ErrNoSchema
+	}
+	rd := &Reader{r: r}
+	if bytes.HasPrefix(head, magic) {
+		rd.file = true
+	} else {
+		rd.r = io.MultiReader(bytes.NewReader(head), r)
+	}
+	headerType, header, _, err := rd.readMessage()
+	if err != nil {
+		if err == io.EOF {
+			err = ErrNoSchema
+		}
+		return nil, err
+	}
+	if headerType != headerSchema {
+		return nil, ErrNoSchema
+	}
+	if err = protect(func() error {
+		rd.fields, err = decodeSchema(header)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+// Attrs returns the names of the columns
+func (r *Reader) Attrs() []string {
+	attrs := make([]string, len(r.fields))
+	for i, f := range r.fields {
+		attrs[i] = f.name
+	}
+	return attrs
+}
+
+// Types returns the types of the columns
+func (r *Reader) Types() []types.Type {
+	typs := make([]types.Type, len(r.fields))
+	for i, f := range r.fields {
+		typs[i] = f.typ
+	}
+	return typs
+}
+
+// protect converts the panic of reading the corrupted metadata to an error
+func protect(fn func() error) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = ErrInvalidFile
+		}
+	}()
+	return fn()
+}
+
+// readMessage returns the header and the body of the next message, io.EOF
+// at the end of the stream
+func (r *Reader) readMessage() (uint8, fbView, []byte, error) {
+	var header fbView
+	prefix := make([]byte, 4)
+	if _, err := io.ReadFull(r.r, prefix); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = ErrInvalidFile
+		}
+		return 0, header, nil, err
+	}
+	size := le.Uint32(prefix)
+	if size == continuation {
+		if _, err := io.ReadFull(r.r, prefix); err != nil {
+			return 0, header, nil, ErrInvalidFile
+		}
+		size = le.Uint32(prefix)
+	} else if r.file {
+		// the footer of the file without the end of stream
+		return 0, header, nil, io.EOF
+	}
+	if size == 0 {
+		return 0, header, nil, io.EOF
+	}
+	if size > maxMetadataSize {
+		return 0, header, nil, ErrInvalidFile
+	}
+
+	meta, err := readN(r.r, int64(size))
+	if err != nil {
+		return 0, header, nil, err
+	}
+	var headerType uint8
+	var bodyLen int64
+	if err := protect(func() error {
+		msg := fbRoot(meta)
+		headerType = msg.uint8(1, 0)
+		bodyLen = msg.int64(3, 0)
+		var ok bool
+		if header, ok = msg.table(2); !ok {
+			return ErrInvalidFile
+		}
+		return nil
+	}); err != nil {
+		return 0, header, nil, err
+	}
+	if bodyLen < 0 || bodyLen > maxBodySize {
+		return 0, header, nil, ErrInvalidFile
+	}
+	body, err := readN(r.r, bodyLen)
+	if err != nil {
+		return 0, header, nil, err
+	}
+	return headerType, header, body, nil
+}
+
+// readN reads n bytes. The buffer grows with the bytes actually read instead
+// of being allocated up front, so a corrupted size in a truncated file can't
+// allocate more memory than the file holds.
+func readN(r io.Reader, n int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r, n); err != nil {
+		return nil, ErrInvalidFile
+	}
+	return buf.Bytes(), nil
+}
+
+// Read returns the next record batch as a batch, io.EOF if there are no
+// more batches.
+func (r *Reader) Read() (*batch.Batch, error) {
+	headerType, header, body, err := r.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	if headerType != headerRecordBatch {
+		return nil, errors.New("unsupported arrow message, only record batches are supported")
+	}
+
+	bat := batch.New(true, r.Attrs())
+	err = protect(func() error {
+		if _, ok := header.table(3); ok {
+			return errors.New("compressed arrow record batch is not supported")
+		}
+		length := header.int64(0, 0)
+		nodePos, nodeCnt := header.vector(1)
+		bufPos, bufCnt := header.vector(2)
+		if nodeCnt != len(r.fields) {
+			return ErrInvalidFile
+		}
+		for i, f := range r.fields {
+			col := column{
+				length:    int64(le.Uint64(header.buf[nodePos+16*i:])),
+				nullCount: int64(le.Uint64(header.buf[nodePos+16*i+8:])),
+			}
+			if col.length != length {
+				return ErrInvalidFile
+			}
+			cnt := bufferCount(f.typeType)
+			if bufCnt < cnt {
+				return ErrInvalidFile
+			}
+			for j := 0; j < cnt; j++ {
+				offset := int64(le.Uint64(header.buf[bufPos:]))
+				size := int64(le.Uint64(header.buf[bufPos+8:]))
+				if offset < 0 || size < 0 || offset+size > int64(len(body)) {
+					return ErrInvalidFile
+				}
+				col.buffers = append(col.buffers, body[offset:offset+size])
+				bufPos += 16
+			}
+			bufCnt -= cnt
+			vec, err := decodeVector(f, col)
+			if err != nil {
+				return err
+			}
+			bat.Vecs[i] = vec
+		}
+		bat.InitZsOne(int(length))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bat, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	ErrInvalidFile     = errors.New("invalid arrow file")
+	ErrNoSchema        = errors.New("arrow schema is not found")
+	ErrUnsupportedType = errors.New("unsupported arrow type")
+)
+
+var (
+	// magic is the leading and trailing bytes of arrow IPC files
+	magic = []byte("ARROW1")
+	// continuation is the marker before the metadata of each message
+	continuation = uint32(0xFFFFFFFF)
+)
+
+// the limits of the sizes read from the file, the larger messages are rejected
+const (
+	maxMetadataSize = 64 << 20
+	maxBodySize     = 2 << 30
+)
+
+// metadataV5 is the version of the arrow columnar format 1.0
+const metadataV5 = 4
+
+// the types of MessageHeader
+const (
+	headerSchema      = 1
+	headerRecordBatch = 3
+)
+
+// the types of Type
+const (
+	typeNull          = 1
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeBinary        = 4
+	typeUtf8          = 5
+	typeBool          = 6
+	typeDecimal       = 7
+	typeDate          = 8
+	typeTimestamp     = 10
+	typeLargeBinary   = 19
+	typeLargeUtf8     = 20
+)
+
+// the precisions of FloatingPoint
+const (
+	precisionHalf   = 0
+	precisionSingle = 1
+	precisionDouble = 2
+)
+
+// the units of Date
+const (
+	dateDay         = 0
+	dateMillisecond = 1
+)
+
+// the units of Timestamp
+const (
+	timeSecond      = 0
+	timeMillisecond = 1
+	timeMicrosecond = 2
+	timeNanosecond  = 3
+)
+
+// the max precisions of decimal64 and decimal128
+const (
+	maxDecimal64Precision  = 18
+	maxDecimal128Precision = 38
+)
+
+// timezone of the arrow timestamps converted from T_timestamp,
+// T_datetime is converted to the timestamp without timezone.
+const utc = "UTC"
+
+/*
+ * the fields of the arrow flatbuffers tables, by the slot number
+ *
+ *   Message:       version, header_type, header, bodyLength
+ *   Schema:        endianness, fields
+ *   Field:         name, nullable, type_type, type, dictionary, children
+ *   RecordBatch:   length, nodes, buffers, compression
+ *   Footer:        version, schema, dictionaries, recordBatches
+ *   Int:           bitWidth, is_signed
+ *   FloatingPoint: precision
+ *   Decimal:       precision, scale, bitWidth
+ *   Date:          unit
+ *   Timestamp:     unit, timezone
+ */
+
+// encodeType returns the arrow type of typ
+func encodeType(typ types.Type) (uint8, *fbTable, error) {
+	t := &fbTable{}
+	switch typ.Oid {
+	case types.T_bool:
+		return typeBool, t, nil
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		t.addInt32(0, int32(typ.Oid.TypeLen()*8))
+		t.addBool(1, true)
+		return typeInt, t, nil
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		t.addInt32(0, int32(typ.Oid.TypeLen()*8))
+		t.addBool(1, false)
+		return typeInt, t, nil
+	case types.T_float32:
+		t.addInt16(0, precisionSingle)
+		return typeFloatingPoint, t, nil
+	case types.T_float64:
+		t.addInt16(0, precisionDouble)
+		return typeFloatingPoint, t, nil
+	case types.T_decimal64, types.T_decimal128:
+		precision := typ.Width
+		if precision <= 0 {
+			precision = maxDecimal64Precision
+			if typ.Oid == types.T_decimal128 {
+				precision = maxDecimal128Precision
+			}
+		}
+		t.addInt32(0, precision)
+		t.addInt32(1, typ.Scale)
+		t.addInt32(2, 128)
+		return typeDecimal, t, nil
+	case types.T_date:
+		t.addInt16(0, dateDay)
+		return typeDate, t, nil
+	case types.T_datetime:
+		t.addInt16(0, timeMicrosecond)
+		return typeTimestamp, t, nil
+	case types.T_timestamp:
+		t.addInt16(0, timeMicrosecond)
+		t.addObject(1, fbString(utc))
+		return typeTimestamp, t, nil
+	case types.T_char, types.T_varchar:
+		return typeUtf8, t, nil
+	case types.T_any:
+		return typeNull, t, nil
+	}
+	return 0, nil, ErrUnsupportedType
+}
+
+// decodeType returns the type of the arrow type
+func decodeType(typeType uint8, t fbView) (types.Type, error) {
+	switch typeType {
+	case typeNull:
+		return types.T_varchar.ToType(), nil
+	case typeBool:
+		return types.T_bool.ToType(), nil
+	case typeInt:
+		signed := t.uint8(1, 0) != 0
+		switch t.int32(0, 0) {
+		case 8:
+			if signed {
+				return types.T_int8.ToType(), nil
+			}
+			return types.T_uint8.ToType(), nil
+		case 16:
+			if signed {
+				return types.T_int16.ToType(), nil
+			}
+			return types.T_uint16.ToType(), nil
+		case 32:
+			if signed {
+				return types.T_int32.ToType(), nil
+			}
+			return types.T_uint32.ToType(), nil
+		case 64:
+			if signed {
+				return types.T_int64.ToType(), nil
+			}
+			return types.T_uint64.ToType(), nil
+		}
+	case typeFloatingPoint:
+		switch t.int16(0, precisionHalf) {
+		case precisionSingle:
+			return types.T_float32.ToType(), nil
+		case precisionDouble:
+			return types.T_float64.ToType(), nil
+		}
+	case typeDecimal:
+		if t.int32(2, 128) != 128 {
+			break
+		}
+		precision := t.int32(0, 0)
+		typ := types.T_decimal128.ToType()
+		if precision <= maxDecimal64Precision {
+			typ = types.T_decimal64.ToType()
+		}
+		typ.Width = precision
+		typ.Scale = t.int32(1, 0)
+		return typ, nil
+	case typeDate:
+		return types.T_date.ToType(), nil
+	case typeTimestamp:
+		if t.string(1) == "" {
+			return types.T_datetime.ToType(), nil
+		}
+		return types.T_timestamp.ToType(), nil
+	case typeUtf8, typeBinary, typeLargeUtf8, typeLargeBinary:
+		return types.T_varchar.ToType(), nil
+	}
+	return types.Type{}, ErrUnsupportedType
+}
+
+func encodeSchema(attrs []string, typs []types.Type) (*fbTable, error) {
+	fields := make(fbTables, len(typs))
+	for i, typ := range typs {
+		typeType, typeTable, err := encodeType(typ)
+		if err != nil {
+			return nil, err
+		}
+		f := &fbTable{}
+		f.addObject(0, fbString(attrs[i]))
+		f.addBool(1, true)
+		f.addUint8(2, typeType)
+		f.addObject(3, typeTable)
+		f.addObject(5, fbTables{})
+		fields[i] = f
+	}
+	schema := &fbTable{}
+	schema.addInt16(0, 0)
+	schema.addObject(1, fields)
+	return schema, nil
+}
+
+// field is a column of the arrow schema
+type field struct {
+	name     string
+	typ      types.Type
+	typeType uint8
+	// unit, the unit of date and timestamp
+	unit int16
+}
+
+func decodeSchema(schema fbView) ([]field, error) {
+	pos, n := schema.vector(1)
+	fields := make([]field, n)
+	for i := range fields {
+		f := schema.tableAt(pos, i)
+		if _, ok := f.table(4); ok {
+			return nil, ErrUnsupportedType
+		}
+		if _, cnt := f.vector(5); cnt > 0 {
+			return nil, ErrUnsupportedType
+		}
+		t, ok := f.table(3)
+		if !ok {
+			return nil, ErrInvalidFile
+		}
+		fields[i].name = f.string(0)
+		fields[i].typeType = f.uint8(2, 0)
+		switch fields[i].typeType {
+		case typeDate:
+			fields[i].unit = t.int16(0, dateMillisecond)
+		case typeTimestamp:
+			fields[i].unit = t.int16(0, timeSecond)
+		}
+		typ, err := decodeType(fields[i].typeType, t)
+		if err != nil {
+			return nil, err
+		}
+		fields[i].typ = typ
+	}
+	return fields, nil
+}
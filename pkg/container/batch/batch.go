@@ -230,6 +230,17 @@ func (bat *Batch) Length() int {
 	return len(bat.Zs)
 }
 
+// Size returns the size of the column data of the batch in bytes
+func (bat *Batch) Size() int {
+	size := 0
+	for _, vec := range bat.Vecs {
+		if vec != nil {
+			size += vector.Size(vec)
+		}
+	}
+	return size
+}
+
 func (bat *Batch) Prefetch(poses []int32, vecs []*vector.Vector) {
 	for i, pos := range poses {
 		vecs[i] = bat.GetVector(pos)
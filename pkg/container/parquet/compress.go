@@ -0,0 +1,109 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// the compression codecs
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func initZstd() {
+	zstdOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxPageSize))
+	})
+}
+
+func compress(codec int32, data []byte) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Encode(nil, data), nil
+	case codecGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case codecZstd:
+		initZstd()
+		return zstdEncoder.EncodeAll(data, nil), nil
+	}
+	return nil, fmt.Errorf("unsupported parquet compression codec %d", codec)
+}
+
+// decompress returns the decompressed data of the size. The size is read from
+// the file, so the output is never allocated by it up front.
+func decompress(codec int32, data []byte, size int) ([]byte, error) {
+	if size < 0 || size > maxPageSize {
+		return nil, ErrInvalidFile
+	}
+	var out []byte
+	var err error
+	switch codec {
+	case codecUncompressed:
+		out = data
+	case codecSnappy:
+		var n int
+		if n, err = snappy.DecodedLen(data); err == nil {
+			if n != size {
+				return nil, ErrInvalidFile
+			}
+			out, err = snappy.Decode(nil, data)
+		}
+	case codecGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(data)); err == nil {
+			var buf bytes.Buffer
+			_, err = io.CopyN(&buf, r, int64(size))
+			out = buf.Bytes()
+		}
+	case codecZstd:
+		initZstd()
+		out, err = zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("unsupported parquet compression codec %d", codec)
+	}
+	if err != nil || len(out) != size {
+		return nil, ErrInvalidFile
+	}
+	return out, nil
+}
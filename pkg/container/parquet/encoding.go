@@ -0,0 +1,214 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// julianUnixEpoch is the julian day of 1970-01-01, for the INT96 timestamps
+const julianUnixEpoch = 2440588
+
+// values holds the decoded values of a column chunk, by the physical type.
+// The INT96 values are converted to the microseconds since unix epoch.
+type values struct {
+	bools   []bool
+	int32s  []int32
+	int64s  []int64
+	floats  []float32
+	doubles []float64
+	bytes   [][]byte
+}
+
+func (vs *values) len(physical int32) int {
+	switch physical {
+	case typeBoolean:
+		return len(vs.bools)
+	case typeInt32:
+		return len(vs.int32s)
+	case typeInt64, typeInt96:
+		return len(vs.int64s)
+	case typeFloat:
+		return len(vs.floats)
+	case typeDouble:
+		return len(vs.doubles)
+	}
+	return len(vs.bytes)
+}
+
+// decodePlain appends n values of the PLAIN encoding
+func (vs *values) decodePlain(f *field, data []byte, n int) error {
+	size := 0
+	switch f.physical {
+	case typeInt32, typeFloat:
+		size = 4
+	case typeInt64, typeDouble:
+		size = 8
+	case typeInt96:
+		size = 12
+	case typeFixedLenByteArray:
+		size = f.typeLength
+	}
+	if size > 0 && len(data) < n*size {
+		return ErrInvalidFile
+	}
+	le := binary.LittleEndian
+	switch f.physical {
+	case typeBoolean:
+		if len(data) < (n+7)/8 {
+			return ErrInvalidFile
+		}
+		for i := 0; i < n; i++ {
+			vs.bools = append(vs.bools, data[i>>3]&(1<<(i&7)) != 0)
+		}
+	case typeInt32:
+		for i := 0; i < n; i++ {
+			vs.int32s = append(vs.int32s, int32(le.Uint32(data[i*4:])))
+		}
+	case typeInt64:
+		for i := 0; i < n; i++ {
+			vs.int64s = append(vs.int64s, int64(le.Uint64(data[i*8:])))
+		}
+	case typeInt96:
+		for i := 0; i < n; i++ {
+			nanos := int64(le.Uint64(data[i*12:]))
+			days := int64(le.Uint32(data[i*12+8:])) - julianUnixEpoch
+			vs.int64s = append(vs.int64s, days*24*60*60*1000000+nanos/1000)
+		}
+	case typeFloat:
+		for i := 0; i < n; i++ {
+			vs.floats = append(vs.floats, math.Float32frombits(le.Uint32(data[i*4:])))
+		}
+	case typeDouble:
+		for i := 0; i < n; i++ {
+			vs.doubles = append(vs.doubles, math.Float64frombits(le.Uint64(data[i*8:])))
+		}
+	case typeByteArray:
+		for i := 0; i < n; i++ {
+			if len(data) < 4 {
+				return ErrInvalidFile
+			}
+			l := int(le.Uint32(data))
+			if l < 0 || len(data) < 4+l {
+				return ErrInvalidFile
+			}
+			vs.bytes = append(vs.bytes, data[4:4+l])
+			data = data[4+l:]
+		}
+	case typeFixedLenByteArray:
+		for i := 0; i < n; i++ {
+			vs.bytes = append(vs.bytes, data[i*size:(i+1)*size])
+		}
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// appendIndexed appends the values of the dictionary by the indexes
+func (vs *values) appendIndexed(dict *values, physical int32, indexes []uint32) error {
+	n := uint32(dict.len(physical))
+	for _, idx := range indexes {
+		if idx >= n {
+			return ErrInvalidFile
+		}
+		switch physical {
+		case typeBoolean:
+			vs.bools = append(vs.bools, dict.bools[idx])
+		case typeInt32:
+			vs.int32s = append(vs.int32s, dict.int32s[idx])
+		case typeInt64, typeInt96:
+			vs.int64s = append(vs.int64s, dict.int64s[idx])
+		case typeFloat:
+			vs.floats = append(vs.floats, dict.floats[idx])
+		case typeDouble:
+			vs.doubles = append(vs.doubles, dict.doubles[idx])
+		default:
+			vs.bytes = append(vs.bytes, dict.bytes[idx])
+		}
+	}
+	return nil
+}
+
+// decodeHybrid decodes n values of the RLE/bit-packing hybrid encoding
+func decodeHybrid(data []byte, bitWidth int, n int) ([]uint32, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, ErrInvalidFile
+	}
+	out := make([]uint32, 0, preallocated(n))
+	byteWidth := (bitWidth + 7) / 8
+	for len(out) < n {
+		header, m := binary.Uvarint(data)
+		if m <= 0 {
+			return nil, ErrInvalidFile
+		}
+		data = data[m:]
+		if header&1 == 0 {
+			// rle run
+			count := int(header >> 1)
+			if len(data) < byteWidth {
+				return nil, ErrInvalidFile
+			}
+			if count > n-len(out) {
+				count = n - len(out)
+			}
+			var v uint32
+			for i := 0; i < byteWidth; i++ {
+				v |= uint32(data[i]) << (8 * i)
+			}
+			data = data[byteWidth:]
+			for i := 0; i < count; i++ {
+				out = append(out, v)
+			}
+		} else {
+			// bit-packed run of groups of 8 values
+			count := int(header>>1) * 8
+			size := count * bitWidth / 8
+			if len(data) < size {
+				return nil, ErrInvalidFile
+			}
+			mask := uint64(1)<<bitWidth - 1
+			for i := 0; i < count && len(out) < n; i++ {
+				bit := i * bitWidth
+				var v uint64
+				for j := 0; j < (bit%8+bitWidth+7)/8; j++ {
+					v |= uint64(data[bit/8+j]) << (8 * j)
+				}
+				out = append(out, uint32(v>>(bit%8)&mask))
+			}
+			data = data[size:]
+		}
+	}
+	return out, nil
+}
+
+// appendLevels appends the definition levels of bit width 1 in the RLE runs
+func appendLevels(buf []byte, defined []bool) []byte {
+	for i := 0; i < len(defined); {
+		j := i + 1
+		for j < len(defined) && defined[j] == defined[i] {
+			j++
+		}
+		buf = binary.AppendUvarint(buf, uint64(j-i)<<1)
+		if defined[i] {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		i = j
+	}
+	return buf
+}
@@ -0,0 +1,427 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newVector(typ types.T, col interface{}, nullRows ...uint64) *vector.Vector {
+	v := vector.New(typ.ToType())
+	v.Col = col
+	nulls.Add(v.Nsp, nullRows...)
+	return v
+}
+
+func newBytes(vs ...string) *types.Bytes {
+	bs := &types.Bytes{}
+	for _, v := range vs {
+		bs.Offsets = append(bs.Offsets, uint32(len(bs.Data)))
+		bs.Lengths = append(bs.Lengths, uint32(len(v)))
+		bs.Data = append(bs.Data, v...)
+	}
+	return bs
+}
+
+func newTestBatch() *batch.Batch {
+	d1, _ := types.ParseDate("1969-12-31")
+	d2, _ := types.ParseDate("2022-07-01")
+	dt1, _ := types.ParseDatetime("1960-01-02 03:04:05.999999", 6)
+	dt2, _ := types.ParseDatetime("2022-07-01 12:00:00.5", 6)
+	dec1, _ := types.ParseStringToDecimal64("-12.34", 10, 2)
+	dec2, _ := types.ParseStringToDecimal128("-12345678901234567890.5", 30, 1)
+
+	bat := batch.New(true, []string{"b", "i8", "i16", "i32", "i64", "u8", "u16", "u32", "u64",
+		"f32", "f64", "d64", "d128", "date", "datetime", "timestamp", "varchar", "char", "const"})
+	bat.Vecs[0] = newVector(types.T_bool, []bool{true, false, true}, 2)
+	bat.Vecs[1] = newVector(types.T_int8, []int8{-1, 0, 1})
+	bat.Vecs[2] = newVector(types.T_int16, []int16{-300, 0, 300}, 1)
+	bat.Vecs[3] = newVector(types.T_int32, []int32{-70000, 0, 70000})
+	bat.Vecs[4] = newVector(types.T_int64, []int64{-1 << 40, 0, 1 << 40}, 0)
+	bat.Vecs[5] = newVector(types.T_uint8, []uint8{255, 0, 1})
+	bat.Vecs[6] = newVector(types.T_uint16, []uint16{65535, 0, 1})
+	bat.Vecs[7] = newVector(types.T_uint32, []uint32{1 << 31, 0, 1})
+	bat.Vecs[8] = newVector(types.T_uint64, []uint64{1 << 63, 0, 1}, 1)
+	bat.Vecs[9] = newVector(types.T_float32, []float32{-1.5, 0, 1.5})
+	bat.Vecs[10] = newVector(types.T_float64, []float64{-2.5, 0, 2.5}, 2)
+	bat.Vecs[11] = newVector(types.T_decimal64, []types.Decimal64{dec1, 0, 1})
+	bat.Vecs[11].Typ.Width, bat.Vecs[11].Typ.Scale = 10, 2
+	bat.Vecs[12] = newVector(types.T_decimal128, []types.Decimal128{dec2, {}, {Lo: 1}}, 1)
+	bat.Vecs[12].Typ.Width, bat.Vecs[12].Typ.Scale = 30, 1
+	bat.Vecs[13] = newVector(types.T_date, []types.Date{d1, d2, 0}, 2)
+	bat.Vecs[14] = newVector(types.T_datetime, []types.Datetime{dt1, dt2, 0}, 2)
+	bat.Vecs[15] = newVector(types.T_timestamp, []types.Timestamp{types.Timestamp(dt1), types.Timestamp(dt2), 0}, 2)
+	bat.Vecs[16] = newVector(types.T_varchar, newBytes("abc", "", "\u4e2d\u6587"), 1)
+	bat.Vecs[17] = newVector(types.T_char, newBytes("x", "y", "z"))
+	bat.Vecs[18] = vector.NewConst(types.T_int64.ToType())
+	bat.Vecs[18].Col = []int64{42}
+	bat.Vecs[18].Length = 3
+	bat.InitZsOne(3)
+	return bat
+}
+
+// value returns the value of the row of the vector, nil for nulls
+func value(v *vector.Vector, row int) interface{} {
+	if v.IsScalar() {
+		row = 0
+	}
+	if nulls.Contains(v.Nsp, uint64(row)) {
+		return nil
+	}
+	if bs, ok := v.Col.(*types.Bytes); ok {
+		return string(bs.Get(int64(row)))
+	}
+	return reflect.ValueOf(v.Col).Index(row).Interface()
+}
+
+func readAll(t *testing.T, data []byte) (*Reader, []*batch.Batch) {
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	var bats []*batch.Batch
+	for {
+		bat, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		bats = append(bats, bat)
+	}
+	return r, bats
+}
+
+func TestWriteAndRead(t *testing.T) {
+	for _, compression := range []string{"none", "snappy", "gzip", "zstd"} {
+		bat := newTestBatch()
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, bat.Attrs, WriterOptions{RowGroupSize: 2, Compression: compression})
+		require.NoError(t, err)
+		require.NoError(t, w.Write(bat))
+		require.NoError(t, w.Write(bat))
+		require.NoError(t, w.Close())
+		data := buf.Bytes()
+		require.Equal(t, magic, data[:4])
+		require.Equal(t, magic, data[len(data)-4:])
+
+		r, bats := readAll(t, data)
+		require.Equal(t, int64(6), r.NumRows())
+		require.Equal(t, bat.Attrs, r.Attrs())
+		require.Equal(t, 3, len(bats))
+		for i, typ := range r.Types() {
+			expected := bat.Vecs[i].Typ
+			if expected.Oid == types.T_char {
+				expected = types.T_varchar.ToType()
+			}
+			require.Equal(t, expected.Oid, typ.Oid, bat.Attrs[i])
+			if typ.Oid == types.T_decimal64 || typ.Oid == types.T_decimal128 {
+				require.Equal(t, expected.Width, typ.Width)
+				require.Equal(t, expected.Scale, typ.Scale)
+			}
+		}
+		for i := range bat.Vecs {
+			for row := 0; row < 6; row++ {
+				got := bats[row/2].Vecs[i]
+				require.Equal(t, value(bat.Vecs[i], row%3), value(got, row%2),
+					"%s: column %s row %d", compression, bat.Attrs[i], row)
+			}
+		}
+	}
+}
+
+func TestSelsAndProjection(t *testing.T) {
+	bat := newTestBatch()
+	bat.Sels = []int64{2, 0}
+	bat.Zs = []int64{1, 0}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, bat.Attrs, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+
+	data := buf.Bytes()
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.NoError(t, r.Project([]string{"VARCHAR", "i32"}))
+	require.Equal(t, []string{"varchar", "i32"}, r.Attrs())
+	require.Error(t, r.Project([]string{"nonexistent"}))
+
+	got, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, 1, vector.Length(got.Vecs[0]))
+	require.Equal(t, 2, len(got.Vecs))
+	require.Equal(t, "\u4e2d\u6587", value(got.Vecs[0], 0))
+	require.Equal(t, int32(70000), value(got.Vecs[1], 0))
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestEmptyFile(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []string{"a", "b"}, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, bats := readAll(t, buf.Bytes())
+	require.Equal(t, 0, len(bats))
+	require.Equal(t, []string{"a", "b"}, r.Attrs())
+	require.Equal(t, types.T_varchar, r.Types()[0].Oid)
+}
+
+func TestNullColumn(t *testing.T) {
+	v := vector.New(types.T_any.ToType())
+	v.Length = 2
+	bat := batch.New(true, []string{"n"})
+	bat.Vecs[0] = v
+	bat.InitZsOne(2)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, bat.Attrs, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+
+	_, bats := readAll(t, buf.Bytes())
+	require.Equal(t, 1, len(bats))
+	require.Nil(t, value(bats[0].Vecs[0], 0))
+	require.Nil(t, value(bats[0].Vecs[0], 1))
+}
+
+func TestWriterOptions(t *testing.T) {
+	_, err := NewWriter(io.Discard, nil, WriterOptions{Compression: "lzo"})
+	require.Error(t, err)
+	_, err = NewWriter(io.Discard, nil, WriterOptions{RowGroupSize: -1})
+	require.Error(t, err)
+
+	bat := newTestBatch()
+	w, err := NewWriter(io.Discard, bat.Attrs[:1], WriterOptions{})
+	require.NoError(t, err)
+	require.Error(t, w.Write(bat))
+}
+
+// testFile builds a parquet file with the pages written by other writers:
+// a required INT32 column of a dictionary page and a v2 data page, an INT96
+// column, a nested column, and an optional BYTE_ARRAY column of a
+// dictionary encoded v1 data page.
+func testFile() []byte {
+	le := binary.LittleEndian
+	data := append([]byte{}, magic...)
+	var chunks []interface{}
+	addChunk := func(physical int32, pages []byte, firstPage int64, dictPage int64, rows int64) {
+		meta := tStruct{
+			{id: 1, v: physical},
+			{id: 2, v: tList{typ: ctI32, vs: []interface{}{int32(encodingRLEDictionary)}}},
+			{id: 3, v: tList{typ: ctBinary, vs: []interface{}{"x"}}},
+			{id: 4, v: int32(codecSnappy)},
+			{id: 5, v: rows},
+			{id: 6, v: int64(len(pages))},
+			{id: 7, v: int64(len(pages))},
+			{id: 9, v: firstPage},
+		}
+		if dictPage >= 0 {
+			meta = append(meta, tField{id: 11, v: dictPage})
+		}
+		chunks = append(chunks, tStruct{{id: 2, v: firstPage}, {id: 3, v: meta}})
+	}
+	page := func(header tStruct, body []byte, compressed bool) []byte {
+		out := body
+		if compressed {
+			out, _ = compress(codecSnappy, body)
+		}
+		header = append(tStruct{
+			{id: 1, v: header[0].v},
+			{id: 2, v: int32(len(body))},
+			{id: 3, v: int32(len(out))},
+		}, header[1:]...)
+		return append(appendStruct(nil, header), out...)
+	}
+
+	// column a: required INT32 UINT_16, dictionary [7, 9], indexes [1, 0, 1, 1]
+	dictBody := le.AppendUint32(le.AppendUint32(nil, 7), 9)
+	dictPage := page(tStruct{{v: int32(pageDictionary)},
+		{id: 7, v: tStruct{{id: 1, v: int32(2)}, {id: 2, v: int32(encodingPlainDictionary)}}}}, dictBody, true)
+	// bit width 1, bit-packed run of one group: 1, 0, 1, 1
+	indexes := []byte{1, 3, 0x0D}
+	dataPage := page(tStruct{{v: int32(pageDataV2)},
+		{id: 8, v: tStruct{
+			{id: 1, v: int32(4)}, {id: 2, v: int32(0)}, {id: 3, v: int32(4)},
+			{id: 4, v: int32(encodingRLEDictionary)},
+			{id: 5, v: int32(0)}, {id: 6, v: int32(0)},
+		}}}, indexes, true)
+	offset := int64(len(data))
+	data = append(data, dictPage...)
+	addChunk(typeInt32, append(dictPage, dataPage...), offset+int64(len(dictPage)), offset, 4)
+	data = append(data, dataPage...)
+
+	// column b: required INT96, 1970-01-02 00:00:00.000001 for all rows
+	var int96 []byte
+	for i := 0; i < 4; i++ {
+		int96 = le.AppendUint64(int96, 1000)
+		int96 = le.AppendUint32(int96, julianUnixEpoch+1)
+	}
+	dataPage = page(tStruct{{v: int32(pageData)},
+		{id: 5, v: tStruct{{id: 1, v: int32(4)}, {id: 2, v: int32(encodingPlain)},
+			{id: 3, v: int32(encodingRLE)}, {id: 4, v: int32(encodingRLE)}}}}, int96, true)
+	offset = int64(len(data))
+	data = append(data, dataPage...)
+	addChunk(typeInt96, dataPage, offset, -1, 4)
+
+	// column c: nested, two leaves
+	addChunk(typeInt32, nil, 0, -1, 4)
+	addChunk(typeInt32, nil, 0, -1, 4)
+
+	// column d: optional string, dictionary ["x", "yz"], values [null, "yz", "x", null]
+	dictBody = append(le.AppendUint32(nil, 1), 'x')
+	dictBody = append(le.AppendUint32(dictBody, 2), 'y', 'z')
+	dictPage = page(tStruct{{v: int32(pageDictionary)},
+		{id: 7, v: tStruct{{id: 1, v: int32(2)}, {id: 2, v: int32(encodingPlainDictionary)}}}}, dictBody, true)
+	levels := appendLevels(nil, []bool{false, true, true, false})
+	body := append(le.AppendUint32(nil, uint32(len(levels))), levels...)
+	// bit width 1, rle run of 1 and rle run of 0
+	body = append(body, 1, 2, 1, 2, 0)
+	dataPage = page(tStruct{{v: int32(pageData)},
+		{id: 5, v: tStruct{{id: 1, v: int32(4)}, {id: 2, v: int32(encodingPlainDictionary)},
+			{id: 3, v: int32(encodingRLE)}, {id: 4, v: int32(encodingRLE)}}}}, body, true)
+	offset = int64(len(data))
+	data = append(data, dictPage...)
+	data = append(data, dataPage...)
+	addChunk(typeByteArray, append(dictPage, dataPage...), offset+int64(len(dictPage)), offset, 4)
+
+	schema := []interface{}{
+		tStruct{{id: 4, v: "schema"}, {id: 5, v: int32(4)}},
+		tStruct{{id: 1, v: int32(typeInt32)}, {id: 3, v: int32(repetitionRequired)}, {id: 4, v: "a"},
+			{id: 6, v: int32(convertedUint16)}},
+		tStruct{{id: 1, v: int32(typeInt96)}, {id: 3, v: int32(repetitionRequired)}, {id: 4, v: "b"}},
+		tStruct{{id: 3, v: int32(repetitionOptional)}, {id: 4, v: "c"}, {id: 5, v: int32(2)}},
+		tStruct{{id: 1, v: int32(typeInt32)}, {id: 3, v: int32(repetitionOptional)}, {id: 4, v: "c1"}},
+		tStruct{{id: 1, v: int32(typeInt32)}, {id: 3, v: int32(repetitionOptional)}, {id: 4, v: "c2"}},
+		tStruct{{id: 1, v: int32(typeByteArray)}, {id: 3, v: int32(repetitionOptional)}, {id: 4, v: "d"},
+			{id: 10, v: tStruct{{id: logicalString, v: tStruct{}}}}},
+	}
+	meta := appendStruct(nil, tStruct{
+		{id: 1, v: int32(2)},
+		{id: 2, v: tList{typ: ctStruct, vs: schema}},
+		{id: 3, v: int64(4)},
+		{id: 4, v: tList{typ: ctStruct, vs: []interface{}{tStruct{
+			{id: 1, v: tList{typ: ctStruct, vs: chunks}},
+			{id: 2, v: int64(0)},
+			{id: 3, v: int64(4)},
+		}}}},
+	})
+	data = append(data, meta...)
+	data = le.AppendUint32(data, uint32(len(meta)))
+	return append(data, magic...)
+}
+
+func TestReadOtherWriters(t *testing.T) {
+	data := testFile()
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, r.Attrs())
+
+	// the nested column cannot be read
+	_, err = r.Read()
+	require.ErrorIs(t, err, ErrUnsupportedType)
+
+	require.NoError(t, r.Project([]string{"d", "a", "b"}))
+	require.Equal(t, []types.T{types.T_varchar, types.T_uint16, types.T_timestamp},
+		[]types.T{r.Types()[0].Oid, r.Types()[1].Oid, r.Types()[2].Oid})
+	bat, err := r.Read()
+	require.NoError(t, err)
+	ts, _ := types.ParseDatetime("1970-01-02 00:00:00.000001", 6)
+	for row, expected := range [][]interface{}{
+		{nil, uint16(9), types.Timestamp(ts)},
+		{"yz", uint16(7), types.Timestamp(ts)},
+		{"x", uint16(9), types.Timestamp(ts)},
+		{nil, uint16(9), types.Timestamp(ts)},
+	} {
+		for i, v := range expected {
+			require.Equal(t, v, value(bat.Vecs[i], row), "row %d column %d", row, i)
+		}
+	}
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestCorruptedFile(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, bat.Attrs, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	_, err = NewReader(bytes.NewReader(data[:len(data)-1]), int64(len(data)-1))
+	require.Error(t, err)
+	_, err = NewReader(bytes.NewReader([]byte("PAR1")), 4)
+	require.Error(t, err)
+
+	// corrupt the metadata
+	corrupted := append([]byte{}, data...)
+	metaLen := int(binary.LittleEndian.Uint32(corrupted[len(corrupted)-8:]))
+	for i := len(corrupted) - 8 - metaLen; i < len(corrupted)-8; i++ {
+		corrupted[i] = 0xFF
+	}
+	_, err = NewReader(bytes.NewReader(corrupted), int64(len(corrupted)))
+	require.ErrorIs(t, err, ErrInvalidFile)
+
+	// corrupt the pages
+	corrupted = append([]byte{}, data...)
+	for i := 4; i < len(corrupted)-8-metaLen; i++ {
+		corrupted[i] = 0xFF
+	}
+	r, err := NewReader(bytes.NewReader(corrupted), int64(len(corrupted)))
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.Error(t, err)
+}
+
+func TestOversizedChunk(t *testing.T) {
+	bat := newTestBatch()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, bat.Attrs, WriterOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write(bat))
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	// the column chunks exceed the file
+	r, err := NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	r.size = 16
+	_, err = r.Read()
+	require.ErrorIs(t, err, ErrInvalidFile)
+
+	// the uncompressed sizes of the pages are beyond the limit or not matched
+	for _, codec := range []int32{codecSnappy, codecGzip, codecZstd} {
+		page, err := compress(codec, []byte("parquet"))
+		require.NoError(t, err)
+		_, err = decompress(codec, page, maxPageSize+1)
+		require.ErrorIs(t, err, ErrInvalidFile)
+		_, err = decompress(codec, page, maxPageSize)
+		require.ErrorIs(t, err, ErrInvalidFile)
+		out, err := decompress(codec, page, len("parquet"))
+		require.NoError(t, err)
+		require.Equal(t, "parquet", string(out))
+	}
+}
@@ -0,0 +1,467 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// Reader reads the row groups of a parquet file as batches.
+// Only the top-level primitive columns can be read.
+type Reader struct {
+	r io.ReaderAt
+	// size, the size of the file, the column chunks beyond it are rejected
+	size   int64
+	fields []field
+	// projection, the indexes of the fields to read
+	projection []int
+	rowGroups  []interface{}
+	numRows    int64
+	// next, the index of the next row group
+	next int
+}
+
+// protect converts the panic of reading the corrupted metadata to an error
+func protect(fn func() error) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if e == ErrInvalidFile {
+				err = ErrInvalidFile
+				return
+			}
+			err = fmt.Errorf("%w: %v", ErrInvalidFile, e)
+		}
+	}()
+	return fn()
+}
+
+// NewReader reads the metadata of the parquet file of the size
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, ErrInvalidFile
+	}
+	tail := make([]byte, len(magic)+4)
+	if _, err := r.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(tail[4:], magic) {
+		return nil, ErrInvalidFile
+	}
+	metaLen := int64(binary.LittleEndian.Uint32(tail))
+	if metaLen > size-int64(2*len(magic)+4) {
+		return nil, ErrInvalidFile
+	}
+	buf := make([]byte, metaLen)
+	if _, err := r.ReadAt(buf, size-int64(len(tail))-metaLen); err != nil {
+		return nil, err
+	}
+
+	rd := &Reader{r: r, size: size}
+	err := protect(func() error {
+		tr := &tReader{buf: buf}
+		meta := tr.readStruct()
+		fields, err := decodeSchema(meta.list(2))
+		if err != nil {
+			return err
+		}
+		rd.fields = fields
+		rd.numRows = meta.int(3, 0)
+		rd.rowGroups = meta.list(4)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rd.projection = make([]int, len(rd.fields))
+	for i := range rd.projection {
+		rd.projection[i] = i
+	}
+	return rd, nil
+}
+
+// NumRows returns the rows of the file
+func (r *Reader) NumRows() int64 {
+	return r.numRows
+}
+
+// Attrs returns the names of the columns to read
+func (r *Reader) Attrs() []string {
+	attrs := make([]string, len(r.projection))
+	for i, j := range r.projection {
+		attrs[i] = r.fields[j].name
+	}
+	return attrs
+}
+
+// Types returns the types of the columns to read
+func (r *Reader) Types() []types.Type {
+	typs := make([]types.Type, len(r.projection))
+	for i, j := range r.projection {
+		typs[i] = r.fields[j].typ
+	}
+	return typs
+}
+
+// Project selects the columns to read by the names, the names are matched
+// case-insensitively if there is no exactly matched column.
+func (r *Reader) Project(attrs []string) error {
+	projection := make([]int, len(attrs))
+	for i, attr := range attrs {
+		projection[i] = -1
+		for j, f := range r.fields {
+			if f.name == attr {
+				projection[i] = j
+				break
+			}
+			if projection[i] < 0 && strings.EqualFold(f.name, attr) {
+				projection[i] = j
+			}
+		}
+		if projection[i] < 0 {
+			return fmt.Errorf("column '%s' is not found in the parquet file", attr)
+		}
+	}
+	r.projection = projection
+	return nil
+}
+
+// Read returns the next row group as a batch, io.EOF if there are no
+// more row groups.
+func (r *Reader) Read() (*batch.Batch, error) {
+	for _, j := range r.projection {
+		if err := r.fields[j].err; err != nil {
+			return nil, err
+		}
+	}
+	if r.next >= len(r.rowGroups) {
+		return nil, io.EOF
+	}
+	rg := r.rowGroups[r.next]
+	r.next++
+
+	bat := batch.New(true, r.Attrs())
+	err := protect(func() error {
+		rowGroup := rg.(tStruct)
+		rows := int(rowGroup.int(3, 0))
+		chunks := rowGroup.list(1)
+		for i, j := range r.projection {
+			f := &r.fields[j]
+			if f.chunk >= len(chunks) {
+				return ErrInvalidFile
+			}
+			meta := chunks[f.chunk].(tStruct).structField(3)
+			if meta == nil {
+				return errors.New("parquet column chunks in other files are not supported")
+			}
+			vec, err := r.readColumn(f, meta, rows)
+			if err != nil {
+				return err
+			}
+			bat.Vecs[i] = vec
+		}
+		bat.InitZsOne(rows)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bat, nil
+}
+
+// readColumn reads the column chunk of the field
+func (r *Reader) readColumn(f *field, meta tStruct, rows int) (*vector.Vector, error) {
+	codec := int32(meta.int(4, codecUncompressed))
+	offset := meta.int(9, 0)
+	if dictOffset := meta.int(11, 0); dictOffset > 0 && dictOffset < offset {
+		offset = dictOffset
+	}
+	size := meta.int(7, 0)
+	if offset < 0 || size < 0 || rows < 0 || size > r.size-offset {
+		return nil, ErrInvalidFile
+	}
+	data := make([]byte, size)
+	if _, err := r.r.ReadAt(data, offset); err != nil {
+		return nil, err
+	}
+
+	var dict *values
+	vs := &values{}
+	defined := make([]bool, 0, preallocated(rows))
+	tr := &tReader{buf: data}
+	for len(defined) < rows && tr.pos < len(data) {
+		header := tr.readStruct()
+		page := tr.bytes(int(header.int(3, 0)))
+		uncompressedSize := int(header.int(2, 0))
+		var levels []byte
+		var body []byte
+		var n int
+		var encoding int64
+		var err error
+		switch header.int(1, -1) {
+		case pageDictionary:
+			dh := header.structField(7)
+			if body, err = decompress(codec, page, uncompressedSize); err != nil {
+				return nil, err
+			}
+			dict = &values{}
+			if err = dict.decodePlain(f, body, int(dh.int(1, 0))); err != nil {
+				return nil, err
+			}
+			continue
+		case pageData:
+			dh := header.structField(5)
+			n, encoding = int(dh.int(1, 0)), dh.int(2, encodingPlain)
+			if body, err = decompress(codec, page, uncompressedSize); err != nil {
+				return nil, err
+			}
+			if f.optional {
+				if len(body) < 4 {
+					return nil, ErrInvalidFile
+				}
+				l := int(binary.LittleEndian.Uint32(body))
+				if l < 0 || len(body) < 4+l {
+					return nil, ErrInvalidFile
+				}
+				levels, body = body[4:4+l], body[4+l:]
+			}
+		case pageDataV2:
+			dh := header.structField(8)
+			n, encoding = int(dh.int(1, 0)), dh.int(4, encodingPlain)
+			defLen, repLen := int(dh.int(5, 0)), int(dh.int(6, 0))
+			if defLen < 0 || repLen < 0 || defLen+repLen > len(page) {
+				return nil, ErrInvalidFile
+			}
+			levels, body = page[repLen:repLen+defLen], page[repLen+defLen:]
+			if dh.bool(7, true) {
+				if body, err = decompress(codec, body, uncompressedSize-repLen-defLen); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			// index pages
+			continue
+		}
+
+		if n < 0 || n > rows-len(defined) {
+			return nil, ErrInvalidFile
+		}
+		nonNull := n
+		if f.optional {
+			lvs, err := decodeHybrid(levels, 1, n)
+			if err != nil {
+				return nil, err
+			}
+			nonNull = 0
+			for _, l := range lvs {
+				defined = append(defined, l == 1)
+				if l == 1 {
+					nonNull++
+				}
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				defined = append(defined, true)
+			}
+		}
+		if nonNull == 0 {
+			continue
+		}
+		switch encoding {
+		case encodingPlain:
+			err = vs.decodePlain(f, body, nonNull)
+		case encodingPlainDictionary, encodingRLEDictionary:
+			if dict == nil || len(body) == 0 {
+				return nil, ErrInvalidFile
+			}
+			var indexes []uint32
+			if indexes, err = decodeHybrid(body[1:], int(body[0]), nonNull); err == nil {
+				err = vs.appendIndexed(dict, f.physical, indexes)
+			}
+		default:
+			err = fmt.Errorf("unsupported parquet encoding %d of column '%s'", encoding, f.name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(defined) != rows {
+		return nil, ErrInvalidFile
+	}
+	return toVector(f, vs, defined)
+}
+
+// preallocated returns the capacity allocated for n values read from the
+// file, the values are appended as they are decoded
+func preallocated(n int) int {
+	if n > maxPreallocated {
+		return maxPreallocated
+	}
+	return n
+}
+
+// floorDiv returns the quotient rounded to negative infinity
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func microsToDatetime(us int64) types.Datetime {
+	sec := floorDiv(us, microsPerSecond)
+	return types.Datetime((sec+unixEpochSeconds)<<20 | (us - sec*microsPerSecond))
+}
+
+// fill places the values to the defined rows
+func fill[T, R any](vs []T, defined []bool, conv func(T) R) []R {
+	rs := make([]R, len(defined))
+	j := 0
+	for i, d := range defined {
+		if d {
+			rs[i] = conv(vs[j])
+			j++
+		}
+	}
+	return rs
+}
+
+func same[T any](v T) T {
+	return v
+}
+
+// bytesToDecimal128 converts the big-endian two's complement to decimal128
+func bytesToDecimal128(bs []byte) types.Decimal128 {
+	var d types.Decimal128
+	if len(bs) > 0 && bs[0]&0x80 != 0 {
+		d.Lo, d.Hi = -1, -1
+	}
+	for _, b := range bs {
+		d.Hi = d.Hi<<8 | int64(uint64(d.Lo)>>56)
+		d.Lo = d.Lo<<8 | int64(b)
+	}
+	return d
+}
+
+// toVector converts the values of the column chunk to a vector
+func toVector(f *field, vs *values, defined []bool) (*vector.Vector, error) {
+	v := vector.New(f.typ)
+	count := 0
+	for i, d := range defined {
+		if d {
+			count++
+		} else {
+			nulls.Add(v.Nsp, uint64(i))
+		}
+	}
+	if count != vs.len(f.physical) {
+		return nil, ErrInvalidFile
+	}
+
+	toMicros := func(t int64) int64 {
+		switch f.unit {
+		case unitMillis:
+			return t * 1000
+		case unitNanos:
+			return floorDiv(t, 1000)
+		}
+		return t
+	}
+	switch f.typ.Oid {
+	case types.T_bool:
+		v.Col = fill(vs.bools, defined, same[bool])
+	case types.T_int8:
+		v.Col = fill(vs.int32s, defined, func(x int32) int8 { return int8(x) })
+	case types.T_int16:
+		v.Col = fill(vs.int32s, defined, func(x int32) int16 { return int16(x) })
+	case types.T_int32:
+		v.Col = fill(vs.int32s, defined, same[int32])
+	case types.T_int64:
+		v.Col = fill(vs.int64s, defined, same[int64])
+	case types.T_uint8:
+		v.Col = fill(vs.int32s, defined, func(x int32) uint8 { return uint8(x) })
+	case types.T_uint16:
+		v.Col = fill(vs.int32s, defined, func(x int32) uint16 { return uint16(x) })
+	case types.T_uint32:
+		v.Col = fill(vs.int32s, defined, func(x int32) uint32 { return uint32(x) })
+	case types.T_uint64:
+		v.Col = fill(vs.int64s, defined, func(x int64) uint64 { return uint64(x) })
+	case types.T_float32:
+		v.Col = fill(vs.floats, defined, same[float32])
+	case types.T_float64:
+		v.Col = fill(vs.doubles, defined, same[float64])
+	case types.T_date:
+		v.Col = fill(vs.int32s, defined, func(d int32) types.Date {
+			return types.Date(int64(d) + unixEpochDays)
+		})
+	case types.T_datetime:
+		v.Col = fill(vs.int64s, defined, func(t int64) types.Datetime {
+			return microsToDatetime(toMicros(t))
+		})
+	case types.T_timestamp:
+		v.Col = fill(vs.int64s, defined, func(t int64) types.Timestamp {
+			return types.Timestamp(microsToDatetime(toMicros(t)))
+		})
+	case types.T_decimal64, types.T_decimal128:
+		var ds []types.Decimal128
+		switch f.physical {
+		case typeInt32:
+			ds = fill(vs.int32s, defined, func(x int32) types.Decimal128 {
+				return types.Decimal128{Lo: int64(x), Hi: int64(x) >> 63}
+			})
+		case typeInt64:
+			ds = fill(vs.int64s, defined, func(x int64) types.Decimal128 {
+				return types.Decimal128{Lo: x, Hi: x >> 63}
+			})
+		default:
+			ds = fill(vs.bytes, defined, bytesToDecimal128)
+		}
+		if f.typ.Oid == types.T_decimal64 {
+			d64s := make([]types.Decimal64, len(ds))
+			for i, d := range ds {
+				d64s[i] = types.Decimal64(d.Lo)
+			}
+			v.Col = d64s
+		} else {
+			v.Col = ds
+		}
+	default:
+		bs := &types.Bytes{
+			Offsets: make([]uint32, len(defined)),
+			Lengths: make([]uint32, len(defined)),
+		}
+		j := 0
+		for i, d := range defined {
+			bs.Offsets[i] = uint32(len(bs.Data))
+			if d {
+				bs.Data = append(bs.Data, vs.bytes[j]...)
+				bs.Lengths[i] = uint32(len(vs.bytes[j]))
+				j++
+			}
+		}
+		v.Col = bs
+	}
+	return v, nil
+}
@@ -0,0 +1,329 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// the types of the thrift compact protocol
+const (
+	ctStop      = 0
+	ctBoolTrue  = 1
+	ctBoolFalse = 2
+	ctByte      = 3
+	ctI16       = 4
+	ctI32       = 5
+	ctI64       = 6
+	ctDouble    = 7
+	ctBinary    = 8
+	ctList      = 9
+	ctSet       = 10
+	ctMap       = 11
+	ctStruct    = 12
+)
+
+// tField is a field of the thrift struct, the value is one of
+// bool, int8, int16, int32, int64, float64, []byte, string, tStruct and tList.
+type tField struct {
+	id int16
+	v  interface{}
+}
+
+// tStruct is a thrift struct, the fields are in the ascending order of the ids
+type tStruct []tField
+
+// tList is a thrift list of the elements of the type typ
+type tList struct {
+	typ byte
+	vs  []interface{}
+}
+
+func (s tStruct) get(id int16) (interface{}, bool) {
+	for _, f := range s {
+		if f.id == id {
+			return f.v, true
+		}
+	}
+	return nil, false
+}
+
+func (s tStruct) has(id int16) bool {
+	_, ok := s.get(id)
+	return ok
+}
+
+// int returns the integer field, def if the field is absent
+func (s tStruct) int(id int16, def int64) int64 {
+	v, ok := s.get(id)
+	if !ok {
+		return def
+	}
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case int64:
+		return x
+	}
+	panic(ErrInvalidFile)
+}
+
+func (s tStruct) bool(id int16, def bool) bool {
+	v, ok := s.get(id)
+	if !ok {
+		return def
+	}
+	return v.(bool)
+}
+
+func (s tStruct) string(id int16) string {
+	v, ok := s.get(id)
+	if !ok {
+		return ""
+	}
+	return string(v.([]byte))
+}
+
+// structField returns the struct field, nil if the field is absent
+func (s tStruct) structField(id int16) tStruct {
+	v, ok := s.get(id)
+	if !ok {
+		return nil
+	}
+	return v.(tStruct)
+}
+
+func (s tStruct) list(id int16) []interface{} {
+	v, ok := s.get(id)
+	if !ok {
+		return nil
+	}
+	return v.(tList).vs
+}
+
+// typeOf returns the compact type of the value
+func typeOf(v interface{}) byte {
+	switch x := v.(type) {
+	case bool:
+		if x {
+			return ctBoolTrue
+		}
+		return ctBoolFalse
+	case int8:
+		return ctByte
+	case int16:
+		return ctI16
+	case int32:
+		return ctI32
+	case int64:
+		return ctI64
+	case float64:
+		return ctDouble
+	case []byte, string:
+		return ctBinary
+	case tList:
+		return ctList
+	case tStruct:
+		return ctStruct
+	}
+	panic("unsupported thrift value")
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// appendStruct appends the struct in the thrift compact protocol
+func appendStruct(buf []byte, s tStruct) []byte {
+	var last int16
+	for _, f := range s {
+		typ := typeOf(f.v)
+		if delta := f.id - last; delta > 0 && delta <= 15 {
+			buf = append(buf, byte(delta)<<4|typ)
+		} else {
+			buf = append(buf, typ)
+			buf = binary.AppendUvarint(buf, zigzag(int64(f.id)))
+		}
+		last = f.id
+		if typ == ctBoolTrue || typ == ctBoolFalse {
+			continue
+		}
+		buf = appendValue(buf, f.v)
+	}
+	return append(buf, ctStop)
+}
+
+func appendValue(buf []byte, v interface{}) []byte {
+	switch x := v.(type) {
+	case bool:
+		// only for the elements of lists
+		if x {
+			return append(buf, ctBoolTrue)
+		}
+		return append(buf, ctBoolFalse)
+	case int8:
+		return append(buf, byte(x))
+	case int16:
+		return binary.AppendUvarint(buf, zigzag(int64(x)))
+	case int32:
+		return binary.AppendUvarint(buf, zigzag(int64(x)))
+	case int64:
+		return binary.AppendUvarint(buf, zigzag(x))
+	case float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(x))
+	case []byte:
+		buf = binary.AppendUvarint(buf, uint64(len(x)))
+		return append(buf, x...)
+	case string:
+		buf = binary.AppendUvarint(buf, uint64(len(x)))
+		return append(buf, x...)
+	case tList:
+		if len(x.vs) < 15 {
+			buf = append(buf, byte(len(x.vs))<<4|x.typ)
+		} else {
+			buf = append(buf, 0xF0|x.typ)
+			buf = binary.AppendUvarint(buf, uint64(len(x.vs)))
+		}
+		for _, e := range x.vs {
+			buf = appendValue(buf, e)
+		}
+		return buf
+	case tStruct:
+		return appendStruct(buf, x)
+	}
+	panic("unsupported thrift value")
+}
+
+// tReader reads the thrift compact protocol, it panics with
+// ErrInvalidFile on the corrupted data.
+type tReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *tReader) byte() byte {
+	if r.pos >= len(r.buf) {
+		panic(ErrInvalidFile)
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *tReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		panic(ErrInvalidFile)
+	}
+	r.pos += n
+	return v
+}
+
+func (r *tReader) bytes(n int) []byte {
+	if n < 0 || r.pos+n > len(r.buf) {
+		panic(ErrInvalidFile)
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *tReader) readStruct() tStruct {
+	var s tStruct
+	var last int16
+	for {
+		b := r.byte()
+		typ := b & 0x0F
+		if typ == ctStop {
+			return s
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(unzigzag(r.uvarint()))
+		}
+		last = id
+		var v interface{}
+		switch typ {
+		case ctBoolTrue:
+			v = true
+		case ctBoolFalse:
+			v = false
+		default:
+			v = r.readValue(typ)
+		}
+		s = append(s, tField{id: id, v: v})
+	}
+}
+
+func (r *tReader) readValue(typ byte) interface{} {
+	switch typ {
+	case ctBoolTrue, ctBoolFalse:
+		// the elements of lists
+		return r.byte() == ctBoolTrue
+	case ctByte:
+		return int8(r.byte())
+	case ctI16:
+		return int16(unzigzag(r.uvarint()))
+	case ctI32:
+		return int32(unzigzag(r.uvarint()))
+	case ctI64:
+		return unzigzag(r.uvarint())
+	case ctDouble:
+		return math.Float64frombits(binary.LittleEndian.Uint64(r.bytes(8)))
+	case ctBinary:
+		return r.bytes(int(r.uvarint()))
+	case ctList, ctSet:
+		b := r.byte()
+		n := int(b >> 4)
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		if n > len(r.buf)-r.pos {
+			panic(ErrInvalidFile)
+		}
+		l := tList{typ: b & 0x0F, vs: make([]interface{}, n)}
+		for i := range l.vs {
+			l.vs[i] = r.readValue(l.typ)
+		}
+		return l
+	case ctMap:
+		n := int(r.uvarint())
+		if n == 0 {
+			return nil
+		}
+		if n > len(r.buf)-r.pos {
+			panic(ErrInvalidFile)
+		}
+		b := r.byte()
+		for i := 0; i < n; i++ {
+			r.readValue(b >> 4)
+			r.readValue(b & 0x0F)
+		}
+		// maps are not used by the parquet metadata we read
+		return nil
+	case ctStruct:
+		return r.readStruct()
+	}
+	panic(ErrInvalidFile)
+}
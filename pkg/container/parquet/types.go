@@ -0,0 +1,471 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	ErrInvalidFile     = errors.New("invalid parquet file")
+	ErrUnsupportedType = errors.New("unsupported parquet type")
+)
+
+// magic is the leading and trailing bytes of parquet files
+var magic = []byte("PAR1")
+
+// the physical types
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// the converted types, the legacy logical types
+const (
+	convertedUTF8            = 0
+	convertedEnum            = 4
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt8            = 15
+	convertedInt16           = 16
+	convertedInt32           = 17
+	convertedInt64           = 18
+	convertedJSON            = 19
+	convertedBSON            = 20
+)
+
+// the fields of the LogicalType union
+const (
+	logicalString    = 1
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalUnknown   = 11
+	logicalJSON      = 12
+	logicalBSON      = 13
+)
+
+// the fields of the TimeUnit union
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+// the repetitions of the fields
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// the encodings
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingBitPacked       = 4
+	encodingRLEDictionary   = 8
+)
+
+// the page types
+const (
+	pageData       = 0
+	pageIndex      = 1
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// the limits of the sizes read from the file
+const (
+	// maxPageSize is the max uncompressed size of a page
+	maxPageSize = 1 << 30
+	// maxPreallocated is the max number of values allocated before they are decoded
+	maxPreallocated = 1 << 16
+)
+
+// the max precisions of decimal64 and decimal128
+const (
+	maxDecimal64Precision  = 18
+	maxDecimal128Precision = 38
+)
+
+/*
+ * the fields of the parquet thrift structs, by the field id
+ *
+ *   FileMetaData:         1 version, 2 schema, 3 num_rows, 4 row_groups, 6 created_by
+ *   SchemaElement:        1 type, 2 type_length, 3 repetition_type, 4 name, 5 num_children,
+ *                         6 converted_type, 7 scale, 8 precision, 10 logicalType
+ *   RowGroup:             1 columns, 2 total_byte_size, 3 num_rows
+ *   ColumnChunk:          2 file_offset, 3 meta_data
+ *   ColumnMetaData:       1 type, 2 encodings, 3 path_in_schema, 4 codec, 5 num_values,
+ *                         6 total_uncompressed_size, 7 total_compressed_size,
+ *                         9 data_page_offset, 11 dictionary_page_offset
+ *   PageHeader:           1 type, 2 uncompressed_page_size, 3 compressed_page_size,
+ *                         5 data_page_header, 7 dictionary_page_header, 8 data_page_header_v2
+ *   DataPageHeader:       1 num_values, 2 encoding, 3 definition_level_encoding,
+ *                         4 repetition_level_encoding
+ *   DictionaryPageHeader: 1 num_values, 2 encoding
+ *   DataPageHeaderV2:     1 num_values, 2 num_nulls, 3 num_rows, 4 encoding,
+ *                         5 definition_levels_byte_length, 6 repetition_levels_byte_length,
+ *                         7 is_compressed
+ *   DecimalType:          1 scale, 2 precision
+ *   TimestampType:        1 isAdjustedToUTC, 2 unit
+ *   IntType:              1 bitWidth, 2 isSigned
+ */
+
+// field is a top-level column of the parquet file
+type field struct {
+	name string
+	typ  types.Type
+	// physical, the physical type
+	physical int32
+	// typeLength, the length of FIXED_LEN_BYTE_ARRAY values
+	typeLength int
+	// optional, the values are nullable and the definition levels are stored
+	optional bool
+	// unit, the TimeUnit of the timestamp
+	unit int16
+	// chunk, the index of the column chunk in the row groups
+	chunk int
+	// err, not nil if the column cannot be read
+	err error
+}
+
+func intType(bitWidth int8, signed bool) tStruct {
+	return tStruct{
+		{id: logicalInteger, v: tStruct{{id: 1, v: bitWidth}, {id: 2, v: signed}}},
+	}
+}
+
+// encodeType returns the schema element of the optional column of the type
+func encodeType(name string, typ types.Type) (tStruct, error) {
+	var (
+		physical  int32
+		length    int32
+		converted int32 = -1
+		scale     int32
+		precision int32
+		logical   tStruct
+	)
+	switch typ.Oid {
+	case types.T_any:
+		physical = typeInt32
+		logical = tStruct{{id: logicalUnknown, v: tStruct{}}}
+	case types.T_bool:
+		physical = typeBoolean
+	case types.T_int8:
+		physical, converted, logical = typeInt32, convertedInt8, intType(8, true)
+	case types.T_int16:
+		physical, converted, logical = typeInt32, convertedInt16, intType(16, true)
+	case types.T_int32:
+		physical = typeInt32
+	case types.T_int64:
+		physical = typeInt64
+	case types.T_uint8:
+		physical, converted, logical = typeInt32, convertedUint8, intType(8, false)
+	case types.T_uint16:
+		physical, converted, logical = typeInt32, convertedUint16, intType(16, false)
+	case types.T_uint32:
+		physical, converted, logical = typeInt32, convertedUint32, intType(32, false)
+	case types.T_uint64:
+		physical, converted, logical = typeInt64, convertedUint64, intType(64, false)
+	case types.T_float32:
+		physical = typeFloat
+	case types.T_float64:
+		physical = typeDouble
+	case types.T_decimal64, types.T_decimal128:
+		physical, converted = typeInt64, convertedDecimal
+		precision, scale = typ.Width, typ.Scale
+		if typ.Oid == types.T_decimal64 {
+			if precision <= 0 || precision > maxDecimal64Precision {
+				precision = maxDecimal64Precision
+			}
+		} else {
+			physical, length = typeFixedLenByteArray, 16
+			if precision <= 0 || precision > maxDecimal128Precision {
+				precision = maxDecimal128Precision
+			}
+		}
+		logical = tStruct{
+			{id: logicalDecimal, v: tStruct{{id: 1, v: scale}, {id: 2, v: precision}}},
+		}
+	case types.T_date:
+		physical, converted = typeInt32, convertedDate
+		logical = tStruct{{id: logicalDate, v: tStruct{}}}
+	case types.T_datetime, types.T_timestamp:
+		// T_timestamp is adjusted to UTC, T_datetime is the local time
+		adjusted := typ.Oid == types.T_timestamp
+		physical = typeInt64
+		if adjusted {
+			converted = convertedTimestampMicros
+		}
+		logical = tStruct{
+			{id: logicalTimestamp, v: tStruct{
+				{id: 1, v: adjusted},
+				{id: 2, v: tStruct{{id: unitMicros, v: tStruct{}}}},
+			}},
+		}
+	case types.T_char, types.T_varchar:
+		physical, converted = typeByteArray, convertedUTF8
+		logical = tStruct{{id: logicalString, v: tStruct{}}}
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnsupportedType, typ)
+	}
+
+	elem := tStruct{{id: 1, v: physical}}
+	if length > 0 {
+		elem = append(elem, tField{id: 2, v: length})
+	}
+	elem = append(elem,
+		tField{id: 3, v: int32(repetitionOptional)},
+		tField{id: 4, v: name})
+	if converted >= 0 {
+		elem = append(elem, tField{id: 6, v: converted})
+	}
+	if converted == convertedDecimal {
+		elem = append(elem, tField{id: 7, v: scale}, tField{id: 8, v: precision})
+	}
+	if logical != nil {
+		elem = append(elem, tField{id: 10, v: logical})
+	}
+	return elem, nil
+}
+
+// decimalType returns the decimal type of the precision and the scale
+func decimalType(precision, scale int64) (types.Type, error) {
+	typ := types.T_decimal64.ToType()
+	if precision > maxDecimal64Precision {
+		typ = types.T_decimal128.ToType()
+	}
+	if precision <= 0 || precision > maxDecimal128Precision || scale < 0 || scale > precision {
+		return typ, fmt.Errorf("%w decimal(%d,%d)", ErrUnsupportedType, precision, scale)
+	}
+	typ.Width, typ.Scale = int32(precision), int32(scale)
+	return typ, nil
+}
+
+// decodeType sets the type of the field by the schema element
+func decodeType(f *field, elem tStruct) error {
+	f.physical = int32(elem.int(1, -1))
+	f.typeLength = int(elem.int(2, 0))
+	converted := elem.int(6, -1)
+	logical := elem.structField(10)
+	var logicalID int16
+	var logicalValue tStruct
+	if len(logical) > 0 {
+		logicalID, logicalValue = logical[0].id, logical[0].v.(tStruct)
+	}
+
+	unsupported := func() error {
+		return fmt.Errorf("%w of column '%s'", ErrUnsupportedType, f.name)
+	}
+	var err error
+	switch {
+	case logicalID == logicalUnknown:
+		// the column of nulls
+		f.typ = types.T_varchar.ToType()
+	case logicalID == logicalDecimal:
+		f.typ, err = decimalType(logicalValue.int(2, 0), logicalValue.int(1, 0))
+	case converted == convertedDecimal:
+		f.typ, err = decimalType(elem.int(8, 0), elem.int(7, 0))
+	case logicalID == logicalString || logicalID == logicalEnum || logicalID == logicalJSON ||
+		logicalID == logicalBSON || converted == convertedUTF8 || converted == convertedEnum ||
+		converted == convertedJSON || converted == convertedBSON:
+		f.typ = types.T_varchar.ToType()
+	case logicalID == logicalDate || converted == convertedDate:
+		f.typ = types.T_date.ToType()
+	case logicalID == logicalTimestamp:
+		f.unit = logicalValue.structField(2)[0].id
+		if logicalValue.bool(1, false) {
+			f.typ = types.T_timestamp.ToType()
+		} else {
+			f.typ = types.T_datetime.ToType()
+		}
+	case converted == convertedTimestampMillis:
+		f.typ, f.unit = types.T_timestamp.ToType(), unitMillis
+	case converted == convertedTimestampMicros:
+		f.typ, f.unit = types.T_timestamp.ToType(), unitMicros
+	case logicalID == logicalInteger:
+		width, signed := logicalValue.int(1, 0), logicalValue.bool(2, true)
+		f.typ, err = intTypeOf(width, signed)
+	case converted >= convertedUint8 && converted <= convertedInt64:
+		width := int64(8) << ((converted - convertedUint8) % 4)
+		f.typ, err = intTypeOf(width, converted >= convertedInt8)
+	case logicalID != 0 || converted >= 0:
+		return unsupported()
+	default:
+		switch f.physical {
+		case typeBoolean:
+			f.typ = types.T_bool.ToType()
+		case typeInt32:
+			f.typ = types.T_int32.ToType()
+		case typeInt64:
+			f.typ = types.T_int64.ToType()
+		case typeInt96:
+			// the legacy timestamps of nanoseconds, in UTC
+			f.typ = types.T_timestamp.ToType()
+		case typeFloat:
+			f.typ = types.T_float32.ToType()
+		case typeDouble:
+			f.typ = types.T_float64.ToType()
+		case typeByteArray, typeFixedLenByteArray:
+			f.typ = types.T_varchar.ToType()
+		default:
+			return unsupported()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// check the physical type of the logical type
+	switch f.typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16, types.T_uint32, types.T_date:
+		if f.physical != typeInt32 {
+			return unsupported()
+		}
+	case types.T_int64, types.T_uint64:
+		if f.physical != typeInt64 {
+			return unsupported()
+		}
+	case types.T_datetime, types.T_timestamp:
+		if f.physical != typeInt64 && f.physical != typeInt96 {
+			return unsupported()
+		}
+	case types.T_decimal64, types.T_decimal128:
+		if f.physical != typeInt32 && f.physical != typeInt64 &&
+			f.physical != typeByteArray && f.physical != typeFixedLenByteArray {
+			return unsupported()
+		}
+	case types.T_varchar:
+		if f.physical != typeByteArray && f.physical != typeFixedLenByteArray && logicalID != logicalUnknown {
+			return unsupported()
+		}
+	}
+	return nil
+}
+
+func intTypeOf(width int64, signed bool) (types.Type, error) {
+	var oid types.T
+	switch width {
+	case 8:
+		oid = types.T_uint8
+	case 16:
+		oid = types.T_uint16
+	case 32:
+		oid = types.T_uint32
+	case 64:
+		oid = types.T_uint64
+	default:
+		return types.Type{}, ErrUnsupportedType
+	}
+	if signed {
+		oid = map[types.T]types.T{
+			types.T_uint8:  types.T_int8,
+			types.T_uint16: types.T_int16,
+			types.T_uint32: types.T_int32,
+			types.T_uint64: types.T_int64,
+		}[oid]
+	}
+	return oid.ToType(), nil
+}
+
+// decodeSchema returns the top-level columns of the flattened schema,
+// the nested columns are kept with err set.
+func decodeSchema(schema []interface{}) ([]field, error) {
+	if len(schema) == 0 {
+		return nil, ErrInvalidFile
+	}
+	root := schema[0].(tStruct)
+	n := int(root.int(5, 0))
+	fields := make([]field, 0, n)
+	pos, chunk := 1, 0
+	for i := 0; i < n; i++ {
+		if pos >= len(schema) {
+			return nil, ErrInvalidFile
+		}
+		elem := schema[pos].(tStruct)
+		f := field{
+			name:     elem.string(4),
+			chunk:    chunk,
+			optional: elem.int(3, repetitionRequired) == repetitionOptional,
+		}
+		if elem.int(5, 0) > 0 || elem.int(3, repetitionRequired) == repetitionRepeated {
+			// nested column, skip all the elements of it
+			f.err = fmt.Errorf("%w, column '%s' is nested", ErrUnsupportedType, f.name)
+			leaves, next := countLeaves(schema, pos)
+			pos, chunk = next, chunk+leaves
+		} else {
+			f.err = decodeType(&f, elem)
+			pos, chunk = pos+1, chunk+1
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// countLeaves returns the number of the leaves of the element at pos
+// and the position of the next sibling
+func countLeaves(schema []interface{}, pos int) (int, int) {
+	if pos >= len(schema) {
+		panic(ErrInvalidFile)
+	}
+	children := int(schema[pos].(tStruct).int(5, 0))
+	if children == 0 {
+		return 1, pos + 1
+	}
+	leaves := 0
+	pos++
+	for i := 0; i < children; i++ {
+		n, next := countLeaves(schema, pos)
+		leaves, pos = leaves+n, next
+	}
+	return leaves, pos
+}
+
+// ParseCompression returns the codec of the compression name,
+// the empty name means the snappy compression.
+func ParseCompression(name string) (int32, error) {
+	switch strings.ToLower(name) {
+	case "none", "uncompressed":
+		return codecUncompressed, nil
+	case "", "snappy":
+		return codecSnappy, nil
+	case "gzip":
+		return codecGzip, nil
+	case "zstd":
+		return codecZstd, nil
+	}
+	return 0, fmt.Errorf("unsupported parquet compression '%s'", name)
+}
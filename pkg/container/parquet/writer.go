@@ -0,0 +1,412 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+/*
+ * parquet file format:
+ *		magic || column chunks of row group 1 || ... || column chunks of row group n ||
+ *		file metadata || file metadata size || magic
+ * column chunk:
+ *		page header || page || page header || page ...
+ * data page of the optional column:
+ *		definition levels size || definition levels || values
+ */
+
+const (
+	// DefaultRowGroupSize is the max rows of a row group by default
+	DefaultRowGroupSize = 1 << 20
+	// pageSize is the size of the values to cut a page
+	pageSize = 1 << 20
+	// createdBy is the application that writes the file
+	createdBy = "matrixone"
+)
+
+var (
+	// unixEpochDays, the days of 1970-01-01 since 0001-01-01
+	unixEpochDays = int64(types.FromCalendar(1970, 1, 1))
+	// unixEpochSeconds, the seconds of 1970-01-01 00:00:00 since 0001-01-01 00:00:00
+	unixEpochSeconds = unixEpochDays * 24 * 60 * 60
+)
+
+const microsPerSecond = 1000000
+
+// datetimeToMicros converts the datetime to the microseconds since unix epoch
+func datetimeToMicros(dt types.Datetime) int64 {
+	return (int64(dt)>>20-unixEpochSeconds)*microsPerSecond + int64(dt)&0xfffff
+}
+
+// WriterOptions are the options of writing the parquet file
+type WriterOptions struct {
+	// RowGroupSize is the max rows of a row group, DefaultRowGroupSize if 0
+	RowGroupSize int
+	// Compression is the compression of the pages, see ParseCompression
+	Compression string
+}
+
+// Writer writes batches into a parquet file, all columns are optional
+type Writer struct {
+	w     io.Writer
+	attrs []string
+	// typs, the types of the columns, nil until the first batch is written
+	typs         []types.Type
+	schema       []interface{}
+	rowGroupSize int
+	codec        int32
+	// pos, the bytes written
+	pos int64
+	// columns, the buffered column chunks of the current row group
+	columns   []*columnWriter
+	rows      int
+	rowGroups []interface{}
+	numRows   int64
+}
+
+// columnWriter buffers the pages of a column chunk
+type columnWriter struct {
+	name     string
+	typ      types.Type
+	physical int32
+	codec    int32
+	// pages, the page headers and the compressed pages
+	pages            []byte
+	uncompressedSize int64
+	numValues        int64
+	// defined, bools and data, the buffered values of the current page
+	defined []bool
+	bools   []bool
+	data    []byte
+}
+
+func NewWriter(w io.Writer, attrs []string, opts WriterOptions) (*Writer, error) {
+	codec, err := ParseCompression(opts.Compression)
+	if err != nil {
+		return nil, err
+	}
+	if opts.RowGroupSize < 0 {
+		return nil, fmt.Errorf("invalid parquet row group size %d", opts.RowGroupSize)
+	}
+	if opts.RowGroupSize == 0 {
+		opts.RowGroupSize = DefaultRowGroupSize
+	}
+	return &Writer{
+		w:            w,
+		attrs:        attrs,
+		rowGroupSize: opts.RowGroupSize,
+		codec:        codec,
+	}, nil
+}
+
+func (w *Writer) write(data []byte) error {
+	n, err := w.w.Write(data)
+	w.pos += int64(n)
+	return err
+}
+
+// begin writes the magic and decides the schema of the file
+func (w *Writer) begin(typs []types.Type) error {
+	w.schema = []interface{}{
+		tStruct{{id: 4, v: "schema"}, {id: 5, v: int32(len(typs))}},
+	}
+	w.columns = make([]*columnWriter, len(typs))
+	for i, typ := range typs {
+		elem, err := encodeType(w.attrs[i], typ)
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", w.attrs[i], err)
+		}
+		w.schema = append(w.schema, elem)
+		w.columns[i] = &columnWriter{
+			name:     w.attrs[i],
+			typ:      typ,
+			physical: int32(elem.int(1, 0)),
+			codec:    w.codec,
+		}
+	}
+	w.typs = typs
+	return w.write(magic)
+}
+
+// batchRows returns the rows of the batch to write, nil if all rows are written
+func batchRows(bat *batch.Batch) ([]int64, int) {
+	n := vector.Length(bat.Vecs[0])
+	if len(bat.Sels) != 0 {
+		n = len(bat.Sels)
+	} else {
+		all := true
+		for j := 0; j < n && j < len(bat.Zs); j++ {
+			if bat.Zs[j] <= 0 {
+				all = false
+				break
+			}
+		}
+		if all {
+			return nil, n
+		}
+	}
+	rows := make([]int64, 0, n)
+	for j := 0; j < n; j++ {
+		if j < len(bat.Zs) && bat.Zs[j] <= 0 {
+			continue
+		}
+		if len(bat.Sels) != 0 {
+			rows = append(rows, bat.Sels[j])
+		} else {
+			rows = append(rows, int64(j))
+		}
+	}
+	return rows, len(rows)
+}
+
+// Write appends the rows of the batch to the file. The schema of the file
+// is decided by the types of the first batch.
+func (w *Writer) Write(bat *batch.Batch) error {
+	if len(bat.Vecs) != len(w.attrs) {
+		return fmt.Errorf("parquet writer expects %d columns, but got %d", len(w.attrs), len(bat.Vecs))
+	}
+	if w.typs == nil {
+		typs := make([]types.Type, len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			typs[i] = vec.Typ
+		}
+		if err := w.begin(typs); err != nil {
+			return err
+		}
+	}
+	if len(bat.Vecs) == 0 {
+		return nil
+	}
+	for i, vec := range bat.Vecs {
+		if vec.Typ.Oid != w.typs[i].Oid {
+			return fmt.Errorf("parquet writer expects %s for column '%s', but got %s", w.typs[i], w.attrs[i], vec.Typ)
+		}
+	}
+
+	rows, n := batchRows(bat)
+	for start := 0; start < n; {
+		m := n - start
+		if m > w.rowGroupSize-w.rows {
+			m = w.rowGroupSize - w.rows
+		}
+		for i, vec := range bat.Vecs {
+			if err := w.columns[i].append(vec, rows, start, m); err != nil {
+				return err
+			}
+		}
+		start += m
+		w.rows += m
+		if w.rows == w.rowGroupSize {
+			if err := w.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// append buffers the rows [start, start+m) of the vector
+func (c *columnWriter) append(v *vector.Vector, rows []int64, start, m int) error {
+	index := func(i int) int64 {
+		if v.IsScalar() {
+			return 0
+		}
+		if rows != nil {
+			return rows[i]
+		}
+		return int64(i)
+	}
+	le := binary.LittleEndian
+	for i := start; i < start+m; i++ {
+		row := index(i)
+		if v.Typ.Oid == types.T_any || v.IsScalarNull() || nulls.Contains(v.Nsp, uint64(row)) {
+			c.defined = append(c.defined, false)
+			continue
+		}
+		c.defined = append(c.defined, true)
+		switch v.Typ.Oid {
+		case types.T_bool:
+			c.bools = append(c.bools, v.Col.([]bool)[row])
+		case types.T_int8:
+			c.data = le.AppendUint32(c.data, uint32(v.Col.([]int8)[row]))
+		case types.T_int16:
+			c.data = le.AppendUint32(c.data, uint32(v.Col.([]int16)[row]))
+		case types.T_int32:
+			c.data = le.AppendUint32(c.data, uint32(v.Col.([]int32)[row]))
+		case types.T_int64:
+			c.data = le.AppendUint64(c.data, uint64(v.Col.([]int64)[row]))
+		case types.T_uint8:
+			c.data = le.AppendUint32(c.data, uint32(v.Col.([]uint8)[row]))
+		case types.T_uint16:
+			c.data = le.AppendUint32(c.data, uint32(v.Col.([]uint16)[row]))
+		case types.T_uint32:
+			c.data = le.AppendUint32(c.data, v.Col.([]uint32)[row])
+		case types.T_uint64:
+			c.data = le.AppendUint64(c.data, v.Col.([]uint64)[row])
+		case types.T_float32:
+			c.data = le.AppendUint32(c.data, math.Float32bits(v.Col.([]float32)[row]))
+		case types.T_float64:
+			c.data = le.AppendUint64(c.data, math.Float64bits(v.Col.([]float64)[row]))
+		case types.T_decimal64:
+			c.data = le.AppendUint64(c.data, uint64(v.Col.([]types.Decimal64)[row]))
+		case types.T_decimal128:
+			// big-endian two's complement
+			d := v.Col.([]types.Decimal128)[row]
+			c.data = binary.BigEndian.AppendUint64(c.data, uint64(d.Hi))
+			c.data = binary.BigEndian.AppendUint64(c.data, uint64(d.Lo))
+		case types.T_date:
+			c.data = le.AppendUint32(c.data, uint32(int64(v.Col.([]types.Date)[row])-unixEpochDays))
+		case types.T_datetime:
+			c.data = le.AppendUint64(c.data, uint64(datetimeToMicros(v.Col.([]types.Datetime)[row])))
+		case types.T_timestamp:
+			ts := v.Col.([]types.Timestamp)[row]
+			c.data = le.AppendUint64(c.data, uint64(datetimeToMicros(types.Datetime(ts))))
+		case types.T_char, types.T_varchar:
+			bs := v.Col.(*types.Bytes).Get(row)
+			c.data = le.AppendUint32(c.data, uint32(len(bs)))
+			c.data = append(c.data, bs...)
+		default:
+			return fmt.Errorf("%w %s", ErrUnsupportedType, v.Typ)
+		}
+	}
+	if len(c.data) >= pageSize || len(c.bools) >= pageSize*8 {
+		return c.cutPage()
+	}
+	return nil
+}
+
+// cutPage compresses the buffered values as a data page
+func (c *columnWriter) cutPage() error {
+	n := len(c.defined)
+	if n == 0 {
+		return nil
+	}
+	levels := appendLevels(nil, c.defined)
+	body := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	body = append(body, levels...)
+	if c.physical == typeBoolean {
+		packed := make([]byte, (len(c.bools)+7)/8)
+		for i, b := range c.bools {
+			if b {
+				packed[i>>3] |= 1 << (i & 7)
+			}
+		}
+		body = append(body, packed...)
+	} else {
+		body = append(body, c.data...)
+	}
+	if len(body) > math.MaxInt32 {
+		return fmt.Errorf("the parquet page of column '%s' is too large", c.name)
+	}
+	compressed, err := compress(c.codec, body)
+	if err != nil {
+		return err
+	}
+	header := appendStruct(nil, tStruct{
+		{id: 1, v: int32(pageData)},
+		{id: 2, v: int32(len(body))},
+		{id: 3, v: int32(len(compressed))},
+		{id: 5, v: tStruct{
+			{id: 1, v: int32(n)},
+			{id: 2, v: int32(encodingPlain)},
+			{id: 3, v: int32(encodingRLE)},
+			{id: 4, v: int32(encodingRLE)},
+		}},
+	})
+	c.pages = append(c.pages, header...)
+	c.pages = append(c.pages, compressed...)
+	c.uncompressedSize += int64(len(header) + len(body))
+	c.numValues += int64(n)
+	c.defined, c.bools, c.data = c.defined[:0], c.bools[:0], c.data[:0]
+	return nil
+}
+
+// flush writes the buffered row group
+func (w *Writer) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+	var chunks []interface{}
+	var totalSize int64
+	for _, c := range w.columns {
+		if err := c.cutPage(); err != nil {
+			return err
+		}
+		offset := w.pos
+		if err := w.write(c.pages); err != nil {
+			return err
+		}
+		meta := tStruct{
+			{id: 1, v: c.physical},
+			{id: 2, v: tList{typ: ctI32, vs: []interface{}{int32(encodingPlain), int32(encodingRLE)}}},
+			{id: 3, v: tList{typ: ctBinary, vs: []interface{}{c.name}}},
+			{id: 4, v: c.codec},
+			{id: 5, v: c.numValues},
+			{id: 6, v: c.uncompressedSize},
+			{id: 7, v: int64(len(c.pages))},
+			{id: 9, v: offset},
+		}
+		chunks = append(chunks, tStruct{
+			{id: 2, v: offset},
+			{id: 3, v: meta},
+		})
+		totalSize += c.uncompressedSize
+		c.pages, c.uncompressedSize, c.numValues = c.pages[:0], 0, 0
+	}
+	w.rowGroups = append(w.rowGroups, tStruct{
+		{id: 1, v: tList{typ: ctStruct, vs: chunks}},
+		{id: 2, v: totalSize},
+		{id: 3, v: int64(w.rows)},
+	})
+	w.numRows += int64(w.rows)
+	w.rows = 0
+	return nil
+}
+
+// Close writes the last row group and the metadata of the file. If no
+// batch is written, the columns of the file are of the null type.
+func (w *Writer) Close() error {
+	if w.typs == nil {
+		typs := make([]types.Type, len(w.attrs))
+		for i := range typs {
+			typs[i] = types.T_any.ToType()
+		}
+		if err := w.begin(typs); err != nil {
+			return err
+		}
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+	meta := appendStruct(nil, tStruct{
+		{id: 1, v: int32(1)},
+		{id: 2, v: tList{typ: ctStruct, vs: w.schema}},
+		{id: 3, v: w.numRows},
+		{id: 4, v: tList{typ: ctStruct, vs: w.rowGroups}},
+		{id: 6, v: createdBy},
+	})
+	meta = binary.LittleEndian.AppendUint32(meta, uint32(len(meta)))
+	meta = append(meta, magic...)
+	return w.write(meta)
+}
@@ -436,6 +436,13 @@ func DateToTimestamp(xs []Date, rs []Timestamp) ([]Timestamp, error) {
 	return rs, nil
 }
 
+func DateToDatetime(xs []Date, rs []Datetime) ([]Datetime, error) {
+	for i, x := range xs {
+		rs[i] = x.ToTime()
+	}
+	return rs, nil
+}
+
 func (d Date) Month() uint8 {
 	_, month, _, _ := d.Calendar(true)
 	return month
@@ -0,0 +1,532 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+var (
+	errIncorrectDatetimeFormat = errors.New(errno.DataException, "Incorrect datetime value for the format")
+
+	monthNames = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"}
+	// dayNames is indexed by Weekday, so it starts from Sunday
+	dayNames = []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+)
+
+// week behaviour flags, the same as the ones of mysql's calc_week
+const (
+	weekMondayFirst  = 1
+	weekYear         = 2
+	weekFirstWeekday = 4
+)
+
+// LastDay returns the last day of the month in which d is
+func (d Date) LastDay() Date {
+	year, month, _, _ := d.Calendar(true)
+	return FromCalendar(year, month, uint8(LastDay(uint16(year), month)))
+}
+
+// DateDiff returns the number of days from d2 to d1
+func DateDiff(d1, d2 Date) int64 {
+	return int64(d1) - int64(d2)
+}
+
+// DatetimeDiff returns the difference dt2 - dt1 as an integer in the unit of its,
+// only the units from MicroSecond to Year are supported.
+// Like mysql, the result is truncated toward zero, so month, quarter and
+// year only count the complete months between dt1 and dt2.
+func DatetimeDiff(its IntervalType, dt1, dt2 Datetime) (int64, error) {
+	micros := (dt2.sec()-dt1.sec())*1000000 + dt2.MicroSec() - dt1.MicroSec()
+	switch its {
+	case MicroSecond:
+		return micros, nil
+	case Second:
+		return micros / 1000000, nil
+	case Minute:
+		return micros / (secsPerMinute * 1000000), nil
+	case Hour:
+		return micros / (secsPerHour * 1000000), nil
+	case Day:
+		return micros / (secsPerDay * 1000000), nil
+	case Week:
+		return micros / (7 * secsPerDay * 1000000), nil
+	case Month:
+		return monthDiff(dt1, dt2), nil
+	case Quarter:
+		return monthDiff(dt1, dt2) / 3, nil
+	case Year:
+		return monthDiff(dt1, dt2) / 12, nil
+	}
+	return 0, errors.New(errno.DataException, "invalid unit '"+its.String()+"' for timestampdiff")
+}
+
+// monthDiff returns the number of complete months from dt1 to dt2
+func monthDiff(dt1, dt2 Datetime) int64 {
+	y1, m1, d1, _ := dt1.ToDate().Calendar(true)
+	y2, m2, d2, _ := dt2.ToDate().Calendar(true)
+	months := int64(y2-y1)*12 + int64(m2) - int64(m1)
+	// compare the rest part of the two values, which is the day in month and the time in day
+	rest1 := int64(d1)*secsPerDay*1000000 + dt1.sec()%secsPerDay*1000000 + dt1.MicroSec()
+	rest2 := int64(d2)*secsPerDay*1000000 + dt2.sec()%secsPerDay*1000000 + dt2.MicroSec()
+	if months > 0 && rest2 < rest1 {
+		months--
+	} else if months < 0 && rest2 > rest1 {
+		months++
+	}
+	return months
+}
+
+// AppendFormat appends the textual representation of dt according to the
+// format of mysql's DATE_FORMAT to buf and returns the extended buffer.
+// Supported specifiers:
+// %a	Abbreviated weekday name (Sun..Sat)
+// %b	Abbreviated month name (Jan..Dec)
+// %c	Month, numeric (1..12)
+// %D	Day of the month with English suffix (1st, 2nd, 3rd, …)
+// %d	Day of the month, numeric (01..31)
+// %e	Day of the month, numeric (1..31)
+// %f	Microseconds (000000..999999)
+// %H	Hour (00..23)
+// %h	Hour (01..12)
+// %I	Hour (01..12)
+// %i	Minutes, numeric (00..59)
+// %j	Day of year (001..366)
+// %k	Hour (0..23)
+// %l	Hour (1..12)
+// %M	Month name (January..December)
+// %m	Month, numeric (01..12)
+// %p	AM or PM
+// %r	Time, 12-hour (hh:mm:ss followed by AM or PM)
+// %S	Seconds (00..59)
+// %s	Seconds (00..59)
+// %T	Time, 24-hour (hh:mm:ss)
+// %U	Week (00..53), where Sunday is the first day of the week; WEEK() mode 0
+// %u	Week (00..53), where Monday is the first day of the week; WEEK() mode 1
+// %V	Week (01..53), where Sunday is the first day of the week; WEEK() mode 2; used with %X
+// %v	Week (01..53), where Monday is the first day of the week; WEEK() mode 3; used with %x
+// %W	Weekday name (Sunday..Saturday)
+// %w	Day of the week (0=Sunday..6=Saturday)
+// %X	Year for the week where Sunday is the first day of the week, numeric, four digits; used with %V
+// %x	Year for the week, where Monday is the first day of the week, numeric, four digits; used with %v
+// %Y	Year, numeric, four digits
+// %y	Year, numeric (two digits)
+// %%	A literal % character
+// %x	x, for any "x" not listed above
+func (dt Datetime) AppendFormat(buf []byte, format []byte) []byte {
+	d := dt.ToDate()
+	year, month, day, yday := d.Calendar(true)
+	hour, minute, second := dt.Clock()
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, dayNames[d.DayOfWeek()][:3]...)
+		case 'b':
+			buf = append(buf, monthNames[month-1][:3]...)
+		case 'c':
+			buf = appendInt(buf, int64(month), 0)
+		case 'D':
+			buf = appendInt(buf, int64(day), 0)
+			buf = append(buf, daySuffix(day)...)
+		case 'd':
+			buf = appendInt(buf, int64(day), 2)
+		case 'e':
+			buf = appendInt(buf, int64(day), 0)
+		case 'f':
+			buf = appendInt(buf, dt.MicroSec(), 6)
+		case 'H':
+			buf = appendInt(buf, int64(hour), 2)
+		case 'h', 'I':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+		case 'i':
+			buf = appendInt(buf, int64(minute), 2)
+		case 'j':
+			buf = appendInt(buf, int64(yday), 3)
+		case 'k':
+			buf = appendInt(buf, int64(hour), 0)
+		case 'l':
+			buf = appendInt(buf, int64(hour12(hour)), 0)
+		case 'M':
+			buf = append(buf, monthNames[month-1]...)
+		case 'm':
+			buf = appendInt(buf, int64(month), 2)
+		case 'p':
+			buf = append(buf, meridiem(hour)...)
+		case 'r':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(second), 2)
+			buf = append(buf, ' ')
+			buf = append(buf, meridiem(hour)...)
+		case 'S', 's':
+			buf = appendInt(buf, int64(second), 2)
+		case 'T':
+			buf = appendInt(buf, int64(hour), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(second), 2)
+		case 'U':
+			_, week := d.calcWeek(weekFirstWeekday)
+			buf = appendInt(buf, int64(week), 2)
+		case 'u':
+			_, week := d.calcWeek(weekMondayFirst)
+			buf = appendInt(buf, int64(week), 2)
+		case 'V':
+			_, week := d.calcWeek(weekYear | weekFirstWeekday)
+			buf = appendInt(buf, int64(week), 2)
+		case 'v':
+			_, week := d.calcWeek(weekMondayFirst | weekYear)
+			buf = appendInt(buf, int64(week), 2)
+		case 'W':
+			buf = append(buf, dayNames[d.DayOfWeek()]...)
+		case 'w':
+			buf = appendInt(buf, int64(d.DayOfWeek()), 0)
+		case 'X':
+			y, _ := d.calcWeek(weekYear | weekFirstWeekday)
+			buf = appendInt(buf, int64(y), 4)
+		case 'x':
+			y, _ := d.calcWeek(weekMondayFirst | weekYear)
+			buf = appendInt(buf, int64(y), 4)
+		case 'Y':
+			buf = appendInt(buf, int64(year), 4)
+		case 'y':
+			buf = appendInt(buf, int64(year%100), 2)
+		default:
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
+
+// calcWeek returns the year and the week number of d, the behaviour
+// is a combination of weekMondayFirst, weekYear and weekFirstWeekday.
+// It is a port of calc_week in mysql.
+func (d Date) calcWeek(behaviour int) (int32, int) {
+	year, month, day, _ := d.Calendar(true)
+	mondayFirst := behaviour&weekMondayFirst != 0
+	useWeekYear := behaviour&weekYear != 0
+	firstWeekday := behaviour&weekFirstWeekday != 0
+
+	dayNr := int32(d)
+	firstDayNr := int32(FromCalendar(year, 1, 1))
+	weekday := calcWeekday(firstDayNr, !mondayFirst)
+
+	if month == 1 && int32(day) <= 7-weekday {
+		if !useWeekYear && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return year, 0
+		}
+		useWeekYear = true
+		year--
+		days := daysInYear(year)
+		firstDayNr -= days
+		weekday = (weekday + 53*7 - days) % 7
+	}
+
+	var days int32
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days = dayNr - (firstDayNr + 7 - weekday)
+	} else {
+		days = dayNr - (firstDayNr - weekday)
+	}
+
+	if useWeekYear && days >= 52*7 {
+		weekday = (weekday + daysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return year + 1, 1
+		}
+	}
+	return year, int(days/7 + 1)
+}
+
+// calcWeekday returns the weekday of the day number, 0 is Monday
+// unless sundayFirst is true, then 0 is Sunday
+func calcWeekday(dayNr int32, sundayFirst bool) int32 {
+	weekday := int32(Date(dayNr).DayOfWeek())
+	if sundayFirst {
+		return weekday
+	}
+	return (weekday + 6) % 7
+}
+
+func daysInYear(year int32) int32 {
+	if isLeap(year) {
+		return 366
+	}
+	return 365
+}
+
+func hour12(hour int8) int8 {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func meridiem(hour int8) string {
+	if hour < 12 {
+		return "AM"
+	}
+	return "PM"
+}
+
+func daySuffix(day uint8) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// appendInt appends the decimal form of v to buf, padding zeros to width
+func appendInt(buf []byte, v int64, width int) []byte {
+	var tmp [20]byte
+	i := len(tmp)
+	for v >= 10 {
+		i--
+		tmp[i] = byte('0' + v%10)
+		v /= 10
+	}
+	i--
+	tmp[i] = byte('0' + v)
+	for n := len(tmp) - i; n < width; n++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, tmp[i:]...)
+}
+
+// ParseDatetimeWithFormat parses s by the format of mysql's STR_TO_DATE,
+// the specifiers are the same as the ones of AppendFormat except the week
+// related ones (%U %u %V %v %X %x), which are not supported.
+// Whitespaces of s are skipped before each part of the format, and
+// the trailing characters of s which are not matched are ignored.
+func ParseDatetimeWithFormat(s string, format string) (Datetime, error) {
+	p := &datetimeParser{s: s}
+	if err := p.parse(format); err != nil {
+		return 0, err
+	}
+	return p.datetime()
+}
+
+type datetimeParser struct {
+	s   string
+	pos int
+
+	year, month, day       int64
+	hour, minute, second   int64
+	microSec, yday         int64
+	isPM, hasPM, is12Hours bool
+}
+
+func (p *datetimeParser) parse(format string) error {
+	for i := 0; i < len(format); i++ {
+		p.skipSpaces()
+		if p.pos >= len(p.s) {
+			break
+		}
+		c := format[i]
+		if c != '%' || i == len(format)-1 {
+			if isSpace(c) {
+				continue
+			}
+			if p.s[p.pos] != c {
+				return errIncorrectDatetimeFormat
+			}
+			p.pos++
+			continue
+		}
+		i++
+		var err error
+		switch format[i] {
+		case 'Y':
+			start := p.pos
+			if p.year, err = p.number(4); err == nil && p.pos-start <= 2 {
+				p.year = twoDigitYear(p.year)
+			}
+		case 'y':
+			if p.year, err = p.number(2); err == nil {
+				p.year = twoDigitYear(p.year)
+			}
+		case 'm', 'c':
+			p.month, err = p.number(2)
+		case 'M':
+			p.month, err = p.name(monthNames, false)
+		case 'b':
+			p.month, err = p.name(monthNames, true)
+		case 'd', 'e':
+			p.day, err = p.number(2)
+		case 'D':
+			if p.day, err = p.number(2); err == nil && len(p.s)-p.pos >= 2 {
+				// skip the English suffix
+				p.pos += 2
+			}
+		case 'H', 'k':
+			p.hour, err = p.number(2)
+		case 'h', 'I', 'l':
+			p.hour, err = p.number(2)
+			p.is12Hours = true
+		case 'i':
+			p.minute, err = p.number(2)
+		case 'S', 's':
+			p.second, err = p.number(2)
+		case 'f':
+			start := p.pos
+			if p.microSec, err = p.number(6); err == nil {
+				for n := p.pos - start; n < 6; n++ {
+					p.microSec *= 10
+				}
+			}
+		case 'j':
+			p.yday, err = p.number(3)
+		case 'p':
+			err = p.meridiem()
+		case 'a':
+			_, err = p.name(dayNames, true)
+		case 'W':
+			_, err = p.name(dayNames, false)
+		case 'w':
+			_, err = p.number(1)
+		case 'T':
+			err = p.parse("%H:%i:%S")
+		case 'r':
+			err = p.parse("%I:%i:%S %p")
+		case '%':
+			if p.s[p.pos] != '%' {
+				return errIncorrectDatetimeFormat
+			}
+			p.pos++
+		default:
+			return errIncorrectDatetimeFormat
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *datetimeParser) datetime() (Datetime, error) {
+	if p.hasPM && !p.is12Hours {
+		return 0, errIncorrectDatetimeFormat
+	}
+	if p.is12Hours {
+		if p.hour < 1 || p.hour > 12 {
+			return 0, errIncorrectDatetimeFormat
+		}
+		p.hour %= 12
+		if p.isPM {
+			p.hour += 12
+		}
+	}
+	if p.yday > 0 {
+		if p.year < MinDatetimeYear || p.year > MaxDatetimeYear || p.yday > int64(daysInYear(int32(p.year))) {
+			return 0, errIncorrectDatetimeFormat
+		}
+		d := Date(int64(FromCalendar(int32(p.year), 1, 1)) + p.yday - 1)
+		_, month, day, _ := d.Calendar(true)
+		p.month, p.day = int64(month), int64(day)
+	}
+	if p.year > MaxDatetimeYear || p.month > MaxMonthInYear || p.day > 31 ||
+		!validDatetime(int32(p.year), uint8(p.month), uint8(p.day)) ||
+		!validTimeInDay(uint8(p.hour), uint8(p.minute), uint8(p.second)) {
+		return 0, errIncorrectDatetimeFormat
+	}
+	return FromClock(int32(p.year), uint8(p.month), uint8(p.day), uint8(p.hour), uint8(p.minute), uint8(p.second), uint32(p.microSec)), nil
+}
+
+// number reads at most maxLen digits from the current position
+func (p *datetimeParser) number(maxLen int) (int64, error) {
+	var v int64
+	start := p.pos
+	for p.pos < len(p.s) && p.pos-start < maxLen && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		v = v*10 + int64(p.s[p.pos]-'0')
+		p.pos++
+	}
+	if p.pos == start {
+		return 0, errIncorrectDatetimeFormat
+	}
+	return v, nil
+}
+
+// name reads one of the names (case-insensitive) from the current position and
+// returns its 1-based index, only the first 3 characters are matched if abbr is true
+func (p *datetimeParser) name(names []string, abbr bool) (int64, error) {
+	for i, name := range names {
+		if abbr {
+			name = name[:3]
+		}
+		if len(p.s)-p.pos >= len(name) && strings.EqualFold(p.s[p.pos:p.pos+len(name)], name) {
+			p.pos += len(name)
+			return int64(i + 1), nil
+		}
+	}
+	return 0, errIncorrectDatetimeFormat
+}
+
+func (p *datetimeParser) meridiem() error {
+	if len(p.s)-p.pos < 2 {
+		return errIncorrectDatetimeFormat
+	}
+	switch strings.ToUpper(p.s[p.pos : p.pos+2]) {
+	case "AM":
+		p.isPM = false
+	case "PM":
+		p.isPM = true
+	default:
+		return errIncorrectDatetimeFormat
+	}
+	p.hasPM = true
+	p.pos += 2
+	return nil
+}
+
+func (p *datetimeParser) skipSpaces() {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// twoDigitYear converts a two-digit year to a four-digit one, the same as mysql:
+// 00-69 are converted to 2000-2069, and 70-99 are converted to 1970-1999.
+func twoDigitYear(year int64) int64 {
+	if year < 70 {
+		return year + 2000
+	}
+	return year + 1900
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseDatetime(t *testing.T, s string) Datetime {
+	dt, err := ParseDatetime(s, 6)
+	require.NoError(t, err)
+	return dt
+}
+
+func TestDatetimeAppendFormat(t *testing.T) {
+	tests := []struct {
+		datetime string
+		format   string
+		want     string
+	}{
+		{"2009-10-04 22:23:00", "%W %M %Y", "Sunday October 2009"},
+		{"2007-10-04 22:23:00", "%H:%i:%s", "22:23:00"},
+		{"1900-10-04 22:23:00", "%D %y %a %d %m %b %j", "4th 00 Thu 04 10 Oct 277"},
+		{"1997-10-04 22:23:00", "%H %k %I %r %T %S %w", "22 22 10 10:23:00 PM 22:23:00 00 6"},
+		{"2022-03-01 00:05:09.000012", "%c/%e %l:%i %p %f", "3/1 12:05 AM 000012"},
+		{"2022-01-22 10:00:00", "%Dx%D", "22ndx22nd"},
+		{"2022-01-13 10:00:00", "100%% %q %", "100% q %"},
+		{"1999-01-01 00:00:00", "%X %V", "1998 52"},
+		{"2008-02-20 00:00:00", "%U %u", "07 08"},
+		{"2000-01-01 00:00:00", "%U %u %V %v", "00 00 52 52"},
+		{"2008-12-29 00:00:00", "%x-%v", "2009-01"},
+	}
+	for _, test := range tests {
+		dt := mustParseDatetime(t, test.datetime)
+		require.Equal(t, test.want, string(dt.AppendFormat(nil, []byte(test.format))), test.format)
+	}
+}
+
+func TestParseDatetimeWithFormat(t *testing.T) {
+	tests := []struct {
+		s       string
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{s: "01,5,2013", format: "%d,%m,%Y", want: "2013-05-01 00:00:00"},
+		{s: "May 1, 2013", format: "%M %d,%Y", want: "2013-05-01 00:00:00"},
+		{s: "  mar 7 13", format: "%b %e %y", want: "2013-03-07 00:00:00"},
+		{s: "2013-05-01 10:20:30 PM", format: "%Y-%m-%d %r", want: "2013-05-01 22:20:30"},
+		{s: "12:00:01 am 2013-05-01", format: "%h:%i:%s %p %Y-%m-%d", want: "2013-05-01 00:00:01"},
+		{s: "2022 32", format: "%Y %j", want: "2022-02-01 00:00:00"},
+		{s: "2022-1-5 1:2:3.5", format: "%Y-%c-%e %k:%i:%s.%f", want: "2022-01-05 01:02:03.500000"},
+		{s: "2022-01-05 trailing", format: "%Y-%m-%d", want: "2022-01-05 00:00:00"},
+		{s: "9", format: "%m", wantErr: true},
+		{s: "2022-02-30", format: "%Y-%m-%d", wantErr: true},
+		{s: "2022/02/03", format: "%Y-%m-%d", wantErr: true},
+		{s: "13:00 PM 2022-02-03", format: "%h:%i %p %Y-%m-%d", wantErr: true},
+		{s: "200442 Monday", format: "%X%V %W", wantErr: true},
+	}
+	for _, test := range tests {
+		dt, err := ParseDatetimeWithFormat(test.s, test.format)
+		if test.wantErr {
+			require.Error(t, err, test.s)
+			continue
+		}
+		require.NoError(t, err, test.s)
+		if dt.MicroSec() != 0 {
+			require.Equal(t, test.want, dt.String2(6), test.s)
+		} else {
+			require.Equal(t, test.want, dt.String(), test.s)
+		}
+	}
+}
+
+func TestDatetimeDiff(t *testing.T) {
+	tests := []struct {
+		unit    IntervalType
+		dt1     string
+		dt2     string
+		want    int64
+		wantErr bool
+	}{
+		{unit: Month, dt1: "2003-02-01", dt2: "2003-05-01", want: 3},
+		{unit: Year, dt1: "2002-05-01", dt2: "2001-01-01", want: -1},
+		{unit: Minute, dt1: "2003-02-01", dt2: "2003-05-01 12:05:55", want: 128885},
+		{unit: Month, dt1: "2022-01-31", dt2: "2022-02-28", want: 0},
+		{unit: Month, dt1: "2022-01-31 10:00:00", dt2: "2022-03-31 09:59:59", want: 1},
+		{unit: Month, dt1: "2022-03-31", dt2: "2022-01-31 00:00:01", want: -1},
+		{unit: Quarter, dt1: "2022-01-01", dt2: "2022-12-31", want: 3},
+		{unit: Week, dt1: "2022-01-01", dt2: "2022-01-14", want: 1},
+		{unit: Day, dt1: "2022-01-02", dt2: "2022-01-01 00:00:01", want: 0},
+		{unit: Hour, dt1: "2022-01-01 00:00:00", dt2: "2022-01-01 01:59:59", want: 1},
+		{unit: Second, dt1: "2022-01-01 00:00:00.5", dt2: "2022-01-01 00:00:02", want: 1},
+		{unit: MicroSecond, dt1: "2022-01-01 00:00:00.5", dt2: "2022-01-01 00:00:02", want: 1500000},
+		{unit: Day_Hour, dt1: "2022-01-01", dt2: "2022-01-01", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := DatetimeDiff(test.unit, mustParseDatetime(t, test.dt1), mustParseDatetime(t, test.dt2))
+		if test.wantErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.unit.String()+" "+test.dt1+" "+test.dt2)
+	}
+	d1, _ := ParseDate("2022-03-01")
+	d2, _ := ParseDate("2022-02-01")
+	require.Equal(t, int64(28), DateDiff(d1, d2))
+	require.Equal(t, int64(-28), DateDiff(d2, d1))
+}
+
+func TestDateLastDay(t *testing.T) {
+	tests := [][2]string{
+		{"2003-02-05", "2003-02-28"},
+		{"2004-02-05", "2004-02-29"},
+		{"2004-01-01", "2004-01-31"},
+		{"2004-12-31", "2004-12-31"},
+	}
+	for _, test := range tests {
+		d, err := ParseDate(test[0])
+		require.NoError(t, err)
+		require.Equal(t, test[1], d.LastDay().String())
+	}
+}
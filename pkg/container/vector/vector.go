@@ -527,6 +527,14 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 	}
 }
 
+// Size returns the size of the column data of the vector in bytes
+func Size(v *Vector) int {
+	if col, ok := v.Col.(*types.Bytes); ok {
+		return len(col.Data)
+	}
+	return Length(v) * int(v.Typ.Size)
+}
+
 func Length(v *Vector) int {
 	if v.IsScalar() || v.Typ.Oid == types.T_any {
 		return v.Length
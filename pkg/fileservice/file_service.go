@@ -67,6 +67,8 @@ type DirEntry struct {
 	// file name, not full path
 	Name  string
 	IsDir bool
+	// file size in bytes, 0 for dirs
+	Size int
 }
 
 // MutableFileService is an extension interface to FileService that allow mutation
@@ -131,6 +131,14 @@ func testFileService(t *testing.T, newFS func() FileService) {
 		})
 		assert.Nil(t, err)
 
+		entries, err := fs.List(ctx, "")
+		assert.Nil(t, err)
+		for _, entry := range entries {
+			if entry.Name == "foo" {
+				assert.Equal(t, 11, entry.Size)
+			}
+		}
+
 		// invalid path TODO
 
 	})
@@ -0,0 +1,109 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// LocalETLFS is a FileService implementation backed by an ordinary local dir,
+// for the files that come from or go to the outside, like the files of LOAD DATA and SELECT ... INTO OUTFILE.
+// Unlike LocalFS, the dir needs not to be a dedicated file service dir,
+// and the temporary files are created aside the target files.
+type LocalETLFS struct {
+	*LocalFS
+}
+
+var _ FileService = new(LocalETLFS)
+
+func NewLocalETLFS(rootPath string) (*LocalETLFS, error) {
+	info, err := os.Stat(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a dir", rootPath)
+	}
+	return &LocalETLFS{
+		LocalFS: &LocalFS{
+			rootPath: rootPath,
+			dirFiles: make(map[string]*os.File),
+		},
+	}, nil
+}
+
+func (l *LocalETLFS) Write(ctx context.Context, vector IOVector) error {
+	nativePath := l.toNativeFilePath(vector.FilePath)
+
+	// check existence
+	_, err := os.Stat(nativePath)
+	if err == nil {
+		// existed
+		return ErrFileExisted
+	}
+
+	// sort
+	sort.Slice(vector.Entries, func(i, j int) bool {
+		return vector.Entries[i].Offset < vector.Entries[j].Offset
+	})
+
+	// size
+	var size int64
+	if len(vector.Entries) > 0 {
+		last := vector.Entries[len(vector.Entries)-1]
+		size = int64(last.Offset + last.Size)
+	}
+
+	// ensure parent dir
+	parentDir, _ := filepath.Split(nativePath)
+	err = l.ensureDir(parentDir)
+	if err != nil {
+		return err
+	}
+
+	// write
+	f, err := os.CreateTemp(parentDir, ".*.tmp")
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, newIOEntriesReader(vector.Entries))
+	if err == nil && n != size {
+		err = ErrSizeNotMatch
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	// move
+	if err := os.Rename(f.Name(), nativePath); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := l.syncDir(parentDir); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalETLFS(t *testing.T) {
+	testFileService(t, func() FileService {
+		dir := t.TempDir()
+		fs, err := NewLocalETLFS(dir)
+		assert.Nil(t, err)
+		return fs
+	})
+}
+
+func TestLocalETLFSOrdinaryDir(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "existed"), []byte("abc"), 0644)
+	assert.Nil(t, err)
+
+	// NewLocalFS rejects the dir that is not a file service dir
+	_, err = NewLocalFS(dir)
+	assert.NotNil(t, err)
+
+	fs, err := NewLocalETLFS(dir)
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	vec := IOVector{
+		FilePath: "existed",
+		Entries: []IOEntry{
+			{
+				Offset: 1,
+				Size:   2,
+			},
+		},
+	}
+	err = fs.Read(ctx, &vec)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bc"), vec.Entries[0].Data)
+
+	err = fs.Write(ctx, IOVector{
+		FilePath: "existed",
+		Entries: []IOEntry{
+			{
+				Size: 1,
+				Data: []byte("d"),
+			},
+		},
+	})
+	assert.ErrorIs(t, err, ErrFileExisted)
+
+	err = fs.Write(ctx, IOVector{
+		FilePath: "sub/new",
+		Entries: []IOEntry{
+			{
+				Size: 1,
+				Data: []byte("d"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	content, err := os.ReadFile(filepath.Join(dir, "sub", "new"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("d"), content)
+
+	// no temporary file is left and no sentinel file is created
+	entries, err := os.ReadDir(filepath.Join(dir, "sub"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	entries, err = os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entries))
+
+	_, err = NewLocalETLFS(filepath.Join(dir, "existed"))
+	assert.NotNil(t, err)
+}
@@ -237,9 +237,18 @@ func (l *LocalFS) List(ctx context.Context, dirPath string) (ret []DirEntry, err
 		if strings.HasPrefix(name, ".") {
 			continue
 		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		fileSize := 0
+		if !entry.IsDir() {
+			fileSize = int(info.Size())
+		}
 		ret = append(ret, DirEntry{
 			Name:  name,
 			IsDir: entry.IsDir(),
+			Size:  fileSize,
 		})
 	}
 
@@ -67,6 +67,9 @@ func (m *MemoryFS) List(ctx context.Context, dirPath string) (entries []DirEntry
 				Name:  name,
 			})
 		}
+		if !isDir {
+			entries[len(entries)-1].Size = item.Offset + item.Size
+		}
 
 		return true
 	})
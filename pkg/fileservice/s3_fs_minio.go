@@ -63,10 +63,14 @@ func (m *S3FSMinio) List(ctx context.Context, dirPath string) (entries []DirEntr
 		isDir := strings.HasSuffix(filePath, "/")
 		filePath = strings.TrimRight(filePath, "/")
 		_, name := path.Split(filePath)
-		entries = append(entries, DirEntry{
+		entry := DirEntry{
 			Name:  name,
 			IsDir: isDir,
-		})
+		}
+		if !isDir {
+			entry.Size = int(info.Size)
+		}
+		entries = append(entries, entry)
 	}
 
 	return
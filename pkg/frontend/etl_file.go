@@ -0,0 +1,164 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+const s3Scheme = "s3://"
+
+var (
+	localETLFSOnce sync.Once
+	localETLFS     *fileservice.LocalETLFS
+	localETLFSErr  error
+)
+
+// getFileService returns the FileService and the path in it of the file
+// of LOAD DATA or SELECT ... INTO OUTFILE.
+// s3://bucket/key is in the S3 configured by the s3* parameters,
+// otherwise the file is on the local host of the server.
+var getFileService = func(ses *Session, filePath string) (fileservice.FileService, string, error) {
+	if strings.HasPrefix(filePath, s3Scheme) {
+		bucket, key, ok := strings.Cut(strings.TrimPrefix(filePath, s3Scheme), "/")
+		if !ok || bucket == "" || key == "" {
+			return nil, "", fmt.Errorf("invalid s3 path %s, it should be s3://bucket/key", filePath)
+		}
+		fs, err := fileservice.NewS3FS(fileservice.S3Config{
+			Endpoint:  ses.Pu.SV.GetS3Endpoint(),
+			Region:    ses.Pu.SV.GetS3Region(),
+			APIKey:    ses.Pu.SV.GetS3AccessKeyId(),
+			APISecret: ses.Pu.SV.GetS3SecretAccessKey(),
+			Bucket:    bucket,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return fs, key, nil
+	}
+
+	localETLFSOnce.Do(func() {
+		localETLFS, localETLFSErr = fileservice.NewLocalETLFS("/")
+	})
+	if localETLFSErr != nil {
+		return nil, "", localETLFSErr
+	}
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return localETLFS, strings.TrimPrefix(filepath.ToSlash(absPath), "/"), nil
+}
+
+// fileServiceFileSize returns the size of the file by listing its dir
+func fileServiceFileSize(fs fileservice.FileService, filePath string) (int64, bool, error) {
+	dir, name := path.Split(filePath)
+	entries, err := fs.List(context.TODO(), dir)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, entry := range entries {
+		if entry.Name == name && !entry.IsDir {
+			return int64(entry.Size), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// fileServiceReaderAt reads the file in the FileService at random offsets
+type fileServiceReaderAt struct {
+	fs       fileservice.FileService
+	filePath string
+	size     int64
+}
+
+var _ io.ReaderAt = new(fileServiceReaderAt)
+
+func (r *fileServiceReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	n := len(p)
+	if rest := r.size - off; int64(n) > rest {
+		n = int(rest)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	vec := &fileservice.IOVector{
+		FilePath: r.filePath,
+		Entries: []fileservice.IOEntry{
+			{Offset: int(off), Size: n, Data: p[:n]},
+		},
+	}
+	if err := r.fs.Read(context.TODO(), vec); err != nil {
+		return 0, err
+	}
+	copy(p, vec.Entries[0].Data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// openFileServiceReaderAt opens the file of LOAD DATA through the FileService
+var openFileServiceReaderAt = func(ses *Session, filePath string) (io.ReaderAt, int64, error) {
+	fs, fsPath, err := getFileService(ses, filePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, exist, err := fileServiceFileSize(fs, fsPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !exist {
+		return nil, 0, fmt.Errorf("file %s does not exist", filePath)
+	}
+	return &fileServiceReaderAt{fs: fs, filePath: fsPath, size: size}, size, nil
+}
+
+// saveTempFile saves the data to a temporary file on the local host of the
+// server, and returns the path of the file
+func saveTempFile(data io.Reader) (string, error) {
+	f, err := os.CreateTemp("", "mo-load-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(f, data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeTempFile(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func removeTempFile(filePath string) {
+	if err := os.Remove(filePath); err != nil {
+		logutil.Errorf("remove temp file %s failed. err:%v", filePath, err)
+	}
+}
@@ -2,6 +2,8 @@ package frontend
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,13 +11,66 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/container/arrow"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/parquet"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 var OpenFile = os.OpenFile
 
+// the formats of the file of SELECT ... INTO OUTFILE and LOAD DATA
+const (
+	csvFormat     = "csv"
+	arrowFormat   = "arrow"
+	parquetFormat = "parquet"
+)
+
+func checkFileFormat(format string) error {
+	switch format {
+	case "", csvFormat, arrowFormat, parquetFormat:
+		return nil
+	}
+	return fmt.Errorf("unsupported file format '%s'", format)
+}
+
+// checkExportParam checks the options of SELECT ... INTO OUTFILE
+func checkExportParam(ep *tree.ExportParam) error {
+	if err := checkFileFormat(ep.FileFormat); err != nil {
+		return err
+	}
+	if ep.FileFormat != parquetFormat {
+		if ep.RowGroupSize != 0 || ep.Compression != "" {
+			return errors.New("row_group_size and compression are only supported by the parquet format")
+		}
+		return nil
+	}
+	_, err := parquet.ParseCompression(ep.Compression)
+	return err
+}
+
+// isBatchFormat reports whether the result is exported batch by batch
+func isBatchFormat(format string) bool {
+	return format == arrowFormat || format == parquetFormat
+}
+
+type batchWriter interface {
+	Write(bat *batch.Batch) error
+	Close() error
+}
+
+// batchExportWriter writes the result into the arrow IPC file or the parquet file.
+// The batches may come from multiple pipelines.
+type batchExportWriter struct {
+	sync.Mutex
+	w batchWriter
+	// finish is called after the writer is closed
+	finish func() error
+}
+
 type CloseExportData struct {
 	stopExportData chan interface{}
 	onceClose      sync.Once
@@ -93,6 +148,88 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 	return nil
 }
 
+// openArrowFile opens the arrow IPC file of SELECT ... INTO OUTFILE ... FORMAT 'arrow',
+// the result is written into one file regardless of the max_file_size.
+var openArrowFile = func(ses *Session) error {
+	ep := ses.ep
+	var err error
+	ep.File, err = OpenFile(ep.FilePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
+	if err != nil {
+		return err
+	}
+	ep.Writer = bufio.NewWriterSize(ep.File, int(ep.DefaultBufSize))
+	attrs := make([]string, len(ses.Mrs.Columns))
+	for i, col := range ses.Mrs.Columns {
+		attrs[i] = col.Name()
+	}
+	ses.batchWriter = &batchExportWriter{w: arrow.NewWriter(ep.Writer, attrs)}
+	return nil
+}
+
+// openParquetFile prepares the parquet file of SELECT ... INTO OUTFILE ... FORMAT 'parquet'.
+// The file is buffered in memory and written through the FileService when it is closed,
+// so the path may be a local file or s3://bucket/key.
+var openParquetFile = func(ses *Session) error {
+	ep := ses.ep
+	fs, filePath, err := getFileService(ses, ep.FilePath)
+	if err != nil {
+		return err
+	}
+	if _, exist, err := fileServiceFileSize(fs, filePath); err != nil {
+		return err
+	} else if exist {
+		return fmt.Errorf("file %s already exists", ep.FilePath)
+	}
+	attrs := make([]string, len(ses.Mrs.Columns))
+	for i, col := range ses.Mrs.Columns {
+		attrs[i] = col.Name()
+	}
+	buf := new(bytes.Buffer)
+	w, err := parquet.NewWriter(buf, attrs, parquet.WriterOptions{
+		RowGroupSize: int(ep.RowGroupSize),
+		Compression:  ep.Compression,
+	})
+	if err != nil {
+		return err
+	}
+	ses.batchWriter = &batchExportWriter{
+		w: w,
+		finish: func() error {
+			err := fs.Write(context.TODO(), fileservice.IOVector{
+				FilePath: filePath,
+				Entries: []fileservice.IOEntry{
+					{Offset: 0, Size: buf.Len(), Data: buf.Bytes()},
+				},
+			})
+			if errors.Is(err, fileservice.ErrFileExisted) {
+				return fmt.Errorf("file %s already exists", ep.FilePath)
+			}
+			return err
+		},
+	}
+	return nil
+}
+
+func exportBatchToFile(ses *Session, bat *batch.Batch) error {
+	bw := ses.batchWriter
+	bw.Lock()
+	defer bw.Unlock()
+	return bw.w.Write(bat)
+}
+
+// closeBatchFile writes the footer of the arrow IPC file or the parquet file
+func closeBatchFile(ses *Session) error {
+	bw := ses.batchWriter
+	ses.batchWriter = nil
+	if err := bw.w.Close(); err != nil {
+		return err
+	}
+	if bw.finish != nil {
+		return bw.finish()
+	}
+	return nil
+}
+
 func getExportFilePath(filename string, fileCnt uint) string {
 	if fileCnt == 0 {
 		return filename
@@ -2,11 +2,16 @@ package frontend
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/parquet"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/prashantv/gostub"
@@ -271,3 +276,58 @@ func Test_exportDataToCSVFile(t *testing.T) {
 		convey.So(exportDataToCSVFile(oq), convey.ShouldNotBeNil)
 	})
 }
+
+func Test_checkFileFormat(t *testing.T) {
+	convey.Convey("checkFileFormat", t, func() {
+		convey.So(checkFileFormat(""), convey.ShouldBeNil)
+		convey.So(checkFileFormat(csvFormat), convey.ShouldBeNil)
+		convey.So(checkFileFormat(arrowFormat), convey.ShouldBeNil)
+		convey.So(checkFileFormat(parquetFormat), convey.ShouldBeNil)
+		convey.So(checkFileFormat("xml"), convey.ShouldNotBeNil)
+	})
+}
+
+func Test_checkExportParam(t *testing.T) {
+	convey.Convey("checkExportParam", t, func() {
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: "xml"}), convey.ShouldNotBeNil)
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: csvFormat}), convey.ShouldBeNil)
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: csvFormat, RowGroupSize: 10}), convey.ShouldNotBeNil)
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: arrowFormat, Compression: "zstd"}), convey.ShouldNotBeNil)
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: parquetFormat, RowGroupSize: 10, Compression: "zstd"}), convey.ShouldBeNil)
+		convey.So(checkExportParam(&tree.ExportParam{FileFormat: parquetFormat, Compression: "lzo"}), convey.ShouldNotBeNil)
+	})
+}
+
+func Test_exportParquetFile(t *testing.T) {
+	convey.Convey("export the parquet file", t, func() {
+		file := filepath.Join(t.TempDir(), "a.parquet")
+		mrs := &MysqlResultSet{}
+		col := new(MysqlColumn)
+		col.SetName("a")
+		mrs.AddColumn(col)
+		ses := &Session{
+			Mrs: mrs,
+			ep:  &tree.ExportParam{FilePath: file, FileFormat: parquetFormat, RowGroupSize: 1},
+		}
+
+		bat := batch.New(true, []string{"a"})
+		bat.Vecs[0] = vector.New(types.T_int32.ToType())
+		bat.Vecs[0].Col = []int32{1, 2, 3}
+		bat.InitZsOne(3)
+
+		convey.So(openParquetFile(ses), convey.ShouldBeNil)
+		convey.So(exportBatchToFile(ses, bat), convey.ShouldBeNil)
+		convey.So(closeBatchFile(ses), convey.ShouldBeNil)
+		convey.So(ses.batchWriter, convey.ShouldBeNil)
+
+		data, err := os.ReadFile(file)
+		convey.So(err, convey.ShouldBeNil)
+		r, err := parquet.NewReader(bytes.NewReader(data), int64(len(data)))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(r.NumRows(), convey.ShouldEqual, 3)
+		convey.So(r.Attrs(), convey.ShouldResemble, []string{"a"})
+
+		// the file is never overwritten
+		convey.So(openParquetFile(ses), convey.ShouldNotBeNil)
+	})
+}
@@ -716,7 +716,8 @@ func InitDB(tae engine.Engine) error {
 
 	/*
 		stage 2: create information_schema database.
-		Views in the information_schema need to created by 'create view'
+		The tables in the information_schema are not stored. They are
+		computed from the catalog by the engine when they are read.
 	*/
 	//1. create database information_schema
 	infoSchemaName := "information_schema"
@@ -730,7 +731,6 @@ func InitDB(tae engine.Engine) error {
 		}
 		return err
 	}
-	//TODO: create views after the computation engine is ready
 	err = txnCtx.Commit()
 	if err != nil {
 		logutil.Infof("txnCtx commit failed.error:%v", err)
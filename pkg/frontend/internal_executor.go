@@ -15,6 +15,9 @@
 package frontend
 
 import (
+	"io"
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/config"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -35,7 +38,8 @@ func applyOverride(sess *Session, opts ie.SessionOverrideOptions) {
 }
 
 type internalExecutor struct {
-	proto MysqlProtocol
+	sync.Mutex
+	proto *internalProtocol
 	// MySqlCmdExecutor struct is used here, because we want to doComQuery directly
 	executor     *MysqlCmdExecutor
 	pu           *config.ParameterUnit
@@ -56,37 +60,96 @@ func NewIternalExecutor(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *inter
 	}
 }
 
-func (ie *internalExecutor) Exec(sql string, opts ie.SessionOverrideOptions) error {
-	sess := ie.newCmdSession(opts)
-	ie.executor.PrepareSessionBeforeExecRequest(sess)
-	if err := ie.executor.doComQuery(sql); err != nil {
+func (exec *internalExecutor) Exec(sql string, opts ie.SessionOverrideOptions) error {
+	exec.Lock()
+	defer exec.Unlock()
+	sess := exec.newCmdSession(opts)
+	exec.executor.PrepareSessionBeforeExecRequest(sess)
+	if err := exec.executor.doComQuery(sql); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (ie *internalExecutor) newCmdSession(opts ie.SessionOverrideOptions) *Session {
+func (exec *internalExecutor) Query(sql string, opts ie.SessionOverrideOptions, args ...interface{}) (ie.ResultSet, error) {
+	sql, err := ie.BindParams(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	exec.Lock()
+	defer exec.Unlock()
+	sess := exec.newCmdSession(opts)
+	exec.executor.PrepareSessionBeforeExecRequest(sess)
+	exec.proto.stashResult()
+	defer exec.proto.dropResult()
+	if err := exec.executor.doComQuery(sql); err != nil {
+		return nil, err
+	}
+	return exec.proto.result, nil
+}
+
+func (exec *internalExecutor) newCmdSession(opts ie.SessionOverrideOptions) *Session {
 	sess := NewSession(
-		ie.proto,
-		ie.pdHook,
-		guest.New(ie.pu.SV.GetGuestMmuLimitation(), ie.pu.HostMmu),
-		ie.pu.Mempool,
-		ie.pu,
+		exec.proto,
+		exec.pdHook,
+		guest.New(exec.pu.SV.GetGuestMmuLimitation(), exec.pu.HostMmu),
+		exec.pu.Mempool,
+		exec.pu,
 		gSysVariables,
 	)
-	applyOverride(sess, ie.baseSessOpts)
+	applyOverride(sess, exec.baseSessOpts)
 	applyOverride(sess, opts)
 	return sess
 }
 
-func (ie *internalExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {
-	ie.baseSessOpts = opts
+func (exec *internalExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {
+	exec.baseSessOpts = opts
 }
 
 type internalProtocol struct {
+	sync.Mutex
 	database string
 	username string
+
+	// result, the result set of the query in execution
+	result *internalExecResult
+}
+
+// stashResult starts collecting a new result set
+func (ip *internalProtocol) stashResult() {
+	ip.Lock()
+	defer ip.Unlock()
+	ip.result = &internalExecResult{
+		resultSet: &MysqlResultSet{},
+	}
+}
+
+// dropResult stops collecting the result set
+func (ip *internalProtocol) dropResult() {
+	ip.Lock()
+	defer ip.Unlock()
+	ip.result = nil
+}
+
+// appendRows copies the first cnt rows of the mrs into the result set, the
+// rows of the mrs are reused by the caller.
+func (ip *internalProtocol) appendRows(mrs *MysqlResultSet, cnt uint64) {
+	ip.Lock()
+	defer ip.Unlock()
+	if ip.result == nil {
+		return
+	}
+	for i := uint64(0); i < cnt; i++ {
+		row := make([]interface{}, len(mrs.Data[i]))
+		for j, v := range mrs.Data[i] {
+			if bs, ok := v.([]byte); ok {
+				v = append([]byte{}, bs...)
+			}
+			row[j] = v
+		}
+		ip.result.resultSet.AddRow(row)
+	}
 }
 
 func (ip *internalProtocol) IsEstablished() bool {
@@ -101,6 +164,26 @@ func (ip *internalProtocol) GetRequest(payload []byte) *Request {
 
 // SendResponse sends a response to the client for the application request
 func (ip *internalProtocol) SendResponse(resp *Response) error {
+	if resp == nil {
+		return nil
+	}
+	switch resp.category {
+	case OkResponse:
+		return ip.sendOKPacket(resp.affectedRows, 0, 0, 0, "")
+	case ResultResponse:
+		if mer, ok := resp.data.(*MysqlExecutionResult); ok && mer.Mrs() != nil {
+			mrs := mer.Mrs()
+			if err := ip.SendColumnCountPacket(mrs.GetColumnCount()); err != nil {
+				return err
+			}
+			for _, col := range mrs.Columns {
+				if err := ip.SendColumnDefinitionPacket(col, 0); err != nil {
+					return err
+				}
+			}
+			return ip.SendResultSetTextBatchRow(mrs, mrs.GetRowCount())
+		}
+	}
 	return nil
 }
 
@@ -134,20 +217,33 @@ func (ip *internalProtocol) Quit() {}
 
 //the server send group row of the result set as an independent packet thread safe
 func (ip *internalProtocol) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	ip.appendRows(mrs, cnt)
 	return nil
 }
 
 func (ip *internalProtocol) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	ip.appendRows(mrs, cnt)
 	return nil
 }
 
 //SendColumnDefinitionPacket the server send the column definition to the client
 func (ip *internalProtocol) SendColumnDefinitionPacket(column Column, cmd int) error {
+	ip.Lock()
+	defer ip.Unlock()
+	if ip.result != nil {
+		ip.result.resultSet.AddColumn(column)
+	}
 	return nil
 }
 
 //SendColumnCountPacket makes the column count packet
 func (ip *internalProtocol) SendColumnCountPacket(count uint64) error {
+	ip.Lock()
+	defer ip.Unlock()
+	// a new result set begins, keep the last one
+	if ip.result != nil {
+		ip.result.resultSet = &MysqlResultSet{}
+	}
 	return nil
 }
 
@@ -157,6 +253,11 @@ func (ip *internalProtocol) SendEOFPacketIf(warnings uint16, status uint16) erro
 
 //send OK packet to the client
 func (ip *internalProtocol) sendOKPacket(affectedRows uint64, lastInsertId uint64, status uint16, warnings uint16, message string) error {
+	ip.Lock()
+	defer ip.Unlock()
+	if ip.result != nil {
+		ip.result.affectedRows = affectedRows
+	}
 	return nil
 }
 
@@ -169,3 +270,72 @@ func (ip *internalProtocol) PrepareBeforeProcessingResultSet() {
 }
 
 func (ip *internalProtocol) GetStats() string { return "internal unknown stats" }
+
+//there is not a client to send the file
+func (ip *internalProtocol) OpenLocalInfile(fileName string) (io.ReadCloser, error) {
+	return nil, NewMysqlError(ER_NOT_ALLOWED_COMMAND)
+}
+
+var _ ie.ResultSet = &internalExecResult{}
+
+// internalExecResult is the result set collected by the internalProtocol
+type internalExecResult struct {
+	affectedRows uint64
+	resultSet    *MysqlResultSet
+}
+
+func (res *internalExecResult) AffectedRows() uint64 {
+	return res.affectedRows
+}
+
+func (res *internalExecResult) ColumnCount() uint64 {
+	return res.resultSet.GetColumnCount()
+}
+
+func (res *internalExecResult) Column(i uint64) (ie.Column, error) {
+	col, err := res.resultSet.GetColumn(i)
+	if err != nil {
+		return ie.Column{}, err
+	}
+	return ie.Column{
+		Name:   col.Name(),
+		Type:   col.ColumnType(),
+		Signed: col.IsSigned(),
+	}, nil
+}
+
+func (res *internalExecResult) RowCount() uint64 {
+	return res.resultSet.GetRowCount()
+}
+
+func (res *internalExecResult) Row(i uint64) ([]interface{}, error) {
+	return res.resultSet.GetRow(i)
+}
+
+func (res *internalExecResult) Value(row uint64, col uint64) (interface{}, error) {
+	return res.resultSet.GetValue(row, col)
+}
+
+func (res *internalExecResult) ValueByName(row uint64, col string) (interface{}, error) {
+	return res.resultSet.GetValueByName(row, col)
+}
+
+func (res *internalExecResult) IsNull(row uint64, col uint64) (bool, error) {
+	return res.resultSet.ColumnIsNull(row, col)
+}
+
+func (res *internalExecResult) Int64(row uint64, col uint64) (int64, error) {
+	return res.resultSet.GetInt64(row, col)
+}
+
+func (res *internalExecResult) Uint64(row uint64, col uint64) (uint64, error) {
+	return res.resultSet.GetUint64(row, col)
+}
+
+func (res *internalExecResult) Float64(row uint64, col uint64) (float64, error) {
+	return res.resultSet.GetFloat64(row, col)
+}
+
+func (res *internalExecResult) String(row uint64, col uint64) (string, error) {
+	return res.resultSet.GetString(row, col)
+}
@@ -17,8 +17,10 @@ package frontend
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/stretchr/testify/assert"
 )
@@ -56,3 +58,79 @@ func TestProtoMoreCoverage(t *testing.T) {
 	assert.Nil(t, p.sendOKPacket(1, 1, 0, 0, ""))
 	assert.Nil(t, p.sendEOFOrOkPacket(0, 1))
 }
+
+func TestIeQuery(t *testing.T) {
+	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, config.ClusterCatalog)
+	executor := NewIternalExecutor(pu, nil)
+	_, err := executor.Query("select a from t where b = ?", ie.NewOptsBuilder().Finish())
+	assert.Error(t, err)
+
+	// the protocol collects the result set sent by doComQuery
+	p := executor.proto
+	p.stashResult()
+	col := new(MysqlColumn)
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	assert.Nil(t, p.SendColumnCountPacket(1))
+	assert.Nil(t, p.SendColumnDefinitionPacket(col, 0))
+	buf := []byte("x")
+	mrs := &MysqlResultSet{Data: [][]interface{}{{buf}, {nil}}}
+	assert.Nil(t, p.SendResultSetTextBatchRowSpeedup(mrs, 2))
+	buf[0] = 'y'
+	res := p.result
+	p.dropResult()
+
+	assert.Equal(t, uint64(1), res.ColumnCount())
+	c, err := res.Column(0)
+	assert.Nil(t, err)
+	assert.Equal(t, "a", c.Name)
+	assert.Equal(t, uint8(defines.MYSQL_TYPE_VARCHAR), c.Type)
+	assert.Equal(t, uint64(2), res.RowCount())
+	s, err := res.String(0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "x", s)
+	v, err := res.ValueByName(0, "a")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("x"), v)
+	isNull, err := res.IsNull(1, 0)
+	assert.Nil(t, err)
+	assert.True(t, isNull)
+
+	// self handled statements send the whole result set in a response
+	p.stashResult()
+	mrs = &MysqlResultSet{}
+	mrs.AddColumn(col)
+	mrs.AddRow([]interface{}{"z"})
+	assert.Nil(t, p.SendResponse(NewResponse(ResultResponse, 0, int(COM_QUERY), NewMysqlExecutionResult(0, 0, 0, 0, mrs))))
+	assert.Equal(t, uint64(1), p.result.RowCount())
+	assert.Nil(t, p.SendResponse(NewOkResponse(3, 0, 0, 0, int(COM_QUERY), nil)))
+	assert.Equal(t, uint64(3), p.result.AffectedRows())
+	p.dropResult()
+}
+
+func TestBindParams(t *testing.T) {
+	ts := time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC)
+	cases := []struct {
+		sql  string
+		args []interface{}
+		want string
+	}{
+		{"select 1", nil, "select 1"},
+		{"select * from t where a = ? and b = ?", []interface{}{1, "x"}, "select * from t where a = 1 and b = 'x'"},
+		{"insert into t values (?, ?, ?, ?)", []interface{}{nil, true, 1.5, ts}, "insert into t values (null, true, 1.5, '2022-06-01 12:30:00')"},
+		{"select '?' from `?` where a = ? /* ? */", []interface{}{uint8(2)}, "select '?' from `?` where a = 2 /* ? */"},
+		{"select ?", []interface{}{"it's \\ \n"}, "select 'it\\'s \\\\ \\n'"},
+	}
+	for _, c := range cases {
+		got, err := ie.BindParams(c.sql, c.args...)
+		assert.Nil(t, err)
+		assert.Equal(t, c.want, got)
+	}
+
+	_, err := ie.BindParams("select ?, ?", 1)
+	assert.Error(t, err)
+	_, err = ie.BindParams("select ?", 1, 2)
+	assert.Error(t, err)
+	_, err = ie.BindParams("select ?", struct{}{})
+	assert.Error(t, err)
+}
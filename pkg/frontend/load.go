@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"io"
 	"os"
 	"runtime"
 	"strconv"
@@ -30,8 +31,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/config"
 
+	"github.com/matrixorigin/matrixone/pkg/container/arrow"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/parquet"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -173,12 +176,156 @@ func (t *ThreadInfo) GetCnt() int32 {
 	return atomic.LoadInt32(&t.threadCnt)
 }
 
+// lineReader reads the lines of the file of LOAD DATA
+type lineReader interface {
+	// ReadLoop delivers the lines into the channel, and an empty
+	// LineOut at the end of the file.
+	ReadLoop(lineOutChan chan simdcsv.LineOut) error
+	Close()
+}
+
+// batchReader reads the batches of the arrow or parquet file, io.EOF at the end
+type batchReader interface {
+	Read() (*batch.Batch, error)
+}
+
+// batchLineReader reads the rows of the batches as the lines
+type batchLineReader struct {
+	r         batchReader
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+func newBatchLineReader(r batchReader) *batchLineReader {
+	return &batchLineReader{
+		r:    r,
+		stop: make(chan struct{}),
+	}
+}
+
+// newArrowLineReader reads the arrow IPC file or stream
+func newArrowLineReader(r io.Reader) (*batchLineReader, error) {
+	ar, err := arrow.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return newBatchLineReader(ar), nil
+}
+
+// newParquetLineReader reads the parquet file of LOAD DATA through the
+// FileService, the row groups are read on demand. The parquet columns are
+// selected by the names of the column list of LOAD DATA if it is specified,
+// otherwise all columns are read in the order of the file.
+func newParquetLineReader(ses *Session, filePath string, columns []tree.LoadColumn) (*batchLineReader, error) {
+	r, size, err := openFileServiceReaderAt(ses, filePath)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := parquet.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 {
+		attrs := make([]string, len(columns))
+		for i, col := range columns {
+			name, ok := col.(*tree.UnresolvedName)
+			if !ok {
+				return nil, fmt.Errorf("only the columns are supported in the column list of LOAD DATA of the parquet file")
+			}
+			attrs[i] = name.Parts[0]
+		}
+		if err = pr.Project(attrs); err != nil {
+			return nil, err
+		}
+	}
+	return newBatchLineReader(pr), nil
+}
+
+func (blr *batchLineReader) ReadLoop(lineOutChan chan simdcsv.LineOut) error {
+	for {
+		bat, err := blr.r.Read()
+		if err == io.EOF {
+			select {
+			case lineOutChan <- simdcsv.LineOut{}:
+			case <-blr.stop:
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for row := 0; row < bat.Length(); row++ {
+			line := make([]string, len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				line[i] = vectorValueToField(vec, row)
+			}
+			select {
+			case lineOutChan <- simdcsv.LineOut{Line: line}:
+			case <-blr.stop:
+				return nil
+			}
+		}
+	}
+}
+
+func (blr *batchLineReader) Close() {
+	blr.closeOnce.Do(func() {
+		close(blr.stop)
+	})
+}
+
+// vectorValueToField converts the value of the vector to the field of the line
+func vectorValueToField(vec *vector.Vector, row int) string {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return NULL_FLAG
+	}
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		if vec.Col.([]bool)[row] {
+			return "1"
+		}
+		return "0"
+	case types.T_int8:
+		return strconv.FormatInt(int64(vec.Col.([]int8)[row]), 10)
+	case types.T_int16:
+		return strconv.FormatInt(int64(vec.Col.([]int16)[row]), 10)
+	case types.T_int32:
+		return strconv.FormatInt(int64(vec.Col.([]int32)[row]), 10)
+	case types.T_int64:
+		return strconv.FormatInt(vec.Col.([]int64)[row], 10)
+	case types.T_uint8:
+		return strconv.FormatUint(uint64(vec.Col.([]uint8)[row]), 10)
+	case types.T_uint16:
+		return strconv.FormatUint(uint64(vec.Col.([]uint16)[row]), 10)
+	case types.T_uint32:
+		return strconv.FormatUint(uint64(vec.Col.([]uint32)[row]), 10)
+	case types.T_uint64:
+		return strconv.FormatUint(vec.Col.([]uint64)[row], 10)
+	case types.T_float32:
+		return strconv.FormatFloat(float64(vec.Col.([]float32)[row]), 'g', -1, 32)
+	case types.T_float64:
+		return strconv.FormatFloat(vec.Col.([]float64)[row], 'g', -1, 64)
+	case types.T_decimal64:
+		return string(vec.Col.([]types.Decimal64)[row].Decimal64ToString(vec.Typ.Scale))
+	case types.T_decimal128:
+		return string(vec.Col.([]types.Decimal128)[row].Decimal128ToString(vec.Typ.Scale))
+	case types.T_date:
+		return vec.Col.([]types.Date)[row].String()
+	case types.T_datetime:
+		return vec.Col.([]types.Datetime)[row].String2(6)
+	case types.T_timestamp:
+		return vec.Col.([]types.Timestamp)[row].String2(6)
+	default:
+		return string(vec.Col.(*types.Bytes).Get(int64(row)))
+	}
+}
+
 type ParseLineHandler struct {
 	SharePart
 	DebugTime
 
 	threadInfo                  map[int]*ThreadInfo
-	simdCsvReader               *simdcsv.Reader
+	simdCsvReader               lineReader
 	closeOnceGetParsedLinesChan sync.Once
 	//csv read put lines into the channel
 	simdCsvGetParsedLinesChan atomic.Value // chan simdcsv.LineOut
@@ -1935,14 +2082,25 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	result := &LoadResult{}
 
 	/*
-		step1 : read block from file
+		step1 : read block from file.
+		the file of LOCAL is read from the client connection.
 	*/
-	dataFile, err := os.Open(load.File)
+	var dataFile io.ReadCloser
+	var err error
+	if load.Local {
+		dataFile, err = ses.GetMysqlProtocol().OpenLocalInfile(load.File)
+	} else if load.FileFormat != parquetFormat {
+		dataFile, err = os.Open(load.File)
+	}
 	if err != nil {
 		logutil.Errorf("open file failed. err:%v", err)
 		return nil, err
 	}
 	defer func() {
+		if dataFile == nil {
+			// the parquet file is read through the file service
+			return
+		}
 		err := dataFile.Close()
 		if err != nil {
 			logutil.Errorf("close file failed. err:%v", err)
@@ -2010,11 +2168,31 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	//put closeRef into the executor
 	mce.loadDataClose = handler.closeRef
 
-	handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
-		rune(load.Fields.Terminated[0]),
-		'#',
-		false,
-		false)
+	switch load.FileFormat {
+	case arrowFormat:
+		handler.simdCsvReader, err = newArrowLineReader(dataFile)
+	case parquetFormat:
+		filePath := load.File
+		if load.Local {
+			// the file is streamed from the client, but parquet is read
+			// from the metadata at the end of the file, so it is saved
+			// on the server until the load ends.
+			if filePath, err = saveTempFile(dataFile); err != nil {
+				return nil, err
+			}
+			defer removeTempFile(filePath)
+		}
+		handler.simdCsvReader, err = newParquetLineReader(ses, filePath, load.ColumnList)
+	default:
+		handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
+			rune(load.Fields.Terminated[0]),
+			'#',
+			false,
+			false)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	/*
 		error channel
@@ -15,19 +15,24 @@
 package frontend
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/arrow"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/parquet"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -336,9 +341,28 @@ func Test_load(t *testing.T) {
 		db.EXPECT().Relation(gomock.Any(), nil).Return(rel, nil).AnyTimes()
 		eng.EXPECT().Database(gomock.Any(), nil).Return(db, nil).AnyTimes()
 
+		var proto *MysqlProtocolImpl
 		ioses := mock_frontend.NewMockIOSession(ctrl)
 		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
-		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+			//the client sends the file in packets for the LOCAL INFILE request
+			packet := msg.([]byte)
+			if len(packet) > PacketHeaderLength && packet[PacketHeaderLength] == defines.LocalInFileHeader {
+				data, err := os.ReadFile(string(packet[PacketHeaderLength+1:]))
+				if err != nil {
+					return err
+				}
+				go func() {
+					for len(data) > 0 {
+						n := Min(len(data), 100)
+						proto.deliverLocalInfile(data[:n])
+						data = data[n:]
+					}
+					proto.deliverLocalInfile(nil)
+				}()
+			}
+			return nil
+		}).AnyTimes()
 
 		cws := []*tree.Load{}
 
@@ -379,6 +403,13 @@ func Test_load(t *testing.T) {
 					"FIELDS TERMINATED BY ',' ",
 				fail: false,
 			},
+			{
+				sql: "load data " +
+					"local infile 'test/loadfile5' " +
+					"INTO TABLE T.A " +
+					"FIELDS TERMINATED BY ',' ",
+				fail: false,
+			},
 		}
 
 		for i := 0; i < len(kases); i++ {
@@ -393,7 +424,7 @@ func Test_load(t *testing.T) {
 		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
 		convey.So(err, convey.ShouldBeNil)
 
-		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		proto = NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
 
 		epochgc := getPCI()
 
@@ -566,3 +597,116 @@ func Test_PrintThreadInfo(t *testing.T) {
 
 	})
 }
+
+func Test_arrowLineReader(t *testing.T) {
+	convey.Convey("arrowLineReader succ", t, func() {
+		d, _ := types.ParseDate("2022-07-01")
+		dt, _ := types.ParseDatetime("2022-07-01 12:00:00.5", 6)
+
+		bat := batch.New(true, []string{"a", "b", "c", "d", "e"})
+		bat.Vecs[0] = vector.New(types.T_int64.ToType())
+		bat.Vecs[0].Col = []int64{-1, 2}
+		bat.Vecs[1] = vector.New(types.T_float64.ToType())
+		bat.Vecs[1].Col = []float64{1.5, 0}
+		nulls.Add(bat.Vecs[1].Nsp, 1)
+		bat.Vecs[2] = vector.New(types.T_date.ToType())
+		bat.Vecs[2].Col = []types.Date{d, d}
+		bat.Vecs[3] = vector.New(types.T_datetime.ToType())
+		bat.Vecs[3].Col = []types.Datetime{dt, dt}
+		bat.Vecs[4] = vector.New(types.T_varchar.ToType())
+		bat.Vecs[4].Col = &types.Bytes{
+			Data:    []byte("abc"),
+			Offsets: []uint32{0, 3},
+			Lengths: []uint32{3, 0},
+		}
+		bat.InitZsOne(2)
+
+		buf := &bytes.Buffer{}
+		w := arrow.NewWriter(buf, bat.Attrs)
+		convey.So(w.Write(bat), convey.ShouldBeNil)
+		convey.So(w.Close(), convey.ShouldBeNil)
+
+		r, err := newArrowLineReader(buf)
+		convey.So(err, convey.ShouldBeNil)
+		lineOutChan := make(chan simdcsv.LineOut, 3)
+		convey.So(r.ReadLoop(lineOutChan), convey.ShouldBeNil)
+		r.Close()
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble,
+			[]string{"-1", "1.5", "2022-07-01", "2022-07-01 12:00:00.500000", "abc"})
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble,
+			[]string{"2", NULL_FLAG, "2022-07-01", "2022-07-01 12:00:00.500000", ""})
+		convey.So((<-lineOutChan).Line, convey.ShouldBeNil)
+	})
+
+	convey.Convey("arrowLineReader failed", t, func() {
+		_, err := newArrowLineReader(bytes.NewReader([]byte("not an arrow file")))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_parquetLineReader(t *testing.T) {
+	convey.Convey("parquetLineReader succ", t, func() {
+		bat := batch.New(true, []string{"a", "b"})
+		bat.Vecs[0] = vector.New(types.T_int64.ToType())
+		bat.Vecs[0].Col = []int64{-1, 2}
+		bat.Vecs[1] = vector.New(types.T_varchar.ToType())
+		bat.Vecs[1].Col = &types.Bytes{
+			Data:    []byte("abc"),
+			Offsets: []uint32{0, 3},
+			Lengths: []uint32{3, 0},
+		}
+		nulls.Add(bat.Vecs[1].Nsp, 1)
+		bat.InitZsOne(2)
+
+		buf := &bytes.Buffer{}
+		w, err := parquet.NewWriter(buf, bat.Attrs, parquet.WriterOptions{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(w.Write(bat), convey.ShouldBeNil)
+		convey.So(w.Close(), convey.ShouldBeNil)
+
+		file := filepath.Join(t.TempDir(), "a.parquet")
+		convey.So(os.WriteFile(file, buf.Bytes(), 0o644), convey.ShouldBeNil)
+
+		// the columns are read in the order of the column list
+		load := &tree.Load{
+			File:       file,
+			FileFormat: parquetFormat,
+			ColumnList: []tree.LoadColumn{
+				tree.SetUnresolvedName("b"),
+				tree.SetUnresolvedName("a"),
+			},
+		}
+		r, err := newParquetLineReader(&Session{}, load.File, load.ColumnList)
+		convey.So(err, convey.ShouldBeNil)
+		lineOutChan := make(chan simdcsv.LineOut, 3)
+		convey.So(r.ReadLoop(lineOutChan), convey.ShouldBeNil)
+		r.Close()
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble, []string{"abc", "-1"})
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble, []string{NULL_FLAG, "2"})
+		convey.So((<-lineOutChan).Line, convey.ShouldBeNil)
+
+		// the file of LOCAL is streamed from the client and saved on the server
+		tmp, err := saveTempFile(bytes.NewReader(buf.Bytes()))
+		convey.So(err, convey.ShouldBeNil)
+		r, err = newParquetLineReader(&Session{}, tmp, nil)
+		convey.So(err, convey.ShouldBeNil)
+		lineOutChan = make(chan simdcsv.LineOut, 3)
+		convey.So(r.ReadLoop(lineOutChan), convey.ShouldBeNil)
+		r.Close()
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble, []string{"-1", "abc"})
+		removeTempFile(tmp)
+		_, err = os.Stat(tmp)
+		convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("parquetLineReader failed", t, func() {
+		dir := t.TempDir()
+		_, err := newParquetLineReader(&Session{}, filepath.Join(dir, "none.parquet"), nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		file := filepath.Join(dir, "a.parquet")
+		convey.So(os.WriteFile(file, []byte("not a parquet file"), 0o644), convey.ShouldBeNil)
+		_, err = newParquetLineReader(&Session{}, file, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	goErrors "errors"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+// the system variables limiting the memory of each level
+var memoryLimitVariables = map[string]string{
+	"query":   "query_memory_limit",
+	"session": "session_memory_limit",
+	"user":    "user_memory_limit",
+}
+
+// getMemoryLimit returns the value of the memory limit variable of the
+// session, or def if it is 0 which means no limit
+func (ses *Session) getMemoryLimit(name string, def int64) int64 {
+	v, err := ses.GetSessionVar(name)
+	if err != nil {
+		return def
+	}
+	return memoryLimit(v, def)
+}
+
+// getGlobalMemoryLimit is like getMemoryLimit, but returns the global value
+// of the variable, which is the same for all the sessions
+func (ses *Session) getGlobalMemoryLimit(name string, def int64) int64 {
+	v, err := ses.GetGlobalVar(name)
+	if err != nil {
+		return def
+	}
+	return memoryLimit(v, def)
+}
+
+func memoryLimit(v interface{}, def int64) int64 {
+	if limit, ok := v.(int64); ok && limit > 0 && limit < def {
+		return limit
+	}
+	return def
+}
+
+// newQueryMmu applies the memory limits of the session and its user, and
+// returns the mmu accounting the memory of the statement to execute.
+// The memory is tracked as global -> user -> session -> query. The limit of
+// the user is global, so every session of the user applies the same value.
+//
+// There is no operator level. The operators of a statement allocate from the
+// mheap of the process shared by the scope, and a batch allocated by one
+// operator is freed by the next one, so the memory can not be accounted to
+// a single operator. The largest memory growth in one call of each operator
+// is reported by EXPLAIN ANALYZE instead.
+func (mce *MysqlCmdExecutor) newQueryMmu() *guest.Mmu {
+	ses := mce.GetSession()
+	gm := ses.GuestMmu
+	if user := gm.Parent(); user != nil {
+		user.SetLimit(ses.getGlobalMemoryLimit("user_memory_limit", math.MaxInt64))
+	}
+	sessionLimit := int64(math.MaxInt64)
+	if ses.Pu != nil {
+		sessionLimit = ses.Pu.SV.GetGuestMmuLimitation()
+	}
+	gm.SetLimit(ses.getMemoryLimit("session_memory_limit", sessionLimit))
+	return guest.NewWithParent("query", ses.getMemoryLimit("query_memory_limit", gm.GetLimit()), gm)
+}
+
+// convertMemoryLimitError converts the error of exceeding a memory limit
+// to the mysql error
+func convertMemoryLimitError(err error) error {
+	var le *mmu.LimitError
+	if !goErrors.As(err, &le) {
+		return err
+	}
+	hint := "Please check the memory of the server."
+	if name, ok := memoryLimitVariables[le.Name]; ok {
+		hint = "Please increase " + name + "."
+	}
+	return NewMysqlError(ER_CAPACITY_EXCEEDED, le.Limit, le.Name, hint)
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_memoryLimit(t *testing.T) {
+	convey.Convey("memory limits of user, session and query", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+
+		gsv := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gsv)
+
+		user := guest.New(math.MaxInt64, pu.HostMmu)
+		user.Name = "user"
+		gm := guest.New(0, pu.HostMmu)
+		gm.Name = "session"
+		gm.SetParent(user)
+		ses := NewSession(&MysqlProtocolImpl{}, nil, gm, pu.Mempool, pu, gsv)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		//no limit
+		qm := mce.newQueryMmu()
+		convey.So(user.GetLimit(), convey.ShouldEqual, int64(math.MaxInt64))
+		convey.So(gm.GetLimit(), convey.ShouldEqual, pu.SV.GetGuestMmuLimitation())
+		convey.So(qm.GetLimit(), convey.ShouldEqual, gm.GetLimit())
+		qm.Release()
+
+		convey.So(ses.SetGlobalVar("user_memory_limit", int64(4096)), convey.ShouldBeNil)
+		convey.So(ses.SetSessionVar("session_memory_limit", int64(2048)), convey.ShouldBeNil)
+		convey.So(ses.SetSessionVar("query_memory_limit", int64(1024)), convey.ShouldBeNil)
+		qm = mce.newQueryMmu()
+		convey.So(user.GetLimit(), convey.ShouldEqual, 4096)
+		convey.So(gm.GetLimit(), convey.ShouldEqual, 2048)
+		convey.So(qm.GetLimit(), convey.ShouldEqual, 1024)
+
+		//the limit of the user is global, other sessions apply the same value
+		gm2 := guest.New(0, pu.HostMmu)
+		gm2.Name = "session"
+		gm2.SetParent(user)
+		mce2 := NewMysqlCmdExecutor()
+		mce2.PrepareSessionBeforeExecRequest(NewSession(&MysqlProtocolImpl{}, nil, gm2, pu.Mempool, pu, gsv))
+		convey.So(ses.SetSessionVar("user_memory_limit", int64(8192)), convey.ShouldNotBeNil)
+		mce2.newQueryMmu().Release()
+		convey.So(user.GetLimit(), convey.ShouldEqual, 4096)
+
+		//the allocations are accounted to all levels
+		convey.So(qm.Alloc(1000), convey.ShouldBeNil)
+		convey.So(gm.Size(), convey.ShouldEqual, 1000)
+		convey.So(user.Size(), convey.ShouldEqual, 1000)
+
+		err = qm.Alloc(100)
+		convey.So(err, convey.ShouldResemble, &mmu.LimitError{Name: "query", Limit: 1024})
+		err = convertMemoryLimitError(err)
+		convey.So(err.Error(), convey.ShouldEqual,
+			"Memory capacity of 1024 bytes for 'query' exceeded. Please increase query_memory_limit.")
+
+		//the memory of the query is returned when it ends
+		qm.Release()
+		convey.So(gm.Size(), convey.ShouldEqual, 0)
+		convey.So(user.Size(), convey.ShouldEqual, 0)
+
+		//the limit of the session is shared by its queries
+		convey.So(ses.SetSessionVar("query_memory_limit", int64(0)), convey.ShouldBeNil)
+		qm = mce.newQueryMmu()
+		convey.So(qm.GetLimit(), convey.ShouldEqual, 2048)
+		convey.So(gm.Alloc(1500), convey.ShouldBeNil)
+		err = convertMemoryLimitError(qm.Alloc(1000))
+		convey.So(err.Error(), convey.ShouldContainSubstring, "for 'session' exceeded")
+		gm.Free(1500)
+		qm.Release()
+
+		convey.So(convertMemoryLimitError(mmu.OutOfMemory), convey.ShouldEqual, mmu.OutOfMemory)
+	})
+}
+
+func Test_userMmu(t *testing.T) {
+	convey.Convey("the mmu of a user is shared by its sessions and removed with the last one", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		convey.So(err, convey.ShouldBeNil)
+
+		rm := NewRoutineManager(pu, nil)
+		user := rm.acquireUserMmu("u1")
+		convey.So(rm.acquireUserMmu("u1"), convey.ShouldPointTo, user)
+		convey.So(rm.acquireUserMmu("u2"), convey.ShouldNotPointTo, user)
+		convey.So(len(rm.userMmus), convey.ShouldEqual, 2)
+
+		rm.releaseUserMmu("u2")
+		convey.So(len(rm.userMmus), convey.ShouldEqual, 1)
+		rm.releaseUserMmu("u1")
+		convey.So(rm.userMmus, convey.ShouldContainKey, "u1")
+		rm.releaseUserMmu("u1")
+		convey.So(len(rm.userMmus), convey.ShouldEqual, 0)
+
+		//a new session of the user starts with a new mmu
+		convey.So(rm.acquireUserMmu("u1"), convey.ShouldNotPointTo, user)
+	})
+}
@@ -15,6 +15,7 @@
 package frontend
 
 import (
+	"context"
 	goErrors "errors"
 	"fmt"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -43,10 +45,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -80,6 +84,9 @@ type MysqlCmdExecutor struct {
 	ses *Session
 
 	routineMgr *RoutineManager
+
+	//for show processlist and kill
+	status commandStatus
 }
 
 func (cei *MysqlCmdExecutor) PrepareSessionBeforeExecRequest(ses *Session) {
@@ -324,6 +331,16 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 			return err
 		}
 	}
+	var sentRows int64
+	for j := 0; j < n; j++ {
+		sentRows += bat.Zs[j]
+	}
+	atomic.AddUint64(&ses.sentRows, uint64(sentRows))
+
+	if oq.ep.Outfile && isBatchFormat(oq.ep.FileFormat) {
+		return exportBatchToFile(ses, bat)
+	}
+
 	for j := 0; j < n; j++ { //row index
 		if oq.ep.Outfile {
 			select {
@@ -745,13 +762,9 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	proto := ses.protocol
 
 	logutil.Infof("+++++load data")
-	/*
-		TODO:support LOCAL
-	*/
-	if load.Local {
-		return fmt.Errorf("LOCAL is unsupported now")
+	if err = checkFileFormat(load.FileFormat); err != nil {
+		return err
 	}
-
 	if load.Fields == nil || len(load.Fields.Terminated) == 0 {
 		return fmt.Errorf("load need FIELDS TERMINATED BY ")
 	}
@@ -761,15 +774,18 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	}
 
 	/*
-		check file
+		check file. the file of LOCAL is on the client host.
+		the parquet file is opened through the FileService later, it may be in S3.
 	*/
-	exist, isfile, err := PathExists(load.File)
-	if err != nil || !exist {
-		return fmt.Errorf("file %s does exist. err:%v", load.File, err)
-	}
+	if !load.Local && load.FileFormat != parquetFormat {
+		exist, isfile, err := PathExists(load.File)
+		if err != nil || !exist {
+			return fmt.Errorf("file %s does exist. err:%v", load.File, err)
+		}
 
-	if !isfile {
-		return fmt.Errorf("file %s is a directory.", load.File)
+		if !isfile {
+			return fmt.Errorf("file %s is a directory.", load.File)
+		}
 	}
 
 	/*
@@ -1031,7 +1047,7 @@ func (mce *MysqlCmdExecutor) handleSelect1(nv *tree.NumVal) error {
 	return nil
 }
 
-func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
+func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt, proc *process.Process, epoch uint64) error {
 	es := explain.NewExplainDefaultOptions()
 
 	for _, v := range stmt.Options {
@@ -1081,7 +1097,15 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	buffer := explain.NewExplainDataBuffer()
 	// generator query explain
 	explainQuery := explain.NewExplainQueryImpl(buildPlan.GetQuery())
-	err = explainQuery.ExplainPlan(buffer, es)
+	if es.Anzlyze {
+		explainQuery.Analysis, err = mce.runExplainAnalyze(stmt.Statement, proc, epoch)
+		if err != nil {
+			return err
+		}
+		err = explainQuery.ExplainAnalyze(buffer, es)
+	} else {
+		err = explainQuery.ExplainPlan(buffer, es)
+	}
 	if err != nil {
 		logutil.Errorf("explain Query statement error: %v", err)
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain Query statement error:%v", err))
@@ -1140,6 +1164,74 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	return nil
 }
 
+// runExplainAnalyze runs the statement of explain analyze, and returns the runtime
+// statistics of its plan nodes. The result rows of the statement are discarded.
+func (mce *MysqlCmdExecutor) runExplainAnalyze(stmt tree.Statement, proc *process.Process, epoch uint64) (map[int32]*explain.AnalyzeInfo, error) {
+	ses := mce.GetSession()
+	pn, err := buildPlan(ses.GetTxnCompilerContext(), stmt)
+	if err != nil {
+		return nil, err
+	}
+	qry := pn.GetQuery()
+	if qry == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "explain analyze only supports query statement")
+	}
+	size := 0
+	for _, n := range qry.Nodes {
+		if int(n.NodeId) >= size {
+			size = int(n.NodeId) + 1
+		}
+	}
+	proc.UnixTime = time.Now().UnixNano()
+	proc.Snapshot = ses.GetTxnHandler().GetTxn().GetCtx()
+	proc.Stats.Analyze = make([]process.AnalyzeInfo, size)
+	comp := compile2.New(ses.GetDatabaseName(), ses.GetSql(), ses.GetUserName(), ses.GetStorage(), proc)
+	if err = comp.Compile(pn, ses, func(interface{}, *batch.Batch) error { return nil }); err != nil {
+		return nil, err
+	}
+	if err = comp.Run(epoch); err != nil {
+		return nil, err
+	}
+	return buildAnalyzeInfos(qry, proc.Stats.Analyze), nil
+}
+
+// buildAnalyzeInfos converts the runtime statistics of the plan nodes for explain.
+// Join operators read their input from other pipelines directly, so a node which
+// has no recorded input takes the output of its children as its input.
+func buildAnalyzeInfos(qry *plan3.Query, stats []process.AnalyzeInfo) map[int32]*explain.AnalyzeInfo {
+	infos := make(map[int32]*explain.AnalyzeInfo, len(qry.Nodes))
+	for _, n := range qry.Nodes {
+		if int(n.NodeId) < len(stats) {
+			st := &stats[n.NodeId]
+			infos[n.NodeId] = &explain.AnalyzeInfo{
+				InputRows:     atomic.LoadInt64(&st.InputRows),
+				OutputRows:    atomic.LoadInt64(&st.OutputRows),
+				InputBatches:  atomic.LoadInt64(&st.InputBatches),
+				OutputBatches: atomic.LoadInt64(&st.OutputBatches),
+				TimeConsumed:  atomic.LoadInt64(&st.TimeConsumed),
+				MemorySize:    atomic.LoadInt64(&st.MemorySize),
+				ReadBlocks:    atomic.LoadInt64(&st.ReadBlocks),
+				PrunedBlocks:  atomic.LoadInt64(&st.PrunedBlocks),
+			}
+		} else {
+			infos[n.NodeId] = &explain.AnalyzeInfo{}
+		}
+	}
+	for _, n := range qry.Nodes {
+		info := infos[n.NodeId]
+		if info.InputBatches != 0 {
+			continue
+		}
+		for _, child := range n.Children {
+			if ci, ok := infos[child]; ok {
+				info.InputRows += ci.OutputRows
+				info.InputBatches += ci.OutputBatches
+			}
+		}
+	}
+	return infos
+}
+
 func GetExplainColumns(attrs []*plan.Attribute) ([]interface{}, error) {
 	//attrs := plan.BuildExplainResultColumns()
 	cols := make([]*compile1.Col, len(attrs))
@@ -1756,6 +1848,38 @@ func (mce *MysqlCmdExecutor) afterRun(stmt tree.Statement, beginInstant time.Tim
 
 }
 
+// recordStatement records the execution statistics of the statement, and
+// writes it into the slow query log if it runs longer than long_query_time.
+// Only the fingerprint of the sql is recorded, so the literals are not leaked.
+func (mce *MysqlCmdExecutor) recordStatement(sql string, proc *process.Process, begin time.Time, rows uint64) {
+	ses := mce.GetSession()
+	if ses.IsInternal {
+		return
+	}
+	proto := ses.GetMysqlProtocol()
+	s := &metric.StatementInfo{
+		Fingerprint: scanner.Fingerprint(dialect.MYSQL, sql),
+		User:        proto.GetUserName(),
+		Database:    proto.GetDatabaseName(),
+		StartTime:   begin,
+		Duration:    time.Since(begin),
+		Rows:        rows,
+	}
+	if proc.Stats != nil {
+		s.BytesRead = atomic.LoadInt64(&proc.Stats.BytesRead)
+	}
+	if proc.Mp != nil && proc.Mp.Gm != nil {
+		s.PeakMemory = proc.Mp.Gm.Peak()
+	}
+	metric.RecordStatement(s)
+
+	if v, err := ses.GetSessionVar("long_query_time"); err == nil {
+		if threshold, ok := v.(float64); ok && s.Duration.Seconds() >= threshold {
+			metric.LogSlowStatement(s)
+		}
+	}
+}
+
 //execute query
 func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	beginInstant := time.Now()
@@ -1786,6 +1910,12 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
 
+	//the statement is canceled by kill query
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc.Ctx = ctx
+	mce.beginStatement(sql, cancel)
+
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
 		proto.GetUserName(),
@@ -1800,6 +1930,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		_ = txnHandler.CleanTxn()
 	}()
 
+	//the memory of each statement is accounted by its own mmu
+	var queryMmu *guest.Mmu
+	defer func() {
+		if queryMmu != nil {
+			queryMmu.Release()
+		}
+	}()
+
 	var cmpBegin time.Time
 	var ret interface{}
 	var runner ComputationRunner
@@ -1822,6 +1960,25 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+
+		//reset the statistics of the statement
+		stmtBegin := time.Now()
+		stmtSql := sql
+		if len(cws) > 1 {
+			stmtSql = tree.String(stmt, dialect.MYSQL)
+		}
+		var affectedRows uint64
+		atomic.StoreUint64(&ses.sentRows, 0)
+		if proc.Stats != nil {
+			atomic.StoreInt64(&proc.Stats.BytesRead, 0)
+		}
+		if ses.GuestMmu != nil {
+			if queryMmu != nil {
+				queryMmu.Release()
+			}
+			queryMmu = mce.newQueryMmu()
+			proc.Mp = mheap.New(queryMmu)
+		}
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
@@ -1858,6 +2015,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		switch st := stmt.(type) {
 		case *tree.Select:
 			if st.Ep != nil {
+				if err = checkExportParam(st.Ep); err != nil {
+					goto handleFailed
+				}
 				mce.exportDataClose = NewCloseExportData()
 				ses.ep = st.Ep
 				ses.closeRef = mce.exportDataClose
@@ -1902,7 +2062,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.ShowVariables, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar,
+				*tree.Use, *tree.SetVar, *tree.ShowProcessList, *tree.Kill,
 				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
@@ -1972,6 +2132,16 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.ShowProcessList:
+			selfHandle = true
+			if err = mce.handleShowProcessList(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.Kill:
+			selfHandle = true
+			if err = mce.handleKill(st); err != nil {
+				goto handleFailed
+			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
 			if err = mce.handleAnalyzeStmt(st); err != nil {
@@ -1979,7 +2149,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		case *tree.ExplainStmt:
 			selfHandle = true
-			if err = mce.handleExplainStmt(st); err != nil {
+			if err = mce.handleExplainStmt(st, proc, epoch); err != nil {
 				goto handleFailed
 			}
 		case *tree.ExplainAnalyze:
@@ -2107,7 +2277,15 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if ses.ep.Outfile {
 				ses.ep.DefaultBufSize = ses.Pu.SV.GetExportDataDefaultFlushSize()
 				initExportFileParam(ses.ep, ses.Mrs)
-				if err = openNewFile(ses.ep, ses.Mrs); err != nil {
+				switch ses.ep.FileFormat {
+				case arrowFormat:
+					err = openArrowFile(ses)
+				case parquetFormat:
+					err = openParquetFile(ses)
+				default:
+					err = openNewFile(ses.ep, ses.Mrs)
+				}
+				if err != nil {
 					goto handleFailed
 				}
 			}
@@ -2115,11 +2293,18 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 			if ses.ep.Outfile {
-				if err = ses.ep.Writer.Flush(); err != nil {
-					goto handleFailed
+				if ses.batchWriter != nil {
+					if err = closeBatchFile(ses); err != nil {
+						goto handleFailed
+					}
 				}
-				if err = ses.ep.File.Close(); err != nil {
-					goto handleFailed
+				if ses.ep.Writer != nil {
+					if err = ses.ep.Writer.Flush(); err != nil {
+						goto handleFailed
+					}
+					if err = ses.ep.File.Close(); err != nil {
+						goto handleFailed
+					}
 				}
 			}
 
@@ -2171,8 +2356,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			/*
 				Step 2: Echo client
 			*/
+			affectedRows = cw.GetAffectedRows()
 			resp := NewOkResponse(
-				cw.GetAffectedRows(),
+				affectedRows,
 				0,
 				0,
 				0,
@@ -2188,6 +2374,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		}
 	handleSucceeded:
+		mce.recordStatement(stmtSql, proc, stmtBegin, affectedRows+atomic.LoadUint64(&ses.sentRows))
 		//load data handle txn failure internally
 		if !fromLoadData {
 			//txn begin,commit,rollback do not need to be committed
@@ -2201,6 +2388,12 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		}
 		goto handleNext
 	handleFailed:
+		mce.recordStatement(stmtSql, proc, stmtBegin, affectedRows+atomic.LoadUint64(&ses.sentRows))
+		if ctx.Err() != nil && !selfHandle {
+			err = NewMysqlError(ER_QUERY_INTERRUPTED)
+		} else {
+			err = convertMemoryLimitError(err)
+		}
 		//the failures due to txn begin,commit,rollback do not need to be rollback.
 		if fromTxnCommand == TxnNoCommand {
 			txnErr = txnHandler.RollbackAfterAutocommitOnly()
@@ -2282,6 +2475,9 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err erro
 		}
 	}
 
+	mce.beginCommand(uint8(req.GetCmd()))
+	defer mce.endCommand()
+
 	switch uint8(req.GetCmd()) {
 	case COM_QUIT:
 		/*resp = NewResponse(
@@ -2301,23 +2497,6 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err erro
 			return resp, nil
 		}
 
-		if strings.ToLower(seps[0]) == "kill" {
-			//last one is processID
-			procIdStr := seps[len(seps)-1]
-			procID, err := strconv.ParseUint(procIdStr, 10, 64)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, nil
-			}
-			err = mce.GetRoutineManager().killStatement(procID)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, err
-			}
-			resp = NewGeneralOkResponse(COM_QUERY)
-			return resp, nil
-		}
-
 		err := mce.doComQuery(query)
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_QUERY, err)
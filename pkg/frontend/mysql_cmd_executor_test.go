@@ -13,6 +13,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -491,22 +492,9 @@ func Test_mce(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(resp, convey.ShouldBeNil)
 
-		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill"),
-		}
-		resp, err = mce.ExecRequest(req)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(resp, convey.ShouldNotBeNil)
-
-		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill 10"),
-		}
 		mce.SetRoutineManager(&RoutineManager{})
-		resp, err = mce.ExecRequest(req)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(resp, convey.ShouldNotBeNil)
+		err = mce.GetRoutineManager().killStatement("root", 10)
+		convey.So(err, convey.ShouldNotBeNil)
 
 		req = &Request{
 			cmd:  int(COM_INIT_DB),
@@ -990,6 +978,36 @@ func Test_GetColumns(t *testing.T) {
 	})
 }
 
+func Test_buildAnalyzeInfos(t *testing.T) {
+	convey.Convey("buildAnalyzeInfos succ", t, func() {
+		qry := &plan.Query{
+			Nodes: []*plan.Node{
+				{NodeId: 0},
+				{NodeId: 1},
+				{NodeId: 2, Children: []int32{0, 1}},
+				{NodeId: 3, Children: []int32{2}},
+			},
+		}
+		stats := []process.AnalyzeInfo{
+			{InputRows: 10, OutputRows: 5, InputBatches: 1, OutputBatches: 1},
+			{InputRows: 20, OutputRows: 20, InputBatches: 2, OutputBatches: 2},
+			{OutputRows: 8, OutputBatches: 3, TimeConsumed: 100},
+		}
+		infos := buildAnalyzeInfos(qry, stats)
+		convey.So(len(infos), convey.ShouldEqual, 4)
+		convey.So(infos[0].InputRows, convey.ShouldEqual, 10)
+		convey.So(infos[0].OutputRows, convey.ShouldEqual, 5)
+		// the join takes the output of its children as input
+		convey.So(infos[2].InputRows, convey.ShouldEqual, 25)
+		convey.So(infos[2].InputBatches, convey.ShouldEqual, 3)
+		convey.So(infos[2].OutputRows, convey.ShouldEqual, 8)
+		convey.So(infos[2].TimeConsumed, convey.ShouldEqual, 100)
+		// a node without statistics
+		convey.So(infos[3].InputRows, convey.ShouldEqual, 8)
+		convey.So(infos[3].OutputRows, convey.ShouldEqual, 0)
+	})
+}
+
 func Test_GetComputationWrapper(t *testing.T) {
 	convey.Convey("GetComputationWrapper succ", t, func() {
 		db, sql, user := "T", "SHOW TABLES", "root"
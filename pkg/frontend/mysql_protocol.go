@@ -19,8 +19,10 @@ import (
 	"crypto/sha1"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"math/rand"
 	"strconv"
+	"sync"
 	"time"
 	"unicode"
 
@@ -163,6 +165,10 @@ type MysqlProtocol interface {
 	PrepareBeforeProcessingResultSet()
 
 	GetStats() string
+
+	//OpenLocalInfile sends the LOCAL INFILE request to the client and
+	//returns the reader of the file content sent by the client
+	OpenLocalInfile(fileName string) (io.ReadCloser, error)
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -279,6 +285,12 @@ type MysqlProtocolImpl struct {
 	rowHandler
 
 	SV *config.SystemVariables
+
+	//the reader of the file content of the LOAD DATA LOCAL in progress.
+	//the packets from the client are delivered to it instead of being
+	//handled as requests until the empty packet arrives.
+	localInfileLock sync.Mutex
+	localInfile     *localInfileReader
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -1123,6 +1135,107 @@ func (mp *MysqlProtocolImpl) sendEOFOrOkPacket(warnings, status uint16) error {
 	}
 }
 
+/*
+localInfileReader reads the file content of LOAD DATA LOCAL which
+is sent by the client as packets.
+*/
+type localInfileReader struct {
+	//the payloads of the packets. it is closed when the empty packet arrives
+	packets chan []byte
+	//the unread part of the current payload
+	buf []byte
+}
+
+func newLocalInfileReader(chanSize int) *localInfileReader {
+	return &localInfileReader{
+		packets: make(chan []byte, chanSize),
+	}
+}
+
+//Read fills p unless the file ends, like reading a file on the disk.
+//The csv parser does not handle the line split by a short read.
+func (r *localInfileReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			payload, ok := <-r.packets
+			if !ok {
+				break
+			}
+			r.buf = payload
+		}
+		m := copy(p[n:], r.buf)
+		r.buf = r.buf[m:]
+		n += m
+	}
+	if n == 0 && len(p) > 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+//Close discards the rest of the file content, so that the client can
+//send the next request after the transfer finishes.
+func (r *localInfileReader) Close() error {
+	for range r.packets {
+	}
+	return nil
+}
+
+//the count of the packets that are buffered in the localInfileReader.
+//the connection is not read when the buffer is full.
+const localInfileBufferedPackets = 16
+
+/*
+OpenLocalInfile sends the LOCAL INFILE request to the client.
+The client responds the content of the file in packets and an empty packet at the end.
+https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_query_response_local_infile_request.html
+*/
+func (mp *MysqlProtocolImpl) OpenLocalInfile(fileName string) (io.ReadCloser, error) {
+	if mp.capability&CLIENT_LOCAL_FILES == 0 {
+		return nil, NewMysqlError(ER_NOT_ALLOWED_COMMAND)
+	}
+
+	r := newLocalInfileReader(localInfileBufferedPackets)
+	mp.localInfileLock.Lock()
+	mp.localInfile = r
+	mp.localInfileLock.Unlock()
+
+	data := make([]byte, HeaderOffset, HeaderOffset+1+len(fileName))
+	data = append(data, defines.LocalInFileHeader)
+	data = append(data, fileName...)
+	if err := mp.writePackets(data); err != nil {
+		mp.localInfileLock.Lock()
+		mp.localInfile = nil
+		mp.localInfileLock.Unlock()
+		return nil, err
+	}
+	return r, nil
+}
+
+/*
+deliverLocalInfile delivers the payload from the client to the
+LOAD DATA LOCAL in progress. It returns false if there is not any.
+*/
+func (mp *MysqlProtocolImpl) deliverLocalInfile(payload []byte) bool {
+	mp.localInfileLock.Lock()
+	r := mp.localInfile
+	if r != nil && len(payload) == 0 {
+		mp.localInfile = nil
+	}
+	mp.localInfileLock.Unlock()
+
+	if r == nil {
+		return false
+	}
+	if len(payload) == 0 {
+		close(r.packets)
+	} else {
+		r.packets <- payload
+	}
+	return true
+}
+
 //make the column information with the format of column definition41
 func (mp *MysqlProtocolImpl) makeColumnDefinition41Payload(column *MysqlColumn, cmd int) []byte {
 	space := HeaderOffset + 8*9 + //lenenc bytes of 8 fields
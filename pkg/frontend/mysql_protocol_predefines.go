@@ -11174,9 +11174,12 @@ type MysqlError struct {
 	Args      []interface{}
 }
 
+// the integer verbs of the mysql error messages in C style
+var cIntegerVerbReplacer = strings.NewReplacer("%llu", "%d", "%lld", "%d", "%lu", "%d", "%ld", "%d")
+
 func (me *MysqlError) Error() string {
 	cnt := strings.Count(me.Format, "%")
-	return fmt.Sprintf(me.Format, me.Args[:cnt]...)
+	return fmt.Sprintf(cIntegerVerbReplacer.Replace(me.Format), me.Args[:cnt]...)
 }
 
 func NewMysqlError(code uint16, args ...interface{}) *MysqlError {
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"strconv"
@@ -1481,6 +1482,86 @@ func Test_writePackets(t *testing.T) {
 	})
 }
 
+func Test_localInfile(t *testing.T) {
+	convey.Convey("read local infile succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		var request []byte
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).DoAndReturn(func(msg interface{}) error {
+			request = msg.([]byte)
+			return nil
+		}).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		convey.So(proto.deliverLocalInfile([]byte("abc")), convey.ShouldBeFalse)
+
+		r, err := proto.OpenLocalInfile("data.csv")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(request[PacketHeaderLength], convey.ShouldEqual, defines.LocalInFileHeader)
+		convey.So(string(request[PacketHeaderLength+1:]), convey.ShouldEqual, "data.csv")
+
+		go func() {
+			proto.deliverLocalInfile([]byte("1,2\n"))
+			proto.deliverLocalInfile([]byte("3,4\n"))
+			proto.deliverLocalInfile(nil)
+		}()
+		data, err := io.ReadAll(r)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(data), convey.ShouldEqual, "1,2\n3,4\n")
+		convey.So(r.Close(), convey.ShouldBeNil)
+
+		//the packets after the transfer are requests
+		convey.So(proto.deliverLocalInfile([]byte("abc")), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("close local infile before the end", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		r, err := proto.OpenLocalInfile("data.csv")
+		convey.So(err, convey.ShouldBeNil)
+
+		go func() {
+			for i := 0; i < 2*localInfileBufferedPackets; i++ {
+				proto.deliverLocalInfile([]byte("1,2\n"))
+			}
+			proto.deliverLocalInfile(nil)
+		}()
+		buf := make([]byte, 2)
+		n, err := r.Read(buf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(buf[:n]), convey.ShouldEqual, "1,")
+		//the rest is discarded
+		convey.So(r.Close(), convey.ShouldBeNil)
+		convey.So(proto.deliverLocalInfile([]byte("abc")), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("local infile is not allowed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.capability &^= CLIENT_LOCAL_FILES
+		_, err = proto.OpenLocalInfile("data.csv")
+		convey.So(err, convey.ShouldBeError)
+		convey.So(proto.deliverLocalInfile([]byte("abc")), convey.ShouldBeFalse)
+	})
+}
+
 func Test_openpacket(t *testing.T) {
 	convey.Convey("openpacket succ", t, func() {
 		ctrl := gomock.NewController(t)
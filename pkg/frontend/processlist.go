@@ -0,0 +1,303 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// the length of the sql shown by SHOW PROCESSLIST without FULL
+const processListInfoLength = 100
+
+// adminUserName is the user who can see and kill the connections of all users.
+// Other users are limited to their own connections, like the users without the
+// PROCESS and CONNECTION_ADMIN privileges in MySQL.
+const adminUserName = "root"
+
+// commandStatus is what the executor is doing now.
+// It is read by other connections for SHOW PROCESSLIST and KILL.
+type commandStatus struct {
+	sync.Mutex
+	// command, the name of the command in execution, "Sleep" when idle.
+	command string
+	// begin, when the command began or when the executor became idle.
+	begin time.Time
+	// sql, the statement in execution.
+	sql string
+	// cancel, cancels the statement in execution.
+	cancel context.CancelFunc
+}
+
+// processInfo is one row of SHOW PROCESSLIST
+type processInfo struct {
+	id      uint64
+	user    string
+	host    string
+	db      string
+	command string
+	time    uint64
+	state   string
+	info    string
+	// memory, the memory used by the connection in bytes
+	memory int64
+}
+
+func commandName(cmd uint8) string {
+	switch cmd {
+	case COM_SLEEP:
+		return "Sleep"
+	case COM_QUIT:
+		return "Quit"
+	case COM_INIT_DB:
+		return "Init DB"
+	case COM_QUERY:
+		return "Query"
+	case COM_FIELD_LIST:
+		return "Field List"
+	case COM_PING:
+		return "Ping"
+	default:
+		return fmt.Sprintf("Command(0x%x)", cmd)
+	}
+}
+
+// beginCommand records that the executor starts the command
+func (mce *MysqlCmdExecutor) beginCommand(cmd uint8) {
+	mce.status.Lock()
+	defer mce.status.Unlock()
+	mce.status.command = commandName(cmd)
+	mce.status.begin = time.Now()
+	mce.status.sql = ""
+	mce.status.cancel = nil
+}
+
+// endCommand records that the executor is idle
+func (mce *MysqlCmdExecutor) endCommand() {
+	mce.beginCommand(COM_SLEEP)
+}
+
+// beginStatement records the statement in execution and how to cancel it
+func (mce *MysqlCmdExecutor) beginStatement(sql string, cancel context.CancelFunc) {
+	mce.status.Lock()
+	defer mce.status.Unlock()
+	mce.status.sql = sql
+	mce.status.cancel = cancel
+}
+
+// cancelStatement cancels the statement in execution if there is one
+func (mce *MysqlCmdExecutor) cancelStatement() {
+	mce.status.Lock()
+	defer mce.status.Unlock()
+	if mce.status.cancel != nil {
+		mce.status.cancel()
+	}
+}
+
+func (mce *MysqlCmdExecutor) getProcessInfo(full bool) processInfo {
+	mce.status.Lock()
+	defer mce.status.Unlock()
+	info := processInfo{
+		command: mce.status.command,
+		info:    mce.status.sql,
+	}
+	if info.command == "" {
+		info.command = commandName(COM_SLEEP)
+	}
+	if !mce.status.begin.IsZero() {
+		info.time = uint64(time.Since(mce.status.begin) / time.Second)
+	}
+	if info.command != commandName(COM_SLEEP) {
+		info.state = "executing"
+	}
+	if !full && len(info.info) > processListInfoLength {
+		// do not cut a multi-byte character
+		n := processListInfoLength
+		for n > 0 && !utf8.RuneStart(info.info[n]) {
+			n--
+		}
+		info.info = info.info[:n]
+	}
+	return info
+}
+
+// findRoutine returns the routine and the session of the connection.
+// It must be called with the lock held.
+func (rm *RoutineManager) findRoutine(id uint64) (goetty.IOSession, *Routine) {
+	for rs, rt := range rm.clients {
+		if uint64(rt.getConnID()) == id {
+			return rs, rt
+		}
+	}
+	return nil, nil
+}
+
+// routineUserName returns the user of the connection
+func routineUserName(rt *Routine) string {
+	if rt.protocol == nil {
+		return ""
+	}
+	return rt.protocol.GetUserName()
+}
+
+// checkKillPrivilege checks whether the user can kill the connection.
+func checkKillPrivilege(user string, rt *Routine, id uint64) error {
+	if user != adminUserName && user != routineUserName(rt) {
+		return NewMysqlError(ER_KILL_DENIED_ERROR, id)
+	}
+	return nil
+}
+
+/*
+KILL CONNECTION statement issued by the user
+*/
+func (rm *RoutineManager) killConnection(user string, id uint64) error {
+	rm.rwlock.RLock()
+	rs, rt := rm.findRoutine(id)
+	rm.rwlock.RUnlock()
+	if rt == nil {
+		return NewMysqlError(ER_NO_SUCH_THREAD, id)
+	}
+	if err := checkKillPrivilege(user, rt, id); err != nil {
+		return err
+	}
+	logutil.Infof("will close the connection %d", id)
+	if mce, ok := rt.executor.(*MysqlCmdExecutor); ok {
+		mce.cancelStatement()
+	}
+	rt.notifyClose()
+	// Closed is called when the io session is closed, it takes the lock.
+	return rs.Close()
+}
+
+// processList returns the connections visible to the user sorted by id
+func (rm *RoutineManager) processList(user string, full bool) []processInfo {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	list := make([]processInfo, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		mce, ok := rt.executor.(*MysqlCmdExecutor)
+		if !ok {
+			continue
+		}
+		if user != adminUserName && user != routineUserName(rt) {
+			continue
+		}
+		info := mce.getProcessInfo(full)
+		info.id = uint64(rt.getConnID())
+		if rt.protocol != nil {
+			host, port := rt.protocol.Peer()
+			info.host = host + ":" + port
+			info.user = rt.protocol.GetUserName()
+			info.db = rt.protocol.GetDatabaseName()
+		}
+		if rt.guestMmu != nil {
+			info.memory = rt.guestMmu.Size()
+		}
+		list = append(list, info)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+/*
+handle show [full] processlist
+*/
+func (mce *MysqlCmdExecutor) handleShowProcessList(sp *tree.ShowProcessList) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	names := []string{"Id", "User", "Host", "db", "Command", "Time", "State", "Info", "Memory"}
+	for _, name := range names {
+		col := new(MysqlColumn)
+		switch name {
+		case "Id":
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			col.SetSigned(false)
+		case "Time":
+			col.SetColumnType(defines.MYSQL_TYPE_LONG)
+			col.SetSigned(false)
+		case "Memory":
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		default:
+			col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		}
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+
+	for _, p := range mce.GetRoutineManager().processList(proto.GetUserName(), sp.Full) {
+		row := make([]interface{}, len(names))
+		row[0] = p.id
+		row[1] = p.user
+		row[2] = p.host
+		if p.db != "" {
+			row[3] = p.db
+		}
+		row[4] = p.command
+		row[5] = p.time
+		row[6] = p.state
+		if p.info != "" {
+			row[7] = p.info
+		}
+		row[8] = p.memory
+		ses.Mrs.AddRow(row)
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle kill [connection|query] id
+*/
+func (mce *MysqlCmdExecutor) handleKill(k *tree.Kill) error {
+	proto := mce.GetSession().GetMysqlProtocol()
+	user := proto.GetUserName()
+	rm := mce.GetRoutineManager()
+	if k.Option != tree.KillQuery && k.ConnectionId == uint64(proto.ConnectionID()) {
+		// the connection is closed by the kill, send the response before it.
+		if err := proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+			return err
+		}
+		return rm.killConnection(user, k.ConnectionId)
+	}
+
+	var err error
+	if k.Option == tree.KillQuery {
+		err = rm.killStatement(user, k.ConnectionId)
+	} else {
+		err = rm.killConnection(user, k.ConnectionId)
+	}
+	if err != nil {
+		return err
+	}
+	return proto.sendOKPacket(0, 0, 0, 0, "")
+}
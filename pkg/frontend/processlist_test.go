@@ -0,0 +1,108 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/fagongzi/goetty"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_processList(t *testing.T) {
+	convey.Convey("show processlist and kill", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddr().Return("127.0.0.1:3306").AnyTimes()
+
+		mce := NewMysqlCmdExecutor()
+		proto := &MysqlProtocolImpl{}
+		proto.connectionID = 7
+		proto.tcpConn = ioses
+		proto.username = "root"
+		gm := guest.New(1<<20, host.New(1<<20))
+		rm := &RoutineManager{
+			clients: map[goetty.IOSession]*Routine{
+				ioses: {protocol: proto, executor: mce, guestMmu: gm},
+			},
+		}
+
+		list := rm.processList("root", false)
+		convey.So(list, convey.ShouldHaveLength, 1)
+		convey.So(list[0].id, convey.ShouldEqual, 7)
+		convey.So(list[0].user, convey.ShouldEqual, "root")
+		convey.So(list[0].host, convey.ShouldEqual, "127.0.0.1:3306")
+		convey.So(list[0].command, convey.ShouldEqual, "Sleep")
+		convey.So(list[0].state, convey.ShouldEqual, "")
+		convey.So(list[0].memory, convey.ShouldEqual, 0)
+
+		convey.So(gm.Alloc(1024), convey.ShouldBeNil)
+		list = rm.processList("root", false)
+		convey.So(list[0].memory, convey.ShouldEqual, 1024)
+		gm.Free(1024)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sql := "select " + strings.Repeat("a", processListInfoLength)
+		mce.beginCommand(COM_QUERY)
+		mce.beginStatement(sql, cancel)
+
+		list = rm.processList("root", false)
+		convey.So(list[0].command, convey.ShouldEqual, "Query")
+		convey.So(list[0].state, convey.ShouldEqual, "executing")
+		convey.So(list[0].info, convey.ShouldEqual, sql[:processListInfoLength])
+		list = rm.processList("root", true)
+		convey.So(list[0].info, convey.ShouldEqual, sql)
+
+		convey.So(rm.processList("u1", true), convey.ShouldHaveLength, 0)
+		convey.So(rm.killStatement("u1", 7), convey.ShouldNotBeNil)
+		convey.So(rm.killConnection("u1", 7), convey.ShouldNotBeNil)
+		convey.So(ctx.Err(), convey.ShouldBeNil)
+
+		convey.So(rm.killStatement("root", 8), convey.ShouldNotBeNil)
+		convey.So(rm.killConnection("root", 8), convey.ShouldNotBeNil)
+		convey.So(rm.killStatement("root", 7), convey.ShouldBeNil)
+		convey.So(ctx.Err(), convey.ShouldNotBeNil)
+
+		mce.endCommand()
+		list = rm.processList("root", true)
+		convey.So(list[0].command, convey.ShouldEqual, "Sleep")
+		convey.So(list[0].info, convey.ShouldEqual, "")
+	})
+}
+
+func Test_getProcessInfo(t *testing.T) {
+	convey.Convey("truncate the info on a rune boundary", t, func() {
+		mce := NewMysqlCmdExecutor()
+		sql := "select '" + strings.Repeat("中", processListInfoLength) + "'"
+		mce.beginCommand(COM_QUERY)
+		mce.beginStatement(sql, nil)
+
+		info := mce.getProcessInfo(false)
+		convey.So(utf8.ValidString(info.info), convey.ShouldBeTrue)
+		convey.So(len(info.info), convey.ShouldBeLessThanOrEqualTo, processListInfoLength)
+		convey.So(strings.HasPrefix(sql, info.info), convey.ShouldBeTrue)
+		convey.So(mce.getProcessInfo(true).info, convey.ShouldEqual, sql)
+	})
+}
@@ -75,9 +75,17 @@ func (routine *Routine) Loop() {
 	var req *Request = nil
 	var err error
 	var resp *Response
-	defer routine.Quit()
 	//session for the connection
 	var ses *Session = nil
+	//the user of the session
+	var user string
+	defer func() {
+		//the memory of the session has been returned to its user by Quit
+		if ses != nil {
+			routine.GetRoutineMgr().releaseUserMmu(user)
+		}
+	}()
+	defer routine.Quit()
 	for {
 		quit := false
 		select {
@@ -98,6 +106,9 @@ func (routine *Routine) Loop() {
 		routine.protocol.(*MysqlProtocolImpl).sequenceId = req.seq
 
 		if ses == nil {
+			//the session is accounted to its user after the handshake
+			user = routine.protocol.GetUserName()
+			routine.guestMmu.SetParent(mgr.acquireUserMmu(user))
 			ses = NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit(), gSysVariables)
 		}
 
@@ -133,6 +144,11 @@ func (routine *Routine) Quit() {
 	if routine.protocol != nil {
 		routine.protocol.Quit()
 	}
+
+	//the memory of the session is not accounted to its user any more
+	if routine.guestMmu != nil {
+		routine.guestMmu.Release()
+	}
 }
 
 /*
@@ -153,6 +169,7 @@ func NewRoutine(protocol MysqlProtocol, executor CmdExecutor, pu *config.Paramet
 		guestMmu:    guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu),
 		mempool:     pu.Mempool,
 	}
+	ri.guestMmu.Name = "session"
 
 	//async process request
 	go ri.Loop()
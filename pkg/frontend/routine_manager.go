@@ -16,11 +16,13 @@ package frontend
 
 import (
 	"errors"
+	"math"
 	"sync"
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
 
 type RoutineManager struct {
@@ -31,6 +33,17 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//the memory accounting of each user, shared by the sessions of the user
+	userMmuLock sync.Mutex
+	userMmus    map[string]*userMmu
+}
+
+// userMmu is the mmu of a user with the number of its sessions, it is removed
+// when the last session of the user is closed
+type userMmu struct {
+	mmu  *guest.Mmu
+	refs int
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -41,6 +54,35 @@ func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 	return rm.pu
 }
 
+// acquireUserMmu returns the mmu accounting the memory of all sessions of the
+// user, each call must be paired with a releaseUserMmu when the session ends
+func (rm *RoutineManager) acquireUserMmu(user string) *guest.Mmu {
+	rm.userMmuLock.Lock()
+	defer rm.userMmuLock.Unlock()
+	um, ok := rm.userMmus[user]
+	if !ok {
+		um = &userMmu{mmu: guest.New(math.MaxInt64, rm.pu.HostMmu)}
+		um.mmu.Name = "user"
+		rm.userMmus[user] = um
+	}
+	um.refs++
+	return um.mmu
+}
+
+// releaseUserMmu is called after the memory of a session of the user has been
+// released, the mmu of the user is removed with its last session
+func (rm *RoutineManager) releaseUserMmu(user string) {
+	rm.userMmuLock.Lock()
+	defer rm.userMmuLock.Unlock()
+	um, ok := rm.userMmus[user]
+	if !ok {
+		return
+	}
+	if um.refs--; um.refs <= 0 {
+		delete(rm.userMmus, user)
+	}
+}
+
 func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	pro := NewMysqlClientProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
 	exe := NewMysqlCmdExecutor()
@@ -78,23 +120,24 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 }
 
 /*
-KILL statement
+KILL QUERY statement
 */
-func (rm *RoutineManager) killStatement(id uint64) error {
-	rm.rwlock.Lock()
-	defer rm.rwlock.Unlock()
-	var rt *Routine = nil
-	for _, value := range rm.clients {
-		if uint64(value.getConnID()) == id {
-			rt = value
-			break
-		}
+func (rm *RoutineManager) killStatement(user string, id uint64) error {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	_, rt := rm.findRoutine(id)
+	if rt == nil {
+		return NewMysqlError(ER_NO_SUCH_THREAD, id)
+	}
+	if err := checkKillPrivilege(user, rt, id); err != nil {
+		return err
 	}
 
-	if rt != nil {
-		logutil.Infof("will close the statement %d", id)
-		rt.notifyClose()
+	logutil.Infof("will close the statement %d", id)
+	if mce, ok := rt.executor.(*MysqlCmdExecutor); ok {
+		mce.cancelStatement()
 	}
+	rt.notifyClose()
 	return nil
 }
 
@@ -159,6 +202,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		return nil
 	}
 
+	//the content of the file of LOAD DATA LOCAL is not a request
+	if protocol.deliverLocalInfile(payload) {
+		return nil
+	}
+
 	req := routine.protocol.GetRequest(payload)
 	req.seq = seq
 	routine.requestChan <- req
@@ -172,6 +220,8 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 
 		pdHook: pdHook,
 		pu:     pu,
+
+		userMmus: make(map[string]*userMmu),
 	}
 	return rm
 }
@@ -175,6 +175,8 @@ type Session struct {
 
 	ep           *tree.ExportParam
 	showStmtType ShowStatementType
+	//the writer of SELECT ... INTO OUTFILE ... FORMAT 'arrow' or 'parquet'
+	batchWriter *batchExportWriter
 
 	closeRef      *CloseExportData
 	txnHandler    *TxnHandler
@@ -182,6 +184,9 @@ type Session struct {
 	storage       engine.Engine
 	sql           string
 
+	//the rows sent to the client by the statement in execution
+	sentRows uint64
+
 	sysVars         map[string]interface{}
 	userDefinedVars map[string]interface{}
 	gSysVars        *GlobalSystemVariables
@@ -355,6 +355,14 @@ type SystemVariableDoubleType struct {
 	maximum float64
 }
 
+func InitSystemVariableDoubleType(name string, minimum, maximum float64) SystemVariableDoubleType {
+	return SystemVariableDoubleType{
+		name:    name,
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
 func (svdt SystemVariableDoubleType) String() string {
 	return "DOUBLE"
 }
@@ -888,6 +896,38 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("tx_isolation", "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
 		Default:           "REPEATABLE-READ",
 	},
+	"long_query_time": {
+		Name:              "long_query_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableDoubleType("long_query_time", 0, 31536000),
+		Default:           float64(10),
+	},
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
+	"session_memory_limit": {
+		Name:              "session_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("session_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
+	"user_memory_limit": {
+		Name:              "user_memory_limit",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("user_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	"testglobalvar_dyn": {
 		Name:              "testglobalvar_dyn",
 		Scope:             ScopeGlobal,
@@ -0,0 +1,437 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	sm "github.com/lni/dragonboat/v4/statemachine"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+const (
+	// AddReplicaOp adds a replica of the shard to the store.
+	AddReplicaOp = "add-replica"
+	// RemoveReplicaOp removes the replica of the shard from the store.
+	RemoveReplicaOp = "remove-replica"
+	// TransferLeaderOp transfers the leadership of the Log shard to the
+	// replica.
+	TransferLeaderOp = "transfer-leader"
+
+	// LogServiceName is the name of the Log service used in OperatorRequest.
+	LogServiceName = "log"
+	// DNServiceName is the name of the DN service used in OperatorRequest.
+	DNServiceName = "dn"
+
+	// AdminStatePath is the path of the admin endpoint for dumping the
+	// HAKeeper state.
+	AdminStatePath = "/hakeeper/state"
+	// AdminOperatorsPath is the path of the admin endpoint for listing,
+	// submitting and canceling operators. Operators are canceled by sending
+	// DELETE requests to AdminOperatorsPath/{id}.
+	AdminOperatorsPath = "/hakeeper/operators"
+	// AdminStoresPath is the path of the admin endpoint for the administrative
+	// state of the Log and DN stores. GET requests to AdminStoresPath/{uuid}
+	// return the StoreStatus of the store, POST requests to
+	// AdminStoresPath/{uuid}/drain and AdminStoresPath/{uuid}/activate change
+	// the state of the store.
+	AdminStoresPath = "/hakeeper/stores"
+	// AdminExportPath is the path of the admin endpoint for exporting a Log
+	// shard to the backup directory of the log store, the shard must have a
+	// replica on the store.
+	AdminExportPath = "/logservice/export"
+	// AdminImportPath is the path of the admin endpoint for seeding a Log
+	// shard from an export in the backup directory of the log store.
+	AdminImportPath = "/logservice/import"
+	// DrainStoreAction marks the store as draining.
+	DrainStoreAction = "drain"
+	// ActivateStoreAction marks the store as active again.
+	ActivateStoreAction = "activate"
+)
+
+var (
+	ErrInvalidOperator = moerr.NewError(moerr.INVALID_INPUT, "invalid operator")
+)
+
+// OperatorRequest describes a manual operation submitted by the admin.
+type OperatorRequest struct {
+	// Type is one of AddReplicaOp, RemoveReplicaOp and TransferLeaderOp.
+	Type string `json:"type"`
+	// Service is either LogServiceName or DNServiceName.
+	Service string `json:"service"`
+	// UUID is the UUID of the store, it is not required by TransferLeaderOp.
+	UUID    string `json:"uuid"`
+	ShardID uint64 `json:"shard_id"`
+	// ReplicaID is the ID of the replica to remove or to transfer leadership
+	// to. It is allocated by the HAKeeper when adding a replica.
+	ReplicaID uint64 `json:"replica_id"`
+}
+
+// AddOperatorResponse is the response of submitting an operator to the admin
+// endpoint.
+type AddOperatorResponse struct {
+	ID uint64 `json:"id"`
+}
+
+// StoreStatus is the administrative status of a store returned by the admin
+// endpoint.
+type StoreStatus struct {
+	UUID  string `json:"uuid"`
+	State string `json:"state"`
+	// LogReplicas is the number of Log shard replicas still on the store.
+	LogReplicas int `json:"log_replicas"`
+	// DNShards is the number of DN shard replicas still on the store.
+	DNShards int `json:"dn_shards"`
+	// SafeToStop is true when the store is drained and can be stopped.
+	SafeToStop bool `json:"safe_to_stop"`
+}
+
+// NewStoreStatus returns the StoreStatus of the store from the result of the
+// StoreStateQuery.
+func NewStoreStatus(uuid string, r StoreStateQueryResult) StoreStatus {
+	return StoreStatus{
+		UUID:        uuid,
+		State:       r.State.String(),
+		LogReplicas: r.LogReplicas,
+		DNShards:    r.DNShards,
+		SafeToStop:  r.SafeToStop(),
+	}
+}
+
+// ExportRequest is the request of exporting the user records of the Log shard
+// starting from FirstIndex to Path in the backup directory.
+type ExportRequest struct {
+	ShardID    uint64 `json:"shard_id"`
+	FirstIndex uint64 `json:"first_index"`
+	Path       string `json:"path"`
+}
+
+// ImportRequest is the request of seeding the Log shard from the export at
+// Path in the backup directory.
+type ImportRequest struct {
+	ShardID uint64 `json:"shard_id"`
+	Path    string `json:"path"`
+}
+
+// ImportResponse is the response of importing a Log shard, Lsn is the Lsn of
+// the last imported record.
+type ImportResponse struct {
+	Lsn uint64 `json:"lsn"`
+}
+
+// ValidateBackupPath checks that the path of an export stays within the backup
+// directory.
+func ValidateBackupPath(p string) error {
+	cleaned := path.Clean(p)
+	if len(p) == 0 || path.IsAbs(cleaned) ||
+		cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return moerr.NewError(moerr.INVALID_INPUT,
+			fmt.Sprintf("invalid backup path %q", p))
+	}
+	return nil
+}
+
+// Operator validates the request and returns the Operator described by it.
+func (r OperatorRequest) Operator() (hapb.Operator, error) {
+	var serviceType hapb.ServiceType
+	switch r.Service {
+	case LogServiceName:
+		serviceType = hapb.LogService
+	case DNServiceName:
+		serviceType = hapb.DnService
+	default:
+		return hapb.Operator{},
+			errors.Wrapf(ErrInvalidOperator, "unknown service %q", r.Service)
+	}
+	if r.ShardID == 0 {
+		return hapb.Operator{}, errors.Wrapf(ErrInvalidOperator, "shard ID not set")
+	}
+	if len(r.UUID) == 0 && r.Type != TransferLeaderOp {
+		return hapb.Operator{}, errors.Wrapf(ErrInvalidOperator, "UUID not set")
+	}
+
+	switch r.Type {
+	case AddReplicaOp:
+		if serviceType == hapb.LogService {
+			return newOperator(fmt.Sprintf("add log replica of shard %d on %s",
+				r.ShardID, r.UUID), r.logStep(hapb.AddNode, 0),
+				r.logStep(hapb.StartNode, 0)), nil
+		}
+		return newOperator(fmt.Sprintf("add dn replica of shard %d on %s",
+			r.ShardID, r.UUID), r.dnStep(hapb.StartNode, 0)), nil
+	case RemoveReplicaOp:
+		if r.ReplicaID == 0 {
+			return hapb.Operator{},
+				errors.Wrapf(ErrInvalidOperator, "replica ID not set")
+		}
+		if serviceType == hapb.LogService {
+			return newOperator(fmt.Sprintf("remove log replica %d:%d from %s",
+				r.ShardID, r.ReplicaID, r.UUID),
+				r.logStep(hapb.RemoveNode, r.ReplicaID),
+				r.logStep(hapb.StopNode, r.ReplicaID)), nil
+		}
+		return newOperator(fmt.Sprintf("remove dn replica %d:%d from %s",
+			r.ShardID, r.ReplicaID, r.UUID),
+			r.dnStep(hapb.StopNode, r.ReplicaID)), nil
+	case TransferLeaderOp:
+		if serviceType != hapb.LogService {
+			return hapb.Operator{}, errors.Wrapf(ErrInvalidOperator,
+				"leadership can only be transferred for log shards")
+		}
+		if r.ReplicaID == 0 {
+			return hapb.Operator{},
+				errors.Wrapf(ErrInvalidOperator, "replica ID not set")
+		}
+		return newOperator(fmt.Sprintf("transfer leader of shard %d to %d",
+			r.ShardID, r.ReplicaID),
+			r.logStep(hapb.TransferLeader, r.ReplicaID)), nil
+	default:
+		return hapb.Operator{},
+			errors.Wrapf(ErrInvalidOperator, "unknown operator type %q", r.Type)
+	}
+}
+
+func (r OperatorRequest) logStep(changeType hapb.ConfigChangeType,
+	replicaID uint64) hapb.ScheduleCommand {
+	return newStep(hapb.LogService, r.UUID, r.ShardID, replicaID, changeType)
+}
+
+func (r OperatorRequest) dnStep(changeType hapb.ConfigChangeType,
+	replicaID uint64) hapb.ScheduleCommand {
+	return newStep(hapb.DnService, r.UUID, r.ShardID, replicaID, changeType)
+}
+
+func newStep(serviceType hapb.ServiceType, uuid string, shardID uint64,
+	replicaID uint64, changeType hapb.ConfigChangeType) hapb.ScheduleCommand {
+	return hapb.ScheduleCommand{
+		UUID: uuid,
+		ConfigChange: hapb.ConfigChange{
+			Replica: hapb.Replica{
+				ShardID:   shardID,
+				ReplicaID: replicaID,
+			},
+			ChangeType: changeType,
+		},
+		ServiceType: serviceType,
+	}
+}
+
+func newOperator(brief string, steps ...hapb.ScheduleCommand) hapb.Operator {
+	return hapb.Operator{Brief: brief, Steps: steps}
+}
+
+// OperatorQuery queries all unfinished operators.
+type OperatorQuery struct{}
+
+// OperatorStatus is the status of an operator.
+type OperatorStatus struct {
+	hapb.Operator
+	// Finished indicates whether each step of the operator is finished.
+	Finished []bool
+}
+
+// IsStepFinished returns a boolean value indicating whether the step has been
+// applied according to the state reported by stores.
+func IsStepFinished(step hapb.ScheduleCommand,
+	dn hapb.DNState, log hapb.LogState) bool {
+	replica := step.ConfigChange.Replica
+	switch step.ServiceType {
+	case hapb.LogService:
+		shardInfo := log.Shards[replica.ShardID]
+		_, inShard := shardInfo.Replicas[replica.ReplicaID]
+		onStore := false
+		for _, replicaInfo := range log.Stores[step.UUID].Replicas {
+			if replicaInfo.ShardID == replica.ShardID {
+				onStore = true
+			}
+		}
+		switch step.ConfigChange.ChangeType {
+		case hapb.AddNode:
+			return inShard
+		case hapb.RemoveNode:
+			return !inShard
+		case hapb.StartNode:
+			return onStore
+		case hapb.StopNode:
+			return !onStore
+		case hapb.TransferLeader:
+			return shardInfo.LeaderID == replica.ReplicaID
+		}
+	case hapb.DnService:
+		onStore := false
+		for _, shardInfo := range dn.Stores[step.UUID].Shards {
+			if shardInfo.ShardID == replica.ShardID &&
+				shardInfo.ReplicaID == replica.ReplicaID {
+				onStore = true
+			}
+		}
+		switch step.ConfigChange.ChangeType {
+		case hapb.StartNode:
+			return onStore
+		case hapb.StopNode:
+			return !onStore
+		}
+	}
+	return false
+}
+
+// operatorKey identifies the shard an operator or a schedule command is for.
+type operatorKey struct {
+	serviceType hapb.ServiceType
+	shardID     uint64
+}
+
+func getOperatorKey(cmd hapb.ScheduleCommand) operatorKey {
+	return operatorKey{
+		serviceType: cmd.ServiceType,
+		shardID:     cmd.ConfigChange.Replica.ShardID,
+	}
+}
+
+// GetOperatorCommands returns the first unfinished step of operators as
+// ScheduleCommands. Operators of the same shard are run one at a time in the
+// order of their IDs. Steps without the UUID set, e.g. transferring the
+// leadership, are sent to the store of the replica they are for.
+func GetOperatorCommands(ops map[uint64]hapb.Operator,
+	dn hapb.DNState, log hapb.LogState) []hapb.ScheduleCommand {
+	ids := make([]uint64, 0, len(ops))
+	for id := range ops {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var cmds []hapb.ScheduleCommand
+	running := make(map[operatorKey]struct{})
+	for _, id := range ids {
+		for _, step := range ops[id].Steps {
+			if IsStepFinished(step, dn, log) {
+				continue
+			}
+			key := getOperatorKey(step)
+			if _, ok := running[key]; ok {
+				break
+			}
+			running[key] = struct{}{}
+			if len(step.UUID) == 0 {
+				replica := step.ConfigChange.Replica
+				step.UUID = log.Shards[replica.ShardID].Replicas[replica.ReplicaID]
+			}
+			if len(step.UUID) != 0 {
+				cmds = append(cmds, step)
+			}
+			break
+		}
+	}
+	return cmds
+}
+
+// MergeScheduleCommands merges ScheduleCommands of operators with those
+// generated by the Checker. Operators take precedence, commands generated by
+// the Checker for shards with running operators are dropped.
+func MergeScheduleCommands(operatorCmds []hapb.ScheduleCommand,
+	checkerCmds []hapb.ScheduleCommand) []hapb.ScheduleCommand {
+	running := make(map[operatorKey]struct{})
+	for _, cmd := range operatorCmds {
+		running[getOperatorKey(cmd)] = struct{}{}
+	}
+	cmds := append([]hapb.ScheduleCommand{}, operatorCmds...)
+	for _, cmd := range checkerCmds {
+		if _, ok := running[getOperatorKey(cmd)]; ok {
+			plog.Infof("schedule command %s dropped, shard has running operators",
+				cmd.String())
+			continue
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds
+}
+
+func (s *stateMachine) isOperatorFinished(op hapb.Operator) bool {
+	for _, step := range op.Steps {
+		if !IsStepFinished(step, s.state.DNState, s.state.LogState) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *stateMachine) handleAddOperatorCmd(cmd []byte) sm.Result {
+	var op hapb.Operator
+	if err := op.Unmarshal(cmd[headerSize:]); err != nil {
+		panic(err)
+	}
+	op.ID = s.assignID()
+	// a new replica ID is assigned to the replica being added
+	var replicaID uint64
+	for i := range op.Steps {
+		if op.Steps[i].ConfigChange.Replica.ReplicaID != 0 {
+			continue
+		}
+		if replicaID == 0 {
+			replicaID = s.assignID()
+		}
+		op.Steps[i].ConfigChange.Replica.ReplicaID = replicaID
+	}
+	plog.Infof("operator %d added: %s", op.ID, op.Brief)
+	if s.state.Operators == nil {
+		s.state.Operators = make(map[uint64]hapb.Operator)
+	}
+	s.state.Operators[op.ID] = op
+	return sm.Result{Value: op.ID}
+}
+
+func (s *stateMachine) handleCancelOperatorCmd(cmd []byte) sm.Result {
+	id := binaryEnc.Uint64(cmd[headerSize:])
+	if _, ok := s.state.Operators[id]; !ok {
+		return sm.Result{}
+	}
+	plog.Infof("operator %d canceled", id)
+	delete(s.state.Operators, id)
+	return sm.Result{Value: 1}
+}
+
+// removeFinishedOperators removes operators with all steps finished.
+func (s *stateMachine) removeFinishedOperators() {
+	for id, op := range s.state.Operators {
+		if s.isOperatorFinished(op) {
+			plog.Infof("operator %d finished", id)
+			delete(s.state.Operators, id)
+		}
+	}
+}
+
+func (s *stateMachine) handleOperatorQuery() []OperatorStatus {
+	result := make([]OperatorStatus, 0, len(s.state.Operators))
+	for _, op := range s.state.Operators {
+		status := OperatorStatus{
+			Operator: op,
+			Finished: make([]bool, len(op.Steps)),
+		}
+		for i, step := range op.Steps {
+			status.Finished[i] = IsStepFinished(step,
+				s.state.DNState, s.state.LogState)
+		}
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
@@ -0,0 +1,268 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"testing"
+
+	sm "github.com/lni/dragonboat/v4/statemachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+func TestOperatorRequest(t *testing.T) {
+	tests := []struct {
+		req   OperatorRequest
+		steps []hapb.ConfigChangeType
+	}{
+		{
+			req:   OperatorRequest{Type: AddReplicaOp, Service: LogServiceName, UUID: "a", ShardID: 1},
+			steps: []hapb.ConfigChangeType{hapb.AddNode, hapb.StartNode},
+		},
+		{
+			req:   OperatorRequest{Type: AddReplicaOp, Service: DNServiceName, UUID: "a", ShardID: 1},
+			steps: []hapb.ConfigChangeType{hapb.StartNode},
+		},
+		{
+			req:   OperatorRequest{Type: RemoveReplicaOp, Service: LogServiceName, UUID: "a", ShardID: 1, ReplicaID: 2},
+			steps: []hapb.ConfigChangeType{hapb.RemoveNode, hapb.StopNode},
+		},
+		{
+			req:   OperatorRequest{Type: RemoveReplicaOp, Service: DNServiceName, UUID: "a", ShardID: 1, ReplicaID: 2},
+			steps: []hapb.ConfigChangeType{hapb.StopNode},
+		},
+		{
+			req:   OperatorRequest{Type: TransferLeaderOp, Service: LogServiceName, ShardID: 1, ReplicaID: 2},
+			steps: []hapb.ConfigChangeType{hapb.TransferLeader},
+		},
+	}
+
+	for _, tt := range tests {
+		op, err := tt.req.Operator()
+		require.NoError(t, err)
+		require.Equal(t, len(tt.steps), len(op.Steps))
+		for i, step := range op.Steps {
+			assert.Equal(t, tt.steps[i], step.ConfigChange.ChangeType)
+			assert.Equal(t, tt.req.UUID, step.UUID)
+			assert.Equal(t, tt.req.ShardID, step.ConfigChange.Replica.ShardID)
+			assert.Equal(t, tt.req.ReplicaID, step.ConfigChange.Replica.ReplicaID)
+		}
+	}
+}
+
+func TestInvalidOperatorRequest(t *testing.T) {
+	tests := []OperatorRequest{
+		{Type: AddReplicaOp, Service: "cn", UUID: "a", ShardID: 1},
+		{Type: AddReplicaOp, Service: LogServiceName, UUID: "a"},
+		{Type: AddReplicaOp, Service: LogServiceName, ShardID: 1},
+		{Type: RemoveReplicaOp, Service: LogServiceName, UUID: "a", ShardID: 1},
+		{Type: TransferLeaderOp, Service: DNServiceName, ShardID: 1, ReplicaID: 2},
+		{Type: TransferLeaderOp, Service: LogServiceName, ShardID: 1},
+		{Type: "split", Service: LogServiceName, UUID: "a", ShardID: 1},
+	}
+
+	for _, req := range tests {
+		_, err := req.Operator()
+		assert.Error(t, err)
+	}
+}
+
+func TestIsStepFinished(t *testing.T) {
+	log := hapb.LogState{
+		Shards: map[uint64]pb.LogShardInfo{
+			1: {ShardID: 1, Replicas: map[uint64]string{1: "a"}, LeaderID: 1},
+		},
+		Stores: map[string]hapb.LogStoreInfo{
+			"a": {Replicas: []pb.LogReplicaInfo{{LogShardInfo: pb.LogShardInfo{ShardID: 1}}}},
+		},
+	}
+	dn := hapb.DNState{
+		Stores: map[string]hapb.DNStoreInfo{
+			"dn": {Shards: []pb.DNShardInfo{{ShardID: 2, ReplicaID: 3}}},
+		},
+	}
+
+	tests := []struct {
+		step     hapb.ScheduleCommand
+		finished bool
+	}{
+		{newStep(hapb.LogService, "a", 1, 1, hapb.AddNode), true},
+		{newStep(hapb.LogService, "b", 1, 2, hapb.AddNode), false},
+		{newStep(hapb.LogService, "a", 1, 1, hapb.RemoveNode), false},
+		{newStep(hapb.LogService, "b", 1, 2, hapb.RemoveNode), true},
+		{newStep(hapb.LogService, "a", 1, 1, hapb.StartNode), true},
+		{newStep(hapb.LogService, "b", 1, 2, hapb.StartNode), false},
+		{newStep(hapb.LogService, "a", 1, 1, hapb.StopNode), false},
+		{newStep(hapb.LogService, "b", 1, 2, hapb.StopNode), true},
+		{newStep(hapb.LogService, "", 1, 1, hapb.TransferLeader), true},
+		{newStep(hapb.LogService, "", 1, 2, hapb.TransferLeader), false},
+		{newStep(hapb.DnService, "dn", 2, 3, hapb.StartNode), true},
+		{newStep(hapb.DnService, "dn", 2, 4, hapb.StartNode), false},
+		{newStep(hapb.DnService, "dn", 2, 3, hapb.StopNode), false},
+		{newStep(hapb.DnService, "dn", 2, 4, hapb.StopNode), true},
+	}
+
+	for i, tt := range tests {
+		assert.Equal(t, tt.finished, IsStepFinished(tt.step, dn, log), "case %d", i)
+	}
+}
+
+func TestAddAndCancelOperator(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	op, err := OperatorRequest{
+		Type: AddReplicaOp, Service: LogServiceName, UUID: "a", ShardID: 1,
+	}.Operator()
+	require.NoError(t, err)
+
+	result, err := tsm1.Update(sm.Entry{Cmd: GetAddOperatorCmd(op)})
+	require.NoError(t, err)
+	id := result.Value
+	assert.Equal(t, uint64(1), id)
+
+	v, err := tsm1.Lookup(&OperatorQuery{})
+	require.NoError(t, err)
+	ops := v.([]OperatorStatus)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, id, ops[0].ID)
+	assert.Equal(t, []bool{false, false}, ops[0].Finished)
+	// replica ID is assigned to both steps
+	for _, step := range ops[0].Steps {
+		assert.Equal(t, uint64(2), step.ConfigChange.Replica.ReplicaID)
+	}
+
+	state := tsm1.handleStateQuery().(*hapb.HAKeeperState)
+	assert.Equal(t, 1, len(state.Operators))
+
+	result, err = tsm1.Update(sm.Entry{Cmd: GetCancelOperatorCmd(id)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.Value)
+	result, err = tsm1.Update(sm.Entry{Cmd: GetCancelOperatorCmd(id)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), result.Value)
+
+	v, err = tsm1.Lookup(&OperatorQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, v.([]OperatorStatus))
+}
+
+func TestFinishedOperatorRemovedOnTick(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	op, err := OperatorRequest{
+		Type: TransferLeaderOp, Service: LogServiceName, ShardID: 1, ReplicaID: 2,
+	}.Operator()
+	require.NoError(t, err)
+	_, err = tsm1.Update(sm.Entry{Cmd: GetAddOperatorCmd(op)})
+	require.NoError(t, err)
+
+	_, err = tsm1.Update(sm.Entry{Cmd: GetTickCmd()})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(tsm1.state.Operators))
+
+	tsm1.state.LogState.Shards[1] = pb.LogShardInfo{
+		ShardID:  1,
+		Replicas: map[uint64]string{1: "a", 2: "b"},
+		LeaderID: 2,
+	}
+	_, err = tsm1.Update(sm.Entry{Cmd: GetTickCmd()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(tsm1.state.Operators))
+}
+
+func TestGetOperatorCommands(t *testing.T) {
+	addLog, err := OperatorRequest{
+		Type: AddReplicaOp, Service: LogServiceName, UUID: "c", ShardID: 1,
+	}.Operator()
+	require.NoError(t, err)
+	addLog.Steps[0].ConfigChange.Replica.ReplicaID = 3
+	addLog.Steps[1].ConfigChange.Replica.ReplicaID = 3
+	transfer, err := OperatorRequest{
+		Type: TransferLeaderOp, Service: LogServiceName, ShardID: 1, ReplicaID: 2,
+	}.Operator()
+	require.NoError(t, err)
+	removeDN, err := OperatorRequest{
+		Type: RemoveReplicaOp, Service: DNServiceName, UUID: "dn1", ShardID: 1, ReplicaID: 5,
+	}.Operator()
+	require.NoError(t, err)
+	ops := map[uint64]hapb.Operator{1: addLog, 2: transfer, 3: removeDN}
+
+	dn := hapb.DNState{Stores: map[string]hapb.DNStoreInfo{
+		"dn1": {Shards: []pb.DNShardInfo{{ShardID: 1, ReplicaID: 5}}},
+	}}
+	log := hapb.LogState{
+		Shards: map[uint64]pb.LogShardInfo{1: {
+			ShardID:  1,
+			Replicas: map[uint64]string{1: "a", 2: "b"},
+			LeaderID: 1,
+		}},
+		Stores: map[string]hapb.LogStoreInfo{},
+	}
+	// operators of the same log shard are run one at a time
+	assert.Equal(t, []hapb.ScheduleCommand{addLog.Steps[0], removeDN.Steps[0]},
+		GetOperatorCommands(ops, dn, log))
+
+	// replica 3 is added and started
+	shardInfo := log.Shards[1]
+	shardInfo.Replicas[3] = "c"
+	log.Stores["c"] = hapb.LogStoreInfo{
+		Replicas: []pb.LogReplicaInfo{{LogShardInfo: shardInfo, ReplicaID: 3}},
+	}
+	// the leadership is transferred on the store of the target replica
+	expected := transfer.Steps[0]
+	expected.UUID = "b"
+	assert.Equal(t, []hapb.ScheduleCommand{expected, removeDN.Steps[0]},
+		GetOperatorCommands(ops, dn, log))
+}
+
+func TestMergeScheduleCommands(t *testing.T) {
+	operatorCmds := []hapb.ScheduleCommand{
+		newStep(hapb.LogService, "a", 1, 1, hapb.RemoveNode),
+	}
+	checkerCmds := []hapb.ScheduleCommand{
+		newStep(hapb.LogService, "b", 1, 2, hapb.AddNode),
+		newStep(hapb.LogService, "b", 2, 3, hapb.AddNode),
+		newStep(hapb.DnService, "dn1", 1, 4, hapb.StartNode),
+	}
+	assert.Equal(t, []hapb.ScheduleCommand{
+		operatorCmds[0], checkerCmds[1], checkerCmds[2],
+	}, MergeScheduleCommands(operatorCmds, checkerCmds))
+}
+
+func TestOperatorCommandsReachStores(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	op, err := OperatorRequest{
+		Type: AddReplicaOp, Service: LogServiceName, UUID: "a", ShardID: 1,
+	}.Operator()
+	require.NoError(t, err)
+	_, err = tsm1.Update(sm.Entry{Cmd: GetAddOperatorCmd(op)})
+	require.NoError(t, err)
+
+	// what the HAKeeper leader does on each health check
+	state := tsm1.handleStateQuery().(*hapb.HAKeeperState)
+	cmds := MergeScheduleCommands(
+		GetOperatorCommands(state.Operators, state.DNState, state.LogState), nil)
+	_, err = tsm1.Update(sm.Entry{Cmd: GetUpdateCommandsCmd(1, cmds)})
+	require.NoError(t, err)
+
+	state = tsm1.handleStateQuery().(*hapb.HAKeeperState)
+	batch, ok := state.ScheduleCommands["a"]
+	require.True(t, ok)
+	require.Equal(t, 1, len(batch.Commands))
+	cmd := batch.Commands[0]
+	assert.Equal(t, hapb.AddNode, cmd.ConfigChange.ChangeType)
+	assert.Equal(t, uint64(1), cmd.ConfigChange.Replica.ShardID)
+	assert.Equal(t, uint64(2), cmd.ConfigChange.Replica.ReplicaID)
+}
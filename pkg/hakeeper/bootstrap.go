@@ -0,0 +1,100 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	sm "github.com/lni/dragonboat/v4/statemachine"
+
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+// GetInitialClusterInfoCmd returns the command for applying the initial
+// cluster info. Only LogShards and DNShards of the specified ClusterInfo are
+// used.
+func GetInitialClusterInfoCmd(info hapb.ClusterInfo) []byte {
+	cmd := make([]byte, headerSize+info.Size())
+	binaryEnc.PutUint16(cmd, initialClusterInfoTag)
+	if _, err := info.MarshalTo(cmd[headerSize:]); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func isInitialClusterInfoCmd(cmd []byte) bool {
+	return len(cmd) >= headerSize && parseCmdTag(cmd) == initialClusterInfoTag
+}
+
+func (s *stateMachine) handleInitialClusterInfoCmd(cmd []byte) sm.Result {
+	if s.state.Initialized {
+		plog.Infof("cluster already initialized, initial cluster info ignored")
+		return sm.Result{}
+	}
+	var info hapb.ClusterInfo
+	if err := info.Unmarshal(cmd[headerSize:]); err != nil {
+		panic(err)
+	}
+	s.state.ClusterInfo.LogShards = info.LogShards
+	s.state.ClusterInfo.DNShards = info.DNShards
+	s.state.Initialized = true
+	plog.Infof("cluster initialized with %d log shards and %d dn shards",
+		len(info.LogShards), len(info.DNShards))
+	return sm.Result{Value: 1}
+}
+
+// IsReadyToBootstrap returns a boolean value indicating whether enough stores
+// have registered to the HAKeeper for placing replicas of the initial shards.
+func IsReadyToBootstrap(state hapb.HAKeeperState,
+	numOfDNShards uint64, numOfLogShardReplicas uint64) bool {
+	if uint64(len(state.LogState.Stores)) < numOfLogShardReplicas {
+		return false
+	}
+	if numOfDNShards > 0 && len(state.DNState.Stores) == 0 {
+		return false
+	}
+	return true
+}
+
+// GetBootstrapClusterInfo returns the initial cluster info with shard IDs
+// allocated from the IDAllocator. Each DN shard is assigned a unique log shard.
+// The returned boolean value is false when there is no enough IDs in the
+// IDAllocator.
+func GetBootstrapClusterInfo(alloc IDAllocator, numOfLogShards uint64,
+	numOfDNShards uint64, numOfLogShardReplicas uint64) (hapb.ClusterInfo, bool) {
+	if numOfDNShards > numOfLogShards {
+		panic("not enough log shards for dn shards")
+	}
+	if alloc.Capacity() < numOfLogShards+numOfDNShards {
+		return hapb.ClusterInfo{}, false
+	}
+	info := hapb.ClusterInfo{
+		LogShards: make([]metadata.LogShardRecord, 0, numOfLogShards),
+		DNShards:  make([]metadata.DNShardRecord, 0, numOfDNShards),
+	}
+	for i := uint64(0); i < numOfLogShards; i++ {
+		id, _ := alloc.Next()
+		info.LogShards = append(info.LogShards, metadata.LogShardRecord{
+			ShardID:          id,
+			NumberOfReplicas: numOfLogShardReplicas,
+		})
+	}
+	for i := uint64(0); i < numOfDNShards; i++ {
+		id, _ := alloc.Next()
+		info.DNShards = append(info.DNShards, metadata.DNShardRecord{
+			ShardID:    id,
+			LogShardID: info.LogShards[i].ShardID,
+		})
+	}
+	return info, true
+}
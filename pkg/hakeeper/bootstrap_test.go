@@ -0,0 +1,105 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"testing"
+
+	sm "github.com/lni/dragonboat/v4/statemachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+type testIDAllocator struct {
+	next uint64
+	last uint64
+}
+
+func (a *testIDAllocator) Next() (uint64, bool) {
+	if a.next <= a.last {
+		v := a.next
+		a.next++
+		return v, true
+	}
+	return 0, false
+}
+
+func (a *testIDAllocator) Set(next uint64, last uint64) {
+	a.next, a.last = next, last
+}
+
+func (a *testIDAllocator) Capacity() uint64 {
+	if a.next <= a.last {
+		return a.last - a.next + 1
+	}
+	return 0
+}
+
+func TestIsReadyToBootstrap(t *testing.T) {
+	state := hapb.HAKeeperState{
+		DNState:  hapb.NewDNState(),
+		LogState: hapb.NewLogState(),
+	}
+	assert.False(t, IsReadyToBootstrap(state, 1, 1))
+	state.LogState.Stores["log1"] = hapb.LogStoreInfo{}
+	assert.False(t, IsReadyToBootstrap(state, 1, 1))
+	assert.True(t, IsReadyToBootstrap(state, 0, 1))
+	state.DNState.Stores["dn1"] = hapb.DNStoreInfo{}
+	assert.True(t, IsReadyToBootstrap(state, 1, 1))
+	assert.False(t, IsReadyToBootstrap(state, 1, 3))
+}
+
+func TestGetBootstrapClusterInfo(t *testing.T) {
+	alloc := &testIDAllocator{next: 1, last: 2}
+	_, ok := GetBootstrapClusterInfo(alloc, 2, 1, 3)
+	assert.False(t, ok)
+
+	alloc.Set(10, 100)
+	info, ok := GetBootstrapClusterInfo(alloc, 2, 1, 3)
+	require.True(t, ok)
+	require.Equal(t, 2, len(info.LogShards))
+	assert.Equal(t, uint64(10), info.LogShards[0].ShardID)
+	assert.Equal(t, uint64(3), info.LogShards[0].NumberOfReplicas)
+	assert.Equal(t, uint64(11), info.LogShards[1].ShardID)
+	require.Equal(t, 1, len(info.DNShards))
+	assert.Equal(t, uint64(12), info.DNShards[0].ShardID)
+	assert.Equal(t, uint64(10), info.DNShards[0].LogShardID)
+}
+
+func TestInitialClusterInfoCmd(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	tsm1.state.ClusterInfo.SetStoreState("log1", hapb.StoreDraining)
+	alloc := &testIDAllocator{next: 1, last: 100}
+	info, ok := GetBootstrapClusterInfo(alloc, 1, 1, 3)
+	require.True(t, ok)
+
+	result, err := tsm1.Update(sm.Entry{Cmd: GetInitialClusterInfoCmd(info)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.Value)
+	state := tsm1.handleStateQuery().(*hapb.HAKeeperState)
+	assert.True(t, state.Initialized)
+	assert.Equal(t, info.LogShards, state.ClusterInfo.LogShards)
+	assert.Equal(t, info.DNShards, state.ClusterInfo.DNShards)
+	assert.True(t, state.ClusterInfo.IsDraining("log1"))
+
+	// initial cluster info is only applied once
+	info2, ok := GetBootstrapClusterInfo(alloc, 2, 2, 3)
+	require.True(t, ok)
+	result, err = tsm1.Update(sm.Entry{Cmd: GetInitialClusterInfoCmd(info2)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), result.Value)
+	assert.Equal(t, info.LogShards, tsm1.state.ClusterInfo.LogShards)
+}
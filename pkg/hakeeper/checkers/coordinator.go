@@ -0,0 +1,207 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"sort"
+
+	"github.com/lni/dragonboat/v4/logger"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/dnservice"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/logservice"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/utils"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+var (
+	plog = logger.GetLogger("checkers")
+)
+
+// Coordinator is the hakeeper.Checker checking both the Log service and the
+// DN service. Operators generated for Log shards are kept by the Coordinator
+// until they are finished, the current step of each operator is returned as
+// a ScheduleCommand on every check.
+type Coordinator struct {
+	OperatorController *operator.Controller
+	// dnLaunches contains DN replicas being launched keyed by shard ID. No
+	// other replica is launched for the shard until the pending one is
+	// reported by its store or the launch expires.
+	dnLaunches map[uint64]dnLaunch
+}
+
+type dnLaunch struct {
+	step dnservice.Step
+	tick uint64
+}
+
+var _ hakeeper.Checker = (*Coordinator)(nil)
+
+func NewCoordinator() *Coordinator {
+	return &Coordinator{
+		OperatorController: operator.NewController(),
+		dnLaunches:         make(map[uint64]dnLaunch),
+	}
+}
+
+// Check implements the hakeeper.Checker interface.
+// NB: the returned order should be deterministic.
+func (c *Coordinator) Check(alloc hakeeper.IDAllocator, cluster hapb.ClusterInfo,
+	dn hapb.DNState, log hapb.LogState, currentTick uint64) []hapb.ScheduleCommand {
+	c.OperatorController.RemoveFinishedOperator(dn, log)
+
+	ops, err := logservice.Check(cluster, log,
+		c.OperatorController.GetRemovingReplicas(),
+		c.OperatorController.GetAddingReplicas(), currentTick, alloc.Next)
+	if err != nil {
+		plog.Errorf("failed to check log service, %v", err)
+	}
+	c.addOperators(ops)
+
+	var cmds []hapb.ScheduleCommand
+	for _, op := range c.OperatorController.GetAllOperators() {
+		if step := op.Check(log, dn); step != nil {
+			if cmd, ok := logStepCommand(step, log); ok {
+				cmds = append(cmds, cmd)
+			}
+		}
+	}
+	return append(cmds, c.checkDN(alloc, cluster, dn, currentTick)...)
+}
+
+func (c *Coordinator) checkDN(alloc hakeeper.IDAllocator, cluster hapb.ClusterInfo,
+	dn hapb.DNState, currentTick uint64) []hapb.ScheduleCommand {
+	for shardID, launch := range c.dnLaunches {
+		cmd := dnStepCommand(launch.step)
+		if hakeeper.IsStepFinished(cmd, dn, hapb.LogState{}) ||
+			utils.ExpiredTick(launch.tick, operator.ExpireTime) < currentTick {
+			delete(c.dnLaunches, shardID)
+		}
+	}
+
+	var cmds []hapb.ScheduleCommand
+	for _, op := range dnservice.Check(cluster, c.withDNLaunches(dn),
+		currentTick, alloc.Next) {
+		if op.Step.Command() == dnservice.AddReplica {
+			c.dnLaunches[op.Step.ShardID()] = dnLaunch{step: op.Step, tick: currentTick}
+			continue
+		}
+		cmds = append(cmds, dnStepCommand(op.Step))
+	}
+
+	shardIDs := make([]uint64, 0, len(c.dnLaunches))
+	for shardID := range c.dnLaunches {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	for _, shardID := range shardIDs {
+		cmds = append(cmds, dnStepCommand(c.dnLaunches[shardID].step))
+	}
+	return cmds
+}
+
+// addOperators adds operators of shards without any unfinished operator, so
+// the same repair is never scheduled twice.
+func (c *Coordinator) addOperators(ops []*operator.Operator) {
+	busy := make(map[uint64]struct{})
+	for _, op := range c.OperatorController.GetAllOperators() {
+		busy[op.ShardID()] = struct{}{}
+	}
+	for _, op := range ops {
+		if _, ok := busy[op.ShardID()]; ok {
+			continue
+		}
+		c.OperatorController.AddOperator(op)
+	}
+}
+
+func logStepCommand(step operator.OpStep, log hapb.LogState) (hapb.ScheduleCommand, bool) {
+	var uuid string
+	var changeType hapb.ConfigChangeType
+	var replica hapb.Replica
+	switch s := step.(type) {
+	case operator.AddLogService:
+		uuid, changeType = s.UUID, hapb.AddNode
+		replica = hapb.Replica{ShardID: s.ShardID, ReplicaID: s.ReplicaID, Epoch: s.Epoch}
+	case operator.RemoveLogService:
+		// previous steps of the operator might have changed the epoch
+		uuid, changeType = s.UUID, hapb.RemoveNode
+		replica = hapb.Replica{ShardID: s.ShardID, ReplicaID: s.ReplicaID,
+			Epoch: log.Shards[s.ShardID].Epoch}
+	case operator.StartLogService:
+		uuid, changeType = s.UUID, hapb.StartNode
+		replica = hapb.Replica{ShardID: s.ShardID, ReplicaID: s.ReplicaID}
+	case operator.StopLogService:
+		uuid, changeType = s.UUID, hapb.StopNode
+		replica = hapb.Replica{ShardID: s.ShardID}
+	default:
+		plog.Errorf("unknown operator step %s", step)
+		return hapb.ScheduleCommand{}, false
+	}
+	return hapb.ScheduleCommand{
+		UUID: uuid,
+		ConfigChange: hapb.ConfigChange{
+			Replica:    replica,
+			ChangeType: changeType,
+		},
+		ServiceType: hapb.LogService,
+	}, true
+}
+
+func dnStepCommand(step dnservice.Step) hapb.ScheduleCommand {
+	changeType := hapb.StartNode
+	if step.Command() == dnservice.RemoveReplica {
+		changeType = hapb.StopNode
+	}
+	return hapb.ScheduleCommand{
+		UUID: string(step.Target()),
+		ConfigChange: hapb.ConfigChange{
+			Replica: hapb.Replica{
+				ShardID:   step.ShardID(),
+				ReplicaID: step.ReplicaID(),
+			},
+			ChangeType: changeType,
+		},
+		ServiceType: hapb.DnService,
+	}
+}
+
+// withDNLaunches returns the DNState with replicas being launched treated as
+// already reported by their stores, so they are not launched again.
+func (c *Coordinator) withDNLaunches(dn hapb.DNState) hapb.DNState {
+	if len(c.dnLaunches) == 0 {
+		return dn
+	}
+	stores := make(map[string]hapb.DNStoreInfo, len(dn.Stores))
+	for uuid, storeInfo := range dn.Stores {
+		stores[uuid] = storeInfo
+	}
+	for _, launch := range c.dnLaunches {
+		uuid := string(launch.step.Target())
+		storeInfo, ok := stores[uuid]
+		if !ok {
+			continue
+		}
+		shards := make([]pb.DNShardInfo, 0, len(storeInfo.Shards)+1)
+		shards = append(shards, storeInfo.Shards...)
+		storeInfo.Shards = append(shards, pb.DNShardInfo{
+			ShardID:   launch.step.ShardID(),
+			ReplicaID: launch.step.ReplicaID(),
+		})
+		stores[uuid] = storeInfo
+	}
+	return hapb.DNState{Stores: stores}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+type testIDAllocator struct {
+	next uint64
+}
+
+func (a *testIDAllocator) Next() (uint64, bool) {
+	a.next++
+	return a.next, true
+}
+
+func (a *testIDAllocator) Set(first uint64, last uint64) {
+	a.next = first - 1
+}
+
+func (a *testIDAllocator) Capacity() uint64 {
+	return 1024
+}
+
+func newCommand(serviceType hakeeper.ServiceType, uuid string,
+	changeType hakeeper.ConfigChangeType, replica hakeeper.Replica) hakeeper.ScheduleCommand {
+	return hakeeper.ScheduleCommand{
+		UUID: uuid,
+		ConfigChange: hakeeper.ConfigChange{
+			Replica:    replica,
+			ChangeType: changeType,
+		},
+		ServiceType: serviceType,
+	}
+}
+
+func TestCoordinatorPlacesBootstrapShards(t *testing.T) {
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{ShardID: 1, NumberOfReplicas: 1}},
+		DNShards:  []metadata.DNShardRecord{{ShardID: 2, LogShardID: 1}},
+	}
+	log := hakeeper.LogState{
+		Shards: map[uint64]logservice.LogShardInfo{},
+		Stores: map[string]hakeeper.LogStoreInfo{"a": {Tick: 10}},
+	}
+	dn := hakeeper.DNState{
+		Stores: map[string]hakeeper.DNStoreInfo{"dn1": {Tick: 10}},
+	}
+	alloc := &testIDAllocator{next: 100}
+	c := NewCoordinator()
+
+	expected := []hakeeper.ScheduleCommand{
+		newCommand(hakeeper.LogService, "a", hakeeper.StartNode,
+			hakeeper.Replica{ShardID: 1, ReplicaID: 101}),
+		newCommand(hakeeper.DnService, "dn1", hakeeper.StartNode,
+			hakeeper.Replica{ShardID: 2, ReplicaID: 102}),
+	}
+	assert.Equal(t, expected, c.Check(alloc, cluster, dn, log, 10))
+	// pending replicas are not scheduled again
+	assert.Equal(t, expected, c.Check(alloc, cluster, dn, log, 11))
+	assert.Equal(t, uint64(102), alloc.next)
+
+	// replicas are started
+	shardInfo := logservice.LogShardInfo{ShardID: 1,
+		Replicas: map[uint64]string{101: "a"}, Epoch: 1}
+	log.Shards[1] = shardInfo
+	log.Stores["a"] = hakeeper.LogStoreInfo{Tick: 12,
+		Replicas: []logservice.LogReplicaInfo{{LogShardInfo: shardInfo, ReplicaID: 101}}}
+	dn.Stores["dn1"] = hakeeper.DNStoreInfo{Tick: 12,
+		Shards: []logservice.DNShardInfo{{ShardID: 2, ReplicaID: 102}}}
+	assert.Empty(t, c.Check(alloc, cluster, dn, log, 12))
+	assert.Empty(t, c.OperatorController.GetAllOperators())
+}
+
+func TestCoordinatorMovesReplica(t *testing.T) {
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{ShardID: 1, NumberOfReplicas: 1}},
+	}
+	shardInfo := logservice.LogShardInfo{ShardID: 1,
+		Replicas: map[uint64]string{11: "a"}, Epoch: 1}
+	log := hakeeper.LogState{
+		Shards: map[uint64]logservice.LogShardInfo{1: shardInfo},
+		Stores: map[string]hakeeper.LogStoreInfo{
+			"a": {Tick: 10, Replicas: []logservice.LogReplicaInfo{
+				{LogShardInfo: shardInfo, ReplicaID: 11}}},
+			"b": {Tick: 10},
+		},
+	}
+	cluster.SetStoreState("a", hakeeper.StoreDraining)
+	alloc := &testIDAllocator{next: 100}
+	c := NewCoordinator()
+
+	// the new replica is added first
+	require.Equal(t, []hakeeper.ScheduleCommand{
+		newCommand(hakeeper.LogService, "b", hakeeper.AddNode,
+			hakeeper.Replica{ShardID: 1, ReplicaID: 101, Epoch: 1}),
+	}, c.Check(alloc, cluster, hakeeper.DNState{}, log, 10))
+
+	// the old replica is removed at the epoch after the add
+	shardInfo = logservice.LogShardInfo{ShardID: 1,
+		Replicas: map[uint64]string{11: "a", 101: "b"}, Epoch: 2}
+	log.Shards[1] = shardInfo
+	require.Equal(t, []hakeeper.ScheduleCommand{
+		newCommand(hakeeper.LogService, "a", hakeeper.RemoveNode,
+			hakeeper.Replica{ShardID: 1, ReplicaID: 11, Epoch: 2}),
+	}, c.Check(alloc, cluster, hakeeper.DNState{}, log, 10)[:1])
+}
@@ -31,7 +31,7 @@ func Check(
 	cluster hakeeper.ClusterInfo,
 	dnState hakeeper.DNState, currTick uint64, idGen idGenerator,
 ) []Operator {
-	stores, shards := parseDnState(dnState, currTick)
+	stores, shards := parseDnState(cluster, dnState, currTick)
 	if len(stores.workingStores()) < 1 {
 		// warning with no working store
 		return nil
@@ -65,7 +65,7 @@ func checkShard(
 	shard *dnShard, workingStores []*dnStore, idGen idGenerator,
 ) []Step {
 	switch len(shard.workingReplicas()) {
-	case 0: // need add replica
+	case 0: // need add replica, replicas on draining stores keep serving until then
 		newReplicaID, ok := idGen()
 		if !ok {
 			// temproray failure when allocate replica ID
@@ -81,11 +81,11 @@ func checkShard(
 		return []Step{
 			newLaunchStep(shard.shardID, newReplicaID, target),
 		}
-	case 1: // ignore expired replicas
-		return nil
+	case 1: // ignore expired replicas, remove replicas on draining stores
+		return stopDrainingReplicas(shard, nil)
 	default: // remove extra working replicas
 		replicas := extraWorkingReplicas(shard)
-		steps := make([]Step, 0, len(replicas))
+		steps := make([]Step, 0, len(replicas)+len(shard.drainingReplicas()))
 		for _, replica := range replicas {
 			steps = append(steps, newStopStep(
 				replica.shardID,
@@ -93,8 +93,26 @@ func checkShard(
 				replica.storeID,
 			))
 		}
-		return steps
+		return stopDrainingReplicas(shard, steps)
+	}
+}
+
+// stopDrainingReplicas appends steps removing replicas on draining stores.
+// NB: the returned order should be deterministic.
+func stopDrainingReplicas(shard *dnShard, steps []Step) []Step {
+	draining := shard.drainingReplicas()
+	// less replica first
+	sort.Slice(draining, func(i, j int) bool {
+		return draining[i].replicaID < draining[j].replicaID
+	})
+	for _, replica := range draining {
+		steps = append(steps, newStopStep(
+			replica.shardID,
+			replica.replicaID,
+			replica.storeID,
+		))
 	}
+	return steps
 }
 
 // expiredReplicas return all expired replicas.
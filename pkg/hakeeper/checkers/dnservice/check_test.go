@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 
 	"github.com/stretchr/testify/require"
 )
@@ -267,6 +268,120 @@ func TestCheck(t *testing.T) {
 	}
 }
 
+func TestCheckDrainingStore(t *testing.T) {
+	currTick := uint64(100)
+	newReplicaID := uint64(100)
+	idGen := func() (uint64, bool) {
+		return newReplicaID, true
+	}
+
+	cluster := mockClusterInfo()
+	cluster.SetStoreState("draining1", hapb.StoreDraining)
+
+	// 1. shard on draining store is launched on working store first
+	{
+		dnState := hapb.DNState{
+			Stores: map[string]hapb.DNStoreInfo{
+				"draining1": {
+					Tick: currTick,
+					Shards: []logservice.DNShardInfo{
+						mockDnShardMeta(10, 11),
+					},
+				},
+				"working1": {
+					Tick:   currTick,
+					Shards: []logservice.DNShardInfo{},
+				},
+			},
+		}
+
+		ops := Check(cluster, dnState, currTick, idGen)
+		require.Equal(t, len(ops), 1)
+		require.Equal(t, ops[0].Step.ShardID(), uint64(10))
+		require.Equal(t, ops[0].Step.Command(), AddReplica)
+		require.Equal(t, ops[0].Step.Target(), StoreID("working1"))
+	}
+
+	// 2. replica on draining store is removed once the new one is working
+	{
+		dnState := hapb.DNState{
+			Stores: map[string]hapb.DNStoreInfo{
+				"draining1": {
+					Tick: currTick,
+					Shards: []logservice.DNShardInfo{
+						mockDnShardMeta(10, 11),
+					},
+				},
+				"working1": {
+					Tick: currTick,
+					Shards: []logservice.DNShardInfo{
+						mockDnShardMeta(10, newReplicaID),
+					},
+				},
+			},
+		}
+
+		ops := Check(cluster, dnState, currTick, idGen)
+		require.Equal(t, len(ops), 1)
+		require.Equal(t, ops[0].Step.ShardID(), uint64(10))
+		require.Equal(t, ops[0].Step.Command(), RemoveReplica)
+		require.Equal(t, ops[0].Step.ReplicaID(), uint64(11))
+		require.Equal(t, ops[0].Step.Target(), StoreID("draining1"))
+	}
+
+	// 3. no working store, replica stays on draining store
+	{
+		dnState := hapb.DNState{
+			Stores: map[string]hapb.DNStoreInfo{
+				"draining1": {
+					Tick: currTick,
+					Shards: []logservice.DNShardInfo{
+						mockDnShardMeta(10, 11),
+					},
+				},
+			},
+		}
+
+		ops := Check(cluster, dnState, currTick, idGen)
+		require.Equal(t, len(ops), 0)
+	}
+}
+
+func TestCheckBootstrapShard(t *testing.T) {
+	currTick := uint64(100)
+	newReplicaID := uint64(100)
+	idGen := func() (uint64, bool) {
+		return newReplicaID, true
+	}
+
+	cluster := mockClusterInfo()
+	cluster.DNShards = []metadata.DNShardRecord{{ShardID: 10, LogShardID: 1}}
+	dnState := hapb.DNState{
+		Stores: map[string]hapb.DNStoreInfo{
+			"working1": {
+				Tick:   currTick,
+				Shards: []logservice.DNShardInfo{},
+			},
+		},
+	}
+
+	// shard without any replica is launched
+	ops := Check(cluster, dnState, currTick, idGen)
+	require.Equal(t, len(ops), 1)
+	require.Equal(t, ops[0].Step.ShardID(), uint64(10))
+	require.Equal(t, ops[0].Step.Command(), AddReplica)
+	require.Equal(t, ops[0].Step.ReplicaID(), newReplicaID)
+	require.Equal(t, ops[0].Step.Target(), StoreID("working1"))
+
+	// no more step once the replica is working
+	dnState.Stores["working1"] = hapb.DNStoreInfo{
+		Tick:   currTick,
+		Shards: []logservice.DNShardInfo{mockDnShardMeta(10, newReplicaID)},
+	}
+	ops = Check(cluster, dnState, currTick, idGen)
+	require.Equal(t, len(ops), 0)
+}
+
 func mockClusterInfo() hapb.ClusterInfo {
 	return hapb.ClusterInfo{}
 }
@@ -28,7 +28,7 @@ const (
 
 // parseDnState parses cluster dn state.
 func parseDnState(
-	dnState hapb.DNState, currTick uint64,
+	cluster hapb.ClusterInfo, dnState hapb.DNState, currTick uint64,
 ) (*clusterStores, *clusterShards) {
 	stores := newClusterStores()
 	shards := newClusterShards()
@@ -39,19 +39,33 @@ func parseDnState(
 			expired = true
 		}
 
+		// expired store would be handled as expired even if it's draining
+		draining := !expired && cluster.IsDraining(storeID)
+
 		store := newDnStore(storeID, len(storeInfo.Shards), DnStoreCapacity)
 		if expired {
 			stores.registerExpired(store)
+		} else if draining {
+			stores.registerDraining(store)
 		} else {
 			stores.registerWorking(store)
 		}
 
 		for _, shard := range storeInfo.Shards {
 			replica := newReplica(shard.ReplicaID, shard.ShardID, storeID)
-			shards.registerReplica(replica, expired)
+			if draining {
+				shards.registerDrainingReplica(replica)
+			} else {
+				shards.registerReplica(replica, expired)
+			}
 		}
 	}
 
+	// shards without any replica, e.g. shards created at bootstrap
+	for _, record := range cluster.DNShards {
+		shards.registerShard(record.ShardID)
+	}
+
 	return stores, shards
 }
 
@@ -63,8 +77,9 @@ const (
 
 // clusterStores collects dn stores by their status.
 type clusterStores struct {
-	working []*dnStore
-	expired []*dnStore
+	working  []*dnStore
+	expired  []*dnStore
+	draining []*dnStore
 }
 
 func newClusterStores() *clusterStores {
@@ -81,6 +96,11 @@ func (cs *clusterStores) registerExpired(store *dnStore) {
 	cs.expired = append(cs.expired, store)
 }
 
+// registerDraining collects draining dn store.
+func (cs *clusterStores) registerDraining(store *dnStore) {
+	cs.draining = append(cs.draining, store)
+}
+
 // workingStores returns all recorded working dn stores.
 // NB: the returned order isn't deterministic.
 func (cs *clusterStores) workingStores() []*dnStore {
@@ -93,6 +113,12 @@ func (cs *clusterStores) expiredStores() []*dnStore {
 	return cs.expired
 }
 
+// drainingStores returns all recorded draining dn stores.
+// NB: the returned order isn't deterministic.
+func (cs *clusterStores) drainingStores() []*dnStore {
+	return cs.draining
+}
+
 // clusterShards collects all dn shards.
 type clusterShards struct {
 	shards   map[uint64]*dnShard
@@ -105,14 +131,23 @@ func newClusterShards() *clusterShards {
 	}
 }
 
-// registerReplica collects dn shard replicas by their status.
-func (cs *clusterShards) registerReplica(replica *dnReplica, expired bool) {
-	shardID := replica.shardID
+// registerShard collects dn shard.
+func (cs *clusterShards) registerShard(shardID uint64) *dnShard {
 	if _, ok := cs.shards[shardID]; !ok {
 		cs.shardIDs = append(cs.shardIDs, shardID)
 		cs.shards[shardID] = newDnShard(shardID)
 	}
-	cs.shards[shardID].register(replica, expired)
+	return cs.shards[shardID]
+}
+
+// registerReplica collects dn shard replicas by their status.
+func (cs *clusterShards) registerReplica(replica *dnReplica, expired bool) {
+	cs.registerShard(replica.shardID).register(replica, expired)
+}
+
+// registerDrainingReplica collects dn shard replica on draining dn store.
+func (cs *clusterShards) registerDrainingReplica(replica *dnReplica) {
+	cs.registerShard(replica.shardID).registerDraining(replica)
 }
 
 // listShards lists all the shard IDs.
@@ -146,9 +181,10 @@ func newDnStore(storeID string, length int, capacity int) *dnStore {
 
 // dnShard records metadata for dn shard.
 type dnShard struct {
-	shardID uint64
-	expired []*dnReplica
-	working []*dnReplica
+	shardID  uint64
+	expired  []*dnReplica
+	working  []*dnReplica
+	draining []*dnReplica
 }
 
 func newDnShard(shardID uint64) *dnShard {
@@ -166,6 +202,11 @@ func (s *dnShard) register(replica *dnReplica, expired bool) {
 	}
 }
 
+// registerDraining collects dn shard replica on draining dn store.
+func (s *dnShard) registerDraining(replica *dnReplica) {
+	s.draining = append(s.draining, replica)
+}
+
 // workingReplicas returns all working replicas.
 // NB: the returned order isn't deterministic.
 func (s *dnShard) workingReplicas() []*dnReplica {
@@ -178,6 +219,12 @@ func (s *dnShard) expiredReplicas() []*dnReplica {
 	return s.expired
 }
 
+// drainingReplicas returns all replicas on draining dn stores.
+// NB: the returned order isn't deterministic.
+func (s *dnShard) drainingReplicas() []*dnReplica {
+	return s.draining
+}
+
 // dnReplica records metadata for dn shard replica
 type dnReplica struct {
 	replicaID uint64
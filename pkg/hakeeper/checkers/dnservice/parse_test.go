@@ -48,7 +48,7 @@ func TestParseDNState(t *testing.T) {
 			},
 		}
 
-		stores, shards := parseDnState(dnState, currTick)
+		stores, shards := parseDnState(hapb.ClusterInfo{}, dnState, currTick)
 
 		// check stores
 		require.Equal(t, len(stores.workingStores()), 0)
@@ -95,7 +95,7 @@ func TestParseDNState(t *testing.T) {
 			},
 		}
 
-		stores, shards := parseDnState(dnState, currTick)
+		stores, shards := parseDnState(hapb.ClusterInfo{}, dnState, currTick)
 
 		// check stores
 		require.Equal(t, len(stores.workingStores()), 2)
@@ -131,3 +131,38 @@ func mockDnShardMeta(shardID, replicaID uint64) logservice.DNShardInfo {
 		ReplicaID: replicaID,
 	}
 }
+
+func TestParseDrainingStore(t *testing.T) {
+	currTick := uint64(100)
+
+	cluster := hapb.ClusterInfo{}
+	cluster.SetStoreState("draining1", hapb.StoreDraining)
+
+	dnState := hapb.DNState{
+		Stores: map[string]hapb.DNStoreInfo{
+			"draining1": {
+				Tick: currTick,
+				Shards: []logservice.DNShardInfo{
+					mockDnShardMeta(10, 11),
+				},
+			},
+			"working1": {
+				Tick: currTick,
+				Shards: []logservice.DNShardInfo{
+					mockDnShardMeta(10, 12),
+				},
+			},
+		},
+	}
+
+	stores, shards := parseDnState(cluster, dnState, currTick)
+	require.Equal(t, len(stores.workingStores()), 1)
+	require.Equal(t, len(stores.drainingStores()), 1)
+	require.Equal(t, len(stores.expiredStores()), 0)
+
+	shard10, err := shards.getShard(10)
+	require.NoError(t, err)
+	require.Equal(t, len(shard10.workingReplicas()), 1)
+	require.Equal(t, len(shard10.drainingReplicas()), 1)
+	require.Equal(t, len(shard10.expiredReplicas()), 0)
+}
@@ -0,0 +1,298 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/utils"
+	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+const (
+	// maxMovesPerStore is the max number of concurrent replica moves a log
+	// store can take part in, either as the source or as the target.
+	maxMovesPerStore = 1
+	// balanceDesc is the brief of operators generated by the balance scheduler.
+	balanceDesc = "balance replica"
+	// createDesc is the brief of operators starting new log shards.
+	createDesc = "create log shard"
+)
+
+// idGenerator allocates the replica ID of new log shard replicas, it is the
+// Next method of the hakeeper.IDAllocator. It returns false when no ID can be
+// allocated at this time, the operators needing new replicas are then skipped
+// in the current check and generated again on a later tick.
+type idGenerator func() (uint64, bool)
+
+// storeScore is the replica score of a healthy log store.
+type storeScore struct {
+	uuid string
+	// replicas is the number of log shard replicas placed on the store.
+	replicas int
+	// moves is the number of in-flight replica moves involving the store.
+	moves int
+	// draining is set when the store is being drained.
+	draining bool
+}
+
+type balancer struct {
+	shards map[uint64]logservice.LogShardInfo
+	stores []*storeScore
+	// busy contains shards with pending membership changes, they are not
+	// considered by the balancer.
+	busy map[uint64]struct{}
+	// paused is set when there are replicas being added to unknown stores.
+	paused bool
+	idGen  idGenerator
+}
+
+func newBalancer(cluster hakeeper.ClusterInfo, infos hakeeper.LogState,
+	removing map[uint64][]uint64, adding map[uint64][]uint64, tick uint64,
+	idGen idGenerator) *balancer {
+	b := &balancer{
+		shards: infos.Shards,
+		busy:   make(map[uint64]struct{}),
+		idGen:  idGen,
+	}
+
+	scores := make(map[string]*storeScore)
+	for uuid, storeInfo := range infos.Stores {
+		if utils.ExpiredTick(storeInfo.Tick, utils.StoreTimeout) < tick {
+			continue
+		}
+		score := &storeScore{uuid: uuid, draining: cluster.IsDraining(uuid)}
+		scores[uuid] = score
+		b.stores = append(b.stores, score)
+	}
+
+	for _, shardInfo := range infos.Shards {
+		for _, uuid := range shardInfo.Replicas {
+			if score, ok := scores[uuid]; ok {
+				score.replicas++
+			}
+		}
+	}
+
+	// replicas being added or removed are counted as in-flight moves of the
+	// stores they are placed on. replicas being added but not yet known to the
+	// HAKeeper can't be attributed to any store, balancing is paused until
+	// they show up so that the limit of concurrent moves is never exceeded.
+	for _, pending := range []map[uint64][]uint64{removing, adding} {
+		for shardID, replicaIDs := range pending {
+			if len(replicaIDs) == 0 {
+				continue
+			}
+			b.busy[shardID] = struct{}{}
+			for _, replicaID := range replicaIDs {
+				uuid, ok := infos.Shards[shardID].Replicas[replicaID]
+				if !ok {
+					b.paused = true
+					continue
+				}
+				if score, ok := scores[uuid]; ok {
+					score.moves++
+				}
+			}
+		}
+	}
+	return b
+}
+
+// Balance generates operators moving log shard replicas from stores with most
+// replicas to stores with least replicas, until the difference of replica
+// counts between any two stores is at most one or no more moves are allowed.
+// Replicas on draining stores are always moved off first and no replica is
+// moved to draining stores. Each move is described by an operator which first
+// adds a new replica on the target store and then removes the replica on the
+// source store.
+// NB: the returned order should be deterministic.
+func Balance(cluster hakeeper.ClusterInfo, infos hakeeper.LogState,
+	removing map[uint64][]uint64, adding map[uint64][]uint64, tick uint64,
+	idGen idGenerator) (operators []*operator.Operator, err error) {
+	b := newBalancer(cluster, infos, removing, adding, tick, idGen)
+	for {
+		op, err := b.nextMove()
+		if err != nil {
+			return nil, err
+		}
+		if op == nil {
+			return operators, nil
+		}
+		operators = append(operators, op)
+	}
+}
+
+// nextMove selects a replica to move and returns the operator for it. nil is
+// returned when the stores are balanced or no replica can be moved.
+func (b *balancer) nextMove() (*operator.Operator, error) {
+	if b.paused {
+		return nil, nil
+	}
+	sort.Slice(b.stores, func(i, j int) bool {
+		if b.stores[i].replicas != b.stores[j].replicas {
+			return b.stores[i].replicas > b.stores[j].replicas
+		}
+		return b.stores[i].uuid < b.stores[j].uuid
+	})
+
+	// draining stores first, then the most loaded ones
+	for _, draining := range []bool{true, false} {
+		for _, source := range b.stores {
+			if source.draining != draining || source.replicas == 0 ||
+				source.moves >= maxMovesPerStore {
+				continue
+			}
+			op, err := b.moveFrom(source)
+			if err != nil || op != nil {
+				return op, err
+			}
+		}
+	}
+	return nil, nil
+}
+
+// moveFrom moves a replica from the source store to the least loaded store
+// that can take it.
+func (b *balancer) moveFrom(source *storeScore) (*operator.Operator, error) {
+	for j := len(b.stores) - 1; j >= 0; j-- {
+		target := b.stores[j]
+		if target == source || target.draining {
+			continue
+		}
+		if !source.draining && source.replicas-target.replicas <= 1 {
+			break
+		}
+		if target.moves >= maxMovesPerStore {
+			continue
+		}
+		shardID, ok := b.selectShard(source.uuid, target.uuid)
+		if !ok {
+			continue
+		}
+		replicaID, ok := b.idGen()
+		if !ok {
+			// temporary failure when allocate replica ID
+			return nil, nil
+		}
+		op := b.move(shardID, source.uuid, target.uuid, replicaID)
+		source.replicas--
+		source.moves++
+		target.replicas++
+		target.moves++
+		b.busy[shardID] = struct{}{}
+		return op, nil
+	}
+	return nil, nil
+}
+
+// selectShard selects the shard with the smallest ID which has a replica on
+// the source store but none on the target store.
+func (b *balancer) selectShard(source, target string) (uint64, bool) {
+	var candidates []uint64
+	for shardID, shardInfo := range b.shards {
+		if _, ok := b.busy[shardID]; ok {
+			continue
+		}
+		onSource, onTarget := false, false
+		for _, uuid := range shardInfo.Replicas {
+			onSource = onSource || uuid == source
+			onTarget = onTarget || uuid == target
+		}
+		if onSource && !onTarget {
+			candidates = append(candidates, shardID)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, false
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	return candidates[0], true
+}
+
+// move builds the operator moving the replica of the specified shard from the
+// source store to the target store. The replica on the source store is only
+// removed after the new replica is added, so the shard is never
+// under-replicated during the move.
+func (b *balancer) move(shardID uint64, source, target string,
+	replicaID uint64) *operator.Operator {
+	shardInfo := b.shards[shardID]
+	var sourceReplicaID uint64
+	for id, uuid := range shardInfo.Replicas {
+		if uuid == source {
+			sourceReplicaID = id
+		}
+	}
+	return operator.NewOperator(balanceDesc, shardID, shardInfo.Epoch,
+		operator.AddLogService{UUID: target, ShardID: shardID,
+			ReplicaID: replicaID, Epoch: shardInfo.Epoch},
+		operator.RemoveLogService{UUID: source, ShardID: shardID,
+			ReplicaID: sourceReplicaID})
+}
+
+// selectTargets selects up to n stores with least replicas to place new
+// replicas of the specified shard, draining stores and stores already having a
+// replica of the shard are excluded.
+func (b *balancer) selectTargets(shardID uint64, n int) []*storeScore {
+	placed := make(map[string]struct{})
+	for _, uuid := range b.shards[shardID].Replicas {
+		placed[uuid] = struct{}{}
+	}
+	var candidates []*storeScore
+	for _, score := range b.stores {
+		if _, ok := placed[score.uuid]; ok || score.draining {
+			continue
+		}
+		candidates = append(candidates, score)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].replicas != candidates[j].replicas {
+			return candidates[i].replicas < candidates[j].replicas
+		}
+		return candidates[i].uuid < candidates[j].uuid
+	})
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
+
+// create builds the operator starting all initial replicas of a shard which
+// is not running on any store yet. The StartLogService steps of the returned
+// operator together describe the initial membership of the shard. An error is
+// returned when there are not enough stores or replica IDs.
+func (b *balancer) create(record metadata.LogShardRecord) (*operator.Operator, error) {
+	targets := b.selectTargets(record.ShardID, int(record.NumberOfReplicas))
+	if len(targets) < int(record.NumberOfReplicas) {
+		return nil, fmt.Errorf("%d replicas required but only %d stores available",
+			record.NumberOfReplicas, len(targets))
+	}
+	steps := make([]operator.OpStep, 0, len(targets))
+	for _, target := range targets {
+		replicaID, ok := b.idGen()
+		if !ok {
+			return nil, fmt.Errorf("failed to allocate replica ID")
+		}
+		steps = append(steps, operator.StartLogService{UUID: target.uuid,
+			ShardID: record.ShardID, ReplicaID: replicaID})
+	}
+	for _, target := range targets {
+		target.replicas++
+	}
+	return operator.NewOperator(createDesc, record.ShardID, 0, steps...), nil
+}
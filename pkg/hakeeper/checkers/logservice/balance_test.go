@@ -0,0 +1,413 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+func newTestIDGen(next uint64) idGenerator {
+	return func() (uint64, bool) {
+		next++
+		return next, true
+	}
+}
+
+// newTestLogState returns a LogState with the specified shards, shards are
+// described as a list of store uuids, replica IDs start from shardID*10+1.
+func newTestLogState(tick uint64, stores []string,
+	shards map[uint64][]string) hakeeper.LogState {
+	state := hakeeper.LogState{
+		Shards: make(map[uint64]logservice.LogShardInfo),
+		Stores: make(map[string]hakeeper.LogStoreInfo),
+	}
+	for _, uuid := range stores {
+		state.Stores[uuid] = hakeeper.LogStoreInfo{Tick: tick}
+	}
+	for shardID, uuids := range shards {
+		shardInfo := logservice.LogShardInfo{
+			ShardID:  shardID,
+			Replicas: make(map[uint64]string),
+			Epoch:    1,
+		}
+		for i, uuid := range uuids {
+			shardInfo.Replicas[shardID*10+uint64(i)+1] = uuid
+		}
+		state.Shards[shardID] = shardInfo
+	}
+	return state
+}
+
+// moveSteps walks through the steps of an operator moving a replica, each step
+// is assumed to be applied once it is returned.
+func moveSteps(op *operator.Operator, state hakeeper.LogState) []operator.OpStep {
+	shards := make(map[uint64]logservice.LogShardInfo)
+	for shardID, shardInfo := range state.Shards {
+		replicas := make(map[uint64]string)
+		for replicaID, uuid := range shardInfo.Replicas {
+			replicas[replicaID] = uuid
+		}
+		shardInfo.Replicas = replicas
+		shards[shardID] = shardInfo
+	}
+	state.Shards = shards
+
+	var steps []operator.OpStep
+	for step := op.Check(state, hakeeper.DNState{}); step != nil; step = op.Check(state, hakeeper.DNState{}) {
+		steps = append(steps, step)
+		switch s := step.(type) {
+		case operator.AddLogService:
+			state.Shards[s.ShardID].Replicas[s.ReplicaID] = s.UUID
+		case operator.RemoveLogService:
+			delete(state.Shards[s.ShardID].Replicas, s.ReplicaID)
+		default:
+			return steps
+		}
+	}
+	return steps
+}
+
+func TestBalanceBalancedCluster(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"b", "c", "d"},
+			3: {"c", "d", "a"},
+		})
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestBalanceNewStores(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d", "e"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+			3: {"a", "b", "c"},
+		})
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ops))
+
+	// a -> e
+	assert.Equal(t, []operator.OpStep{
+		operator.AddLogService{
+			UUID: "e", ShardID: 1, ReplicaID: 101, Epoch: 1,
+		},
+		operator.RemoveLogService{
+			UUID: "a", ShardID: 1, ReplicaID: 11,
+		},
+	}, moveSteps(ops[0], state))
+	// b -> d
+	assert.Equal(t, []operator.OpStep{
+		operator.AddLogService{
+			UUID: "d", ShardID: 2, ReplicaID: 102, Epoch: 1,
+		},
+		operator.RemoveLogService{
+			UUID: "b", ShardID: 2, ReplicaID: 22,
+		},
+	}, moveSteps(ops[1], state))
+}
+
+func TestBalanceRespectsPendingChanges(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+
+	// replica 11 on store a is being removed, a can't take part in any other
+	// move and shard 1 is excluded from balancing.
+	removing := map[uint64][]uint64{1: {11}}
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, removing, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, []operator.OpStep{
+		operator.AddLogService{
+			UUID: "d", ShardID: 2, ReplicaID: 101, Epoch: 1,
+		},
+		operator.RemoveLogService{
+			UUID: "b", ShardID: 2, ReplicaID: 22,
+		},
+	}, moveSteps(ops[0], state))
+
+	// replica 200 is being added to an unknown store
+	adding := map[uint64][]uint64{2: {200}}
+	ops, err = Balance(hakeeper.ClusterInfo{}, state, removing, adding, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// replica 101 has been added to store d, both shards have pending
+	// membership changes
+	shardInfo := state.Shards[2]
+	shardInfo.Replicas[101] = "d"
+	adding = map[uint64][]uint64{2: {101}}
+	ops, err = Balance(hakeeper.ClusterInfo{}, state, removing, adding, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestBalanceWithController(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+	c := operator.NewController()
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, c.GetRemovingReplicas(), c.GetAddingReplicas(),
+		10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	c.AddOperator(ops...)
+
+	// the move is still in-flight, no more moves are generated
+	ops, err = Balance(hakeeper.ClusterInfo{}, state, c.GetRemovingReplicas(), c.GetAddingReplicas(),
+		10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestBalanceSkipsExpiredStores(t *testing.T) {
+	state := newTestLogState(0, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+	for _, uuid := range []string{"a", "b", "c"} {
+		state.Stores[uuid] = hakeeper.LogStoreInfo{Tick: 999999999}
+	}
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, nil, nil, 999999999, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestBalanceIDExhausted(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+	idGen := func() (uint64, bool) { return 0, false }
+	ops, err := Balance(hakeeper.ClusterInfo{}, state, nil, nil, 10, idGen)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestBalanceDrainingStore(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d", "e"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"b", "c", "d"},
+			3: {"c", "d", "e"},
+		})
+	cluster := hakeeper.ClusterInfo{}
+	cluster.SetStoreState("c", hakeeper.StoreDraining)
+
+	// the cluster is balanced, replicas on c are still moved off. only one
+	// replica is moved at a time.
+	ops, err := Balance(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, []operator.OpStep{
+		operator.AddLogService{
+			UUID: "e", ShardID: 1, ReplicaID: 101, Epoch: 1,
+		},
+		operator.RemoveLogService{
+			UUID: "c", ShardID: 1, ReplicaID: 13,
+		},
+	}, moveSteps(ops[0], state))
+}
+
+func TestBalanceNeverMovesToDrainingStore(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+	cluster := hakeeper.ClusterInfo{}
+	cluster.SetStoreState("d", hakeeper.StoreDraining)
+	ops, err := Balance(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// no store can take the replicas on c
+	cluster.SetStoreState("c", hakeeper.StoreDraining)
+	ops, err = Balance(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// d is active again
+	cluster.SetStoreState("d", hakeeper.StoreActive)
+	ops, err = Balance(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, []operator.OpStep{
+		operator.AddLogService{
+			UUID: "d", ShardID: 1, ReplicaID: 101, Epoch: 1,
+		},
+		operator.RemoveLogService{
+			UUID: "c", ShardID: 1, ReplicaID: 13,
+		},
+	}, moveSteps(ops[0], state))
+}
+
+func TestCheckBalancesHealthyCluster(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"},
+		map[uint64][]string{
+			1: {"a", "b", "c"},
+			2: {"a", "b", "c"},
+		})
+	for _, uuid := range []string{"a", "b", "c"} {
+		storeInfo := state.Stores[uuid]
+		for _, shardID := range []uint64{1, 2} {
+			storeInfo.Replicas = append(storeInfo.Replicas,
+				logservice.LogReplicaInfo{LogShardInfo: state.Shards[shardID]})
+		}
+		state.Stores[uuid] = storeInfo
+	}
+	cluster := hakeeper.ClusterInfo{}
+	ops, err := Check(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(ops))
+}
+
+// createSteps walks through the steps of an operator creating a log shard by
+// starting its replicas one by one.
+func createSteps(op *operator.Operator, state hakeeper.LogState) []operator.OpStep {
+	stores := make(map[string]hakeeper.LogStoreInfo)
+	for uuid, storeInfo := range state.Stores {
+		stores[uuid] = storeInfo
+	}
+	state.Stores = stores
+
+	var steps []operator.OpStep
+	for step := op.Check(state, hakeeper.DNState{}); step != nil; step = op.Check(state, hakeeper.DNState{}) {
+		start := step.(operator.StartLogService)
+		storeInfo := state.Stores[start.UUID]
+		storeInfo.Replicas = append(storeInfo.Replicas, logservice.LogReplicaInfo{
+			LogShardInfo: logservice.LogShardInfo{ShardID: start.ShardID},
+			ReplicaID:    start.ReplicaID,
+		})
+		state.Stores[start.UUID] = storeInfo
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func TestCheckCreatesBootstrapShards(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d"}, nil)
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{
+			{ShardID: 1, NumberOfReplicas: 3},
+			{ShardID: 2, NumberOfReplicas: 3},
+		},
+	}
+	ops, err := Check(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ops))
+	assert.Equal(t, []operator.OpStep{
+		operator.StartLogService{UUID: "a", ShardID: 1, ReplicaID: 101},
+		operator.StartLogService{UUID: "b", ShardID: 1, ReplicaID: 102},
+		operator.StartLogService{UUID: "c", ShardID: 1, ReplicaID: 103},
+	}, createSteps(ops[0], state))
+	// replicas of shard 2 are placed on stores with least replicas
+	assert.Equal(t, []operator.OpStep{
+		operator.StartLogService{UUID: "d", ShardID: 2, ReplicaID: 104},
+		operator.StartLogService{UUID: "a", ShardID: 2, ReplicaID: 105},
+		operator.StartLogService{UUID: "b", ShardID: 2, ReplicaID: 106},
+	}, createSteps(ops[1], state))
+
+	// shard 1 is being created
+	adding := map[uint64][]uint64{1: {101, 102, 103}}
+	ops, err = Check(cluster, state, nil, adding, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, operator.StartLogService{UUID: "a", ShardID: 2, ReplicaID: 101},
+		ops[0].Check(state, hakeeper.DNState{}))
+}
+
+func TestCheckCreateWithoutEnoughStores(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b"}, nil)
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{ShardID: 1, NumberOfReplicas: 3}},
+	}
+	ops, err := Check(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestCheckIgnoresRunningReplicas(t *testing.T) {
+	state := newTestLogState(10, []string{"a"}, map[uint64][]string{1: {"a"}})
+	// the replica of shard 1 is reported by the store under another key
+	state.Stores[""] = hakeeper.LogStoreInfo{Tick: 10,
+		Replicas: []logservice.LogReplicaInfo{
+			{LogShardInfo: state.Shards[1], ReplicaID: 11},
+		}}
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{
+			{ShardID: 1, NumberOfReplicas: 1},
+			{ShardID: 2, NumberOfReplicas: 1},
+		},
+	}
+	ops, err := Check(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, uint64(2), ops[0].ShardID())
+}
+
+func TestCheckPlacesLackingReplicas(t *testing.T) {
+	state := newTestLogState(10, []string{"a", "b", "c", "d", "e"},
+		map[uint64][]string{
+			1: {"a", "b"},
+			2: {"c"},
+		})
+	for shardID, shardInfo := range state.Shards {
+		for _, uuid := range shardInfo.Replicas {
+			storeInfo := state.Stores[uuid]
+			storeInfo.Replicas = append(storeInfo.Replicas,
+				logservice.LogReplicaInfo{LogShardInfo: state.Shards[shardID]})
+			state.Stores[uuid] = storeInfo
+		}
+	}
+	cluster := hakeeper.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{
+			{ShardID: 1, NumberOfReplicas: 3},
+			{ShardID: 2, NumberOfReplicas: 1},
+		},
+	}
+	state.Stores["e"] = hakeeper.LogStoreInfo{Tick: 10}
+	cluster.SetStoreState("d", hakeeper.StoreDraining)
+
+	ops, err := Check(cluster, state, nil, nil, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ops))
+	// c already has a replica, d is draining
+	assert.Equal(t, operator.AddLogService{
+		UUID: "e", ShardID: 1, ReplicaID: 101, Epoch: 1,
+	}, ops[0].Check(state, hakeeper.DNState{}))
+
+	// the lacking replica is being added
+	ops, err = Check(cluster, state, nil, map[uint64][]uint64{1: {101}}, 10, newTestIDGen(100))
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
@@ -16,12 +16,18 @@ package logservice
 import (
 	"sort"
 
+	"github.com/lni/dragonboat/v4/logger"
+
 	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper/utils"
 	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 )
 
+var (
+	plog = logger.GetLogger("checkers")
+)
+
 type replica struct {
 	uuid    string
 	shardID uint64
@@ -35,6 +41,14 @@ type stats struct {
 	toStart  []replica
 	toRemove map[uint64][]replica
 	toAdd    map[uint64]int
+	// toCreate contains shards known to the cluster but not yet started on
+	// any store, e.g. shards defined by the bootstrap config.
+	toCreate []metadata.LogShardRecord
+}
+
+func (s stats) healthy() bool {
+	return len(s.toStop) == 0 && len(s.toStart) == 0 &&
+		len(s.toRemove) == 0 && len(s.toAdd) == 0 && len(s.toCreate) == 0
 }
 
 func collectStats(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, tick uint64) stats {
@@ -43,6 +57,17 @@ func collectStats(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, tick ui
 		toAdd:    make(map[uint64]int),
 	}
 
+	// replicas reported by the stores, shard ID -> replica ID
+	running := make(map[uint64]map[uint64]struct{})
+	for _, storeInfo := range infos.Stores {
+		for _, replicaInfo := range storeInfo.Replicas {
+			if _, ok := running[replicaInfo.ShardID]; !ok {
+				running[replicaInfo.ShardID] = make(map[uint64]struct{})
+			}
+			running[replicaInfo.ShardID][replicaInfo.ReplicaID] = struct{}{}
+		}
+	}
+
 	for _, shardInfo := range infos.Shards {
 		var record metadata.LogShardRecord
 		for _, logShardRecord := range cluster.LogShards {
@@ -72,8 +97,8 @@ func collectStats(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, tick ui
 		}
 
 		for replicaID, uuid := range shardInfo.Replicas {
-			// Check dangling
-			started := false
+			// Check dangling, replicas already running on any store are ignored
+			_, started := running[shardInfo.ShardID][replicaID]
 			for _, replica := range infos.Stores[uuid].Replicas {
 				if replica.ShardID == shardInfo.ShardID {
 					started = true
@@ -93,6 +118,13 @@ func collectStats(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, tick ui
 		}
 	}
 
+	// Check uncreated
+	for _, record := range cluster.LogShards {
+		if _, ok := infos.Shards[record.ShardID]; !ok {
+			s.toCreate = append(s.toCreate, record)
+		}
+	}
+
 	// Check zombies
 	for uuid, storeInfo := range infos.Stores {
 		for _, replicaInfo := range storeInfo.Replicas {
@@ -105,21 +137,50 @@ func collectStats(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, tick ui
 	return s
 }
 
-func Check(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, removing map[uint64][]uint64, adding map[uint64][]uint64, tick uint64) (operators []*operator.Operator, err error) {
+func Check(cluster hakeeper.ClusterInfo, infos hakeeper.LogState, removing map[uint64][]uint64, adding map[uint64][]uint64, tick uint64, idGen idGenerator) (operators []*operator.Operator, err error) {
 	stats := collectStats(cluster, infos, tick)
 
-	for shardID, toAdd := range stats.toAdd {
-		for toAdd-len(adding[shardID]) > 0 {
-			var bestStore string
-			var newReplicaID uint64
-			//bestStore := selectStore()
-			//newReplicaID, ok := GenerateNewReplicaID()
-			op, err := operator.NewBuilder("", infos.Shards[shardID]).AddPeer(bestStore, newReplicaID).Build()
+	// replicas are only rebalanced when there is nothing to repair
+	if stats.healthy() {
+		return Balance(cluster, infos, removing, adding, tick, idGen)
+	}
+
+	placer := newBalancer(cluster, infos, removing, adding, tick, idGen)
+
+	for _, record := range stats.toCreate {
+		if len(adding[record.ShardID]) > 0 {
+			continue
+		}
+		op, err := placer.create(record)
+		if err != nil {
+			plog.Warningf("failed to create log shard %d, %v", record.ShardID, err)
+			continue
+		}
+		operators = append(operators, op)
+	}
+
+	addShards := make([]uint64, 0, len(stats.toAdd))
+	for shardID := range stats.toAdd {
+		addShards = append(addShards, shardID)
+	}
+	sort.Slice(addShards, func(i, j int) bool { return addShards[i] < addShards[j] })
+	for _, shardID := range addShards {
+		toAdd := stats.toAdd[shardID] - len(adding[shardID])
+		if toAdd <= 0 {
+			continue
+		}
+		for _, target := range placer.selectTargets(shardID, toAdd) {
+			replicaID, ok := idGen()
+			if !ok {
+				break
+			}
+			op, err := operator.NewBuilder("", infos.Shards[shardID]).
+				AddPeer(target.uuid, replicaID).Build()
 			if err != nil {
 				return nil, err
 			}
 			operators = append(operators, op)
-			toAdd--
+			target.replicas++
 		}
 	}
 
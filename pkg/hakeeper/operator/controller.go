@@ -22,6 +22,7 @@ package operator
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"sort"
 	"sync"
 )
 
@@ -37,6 +38,16 @@ func NewController() *Controller {
 	}
 }
 
+// AddOperator adds operators to the controller.
+func (c *Controller) AddOperator(ops ...*Operator) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, op := range ops {
+		c.operators[op.shardID] = append(c.operators[op.shardID], op)
+	}
+}
+
 // RemoveOperator removes an operator from the operators.
 func (c *Controller) RemoveOperator(op *Operator) bool {
 	c.Lock()
@@ -50,9 +61,14 @@ func (c *Controller) RemoveOperator(op *Operator) bool {
 
 func (c *Controller) removeOperatorLocked(op *Operator) bool {
 	curOps := c.operators[op.shardID]
-	for _, curOp := range curOps {
-		if curOp == op {
+	for i, curOp := range curOps {
+		if curOp != op {
+			continue
+		}
+		if len(curOps) == 1 {
 			delete(c.operators, op.shardID)
+		} else {
+			c.operators[op.shardID] = append(curOps[:i:i], curOps[i+1:]...)
 		}
 		return true
 	}
@@ -67,7 +83,28 @@ func (c *Controller) GetOperators(shardID uint64) []*Operator {
 	return c.operators[shardID]
 }
 
+// GetAllOperators gets operators of all shards ordered by shard ID.
+func (c *Controller) GetAllOperators() []*Operator {
+	c.RLock()
+	defer c.RUnlock()
+
+	shardIDs := make([]uint64, 0, len(c.operators))
+	for shardID := range c.operators {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	var ops []*Operator
+	for _, shardID := range shardIDs {
+		ops = append(ops, c.operators[shardID]...)
+	}
+	return ops
+}
+
 func (c *Controller) GetRemovingReplicas() (removing map[uint64][]uint64) {
+	c.RLock()
+	defer c.RUnlock()
+
+	removing = make(map[uint64][]uint64)
 	for shardID, operators := range c.operators {
 		for _, op := range operators {
 			for _, step := range op.steps {
@@ -82,12 +119,19 @@ func (c *Controller) GetRemovingReplicas() (removing map[uint64][]uint64) {
 }
 
 func (c *Controller) GetAddingReplicas() (adding map[uint64][]uint64) {
+	c.RLock()
+	defer c.RUnlock()
+
+	adding = make(map[uint64][]uint64)
 	for shardID, operators := range c.operators {
 		for _, op := range operators {
 			for _, step := range op.steps {
 				switch step.(type) {
 				case AddLogService:
 					adding[shardID] = append(adding[shardID], step.(AddLogService).ReplicaID)
+				case StartLogService:
+					// replicas of new shards are started without being added
+					adding[shardID] = append(adding[shardID], step.(StartLogService).ReplicaID)
 				}
 			}
 		}
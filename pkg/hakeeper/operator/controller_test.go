@@ -0,0 +1,55 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveOperatorKeepsOtherOperators(t *testing.T) {
+	c := NewController()
+	op1 := NewOperator("", 1, 1, AddLogService{UUID: "d", ShardID: 1, ReplicaID: 4})
+	op2 := NewOperator("", 1, 1, RemoveLogService{UUID: "a", ShardID: 1, ReplicaID: 1})
+	c.AddOperator(op1, op2)
+
+	assert.True(t, c.RemoveOperator(op1))
+	assert.Equal(t, []*Operator{op2}, c.GetOperators(1))
+	assert.False(t, c.RemoveOperator(op1))
+
+	assert.True(t, c.RemoveOperator(op2))
+	assert.Empty(t, c.GetOperators(1))
+}
+
+func TestRemoveFinishedOperator(t *testing.T) {
+	logState := hakeeper.LogState{
+		Shards: map[uint64]logservice.LogShardInfo{1: {
+			ShardID:  1,
+			Replicas: map[uint64]string{1: "a", 2: "b", 3: "c", 4: "d"},
+			Epoch:    2,
+		}},
+	}
+	c := NewController()
+	finished := NewOperator("", 1, 1, AddLogService{UUID: "d", ShardID: 1, ReplicaID: 4})
+	pending := NewOperator("", 1, 1, RemoveLogService{UUID: "a", ShardID: 1, ReplicaID: 1})
+	c.AddOperator(finished, pending)
+
+	c.RemoveFinishedOperator(hakeeper.DNState{}, logState)
+	assert.Equal(t, []*Operator{pending}, c.GetOperators(1))
+	assert.Equal(t, map[uint64][]uint64{1: {1}}, c.GetRemovingReplicas())
+	assert.Equal(t, map[uint64][]uint64{}, c.GetAddingReplicas())
+}
@@ -56,6 +56,11 @@ func NewOperator(brief string, shardID uint64, epoch uint64, steps ...OpStep) *O
 	}
 }
 
+// ShardID returns the ID of the shard the operator is for.
+func (o *Operator) ShardID() uint64 {
+	return o.shardID
+}
+
 // Status returns operator status.
 func (o *Operator) Status() OpStatus {
 	return o.status.Status()
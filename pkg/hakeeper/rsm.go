@@ -44,7 +44,10 @@ const (
 	// DefaultHAKeeperShardID is the shard ID assigned to the special HAKeeper
 	// shard.
 	DefaultHAKeeperShardID uint64 = 0
-	headerSize                    = 2
+	// CNStoreTimeout defines how long a CN store is considered as healthy
+	// after its last heartbeat.
+	CNStoreTimeout = 5 * time.Second
+	headerSize     = 2
 )
 
 const (
@@ -54,9 +57,39 @@ const (
 	logHeartbeatTag
 	getIDTag
 	updateScheduleCommandTag
+	cnHeartbeatTag
+	setStoreStateTag
+	addOperatorTag
+	cancelOperatorTag
+	initialClusterInfoTag
+	setTSOLimitTag
 )
 
 type StateQuery struct{}
+
+// CNStoreQuery queries healthy CN stores. When Labels is not empty, only CN
+// stores with all specified labels are returned.
+type CNStoreQuery struct{ Labels map[string]string }
+
+// StoreStateQuery queries the administrative state of the specified Log or DN
+// store.
+type StoreStateQuery struct{ UUID string }
+
+// StoreStateQueryResult is the result of StoreStateQuery.
+type StoreStateQueryResult struct {
+	State hapb.StoreState
+	// LogReplicas is the number of Log shard replicas still on the store.
+	LogReplicas int
+	// DNShards is the number of DN shard replicas still on the store.
+	DNShards int
+}
+
+// SafeToStop returns true when the store is drained and can be stopped without
+// affecting the availability of any shard.
+func (r StoreStateQueryResult) SafeToStop() bool {
+	return r.State == hapb.StoreDraining && r.LogReplicas == 0 && r.DNShards == 0
+}
+
 type logShardIDQuery struct{ name string }
 type logShardIDQueryResult struct{ id uint64 }
 
@@ -93,6 +126,52 @@ func GetGetIDCmd(count uint64) []byte {
 	return cmd
 }
 
+// GetSetStoreStateCmd returns the command for setting the administrative state
+// of the specified store.
+func GetSetStoreStateCmd(uuid string, state hapb.StoreState) []byte {
+	cmd := make([]byte, headerSize+4+len(uuid))
+	binaryEnc.PutUint16(cmd, setStoreStateTag)
+	binaryEnc.PutUint32(cmd[headerSize:], uint32(state))
+	copy(cmd[headerSize+4:], []byte(uuid))
+	return cmd
+}
+
+func isSetStoreStateCmd(cmd []byte) bool {
+	return len(cmd) > headerSize+4 && parseCmdTag(cmd) == setStoreStateTag
+}
+
+func parseSetStoreStateCmd(cmd []byte) (string, hapb.StoreState) {
+	state := hapb.StoreState(binaryEnc.Uint32(cmd[headerSize:]))
+	return string(cmd[headerSize+4:]), state
+}
+
+// GetAddOperatorCmd returns the command for adding a manual operator.
+func GetAddOperatorCmd(op hapb.Operator) []byte {
+	cmd := make([]byte, headerSize+op.Size())
+	binaryEnc.PutUint16(cmd, addOperatorTag)
+	if _, err := op.MarshalTo(cmd[headerSize:]); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+func isAddOperatorCmd(cmd []byte) bool {
+	return len(cmd) > headerSize && parseCmdTag(cmd) == addOperatorTag
+}
+
+// GetCancelOperatorCmd returns the command for canceling the specified
+// operator.
+func GetCancelOperatorCmd(id uint64) []byte {
+	cmd := make([]byte, headerSize+8)
+	binaryEnc.PutUint16(cmd, cancelOperatorTag)
+	binaryEnc.PutUint64(cmd[headerSize:], id)
+	return cmd
+}
+
+func isCancelOperatorCmd(cmd []byte) bool {
+	return len(cmd) == headerSize+8 && parseCmdTag(cmd) == cancelOperatorTag
+}
+
 func getCreateLogShardCmd(name string) []byte {
 	return getLogShardCmd(name, createLogShardTag)
 }
@@ -116,6 +195,10 @@ func isLogHeartbeatCmd(cmd []byte) bool {
 	return isHeartbeatCmd(cmd, logHeartbeatTag)
 }
 
+func isCNHeartbeatCmd(cmd []byte) bool {
+	return isHeartbeatCmd(cmd, cnHeartbeatTag)
+}
+
 func isHeartbeatCmd(cmd []byte, tag uint16) bool {
 	if len(cmd) <= headerSize {
 		return false
@@ -163,6 +246,10 @@ func GetDNStoreHeartbeatCmd(data []byte) []byte {
 	return getHeartbeatCmd(data, dnHeartbeatTag)
 }
 
+func GetCNStoreHeartbeatCmd(data []byte) []byte {
+	return getHeartbeatCmd(data, cnHeartbeatTag)
+}
+
 func getHeartbeatCmd(data []byte, tag uint16) []byte {
 	cmd := make([]byte, headerSize+len(data))
 	binaryEnc.PutUint16(cmd, tag)
@@ -250,11 +337,41 @@ func (s *stateMachine) handleLogHeartbeat(cmd []byte) sm.Result {
 	return sm.Result{}
 }
 
+func (s *stateMachine) handleCNHeartbeat(cmd []byte) sm.Result {
+	data := parseHeartbeatCmd(cmd)
+	var hb pb.CNStoreHeartbeat
+	if err := hb.Unmarshal(data); err != nil {
+		panic(err)
+	}
+	s.state.CNState.Update(hb, s.state.Tick)
+	return sm.Result{}
+}
+
+func (s *stateMachine) handleSetStoreStateCmd(cmd []byte) sm.Result {
+	uuid, state := parseSetStoreStateCmd(cmd)
+	plog.Infof("store %s state set to %s", uuid, state)
+	s.state.ClusterInfo.SetStoreState(uuid, state)
+	return sm.Result{}
+}
+
 func (s *stateMachine) handleTick(cmd []byte) sm.Result {
 	s.state.Tick++
+	s.removeFinishedOperators()
+	s.removeExpiredCNStores()
 	return sm.Result{}
 }
 
+// removeExpiredCNStores removes CN stores without any heartbeat received
+// within CNStoreTimeout, so they are not kept in the state forever.
+func (s *stateMachine) removeExpiredCNStores() {
+	for uuid, info := range s.state.CNState.Stores {
+		if ExpiredTick(info.Tick, CNStoreTimeout) < s.state.Tick {
+			plog.Infof("CN store %s expired", uuid)
+			delete(s.state.CNState.Stores, uuid)
+		}
+	}
+}
+
 func (s *stateMachine) handleGetIDCmd(cmd []byte) sm.Result {
 	count := parseGetIDCmd(cmd)
 	s.state.NextID++
@@ -271,12 +388,24 @@ func (s *stateMachine) Update(e sm.Entry) (sm.Result, error) {
 		return s.handleDNHeartbeat(cmd), nil
 	} else if isLogHeartbeatCmd(cmd) {
 		return s.handleLogHeartbeat(cmd), nil
+	} else if isCNHeartbeatCmd(cmd) {
+		return s.handleCNHeartbeat(cmd), nil
 	} else if isTickCmd(cmd) {
 		return s.handleTick(cmd), nil
 	} else if isGetIDCmd(cmd) {
 		return s.handleGetIDCmd(cmd), nil
 	} else if isUpdateCommandsCmd(cmd) {
 		return s.handleUpdateCommandsCmd(cmd), nil
+	} else if isSetStoreStateCmd(cmd) {
+		return s.handleSetStoreStateCmd(cmd), nil
+	} else if isAddOperatorCmd(cmd) {
+		return s.handleAddOperatorCmd(cmd), nil
+	} else if isCancelOperatorCmd(cmd) {
+		return s.handleCancelOperatorCmd(cmd), nil
+	} else if isInitialClusterInfoCmd(cmd) {
+		return s.handleInitialClusterInfoCmd(cmd), nil
+	} else if isSetTSOLimitCmd(cmd) {
+		return s.handleSetTSOLimitCmd(cmd), nil
 	}
 	panic(moerr.NewError(moerr.INVALID_INPUT, "unexpected haKeeper cmd"))
 }
@@ -288,7 +417,26 @@ func (s *stateMachine) handleStateQuery() interface{} {
 		ClusterInfo: s.state.ClusterInfo,
 		DNState:     s.state.DNState,
 		LogState:    s.state.LogState,
+		CNState:     s.state.CNState,
+
+		ScheduleCommands: s.state.ScheduleCommands,
+		Operators:        s.state.Operators,
+		Initialized:      s.state.Initialized,
+	}
+}
+
+func (s *stateMachine) handleCNStoreQuery(labels map[string]string) *hapb.CNState {
+	result := hapb.NewCNState()
+	for uuid, info := range s.state.CNState.Stores {
+		if ExpiredTick(info.Tick, CNStoreTimeout) < s.state.Tick {
+			continue
+		}
+		if !info.HasLabels(labels) {
+			continue
+		}
+		result.Stores[uuid] = info
 	}
+	return &result
 }
 
 func (s *stateMachine) handleShardIDQuery(name string) *logShardIDQueryResult {
@@ -299,11 +447,46 @@ func (s *stateMachine) handleShardIDQuery(name string) *logShardIDQueryResult {
 	return &logShardIDQueryResult{}
 }
 
+func (s *stateMachine) handleStoreStateQuery(uuid string) *StoreStateQueryResult {
+	result := &StoreStateQueryResult{
+		State: s.state.ClusterInfo.StoreStates[uuid],
+	}
+	// replicas are counted from both the shard membership and what was last
+	// reported by the store, the store still hosts a replica if either of them
+	// says so.
+	for shardID, shardInfo := range s.state.LogState.Shards {
+		onStore := false
+		for _, storeUUID := range shardInfo.Replicas {
+			if storeUUID == uuid {
+				onStore = true
+			}
+		}
+		for _, replica := range s.state.LogState.Stores[uuid].Replicas {
+			if replica.ShardID == shardID {
+				onStore = true
+			}
+		}
+		if onStore {
+			result.LogReplicas++
+		}
+	}
+	result.DNShards = len(s.state.DNState.Stores[uuid].Shards)
+	return result
+}
+
 func (s *stateMachine) Lookup(query interface{}) (interface{}, error) {
 	if q, ok := query.(*logShardIDQuery); ok {
 		return s.handleShardIDQuery(q.name), nil
 	} else if _, ok := query.(*StateQuery); ok {
 		return s.handleStateQuery(), nil
+	} else if q, ok := query.(*CNStoreQuery); ok {
+		return s.handleCNStoreQuery(q.Labels), nil
+	} else if q, ok := query.(*StoreStateQuery); ok {
+		return s.handleStoreStateQuery(q.UUID), nil
+	} else if _, ok := query.(*OperatorQuery); ok {
+		return s.handleOperatorQuery(), nil
+	} else if _, ok := query.(*TSOLimitQuery); ok {
+		return s.state.TSOLimit, nil
 	}
 	panic("unknown query type")
 }
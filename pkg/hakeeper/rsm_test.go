@@ -214,6 +214,140 @@ func TestHandleDNHeartbeat(t *testing.T) {
 	assert.Equal(t, hb.Shards, dninfo.Shards)
 }
 
+func addCNHeartbeat(t *testing.T, tsm *stateMachine, hb pb.CNStoreHeartbeat) {
+	data, err := hb.Marshal()
+	require.NoError(t, err)
+	_, err = tsm.Update(sm.Entry{Cmd: GetCNStoreHeartbeatCmd(data)})
+	require.NoError(t, err)
+}
+
+func TestHandleCNHeartbeat(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	cmd := GetTickCmd()
+	_, err := tsm1.Update(sm.Entry{Cmd: cmd})
+	assert.NoError(t, err)
+
+	hb := pb.CNStoreHeartbeat{
+		UUID:       "uuid1",
+		SQLAddress: "127.0.0.1:6001",
+		Labels:     map[string]string{"zone": "z1"},
+		Version:    "v0.5.0",
+	}
+	addCNHeartbeat(t, tsm1, hb)
+	s := tsm1.state.CNState
+	assert.Equal(t, 1, len(s.Stores))
+	cninfo, ok := s.Stores[hb.UUID]
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), cninfo.Tick)
+	assert.Equal(t, hb.SQLAddress, cninfo.SQLAddress)
+	assert.Equal(t, hb.Labels, cninfo.Labels)
+
+	state := tsm1.handleStateQuery().(*hapb.HAKeeperState)
+	assert.Equal(t, s, state.CNState)
+}
+
+func TestCNStoreQuery(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	addCNHeartbeat(t, tsm1, pb.CNStoreHeartbeat{
+		UUID:   "uuid1",
+		Labels: map[string]string{"zone": "z1"},
+	})
+	for i := uint64(0); i < ExpiredTick(0, CNStoreTimeout); i++ {
+		_, err := tsm1.Update(sm.Entry{Cmd: GetTickCmd()})
+		require.NoError(t, err)
+	}
+	addCNHeartbeat(t, tsm1, pb.CNStoreHeartbeat{
+		UUID:   "uuid2",
+		Labels: map[string]string{"zone": "z1"},
+	})
+	addCNHeartbeat(t, tsm1, pb.CNStoreHeartbeat{
+		UUID:   "uuid3",
+		Labels: map[string]string{"zone": "z2"},
+	})
+
+	query := func(labels map[string]string) []string {
+		v, err := tsm1.Lookup(&CNStoreQuery{Labels: labels})
+		require.NoError(t, err)
+		var uuids []string
+		for uuid := range v.(*hapb.CNState).Stores {
+			uuids = append(uuids, uuid)
+		}
+		return uuids
+	}
+	assert.ElementsMatch(t, []string{"uuid1", "uuid2", "uuid3"}, query(nil))
+	assert.ElementsMatch(t, []string{"uuid1", "uuid2"},
+		query(map[string]string{"zone": "z1"}))
+
+	// uuid1 expires after one more tick and is removed from the state
+	_, err := tsm1.Update(sm.Entry{Cmd: GetTickCmd()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"uuid2", "uuid3"}, query(nil))
+	assert.NotContains(t, tsm1.state.CNState.Stores, "uuid1")
+	assert.Equal(t, 2, len(tsm1.state.CNState.Stores))
+	assert.ElementsMatch(t, []string{"uuid2"},
+		query(map[string]string{"zone": "z1"}))
+	assert.Empty(t, query(map[string]string{"zone": "z3"}))
+}
+
+func TestSetStoreStateCmd(t *testing.T) {
+	cmd := GetSetStoreStateCmd("uuid1", hapb.StoreDraining)
+	assert.True(t, isSetStoreStateCmd(cmd))
+	uuid, state := parseSetStoreStateCmd(cmd)
+	assert.Equal(t, "uuid1", uuid)
+	assert.Equal(t, hapb.StoreDraining, state)
+
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	_, err := tsm1.Update(sm.Entry{Cmd: cmd})
+	require.NoError(t, err)
+	assert.True(t, tsm1.state.ClusterInfo.IsDraining("uuid1"))
+
+	_, err = tsm1.Update(sm.Entry{Cmd: GetSetStoreStateCmd("uuid1", hapb.StoreActive)})
+	require.NoError(t, err)
+	assert.False(t, tsm1.state.ClusterInfo.IsDraining("uuid1"))
+}
+
+func TestStoreStateQuery(t *testing.T) {
+	tsm1 := NewStateMachine(0, 1).(*stateMachine)
+	query := func(uuid string) *StoreStateQueryResult {
+		v, err := tsm1.Lookup(&StoreStateQuery{UUID: uuid})
+		require.NoError(t, err)
+		return v.(*StoreStateQueryResult)
+	}
+
+	_, err := tsm1.Update(sm.Entry{Cmd: GetSetStoreStateCmd("log1", hapb.StoreDraining)})
+	require.NoError(t, err)
+	_, err = tsm1.Update(sm.Entry{Cmd: GetSetStoreStateCmd("dn1", hapb.StoreDraining)})
+	require.NoError(t, err)
+	r := query("log1")
+	assert.Equal(t, hapb.StoreDraining, r.State)
+	assert.True(t, r.SafeToStop())
+	assert.False(t, query("log2").SafeToStop())
+
+	shardInfo := pb.LogShardInfo{
+		ShardID:  1,
+		Replicas: map[uint64]string{1: "log1", 2: "log2"},
+	}
+	tsm1.state.LogState.Shards[1] = shardInfo
+	tsm1.state.DNState.Stores["dn1"] = hapb.DNStoreInfo{
+		Shards: []pb.DNShardInfo{{ShardID: 2, ReplicaID: 3}},
+	}
+	r = query("log1")
+	assert.Equal(t, 1, r.LogReplicas)
+	assert.False(t, r.SafeToStop())
+	r = query("dn1")
+	assert.Equal(t, 1, r.DNShards)
+	assert.False(t, r.SafeToStop())
+
+	// replica removed from the shard but still reported by the store
+	delete(shardInfo.Replicas, 1)
+	tsm1.state.LogState.Stores["log1"] = hapb.LogStoreInfo{
+		Replicas: []pb.LogReplicaInfo{{LogShardInfo: shardInfo}},
+	}
+	assert.False(t, query("log1").SafeToStop())
+	tsm1.state.LogState.Stores["log1"] = hapb.LogStoreInfo{}
+	assert.True(t, query("log1").SafeToStop())
+}
+
 func TestGetIDCmd(t *testing.T) {
 	tsm1 := NewStateMachine(0, 1).(*stateMachine)
 	cmd := GetGetIDCmd(100)
@@ -0,0 +1,57 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	sm "github.com/lni/dragonboat/v4/statemachine"
+)
+
+// TSOLimitQuery queries the upper bound of the physical time of the timestamps
+// handed out by the TSO running on the HAKeeper leader.
+type TSOLimitQuery struct{}
+
+// GetSetTSOLimitCmd returns the command for updating the upper bound of the
+// physical time of the timestamps handed out by the TSO from prev to limit.
+// The command is rejected when the current upper bound is not prev, i.e. it
+// has been updated by another TSO leader.
+func GetSetTSOLimitCmd(prev int64, limit int64) []byte {
+	cmd := make([]byte, headerSize+16)
+	binaryEnc.PutUint16(cmd, setTSOLimitTag)
+	binaryEnc.PutUint64(cmd[headerSize:], uint64(prev))
+	binaryEnc.PutUint64(cmd[headerSize+8:], uint64(limit))
+	return cmd
+}
+
+func isSetTSOLimitCmd(cmd []byte) bool {
+	return len(cmd) == headerSize+16 && parseCmdTag(cmd) == setTSOLimitTag
+}
+
+func parseSetTSOLimitCmd(cmd []byte) (int64, int64) {
+	prev := int64(binaryEnc.Uint64(cmd[headerSize:]))
+	limit := int64(binaryEnc.Uint64(cmd[headerSize+8:]))
+	return prev, limit
+}
+
+// handleSetTSOLimitCmd returns a Result with Value 1 when the upper bound is
+// updated, or 0 when it is rejected.
+func (s *stateMachine) handleSetTSOLimitCmd(cmd []byte) sm.Result {
+	prev, limit := parseSetTSOLimitCmd(cmd)
+	if s.state.TSOLimit != prev || limit < prev {
+		plog.Infof("TSO limit %d rejected, expected %d, current %d",
+			limit, prev, s.state.TSOLimit)
+		return sm.Result{}
+	}
+	s.state.TSOLimit = limit
+	return sm.Result{Value: 1}
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"testing"
+
+	sm "github.com/lni/dragonboat/v4/statemachine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTSOLimitCmd(t *testing.T) {
+	cmd := GetSetTSOLimitCmd(100, 200)
+	assert.True(t, isSetTSOLimitCmd(cmd))
+	prev, limit := parseSetTSOLimitCmd(cmd)
+	assert.Equal(t, int64(100), prev)
+	assert.Equal(t, int64(200), limit)
+	assert.False(t, isGetIDCmd(cmd))
+}
+
+func TestHandleSetTSOLimitCmd(t *testing.T) {
+	tsm := NewStateMachine(0, 1).(*stateMachine)
+	getLimit := func() int64 {
+		v, err := tsm.Lookup(&TSOLimitQuery{})
+		require.NoError(t, err)
+		return v.(int64)
+	}
+
+	result, err := tsm.Update(sm.Entry{Cmd: GetSetTSOLimitCmd(0, 100)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.Value)
+	assert.Equal(t, int64(100), getLimit())
+
+	// rejected as the limit has been updated by another TSO leader
+	result, err = tsm.Update(sm.Entry{Cmd: GetSetTSOLimitCmd(0, 200)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), result.Value)
+	assert.Equal(t, int64(100), getLimit())
+
+	result, err = tsm.Update(sm.Entry{Cmd: GetSetTSOLimitCmd(100, 200)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), result.Value)
+	assert.Equal(t, int64(200), getLimit())
+}
@@ -0,0 +1,325 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+const (
+	adminRequestTimeout = 5 * time.Second
+	// backupRequestTimeout is the timeout of exporting or importing a Log
+	// shard, they read or write all records of the shard.
+	backupRequestTimeout = 30 * time.Minute
+)
+
+// GetHAKeeperState returns the state of the HAKeeper.
+func (l *logStore) GetHAKeeperState(ctx context.Context) (hapb.HAKeeperState, error) {
+	v, err := l.read(ctx, hakeeper.DefaultHAKeeperShardID, &hakeeper.StateQuery{})
+	if err != nil {
+		return hapb.HAKeeperState{}, err
+	}
+	return *(v.(*hapb.HAKeeperState)), nil
+}
+
+// GetOperators returns all unfinished manual operators and their status.
+func (l *logStore) GetOperators(ctx context.Context) ([]hakeeper.OperatorStatus, error) {
+	v, err := l.read(ctx, hakeeper.DefaultHAKeeperShardID, &hakeeper.OperatorQuery{})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]hakeeper.OperatorStatus), nil
+}
+
+// AddOperator submits a manual operator to the HAKeeper, the ID of the
+// operator is returned.
+func (l *logStore) AddOperator(ctx context.Context, op hapb.Operator) (uint64, error) {
+	cmd := hakeeper.GetAddOperatorCmd(op)
+	session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	result, err := l.propose(ctx, session, cmd)
+	if err != nil {
+		plog.Errorf("propose failed, %v", err)
+		return 0, err
+	}
+	return result.Value, nil
+}
+
+// CancelOperator cancels the specified operator. It returns false when the
+// operator is not found.
+func (l *logStore) CancelOperator(ctx context.Context, id uint64) (bool, error) {
+	cmd := hakeeper.GetCancelOperatorCmd(id)
+	session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	result, err := l.propose(ctx, session, cmd)
+	if err != nil {
+		plog.Errorf("propose failed, %v", err)
+		return false, err
+	}
+	return result.Value == 1, nil
+}
+
+// adminStore is the HAKeeper related interface of the log store used by the
+// admin endpoint.
+type adminStore interface {
+	GetHAKeeperState(ctx context.Context) (hapb.HAKeeperState, error)
+	GetOperators(ctx context.Context) ([]hakeeper.OperatorStatus, error)
+	AddOperator(ctx context.Context, op hapb.Operator) (uint64, error)
+	CancelOperator(ctx context.Context, id uint64) (bool, error)
+	SetStoreState(ctx context.Context, uuid string, state hapb.StoreState) error
+	GetStoreState(ctx context.Context, uuid string) (hakeeper.StoreStateQueryResult, error)
+}
+
+var _ adminStore = (*logStore)(nil)
+
+// backupService is the interface of exporting and importing Log shards used
+// by the admin endpoint.
+type backupService interface {
+	ExportLogShard(ctx context.Context, shardID uint64, firstIndex Lsn, path string) error
+	ImportLogShard(ctx context.Context, shardID uint64, path string) (Lsn, error)
+}
+
+var _ backupService = (*Service)(nil)
+
+// newAdminHandler returns the http.Handler of the HAKeeper admin endpoint.
+// Changing the state of a store responds with the status of the store, so the
+// admin can poll it until the store is safe to stop.
+// All changes are proposed to the HAKeeper shard so they are replicated. When
+// token is not empty, requests without the token as the bearer token in the
+// Authorization header are rejected.
+func newAdminHandler(store adminStore,
+	backup backupService, token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(hakeeper.AdminStatePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+		defer cancel()
+		state, err := store.GetHAKeeperState(ctx)
+		if err != nil {
+			writeAdminError(w, err)
+			return
+		}
+		writeAdminJSON(w, state)
+	})
+	mux.HandleFunc(hakeeper.AdminOperatorsPath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+		defer cancel()
+		switch r.Method {
+		case http.MethodGet:
+			ops, err := store.GetOperators(ctx)
+			if err != nil {
+				writeAdminError(w, err)
+				return
+			}
+			writeAdminJSON(w, ops)
+		case http.MethodPost:
+			var req hakeeper.OperatorRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			op, err := req.Operator()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			id, err := store.AddOperator(ctx, op)
+			if err != nil {
+				writeAdminError(w, err)
+				return
+			}
+			writeAdminJSON(w, hakeeper.AddOperatorResponse{ID: id})
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc(hakeeper.AdminOperatorsPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		id, err := strconv.ParseUint(
+			strings.TrimPrefix(r.URL.Path, hakeeper.AdminOperatorsPath+"/"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid operator ID", http.StatusBadRequest)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+		defer cancel()
+		ok, err := store.CancelOperator(ctx, id)
+		if err != nil {
+			writeAdminError(w, err)
+			return
+		}
+		if !ok {
+			http.Error(w, "operator not found", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc(hakeeper.AdminStoresPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		uuid, action := path.Split(strings.TrimPrefix(r.URL.Path, hakeeper.AdminStoresPath+"/"))
+		ctx, cancel := context.WithTimeout(r.Context(), adminRequestTimeout)
+		defer cancel()
+		switch r.Method {
+		case http.MethodGet:
+			// GET AdminStoresPath/{uuid}, the UUID is the last element
+			uuid = path.Join(uuid, action)
+		case http.MethodPost:
+			uuid = strings.TrimSuffix(uuid, "/")
+			var state hapb.StoreState
+			switch action {
+			case hakeeper.DrainStoreAction:
+				state = hapb.StoreDraining
+			case hakeeper.ActivateStoreAction:
+				state = hapb.StoreActive
+			default:
+				http.Error(w, "unknown store action", http.StatusNotFound)
+				return
+			}
+			if len(uuid) == 0 {
+				http.Error(w, "UUID not set", http.StatusBadRequest)
+				return
+			}
+			if err := store.SetStoreState(ctx, uuid, state); err != nil {
+				writeAdminError(w, err)
+				return
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if len(uuid) == 0 {
+			http.Error(w, "UUID not set", http.StatusBadRequest)
+			return
+		}
+		result, err := store.GetStoreState(ctx, uuid)
+		if err != nil {
+			writeAdminError(w, err)
+			return
+		}
+		writeAdminJSON(w, hakeeper.NewStoreStatus(uuid, result))
+	})
+	mux.HandleFunc(hakeeper.AdminExportPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req hakeeper.ExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := hakeeper.ValidateBackupPath(req.Path); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), backupRequestTimeout)
+		defer cancel()
+		if err := backup.ExportLogShard(ctx, req.ShardID, req.FirstIndex, req.Path); err != nil {
+			writeAdminError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc(hakeeper.AdminImportPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req hakeeper.ImportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := hakeeper.ValidateBackupPath(req.Path); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), backupRequestTimeout)
+		defer cancel()
+		lsn, err := backup.ImportLogShard(ctx, req.ShardID, req.Path)
+		if err != nil {
+			writeAdminError(w, err)
+			return
+		}
+		writeAdminJSON(w, hakeeper.ImportResponse{Lsn: lsn})
+	})
+	if len(token) == 0 {
+		return mux
+	}
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func writeAdminJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		plog.Errorf("failed to write admin response, %v", err)
+	}
+}
+
+func writeAdminError(w http.ResponseWriter, err error) {
+	plog.Errorf("admin request failed, %v", err)
+	http.Error(w, err.Error(), http.StatusServiceUnavailable)
+}
+
+func (s *Service) startAdminServer() error {
+	if len(s.cfg.AdminAddress) == 0 {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.cfg.AdminAddress)
+	if err != nil {
+		return err
+	}
+	s.adminServer = &http.Server{
+		Handler: newAdminHandler(s.store, s, s.cfg.AdminToken),
+	}
+	s.stopper.RunWorker(func() {
+		if err := s.adminServer.Serve(listener); err != nil &&
+			!errors.Is(err, http.ErrServerClosed) {
+			plog.Errorf("admin server failed, %v", err)
+		}
+	})
+	return nil
+}
+
+func (s *Service) stopAdminServer() {
+	if s.adminServer == nil {
+		return
+	}
+	if err := s.adminServer.Close(); err != nil {
+		plog.Errorf("failed to close the admin server, %v", err)
+	}
+}
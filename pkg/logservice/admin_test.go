@@ -0,0 +1,263 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+)
+
+type testAdminStore struct {
+	state  hapb.HAKeeperState
+	ops    map[uint64]hapb.Operator
+	stores map[string]hapb.StoreState
+}
+
+func (s *testAdminStore) GetHAKeeperState(ctx context.Context) (hapb.HAKeeperState, error) {
+	return s.state, nil
+}
+
+func (s *testAdminStore) GetOperators(ctx context.Context) ([]hakeeper.OperatorStatus, error) {
+	var result []hakeeper.OperatorStatus
+	for _, op := range s.ops {
+		result = append(result, hakeeper.OperatorStatus{
+			Operator: op,
+			Finished: make([]bool, len(op.Steps)),
+		})
+	}
+	return result, nil
+}
+
+func (s *testAdminStore) AddOperator(ctx context.Context, op hapb.Operator) (uint64, error) {
+	op.ID = uint64(len(s.ops) + 1)
+	s.ops[op.ID] = op
+	return op.ID, nil
+}
+
+func (s *testAdminStore) CancelOperator(ctx context.Context, id uint64) (bool, error) {
+	if _, ok := s.ops[id]; !ok {
+		return false, nil
+	}
+	delete(s.ops, id)
+	return true, nil
+}
+
+func (s *testAdminStore) SetStoreState(ctx context.Context,
+	uuid string, state hapb.StoreState) error {
+	s.stores[uuid] = state
+	return nil
+}
+
+func (s *testAdminStore) GetStoreState(ctx context.Context,
+	uuid string) (hakeeper.StoreStateQueryResult, error) {
+	return hakeeper.StoreStateQueryResult{State: s.stores[uuid], DNShards: 1}, nil
+}
+
+type testBackupService struct {
+	exported map[string]uint64
+}
+
+func (s *testBackupService) ExportLogShard(ctx context.Context,
+	shardID uint64, firstIndex Lsn, path string) error {
+	s.exported[path] = shardID
+	return nil
+}
+
+func (s *testBackupService) ImportLogShard(ctx context.Context,
+	shardID uint64, path string) (Lsn, error) {
+	if _, ok := s.exported[path]; !ok {
+		return 0, ErrInvalidSnapshot
+	}
+	return 10, nil
+}
+
+func TestAdminHandlerBackup(t *testing.T) {
+	backup := &testBackupService{exported: make(map[string]uint64)}
+	server := httptest.NewServer(newAdminHandler(&testAdminStore{}, backup, ""))
+	defer server.Close()
+
+	post := func(path string, req interface{}) *http.Response {
+		data, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := http.Post(server.URL+path, "application/json", bytes.NewReader(data))
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := post(hakeeper.AdminExportPath,
+		hakeeper.ExportRequest{ShardID: 1, FirstIndex: 1, Path: "backup/1"})
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, uint64(1), backup.exported["backup/1"])
+
+	resp = post(hakeeper.AdminImportPath,
+		hakeeper.ImportRequest{ShardID: 2, Path: "backup/1"})
+	var imported hakeeper.ImportResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&imported))
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, uint64(10), imported.Lsn)
+
+	resp = post(hakeeper.AdminImportPath,
+		hakeeper.ImportRequest{ShardID: 2, Path: "backup/2"})
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	// paths out of the backup directory are rejected
+	for _, path := range []string{"", "/backup", "../backup", "backup/../../x"} {
+		resp = post(hakeeper.AdminExportPath,
+			hakeeper.ExportRequest{ShardID: 1, FirstIndex: 1, Path: path})
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestAdminHandlerStoreState(t *testing.T) {
+	store := &testAdminStore{stores: make(map[string]hapb.StoreState)}
+	server := httptest.NewServer(newAdminHandler(store, &testBackupService{}, ""))
+	defer server.Close()
+
+	do := func(method string, path string) (int, hakeeper.StoreStatus) {
+		req, err := http.NewRequest(method, server.URL+hakeeper.AdminStoresPath+path, nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var status hakeeper.StoreStatus
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+		}
+		return resp.StatusCode, status
+	}
+
+	code, status := do(http.MethodPost, "/store1/"+hakeeper.DrainStoreAction)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, hapb.StoreDraining, store.stores["store1"])
+	assert.Equal(t, hakeeper.StoreStatus{UUID: "store1",
+		State: hapb.StoreDraining.String(), DNShards: 1}, status)
+
+	code, status = do(http.MethodGet, "/store1")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, hapb.StoreDraining.String(), status.State)
+
+	code, _ = do(http.MethodPost, "/store1/"+hakeeper.ActivateStoreAction)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, hapb.StoreActive, store.stores["store1"])
+
+	code, _ = do(http.MethodPost, "/store1/split")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = do(http.MethodPost, "/"+hakeeper.DrainStoreAction)
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = do(http.MethodGet, "/")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestAdminHandler(t *testing.T) {
+	store := &testAdminStore{
+		state: hapb.HAKeeperState{Tick: 100},
+		ops:   make(map[uint64]hapb.Operator),
+	}
+	server := httptest.NewServer(newAdminHandler(store, &testBackupService{}, ""))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + hakeeper.AdminStatePath)
+	require.NoError(t, err)
+	var state hapb.HAKeeperState
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&state))
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, uint64(100), state.Tick)
+
+	data, err := json.Marshal(hakeeper.OperatorRequest{
+		Type:    hakeeper.AddReplicaOp,
+		Service: hakeeper.LogServiceName,
+		UUID:    "store1",
+		ShardID: 1,
+	})
+	require.NoError(t, err)
+	resp, err = http.Post(server.URL+hakeeper.AdminOperatorsPath,
+		"application/json", bytes.NewReader(data))
+	require.NoError(t, err)
+	var added hakeeper.AddOperatorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&added))
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, uint64(1), added.ID)
+
+	// invalid operator is rejected
+	resp, err = http.Post(server.URL+hakeeper.AdminOperatorsPath,
+		"application/json", bytes.NewReader([]byte(`{"type":"split"}`)))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + hakeeper.AdminOperatorsPath)
+	require.NoError(t, err)
+	var ops []hakeeper.OperatorStatus
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&ops))
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, 1, len(ops))
+	assert.Equal(t, 2, len(ops[0].Steps))
+
+	cancel := func() int {
+		req, err := http.NewRequest(http.MethodDelete,
+			server.URL+hakeeper.AdminOperatorsPath+"/1", nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusOK, cancel())
+	assert.Equal(t, http.StatusNotFound, cancel())
+}
+
+func TestAdminHandlerRequiresToken(t *testing.T) {
+	store := &testAdminStore{
+		state: hapb.HAKeeperState{Tick: 100},
+		ops:   make(map[uint64]hapb.Operator),
+	}
+	server := httptest.NewServer(newAdminHandler(store, &testBackupService{}, "secret"))
+	defer server.Close()
+
+	get := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet,
+			server.URL+hakeeper.AdminStatePath, nil)
+		require.NoError(t, err)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusUnauthorized, get(""))
+	assert.Equal(t, http.StatusUnauthorized, get("wrong"))
+	assert.Equal(t, http.StatusOK, get("secret"))
+
+	resp, err := http.Post(server.URL+hakeeper.AdminOperatorsPath,
+		"application/json", bytes.NewReader([]byte(`{}`)))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Empty(t, store.ops)
+}
@@ -15,6 +15,9 @@
 package logservice
 
 import (
+	"net"
+	"time"
+
 	"github.com/cockroachdb/errors"
 	"github.com/lni/vfs"
 
@@ -26,6 +29,12 @@ const (
 	defaultServiceAddress = "0.0.0.0:32000"
 	defaultRaftAddress    = "0.0.0.0:32001"
 	defaultGossipAddress  = "0.0.0.0:32002"
+
+	defaultNumOfLogShards        = 1
+	defaultNumOfDNShards         = 1
+	defaultNumOfLogShardReplicas = 1
+
+	defaultTSOWindow = 3 * time.Second
 )
 
 var (
@@ -47,6 +56,28 @@ type Config struct {
 	GossipAddress        string
 	GossipListenAddress  string
 	GossipSeedAddresses  []string
+	// AdminAddress is the address of the HAKeeper admin endpoint, the endpoint
+	// is disabled when it is not set.
+	AdminAddress string
+	// AdminToken is the bearer token required by all requests to the admin
+	// endpoint. The admin endpoint can only listen on loopback addresses when
+	// AdminToken is not set.
+	AdminToken string
+	// BackupDir is the local directory the Log shards are exported to and
+	// imported from through the admin endpoint, exporting and importing are
+	// disabled when it is not set.
+	BackupDir string
+	// TSOWindow is the length of the physical time persisted at a time by the
+	// TSO running on the HAKeeper leader.
+	TSOWindow time.Duration
+	// BootstrapConfig describes the initial shards of the cluster, it is
+	// applied once by the HAKeeper leader when the cluster is started for the
+	// first time. Each DN shard has one replica and uses a unique log shard.
+	BootstrapConfig struct {
+		NumOfLogShards        uint64
+		NumOfDNShards         uint64
+		NumOfLogShardReplicas uint64
+	}
 }
 
 // Validate validates the configuration.
@@ -68,6 +99,20 @@ func (c *Config) Validate() error {
 	if len(c.GossipSeedAddresses) == 0 {
 		return errors.Wrapf(ErrInvalidConfig, "GossipSeedAddresses not set")
 	}
+	if len(c.AdminAddress) != 0 && len(c.AdminToken) == 0 &&
+		!isLoopbackAddress(c.AdminAddress) {
+		return errors.Wrapf(ErrInvalidConfig,
+			"AdminToken not set for non-loopback AdminAddress %s", c.AdminAddress)
+	}
+	numOfLogShards := c.BootstrapConfig.NumOfLogShards
+	if numOfLogShards == 0 {
+		numOfLogShards = defaultNumOfLogShards
+	}
+	if c.BootstrapConfig.NumOfDNShards > numOfLogShards {
+		return errors.Wrapf(ErrInvalidConfig,
+			"NumOfDNShards %d is larger than NumOfLogShards %d",
+			c.BootstrapConfig.NumOfDNShards, numOfLogShards)
+	}
 	return nil
 }
 
@@ -96,4 +141,30 @@ func (c *Config) Fill() {
 	} else if len(c.GossipAddress) != 0 && len(c.GossipListenAddress) == 0 {
 		c.GossipListenAddress = c.GossipAddress
 	}
+	if c.TSOWindow == 0 {
+		c.TSOWindow = defaultTSOWindow
+	}
+	if c.BootstrapConfig.NumOfLogShards == 0 {
+		c.BootstrapConfig.NumOfLogShards = defaultNumOfLogShards
+	}
+	if c.BootstrapConfig.NumOfDNShards == 0 {
+		c.BootstrapConfig.NumOfDNShards = defaultNumOfDNShards
+	}
+	if c.BootstrapConfig.NumOfLogShardReplicas == 0 {
+		c.BootstrapConfig.NumOfLogShardReplicas = defaultNumOfLogShardReplicas
+	}
+}
+
+// isLoopbackAddress returns a boolean value indicating whether the specified
+// address only listens on the loopback interface.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
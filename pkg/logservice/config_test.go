@@ -58,6 +58,23 @@ func TestConfigCanBeValidated(t *testing.T) {
 	c5 := c
 	c5.GossipSeedAddresses = []string{}
 	assert.True(t, errors.Is(c5.Validate(), ErrInvalidConfig))
+
+	c6 := c
+	c6.BootstrapConfig.NumOfDNShards = 2
+	assert.True(t, errors.Is(c6.Validate(), ErrInvalidConfig))
+	c6.BootstrapConfig.NumOfLogShards = 2
+	assert.Nil(t, c6.Validate())
+
+	c7 := c
+	c7.AdminAddress = "0.0.0.0:32003"
+	assert.True(t, errors.Is(c7.Validate(), ErrInvalidConfig))
+	c7.AdminToken = "secret"
+	assert.Nil(t, c7.Validate())
+	c7.AdminToken = ""
+	for _, address := range []string{"127.0.0.1:32003", "localhost:32003", "[::1]:32003"} {
+		c7.AdminAddress = address
+		assert.Nil(t, c7.Validate())
+	}
 }
 
 func TestFillConfig(t *testing.T) {
@@ -73,6 +90,10 @@ func TestFillConfig(t *testing.T) {
 	assert.Equal(t, defaultGossipAddress, c.GossipAddress)
 	assert.Equal(t, defaultGossipAddress, c.GossipListenAddress)
 	assert.Equal(t, 0, len(c.GossipSeedAddresses))
+	assert.Equal(t, uint64(defaultNumOfLogShards), c.BootstrapConfig.NumOfLogShards)
+	assert.Equal(t, uint64(defaultNumOfDNShards), c.BootstrapConfig.NumOfDNShards)
+	assert.Equal(t, uint64(defaultNumOfLogShardReplicas),
+		c.BootstrapConfig.NumOfLogShardReplicas)
 }
 
 func TestListenAddressCanBeFilled(t *testing.T) {
@@ -51,6 +51,7 @@ func getErrorToCodeMapping() []errorToCode {
 
 		{ErrInvalidTruncateIndex, pb.ErrorCode_IndexAlreadyTruncated, true},
 		{ErrNotLeaseHolder, pb.ErrorCode_NotLeaseHolder, true},
+		{ErrNotTSOLeader, pb.ErrorCode_NotTSOLeader, true},
 	}
 }
 
@@ -85,7 +85,9 @@ func (l *logStore) healthCheck() {
 		plog.Errorf("failed to get HAKeeper Leader ID, %v", err)
 		return
 	}
-	if ok && leaderID == l.haKeeperReplicaID {
+	isLeader := ok && leaderID == l.haKeeperReplicaID
+	l.updateTSOServer(isLeader)
+	if isLeader {
 		if l.alloc.Capacity() < minIDAllocCapacity {
 			if err := l.updateIDAlloc(defaultIDBatchSize); err != nil {
 				// TODO: check whether this is temp error
@@ -101,22 +103,53 @@ func (l *logStore) healthCheck() {
 			return
 		}
 		state := s.(*hapb.HAKeeperState)
-		cmds := l.checker.Check(l.alloc,
-			state.ClusterInfo, state.DNState, state.LogState, state.Tick)
-		if len(cmds) > 0 {
+		if !state.Initialized {
+			l.bootstrap(*state)
+			return
+		}
+		// manually submitted operators are run before anything generated by
+		// the checker
+		cmds := hakeeper.MergeScheduleCommands(
+			hakeeper.GetOperatorCommands(state.Operators,
+				state.DNState, state.LogState),
+			l.checker.Check(l.alloc,
+				state.ClusterInfo, state.DNState, state.LogState, state.Tick))
+		// pending commands are replaced by the proposed ones, an empty batch
+		// is proposed to clear commands that are no longer required
+		if len(cmds) > 0 || len(state.ScheduleCommands) > 0 {
 			ctx2, cancel2 := context.WithTimeout(context.Background(),
 				hakeeperCmdUploadTimeout)
 			defer cancel2()
-			b := &hapb.CommandBatch{
-				Term:     term,
-				Commands: cmds,
-			}
-			data := MustMarshal(b)
+			cmd := hakeeper.GetUpdateCommandsCmd(term, cmds)
 			session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
-			if _, err := l.propose(ctx2, session, data); err != nil {
+			if _, err := l.propose(ctx2, session, cmd); err != nil {
 				// TODO: check whether this is temp error
 				return
 			}
 		}
 	}
 }
+
+// bootstrap applies the initial cluster info described by the bootstrap config
+// once enough stores have registered to the HAKeeper. Replicas of the initial
+// shards are placed by checkers afterwards.
+func (l *logStore) bootstrap(state hapb.HAKeeperState) {
+	cfg := l.cfg.BootstrapConfig
+	if !hakeeper.IsReadyToBootstrap(state,
+		cfg.NumOfDNShards, cfg.NumOfLogShardReplicas) {
+		return
+	}
+	info, ok := hakeeper.GetBootstrapClusterInfo(l.alloc, cfg.NumOfLogShards,
+		cfg.NumOfDNShards, cfg.NumOfLogShardReplicas)
+	if !ok {
+		plog.Errorf("not enough IDs for bootstrapping the cluster")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), hakeeperDefaultTimeout)
+	defer cancel()
+	cmd := hakeeper.GetInitialClusterInfoCmd(info)
+	session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	if _, err := l.propose(ctx, session, cmd); err != nil {
+		plog.Errorf("failed to propose initial cluster info, %v", err)
+	}
+}
@@ -15,9 +15,16 @@
 package logservice
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/lni/dragonboat/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
 func TestIDAllocatorDefaultState(t *testing.T) {
@@ -81,3 +88,58 @@ func TestIDAllocatorNext(t *testing.T) {
 		}
 	}
 }
+
+func TestBootstrap(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.ID()
+		require.NoError(t, store.StartHAKeeperReplica(1, peers))
+		store.cfg.BootstrapConfig.NumOfLogShards = 2
+		store.cfg.BootstrapConfig.NumOfDNShards = 1
+		store.cfg.BootstrapConfig.NumOfLogShardReplicas = 1
+		require.NoError(t, store.updateIDAlloc(100))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		// no DN store registered yet
+		require.NoError(t, store.AddLogStoreHeartbeat(ctx, store.getHeartbeatMessage()))
+		state, err := store.GetHAKeeperState(ctx)
+		require.NoError(t, err)
+		store.bootstrap(state)
+		state, err = store.GetHAKeeperState(ctx)
+		require.NoError(t, err)
+		assert.False(t, state.Initialized)
+
+		require.NoError(t, store.AddDNStoreHeartbeat(ctx, pb.DNStoreHeartbeat{UUID: "dn1"}))
+		state, err = store.GetHAKeeperState(ctx)
+		require.NoError(t, err)
+		store.bootstrap(state)
+		state, err = store.GetHAKeeperState(ctx)
+		require.NoError(t, err)
+		assert.True(t, state.Initialized)
+		require.Equal(t, 2, len(state.ClusterInfo.LogShards))
+		require.Equal(t, 1, len(state.ClusterInfo.DNShards))
+		assert.Equal(t, state.ClusterInfo.LogShards[0].ShardID,
+			state.ClusterInfo.DNShards[0].LogShardID)
+
+		// replicas of the initial shards are placed by the checker
+		store.healthCheck()
+		state, err = store.GetHAKeeperState(ctx)
+		require.NoError(t, err)
+		dnCmds := state.ScheduleCommands["dn1"].Commands
+		require.Equal(t, 1, len(dnCmds))
+		assert.Equal(t, hapb.DnService, dnCmds[0].ServiceType)
+		assert.Equal(t, hapb.StartNode, dnCmds[0].ConfigChange.ChangeType)
+		assert.Equal(t, state.ClusterInfo.DNShards[0].ShardID,
+			dnCmds[0].ConfigChange.Replica.ShardID)
+		// the first log shard is already running on the store, only the
+		// second one is created
+		logCmds := state.ScheduleCommands[store.ID()].Commands
+		require.Equal(t, 1, len(logCmds))
+		assert.Equal(t, hapb.LogService, logCmds[0].ServiceType)
+		assert.Equal(t, hapb.StartNode, logCmds[0].ConfigChange.ChangeType)
+		assert.Equal(t, state.ClusterInfo.LogShards[1].ShardID,
+			logCmds[0].ConfigChange.Replica.ShardID)
+	}
+	runStoreTest(t, fn)
+}
@@ -43,6 +43,7 @@ type leaseHolderIDQuery struct{}
 type indexQuery struct{}
 type truncatedIndexQuery struct{}
 type leaseHistoryQuery struct{ index uint64 }
+type leaseHistoryMapQuery struct{}
 
 func getAppendCmd(cmd []byte, replicaID uint64) []byte {
 	if len(cmd) < headerSize+8 {
@@ -199,6 +200,12 @@ func (s *stateMachine) Lookup(query interface{}) (interface{}, error) {
 	} else if v, ok := query.(leaseHistoryQuery); ok {
 		lease, _ := s.getLeaseHistory(v.index)
 		return lease, nil
+	} else if _, ok := query.(leaseHistoryMapQuery); ok {
+		history := make(map[uint64]uint64, len(s.state.LeaseHistory))
+		for index, lease := range s.state.LeaseHistory {
+			history[index] = lease
+		}
+		return history, nil
 	}
 	panic("unknown lookup command type")
 }
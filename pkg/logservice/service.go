@@ -17,6 +17,7 @@ package logservice
 import (
 	"context"
 	"net"
+	"net/http"
 	"sync"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/lni/goutils/netutil"
 	"github.com/lni/goutils/syncutil"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
@@ -46,6 +48,10 @@ type Service struct {
 	store       *logStore
 	stopper     *syncutil.Stopper
 	connStopper *syncutil.Stopper
+	adminServer *http.Server
+	// backupFS is the FileService of the backup directory, it is nil when the
+	// backup directory is not set.
+	backupFS fileservice.FileService
 }
 
 func NewService(cfg Config) (*Service, error) {
@@ -54,6 +60,16 @@ func NewService(cfg Config) (*Service, error) {
 	}
 	cfg.Fill()
 
+	var backupFS fileservice.FileService
+	if len(cfg.BackupDir) != 0 {
+		fs, err := fileservice.NewLocalFS(cfg.BackupDir)
+		if err != nil {
+			plog.Errorf("failed to open the backup directory %v", err)
+			return nil, err
+		}
+		backupFS = fs
+	}
+
 	store, err := newLogStore(cfg)
 	if err != nil {
 		plog.Errorf("failed to create log store %v", err)
@@ -64,6 +80,7 @@ func NewService(cfg Config) (*Service, error) {
 		store:       store,
 		stopper:     syncutil.NewStopper(),
 		connStopper: syncutil.NewStopper(),
+		backupFS:    backupFS,
 	}
 	// TODO: before making the service available to the outside world, restore all
 	// replicas already known to the local store
@@ -74,10 +91,18 @@ func NewService(cfg Config) (*Service, error) {
 		}
 		return nil, err
 	}
+	if err := service.startAdminServer(); err != nil {
+		plog.Errorf("failed to start the admin server %v", err)
+		if err := service.Close(); err != nil {
+			plog.Errorf("failed to close the service, %v", err)
+		}
+		return nil, err
+	}
 	return service, nil
 }
 
 func (s *Service) Close() error {
+	s.stopAdminServer()
 	s.stopper.Stop()
 	s.connStopper.Stop()
 	return s.store.Close()
@@ -195,6 +220,8 @@ func (s *Service) handle(req pb.Request,
 		return s.handleConnect(req), pb.LogRecordResponse{}
 	case pb.MethodType_CONNECT_RO:
 		return s.handleConnectRO(req), pb.LogRecordResponse{}
+	case pb.MethodType_TSO_ALLOCATE:
+		return s.handleTSOAllocate(req), pb.LogRecordResponse{}
 	default:
 		panic("unknown method type")
 	}
@@ -273,3 +300,16 @@ func (s *Service) handleGetTruncatedIndex(req pb.Request) pb.Response {
 	}
 	return resp
 }
+
+func (s *Service) handleTSOAllocate(req pb.Request) pb.Response {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(req.Timeout))
+	defer cancel()
+	resp := getResponse(req)
+	ts, err := s.store.AllocateTimestamps(ctx, req.TSOCount)
+	if err != nil {
+		resp.ErrorCode, resp.ErrorMessage = toErrorCode(err)
+	} else {
+		resp.Timestamp = ts
+	}
+	return resp
+}
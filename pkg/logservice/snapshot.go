@@ -0,0 +1,319 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+var (
+	ErrShardNotEmpty   = moerr.NewError(moerr.INVALID_STATE, "shard not empty")
+	ErrInvalidSnapshot = moerr.NewError(moerr.INVALID_INPUT, "invalid log shard snapshot")
+	ErrNoBackupDir     = moerr.NewError(moerr.BAD_CONFIGURATION, "backup directory not set")
+)
+
+const (
+	// exportBatchSize is the max size of log entries read from the shard by
+	// each query during the export, entries read by each query are written
+	// to a separate chunk file.
+	exportBatchSize = 4 * 1024 * 1024
+	// snapshotHeaderSize is the size of the header of each exported file, the
+	// header contains the size of the marshaled content.
+	snapshotHeaderSize = 8
+	// snapshotMetaFile is the name of the file containing the LogShardSnapshot,
+	// it is written after all chunks so an export without it is incomplete.
+	snapshotMetaFile = "meta"
+)
+
+func getSnapshotMetaPath(path string) string {
+	return fmt.Sprintf("%s/%s", path, snapshotMetaFile)
+}
+
+func getSnapshotChunkPath(path string, chunk uint64) string {
+	return fmt.Sprintf("%s/chunk-%08d", path, chunk)
+}
+
+// ExportLogShard exports the specified shard to the path in the backup
+// directory, see logStore.ExportLogShard.
+func (s *Service) ExportLogShard(ctx context.Context,
+	shardID uint64, firstIndex Lsn, path string) error {
+	if s.backupFS == nil {
+		return ErrNoBackupDir
+	}
+	return s.store.ExportLogShard(ctx, shardID, firstIndex, s.backupFS, path)
+}
+
+// ImportLogShard seeds the specified shard from the export at the path in the
+// backup directory, see logStore.ImportLogShard.
+func (s *Service) ImportLogShard(ctx context.Context,
+	shardID uint64, path string) (Lsn, error) {
+	if s.backupFS == nil {
+		return 0, ErrNoBackupDir
+	}
+	return s.store.ImportLogShard(ctx, shardID, s.backupFS, path)
+}
+
+// ExportLogShard exports user records of the specified shard starting from
+// firstIndex, together with the metadata and the lease history of the shard,
+// to the directory at path in the FileService. Records are written in chunks
+// of bounded size and the metadata is written last. The shard keeps serving
+// requests during the export, records appended after the export is started
+// are not included.
+func (l *logStore) ExportLogShard(ctx context.Context, shardID uint64,
+	firstIndex Lsn, fs fileservice.FileService, path string) error {
+	return l.exportLogShard(ctx, shardID, firstIndex, fs, path, exportBatchSize)
+}
+
+func (l *logStore) exportLogShard(ctx context.Context, shardID uint64,
+	firstIndex Lsn, fs fileservice.FileService, path string, batchSize uint64) error {
+	meta, err := l.getLogShardSnapshot(ctx, shardID, firstIndex)
+	if err != nil {
+		return err
+	}
+	for index := firstIndex; index <= meta.LastIndex; {
+		records, next, err := l.QueryLog(ctx, shardID, index, batchSize)
+		if err != nil {
+			return err
+		}
+		var chunk pb.LogShardSnapshotChunk
+		for _, record := range records {
+			if record.Index <= meta.LastIndex {
+				chunk.Records = append(chunk.Records, record)
+			}
+		}
+		if len(chunk.Records) > 0 {
+			meta.Chunks++
+			meta.Records += uint64(len(chunk.Records))
+			if err := writeSnapshotFile(ctx, fs,
+				getSnapshotChunkPath(path, meta.Chunks), &chunk); err != nil {
+				return err
+			}
+		}
+		// QueryLog returns the requested index as next when there is nothing
+		// more to read
+		if next <= index {
+			break
+		}
+		index = next
+	}
+	if err := writeSnapshotFile(ctx, fs, getSnapshotMetaPath(path), &meta); err != nil {
+		return err
+	}
+	plog.Infof("exported %d records of shard %d to %s in %d chunks",
+		meta.Records, shardID, path, meta.Chunks)
+	return nil
+}
+
+// getLogShardSnapshot returns the metadata of the shard for exporting records
+// starting from firstIndex.
+func (l *logStore) getLogShardSnapshot(ctx context.Context,
+	shardID uint64, firstIndex Lsn) (pb.LogShardSnapshot, error) {
+	if shardID == hakeeper.DefaultHAKeeperShardID {
+		return pb.LogShardSnapshot{}, ErrInvalidShardID
+	}
+	truncatedIndex, err := l.GetTruncatedIndex(ctx, shardID)
+	if err != nil {
+		return pb.LogShardSnapshot{}, err
+	}
+	if firstIndex <= truncatedIndex {
+		return pb.LogShardSnapshot{}, errors.Wrapf(ErrInvalidTruncateIndex,
+			"shard %d already truncated to %d", shardID, truncatedIndex)
+	}
+	v, err := l.read(ctx, shardID, leaseHolderIDQuery{})
+	if err != nil {
+		return pb.LogShardSnapshot{}, err
+	}
+	leaseHolderID := v.(uint64)
+	v, err = l.read(ctx, shardID, leaseHistoryMapQuery{})
+	if err != nil {
+		return pb.LogShardSnapshot{}, err
+	}
+	leaseHistory := v.(map[uint64]uint64)
+	v, err = l.read(ctx, shardID, indexQuery{})
+	if err != nil {
+		return pb.LogShardSnapshot{}, err
+	}
+	return pb.LogShardSnapshot{
+		ShardID:        shardID,
+		FirstIndex:     firstIndex,
+		LastIndex:      v.(uint64),
+		LeaseHolderID:  leaseHolderID,
+		TruncatedIndex: truncatedIndex,
+		LeaseHistory:   leaseHistory,
+	}, nil
+}
+
+// ImportLogShard seeds the specified shard with user records exported by
+// ExportLogShard. Records are appended in their exported order with their
+// original lease holders, Lsns are assigned by the shard and are different
+// from the exported ones. The lease holder of the exported shard is restored
+// once all records are imported. The Lsn of the last imported record is
+// returned.
+//
+// A failed import can be resumed by calling ImportLogShard again, records
+// already in the shard are skipped. ErrShardNotEmpty is returned when the
+// shard contains records not from the export.
+func (l *logStore) ImportLogShard(ctx context.Context, shardID uint64,
+	fs fileservice.FileService, path string) (Lsn, error) {
+	if shardID == hakeeper.DefaultHAKeeperShardID {
+		return 0, ErrInvalidShardID
+	}
+	var meta pb.LogShardSnapshot
+	if err := readSnapshotFile(ctx, fs, getSnapshotMetaPath(path), &meta); err != nil {
+		return 0, err
+	}
+	imported, last, err := l.getUserRecords(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+	if imported > meta.Records {
+		return 0, errors.Wrapf(ErrShardNotEmpty,
+			"shard %d has %d records, %d exported", shardID, imported, meta.Records)
+	}
+	v, err := l.read(ctx, shardID, leaseHolderIDQuery{})
+	if err != nil {
+		return 0, err
+	}
+	leaseHolderID := v.(uint64)
+
+	lsn := last.Index
+	skipped := uint64(0)
+	for i := uint64(1); i <= meta.Chunks; i++ {
+		var chunk pb.LogShardSnapshotChunk
+		if err := readSnapshotFile(ctx, fs,
+			getSnapshotChunkPath(path, i), &chunk); err != nil {
+			return 0, err
+		}
+		for _, record := range chunk.Records {
+			if !isUserUpdate(record.Data) {
+				return 0, errors.Wrapf(ErrInvalidSnapshot,
+					"record at index %d is not a user record", record.Index)
+			}
+			if skipped < imported {
+				skipped++
+				if skipped == imported && !bytes.Equal(record.Data, last.Data) {
+					return 0, errors.Wrapf(ErrShardNotEmpty,
+						"record at index %d of shard %d is not imported", last.Index, shardID)
+				}
+				continue
+			}
+			if id := parseLeaseHolderID(record.Data); id != leaseHolderID {
+				if err := l.GetOrExtendDNLease(ctx, shardID, id); err != nil {
+					return 0, err
+				}
+				leaseHolderID = id
+			}
+			if lsn, err = l.Append(ctx, shardID, record.Data); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if meta.LeaseHolderID != leaseHolderID {
+		if err := l.GetOrExtendDNLease(ctx, shardID, meta.LeaseHolderID); err != nil {
+			return 0, err
+		}
+	}
+	plog.Infof("imported %d records of shard %d from %s to shard %d",
+		meta.Records-imported, meta.ShardID, path, shardID)
+	return lsn, nil
+}
+
+// getUserRecords returns the number of user records in the shard and the last
+// one of them.
+func (l *logStore) getUserRecords(ctx context.Context,
+	shardID uint64) (uint64, LogRecord, error) {
+	v, err := l.read(ctx, shardID, indexQuery{})
+	if err != nil {
+		return 0, LogRecord{}, err
+	}
+	lastIndex := v.(uint64)
+	count := uint64(0)
+	var last LogRecord
+	for index := uint64(1); index <= lastIndex; {
+		records, next, err := l.QueryLog(ctx, shardID, index, exportBatchSize)
+		if err != nil {
+			return 0, LogRecord{}, err
+		}
+		if len(records) > 0 {
+			count += uint64(len(records))
+			last = records[len(records)-1]
+		}
+		if next <= index {
+			break
+		}
+		index = next
+	}
+	return count, last, nil
+}
+
+// writeSnapshotFile writes the marshaled content prefixed by its size to a new
+// file at path.
+func writeSnapshotFile(ctx context.Context,
+	fs fileservice.FileService, path string, m Marshaler) error {
+	data := MustMarshal(m)
+	file := make([]byte, snapshotHeaderSize+len(data))
+	binaryEnc.PutUint64(file, uint64(len(data)))
+	copy(file[snapshotHeaderSize:], data)
+	vector := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: len(file), Data: file},
+		},
+	}
+	if err := fs.Write(ctx, vector); err != nil {
+		plog.Errorf("failed to write %s, %v", path, err)
+		return err
+	}
+	return nil
+}
+
+// readSnapshotFile reads the file written by writeSnapshotFile.
+func readSnapshotFile(ctx context.Context,
+	fs fileservice.FileService, path string, m Unmarshaler) error {
+	header := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: snapshotHeaderSize},
+		},
+	}
+	if err := fs.Read(ctx, &header); err != nil {
+		return err
+	}
+	size := int(binaryEnc.Uint64(header.Entries[0].Data))
+	if size == 0 {
+		return m.Unmarshal(nil)
+	}
+	body := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{Offset: snapshotHeaderSize, Size: size},
+		},
+	}
+	if err := fs.Read(ctx, &body); err != nil {
+		return err
+	}
+	if err := m.Unmarshal(body.Entries[0].Data); err != nil {
+		return errors.Wrapf(ErrInvalidSnapshot, "%s, %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lni/dragonboat/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+func getTestUserEntryWithLeaseHolder(leaseHolderID uint64, payload uint64) []byte {
+	cmd := make([]byte, 2+8+8)
+	binaryEnc.PutUint16(cmd, userEntryTag)
+	binaryEnc.PutUint64(cmd[headerSize:], leaseHolderID)
+	binaryEnc.PutUint64(cmd[headerSize+8:], payload)
+	return cmd
+}
+
+func TestExportImportLogShard(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+		defer cancel()
+		fs, err := fileservice.NewMemoryFS()
+		require.NoError(t, err)
+
+		var cmds [][]byte
+		for _, leaseHolderID := range []uint64{100, 100, 101} {
+			require.NoError(t, store.GetOrExtendDNLease(ctx, 1, leaseHolderID))
+			cmd := getTestUserEntryWithLeaseHolder(leaseHolderID, uint64(len(cmds)))
+			_, err := store.Append(ctx, 1, cmd)
+			require.NoError(t, err)
+			cmds = append(cmds, cmd)
+		}
+		require.NoError(t, store.ExportLogShard(ctx, 1, 4, fs, "backup/1"))
+		// the export is not overwritten
+		err = store.ExportLogShard(ctx, 1, 4, fs, "backup/1")
+		assert.True(t, errors.Is(err, fileservice.ErrFileExisted))
+
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.nh.ID()
+		require.NoError(t, store.StartReplica(3, 1, peers))
+		lsn, err := store.ImportLogShard(ctx, 3, fs, "backup/1")
+		require.NoError(t, err)
+
+		entries, _, err := store.QueryLog(ctx, 3, 1, math.MaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, len(cmds), len(entries))
+		for i, entry := range entries {
+			assert.Equal(t, cmds[i], entry.Data)
+		}
+		assert.Equal(t, entries[len(entries)-1].Index, lsn)
+		v, err := store.read(ctx, 3, leaseHolderIDQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(101), v.(uint64))
+
+		// importing again is a no-op
+		lsn2, err := store.ImportLogShard(ctx, 3, fs, "backup/1")
+		require.NoError(t, err)
+		assert.Equal(t, lsn, lsn2)
+		entries, _, err = store.QueryLog(ctx, 3, 1, math.MaxUint64)
+		require.NoError(t, err)
+		assert.Equal(t, len(cmds), len(entries))
+	}
+	runStoreTest(t, fn)
+}
+
+func TestExportLogShardInChunks(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+		defer cancel()
+		fs, err := fileservice.NewMemoryFS()
+		require.NoError(t, err)
+
+		require.NoError(t, store.GetOrExtendDNLease(ctx, 1, 100))
+		var cmds [][]byte
+		for i := 0; i < 5; i++ {
+			cmd := getTestUserEntryWithLeaseHolder(100, uint64(i))
+			_, err := store.Append(ctx, 1, cmd)
+			require.NoError(t, err)
+			cmds = append(cmds, cmd)
+		}
+		// each query returns a single record
+		require.NoError(t, store.exportLogShard(ctx, 1, 1, fs, "backup/1", 1))
+		var meta pb.LogShardSnapshot
+		require.NoError(t, readSnapshotFile(ctx, fs, getSnapshotMetaPath("backup/1"), &meta))
+		assert.Equal(t, uint64(5), meta.Records)
+		assert.True(t, meta.Chunks > 1)
+
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.nh.ID()
+		require.NoError(t, store.StartReplica(3, 1, peers))
+		_, err = store.ImportLogShard(ctx, 3, fs, "backup/1")
+		require.NoError(t, err)
+		entries, _, err := store.QueryLog(ctx, 3, 1, math.MaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, len(cmds), len(entries))
+		for i, entry := range entries {
+			assert.Equal(t, cmds[i], entry.Data)
+		}
+	}
+	runStoreTest(t, fn)
+}
+
+func TestResumeImportLogShard(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+		defer cancel()
+		fs, err := fileservice.NewMemoryFS()
+		require.NoError(t, err)
+
+		require.NoError(t, store.GetOrExtendDNLease(ctx, 1, 100))
+		var cmds [][]byte
+		for i := 0; i < 3; i++ {
+			cmd := getTestUserEntryWithLeaseHolder(100, uint64(i))
+			_, err := store.Append(ctx, 1, cmd)
+			require.NoError(t, err)
+			cmds = append(cmds, cmd)
+		}
+		require.NoError(t, store.ExportLogShard(ctx, 1, 1, fs, "backup/1"))
+
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.nh.ID()
+		// the previous import failed after the first record is imported
+		require.NoError(t, store.StartReplica(3, 1, peers))
+		require.NoError(t, store.GetOrExtendDNLease(ctx, 3, 100))
+		_, err = store.Append(ctx, 3, cmds[0])
+		require.NoError(t, err)
+		_, err = store.ImportLogShard(ctx, 3, fs, "backup/1")
+		require.NoError(t, err)
+		entries, _, err := store.QueryLog(ctx, 3, 1, math.MaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, len(cmds), len(entries))
+		for i, entry := range entries {
+			assert.Equal(t, cmds[i], entry.Data)
+		}
+
+		// records not from the export
+		require.NoError(t, store.StartReplica(4, 1, peers))
+		require.NoError(t, store.GetOrExtendDNLease(ctx, 4, 100))
+		_, err = store.Append(ctx, 4, getTestUserEntryWithLeaseHolder(100, 10))
+		require.NoError(t, err)
+		_, err = store.ImportLogShard(ctx, 4, fs, "backup/1")
+		assert.True(t, errors.Is(err, ErrShardNotEmpty))
+	}
+	runStoreTest(t, fn)
+}
+
+func TestExportLogShardFromIndex(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+		defer cancel()
+		fs, err := fileservice.NewMemoryFS()
+		require.NoError(t, err)
+		require.NoError(t, store.GetOrExtendDNLease(ctx, 1, 100))
+		cmd := getTestUserEntry()
+		for i := 0; i < 3; i++ {
+			_, err := store.Append(ctx, 1, cmd)
+			require.NoError(t, err)
+		}
+		require.NoError(t, store.ExportLogShard(ctx, 1, 5, fs, "backup/1"))
+		var meta pb.LogShardSnapshot
+		require.NoError(t, readSnapshotFile(ctx, fs, getSnapshotMetaPath("backup/1"), &meta))
+		assert.Equal(t, uint64(1), meta.ShardID)
+		assert.Equal(t, uint64(5), meta.FirstIndex)
+		assert.Equal(t, uint64(6), meta.LastIndex)
+		assert.Equal(t, uint64(100), meta.LeaseHolderID)
+		assert.Equal(t, map[uint64]uint64{3: 100}, meta.LeaseHistory)
+		assert.Equal(t, uint64(2), meta.Records)
+		require.Equal(t, uint64(1), meta.Chunks)
+		var chunk pb.LogShardSnapshotChunk
+		require.NoError(t, readSnapshotFile(ctx, fs, getSnapshotChunkPath("backup/1", 1), &chunk))
+		require.Equal(t, 2, len(chunk.Records))
+		assert.Equal(t, uint64(5), chunk.Records[0].Index)
+		assert.Equal(t, uint64(6), chunk.Records[1].Index)
+
+		// truncated records can't be exported
+		require.NoError(t, store.TruncateLog(ctx, 1, 5))
+		err = store.ExportLogShard(ctx, 1, 5, fs, "backup/2")
+		assert.True(t, errors.Is(err, ErrInvalidTruncateIndex))
+		// HAKeeper shard can't be exported
+		err = store.ExportLogShard(ctx, 0, 1, fs, "backup/3")
+		assert.True(t, errors.Is(err, ErrInvalidShardID))
+	}
+	runStoreTest(t, fn)
+}
+
+func TestReadInvalidLogShardSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+	defer cancel()
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+
+	var meta pb.LogShardSnapshot
+	assert.Error(t, readSnapshotFile(ctx, fs, "missing", &meta))
+
+	data := []byte{0, 0, 0, 0, 0, 0, 0, 2, 0xFF, 0xFF}
+	require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+		FilePath: "corrupted",
+		Entries:  []fileservice.IOEntry{{Offset: 0, Size: len(data), Data: data}},
+	}))
+	err = readSnapshotFile(ctx, fs, "corrupted", &meta)
+	assert.True(t, errors.Is(err, ErrInvalidSnapshot))
+}
+
+func TestServiceExportImportLogShard(t *testing.T) {
+	fn := func(t *testing.T, s *Service) {
+		ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+		defer cancel()
+		err := s.ExportLogShard(ctx, 1, 1, "backup/1")
+		assert.True(t, errors.Is(err, ErrNoBackupDir))
+		_, err = s.ImportLogShard(ctx, 1, "backup/1")
+		assert.True(t, errors.Is(err, ErrNoBackupDir))
+
+		fs, err := fileservice.NewMemoryFS()
+		require.NoError(t, err)
+		s.backupFS = fs
+		require.NoError(t, s.store.GetOrExtendDNLease(ctx, 1, 100))
+		_, err = s.store.Append(ctx, 1, getTestUserEntry())
+		require.NoError(t, err)
+		require.NoError(t, s.ExportLogShard(ctx, 1, 1, "backup/1"))
+		var meta pb.LogShardSnapshot
+		require.NoError(t, readSnapshotFile(ctx, fs, getSnapshotMetaPath("backup/1"), &meta))
+		assert.Equal(t, uint64(1), meta.Records)
+	}
+	runServiceTest(t, fn)
+}
@@ -29,7 +29,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers"
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 )
 
 var (
@@ -98,6 +101,8 @@ type logStore struct {
 	haKeeperReplicaID uint64
 	checker           hakeeper.Checker
 	alloc             hakeeper.IDAllocator
+	tso               *clock.TSOServer
+	tsoStore          *hakeeperTSOStore
 	stopper           *syncutil.Stopper
 
 	mu struct {
@@ -115,9 +120,14 @@ func newLogStore(cfg Config) (*logStore, error) {
 	ls := &logStore{
 		cfg:     cfg,
 		nh:      nh,
+		checker: checkers.NewCoordinator(),
 		alloc:   newIDAllocator(),
 		stopper: syncutil.NewStopper(),
 	}
+	ls.tsoStore = &hakeeperTSOStore{store: ls}
+	ls.tso = clock.NewTSOServer(ls.tsoStore, func() int64 {
+		return time.Now().UTC().UnixNano()
+	}, cfg.TSOWindow)
 	ls.mu.truncateCh = make(chan struct{})
 	ls.mu.pendingTruncate = make(map[uint64]struct{})
 	ls.stopper.RunWorker(func() {
@@ -128,6 +138,7 @@ func newLogStore(cfg Config) (*logStore, error) {
 
 func (l *logStore) Close() error {
 	l.stopper.Stop()
+	l.tso.Stop()
 	if l.nh != nil {
 		l.nh.Close()
 	}
@@ -286,6 +297,55 @@ func (l *logStore) AddDNStoreHeartbeat(ctx context.Context,
 	return nil
 }
 
+func (l *logStore) AddCNStoreHeartbeat(ctx context.Context,
+	hb pb.CNStoreHeartbeat) error {
+	data := MustMarshal(&hb)
+	cmd := hakeeper.GetCNStoreHeartbeatCmd(data)
+	session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	if _, err := l.propose(ctx, session, cmd); err != nil {
+		plog.Errorf("propose failed, %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetCNStores returns all healthy CN stores known to the HAKeeper. When labels
+// is not empty, only CN stores with all specified labels are returned.
+func (l *logStore) GetCNStores(ctx context.Context,
+	labels map[string]string) (hapb.CNState, error) {
+	v, err := l.read(ctx,
+		hakeeper.DefaultHAKeeperShardID, &hakeeper.CNStoreQuery{Labels: labels})
+	if err != nil {
+		return hapb.CNState{}, err
+	}
+	return *(v.(*hapb.CNState)), nil
+}
+
+// SetStoreState sets the administrative state of the specified Log or DN
+// store. Replicas on a draining store are moved to other stores by HAKeeper.
+func (l *logStore) SetStoreState(ctx context.Context,
+	uuid string, state hapb.StoreState) error {
+	cmd := hakeeper.GetSetStoreStateCmd(uuid, state)
+	session := l.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	if _, err := l.propose(ctx, session, cmd); err != nil {
+		plog.Errorf("propose failed, %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetStoreState returns the administrative state of the specified Log or DN
+// store, it also reports whether the store is safe to stop.
+func (l *logStore) GetStoreState(ctx context.Context,
+	uuid string) (hakeeper.StoreStateQueryResult, error) {
+	v, err := l.read(ctx,
+		hakeeper.DefaultHAKeeperShardID, &hakeeper.StoreStateQuery{UUID: uuid})
+	if err != nil {
+		return hakeeper.StoreStateQueryResult{}, err
+	}
+	return *(v.(*hakeeper.StoreStateQueryResult)), nil
+}
+
 func (l *logStore) getLeaseHolderID(ctx context.Context,
 	shardID uint64, entries []raftpb.Entry) (uint64, error) {
 	if len(entries) == 0 {
@@ -495,7 +555,7 @@ func (l *logStore) truncateLog() error {
 
 func (l *logStore) getHeartbeatMessage() pb.LogStoreHeartbeat {
 	m := pb.LogStoreHeartbeat{
-		UUID:           l.cfg.NodeHostID,
+		UUID:           l.nh.ID(),
 		RaftAddress:    l.cfg.RaftAddress,
 		ServiceAddress: l.cfg.ServiceAddress,
 		GossipAddress:  l.cfg.GossipAddress,
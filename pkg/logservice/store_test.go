@@ -25,7 +25,9 @@ import (
 	"github.com/lni/goutils/leaktest"
 	"github.com/lni/vfs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	hapb "github.com/matrixorigin/matrixone/pkg/pb/hakeeper"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
@@ -283,3 +285,53 @@ func TestAddHeartbeat(t *testing.T) {
 	}
 	runStoreTest(t, fn)
 }
+
+func TestAddCNStoreHeartbeat(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.ID()
+		assert.NoError(t, store.StartHAKeeperReplica(1, peers))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		cnMsg := pb.CNStoreHeartbeat{
+			UUID:       "cn1",
+			SQLAddress: "127.0.0.1:6001",
+			Labels:     map[string]string{"zone": "z1"},
+		}
+		assert.NoError(t, store.AddCNStoreHeartbeat(ctx, cnMsg))
+
+		state, err := store.GetCNStores(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(state.Stores))
+		assert.Equal(t, cnMsg.SQLAddress, state.Stores["cn1"].SQLAddress)
+
+		state, err = store.GetCNStores(ctx, map[string]string{"zone": "z2"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(state.Stores))
+	}
+	runStoreTest(t, fn)
+}
+
+func TestSetStoreState(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.ID()
+		assert.NoError(t, store.StartHAKeeperReplica(1, peers))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		require.NoError(t, store.SetStoreState(ctx, "dn1", hapb.StoreDraining))
+		result, err := store.GetStoreState(ctx, "dn1")
+		require.NoError(t, err)
+		assert.Equal(t, hapb.StoreDraining, result.State)
+		assert.True(t, result.SafeToStop())
+
+		require.NoError(t, store.SetStoreState(ctx, "dn1", hapb.StoreActive))
+		result, err = store.GetStoreState(ctx, "dn1")
+		require.NoError(t, err)
+		assert.Equal(t, hapb.StoreActive, result.State)
+		assert.False(t, result.SafeToStop())
+	}
+	runStoreTest(t, fn)
+}
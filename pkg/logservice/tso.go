@@ -0,0 +1,254 @@
+// Copyright 2022 MatrixOrigin.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+)
+
+var (
+	ErrNotTSOLeader     = moerr.NewError(moerr.INVALID_STATE, "not TSO leader")
+	ErrTSOLimitChanged  = moerr.NewError(moerr.INVALID_STATE, "TSO limit changed by another leader")
+	ErrNoServiceAddress = moerr.NewError(moerr.BAD_CONFIGURATION, "service addresses not set")
+)
+
+// hakeeperTSOStore persists the upper bound of the physical time of the
+// timestamps handed out by the TSO in the HAKeeper RSM. The upper bound is
+// only updated when it has not been changed since it was loaded. Load is a
+// linearizable read through the HAKeeper leader, the TSO server loads the upper
+// bound before each allocation, so a deposed TSO leader stops handing out
+// timestamps once the new leader persisted its window.
+type hakeeperTSOStore struct {
+	store *logStore
+
+	mu struct {
+		sync.Mutex
+		limit int64
+		stale bool
+	}
+}
+
+var _ clock.TSOStore = (*hakeeperTSOStore)(nil)
+
+func (s *hakeeperTSOStore) Load(ctx context.Context) (int64, error) {
+	v, err := s.store.read(ctx,
+		hakeeper.DefaultHAKeeperShardID, &hakeeper.TSOLimitQuery{})
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.limit = v.(int64)
+	s.mu.stale = false
+	return s.mu.limit, nil
+}
+
+func (s *hakeeperTSOStore) Save(ctx context.Context, limit int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cmd := hakeeper.GetSetTSOLimitCmd(s.mu.limit, limit)
+	session := s.store.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+	result, err := s.store.propose(ctx, session, cmd)
+	if err != nil {
+		return err
+	}
+	if result.Value == 0 {
+		s.mu.stale = true
+		return errors.Wrapf(ErrTSOLimitChanged, "limit %d", s.mu.limit)
+	}
+	s.mu.limit = limit
+	return nil
+}
+
+// isStale returns true if the upper bound has been changed by others since it
+// was loaded.
+func (s *hakeeperTSOStore) isStale() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mu.stale
+}
+
+// updateTSOServer starts the TSO server when the store becomes the HAKeeper
+// leader and stops it once the leadership is lost. The TSO server is restarted
+// to reload the upper bound after it was changed by another leader.
+func (l *logStore) updateTSOServer(isLeader bool) {
+	if !isLeader {
+		if l.tso.Started() {
+			l.tso.Stop()
+			plog.Infof("TSO server stopped")
+		}
+		return
+	}
+	if l.tso.Started() && !l.tsoStore.isStale() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), hakeeperDefaultTimeout)
+	defer cancel()
+	if err := l.tso.Start(ctx); err != nil {
+		plog.Errorf("failed to start TSO server, %v", err)
+		return
+	}
+	plog.Infof("TSO server started")
+}
+
+// AllocateTimestamps allocates count consecutive timestamps from the TSO
+// running on the HAKeeper leader, ErrNotTSOLeader is returned if the store is
+// not the HAKeeper leader. The leadership is confirmed by the TSO server on each
+// allocation, not only by the periodic health check.
+func (l *logStore) AllocateTimestamps(ctx context.Context,
+	count uint32) (timestamp.Timestamp, error) {
+	if !l.tso.Started() {
+		return timestamp.Timestamp{}, ErrNotTSOLeader
+	}
+	return l.tso.Allocate(ctx, count)
+}
+
+// TSOClientConfig is the configuration of the TSOClient.
+type TSOClientConfig struct {
+	// ServiceAddresses are the service addresses of the Log stores, they are
+	// tried in turn to find the HAKeeper leader.
+	ServiceAddresses []string
+}
+
+// TSOClient allocates timestamps from the TSO running on the HAKeeper leader,
+// it is used as the TSOAllocator of the TSOClock on DN and CN nodes.
+type TSOClient interface {
+	clock.TSOAllocator
+	Close() error
+}
+
+type tsoClient struct {
+	cfg TSOClientConfig
+
+	mu struct {
+		sync.Mutex
+		conn net.Conn
+		// next is the index of the address to connect
+		next int
+		buf  []byte
+	}
+}
+
+var _ TSOClient = (*tsoClient)(nil)
+
+// NewTSOClient returns a TSOClient, connections are established on demand.
+func NewTSOClient(cfg TSOClientConfig) (TSOClient, error) {
+	if len(cfg.ServiceAddresses) == 0 {
+		return nil, ErrNoServiceAddress
+	}
+	c := &tsoClient{cfg: cfg}
+	c.mu.buf = make([]byte, reqBufSize)
+	return c, nil
+}
+
+// Allocate allocates count consecutive timestamps. The connected store might
+// no longer be the HAKeeper leader, other stores are tried in turn then.
+func (c *tsoClient) Allocate(ctx context.Context,
+	count uint32) (timestamp.Timestamp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var err error
+	for i := 0; i < len(c.cfg.ServiceAddresses); i++ {
+		var ts timestamp.Timestamp
+		if ts, err = c.allocate(ctx, count); err == nil {
+			return ts, nil
+		}
+		c.closeConn()
+		c.mu.next = (c.mu.next + 1) % len(c.cfg.ServiceAddresses)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return timestamp.Timestamp{}, err
+}
+
+func (c *tsoClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.conn == nil {
+		return nil
+	}
+	defer c.closeConn()
+	if err := sendPoison(c.mu.conn, poisonNumber[:]); err != nil {
+		return err
+	}
+	return waitPoisonAck(c.mu.conn)
+}
+
+func (c *tsoClient) allocate(ctx context.Context,
+	count uint32) (timestamp.Timestamp, error) {
+	if c.mu.conn == nil {
+		conn, err := getConnection(ctx, c.cfg.ServiceAddresses[c.mu.next])
+		if err != nil {
+			return timestamp.Timestamp{}, err
+		}
+		c.mu.conn = conn
+	}
+	timeout, err := getTimeoutFromContext(ctx)
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	req := pb.Request{
+		Method:   pb.MethodType_TSO_ALLOCATE,
+		Timeout:  int64(timeout),
+		TSOCount: count,
+	}
+	if err := writeRequest(c.mu.conn, req, c.mu.buf, nil); err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	resp, _, err := readResponse(c.mu.conn, c.mu.buf)
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	if err := toError(resp); err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	return resp.Timestamp, nil
+}
+
+func (c *tsoClient) closeConn() {
+	if c.mu.conn != nil {
+		if err := c.mu.conn.Close(); err != nil {
+			plog.Errorf("failed to close the connection, %v", err)
+		}
+		c.mu.conn = nil
+	}
+}
+
+// NewTxnClock returns the txn clock specified by the configuration of DN and
+// CN nodes. The TSOClock allocates timestamps from the TSO running on the
+// HAKeeper leader through the Log stores at cfg.TSOServiceAddresses.
+func NewTxnClock(ctx context.Context, cfg clock.Config) (clock.Clock, error) {
+	var allocator clock.TSOAllocator
+	if cfg.Type == clock.TSOClockType {
+		client, err := NewTSOClient(TSOClientConfig{
+			ServiceAddresses: cfg.TSOServiceAddresses,
+		})
+		if err != nil {
+			return nil, err
+		}
+		allocator = client
+	}
+	return clock.NewClock(ctx, cfg, allocator)
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lni/dragonboat/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+)
+
+func TestTSOServerOnHAKeeperLeader(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.ID()
+		require.NoError(t, store.StartHAKeeperReplica(1, peers))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := store.AllocateTimestamps(ctx, 1)
+		assert.Equal(t, ErrNotTSOLeader, err)
+
+		store.updateTSOServer(true)
+		require.True(t, store.tso.Started())
+		ts1, err := store.AllocateTimestamps(ctx, 1)
+		require.NoError(t, err)
+		ts2, err := store.AllocateTimestamps(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, ts1.Less(ts2))
+
+		store.updateTSOServer(false)
+		assert.False(t, store.tso.Started())
+	}
+	runStoreTest(t, fn)
+}
+
+func TestHAKeeperTSOStoreRejectsStaleLimit(t *testing.T) {
+	fn := func(t *testing.T, store *logStore) {
+		peers := make(map[uint64]dragonboat.Target)
+		peers[1] = store.ID()
+		require.NoError(t, store.StartHAKeeperReplica(1, peers))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		s := &hakeeperTSOStore{store: store}
+		limit, err := s.Load(ctx)
+		require.NoError(t, err)
+		require.NoError(t, s.Save(ctx, limit+100))
+
+		// another leader changed the limit
+		session := store.nh.GetNoOPSession(hakeeper.DefaultHAKeeperShardID)
+		_, err = store.propose(ctx, session,
+			hakeeper.GetSetTSOLimitCmd(limit+100, limit+200))
+		require.NoError(t, err)
+
+		err = s.Save(ctx, limit+300)
+		assert.ErrorIs(t, err, ErrTSOLimitChanged)
+		assert.True(t, s.isStale())
+
+		v, err := s.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, limit+200, v)
+		assert.False(t, s.isStale())
+	}
+	runStoreTest(t, fn)
+}
+
+func TestNewTxnClock(t *testing.T) {
+	_, err := NewTxnClock(context.Background(),
+		clock.Config{Type: clock.TSOClockType})
+	assert.Equal(t, ErrNoServiceAddress, err)
+
+	c, err := NewTxnClock(context.Background(), clock.Config{
+		Type:                clock.TSOClockType,
+		TSOServiceAddresses: []string{"127.0.0.1:1"},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, c)
+}
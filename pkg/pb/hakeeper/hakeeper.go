@@ -30,20 +30,40 @@ const (
 func NewRSMState() RSMState {
 	return RSMState{
 		ScheduleCommands: make(map[string]CommandBatch),
+		Operators:        make(map[uint64]Operator),
 		LogShards:        make(map[string]uint64),
 		DNState:          NewDNState(),
 		LogState:         NewLogState(),
+		CNState:          NewCNState(),
 		ClusterInfo:      newClusterInfo(),
 	}
 }
 
 func newClusterInfo() ClusterInfo {
 	return ClusterInfo{
-		DNShards:  make([]metadata.DNShardRecord, 0),
-		LogShards: make([]metadata.LogShardRecord, 0),
+		DNShards:    make([]metadata.DNShardRecord, 0),
+		LogShards:   make([]metadata.LogShardRecord, 0),
+		StoreStates: make(map[string]StoreState),
 	}
 }
 
+// SetStoreState sets the administrative state of the specified store.
+func (m *ClusterInfo) SetStoreState(uuid string, state StoreState) {
+	if state == StoreActive {
+		delete(m.StoreStates, uuid)
+		return
+	}
+	if m.StoreStates == nil {
+		m.StoreStates = make(map[string]StoreState)
+	}
+	m.StoreStates[uuid] = state
+}
+
+// IsDraining returns true if the specified store is being drained.
+func (m ClusterInfo) IsDraining(uuid string) bool {
+	return m.StoreStates[uuid] == StoreDraining
+}
+
 // NewDNState creates a new DNState.
 func NewDNState() DNState {
 	return DNState{
@@ -64,6 +84,39 @@ func (s *DNState) Update(hb pb.DNStoreHeartbeat, tick uint64) {
 	s.Stores[hb.UUID] = storeInfo
 }
 
+// NewCNState creates a new CNState.
+func NewCNState() CNState {
+	return CNState{
+		Stores: make(map[string]CNStoreInfo),
+	}
+}
+
+// Update applies the incoming CNStoreHeartbeat into HAKeeper. Tick is the
+// current tick of the HAKeeper which can be used as the timestamp of the
+// heartbeat.
+func (s *CNState) Update(hb pb.CNStoreHeartbeat, tick uint64) {
+	storeInfo, ok := s.Stores[hb.UUID]
+	if !ok {
+		storeInfo = CNStoreInfo{}
+	}
+	storeInfo.Tick = tick
+	storeInfo.SQLAddress = hb.SQLAddress
+	storeInfo.Labels = hb.Labels
+	storeInfo.Version = hb.Version
+	storeInfo.Load = hb.Load
+	s.Stores[hb.UUID] = storeInfo
+}
+
+// HasLabels returns true if the CN store has all the specified labels.
+func (m CNStoreInfo) HasLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if value, ok := m.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
 // NewLogState creates a new LogState.
 func NewLogState() LogState {
 	return LogState{
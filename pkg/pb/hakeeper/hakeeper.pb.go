@@ -33,6 +33,8 @@ const (
 	RemoveNode ConfigChangeType = 1
 	StartNode  ConfigChangeType = 2
 	StopNode   ConfigChangeType = 3
+	// TransferLeader transfers the leadership of the Log shard to the replica.
+	TransferLeader ConfigChangeType = 4
 )
 
 var ConfigChangeType_name = map[int32]string{
@@ -40,13 +42,15 @@ var ConfigChangeType_name = map[int32]string{
 	1: "RemoveNode",
 	2: "StartNode",
 	3: "StopNode",
+	4: "TransferLeader",
 }
 
 var ConfigChangeType_value = map[string]int32{
-	"AddNode":    0,
-	"RemoveNode": 1,
-	"StartNode":  2,
-	"StopNode":   3,
+	"AddNode":        0,
+	"RemoveNode":     1,
+	"StartNode":      2,
+	"StopNode":       3,
+	"TransferLeader": 4,
 }
 
 func (x ConfigChangeType) String() string {
@@ -63,16 +67,19 @@ type ServiceType int32
 const (
 	LogService ServiceType = 0
 	DnService  ServiceType = 1
+	CnService  ServiceType = 2
 )
 
 var ServiceType_name = map[int32]string{
 	0: "LogService",
 	1: "DnService",
+	2: "CnService",
 }
 
 var ServiceType_value = map[string]int32{
 	"LogService": 0,
 	"DnService":  1,
+	"CnService":  2,
 }
 
 func (x ServiceType) String() string {
@@ -83,6 +90,39 @@ func (ServiceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5e1506f3aa5330eb, []int{1}
 }
 
+// ClusterInfo provides a global view of all shards in the cluster. It
+// describes the logical sharding of the system, rather than physical
+// distribution of all replicas that belong to those shards.
+// StoreState is the administrative state of a Log or DN store.
+type StoreState int32
+
+const (
+	// StoreActive means the store can host shard replicas.
+	StoreActive StoreState = 0
+	// StoreDraining means the store is going down for planned maintenance,
+	// replicas on it are moved to other stores and no new replica is placed on
+	// it.
+	StoreDraining StoreState = 1
+)
+
+var StoreState_name = map[int32]string{
+	0: "StoreActive",
+	1: "StoreDraining",
+}
+
+var StoreState_value = map[string]int32{
+	"StoreActive":   0,
+	"StoreDraining": 1,
+}
+
+func (x StoreState) String() string {
+	return proto.EnumName(StoreState_name, int32(x))
+}
+
+func (StoreState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5e1506f3aa5330eb, []int{2}
+}
+
 // Replica of the shard
 type Replica struct {
 	ShardID              uint64   `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
@@ -323,6 +363,71 @@ func (m *CommandBatch) GetCommands() []ScheduleCommand {
 }
 
 // DNStoreInfo contins information on a list of shards.
+// Operator is a manually submitted operation. It is kept by the HAKeeper
+// until all its steps are finished or it is canceled.
+type Operator struct {
+	ID                   uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Brief                string            `protobuf:"bytes,2,opt,name=Brief,proto3" json:"Brief,omitempty"`
+	Steps                []ScheduleCommand `protobuf:"bytes,3,rep,name=Steps,proto3" json:"Steps"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Operator) Reset()         { *m = Operator{} }
+func (m *Operator) String() string { return proto.CompactTextString(m) }
+func (*Operator) ProtoMessage()    {}
+func (*Operator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1506f3aa5330eb, []int{4}
+}
+func (m *Operator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operator.Merge(m, src)
+}
+func (m *Operator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operator proto.InternalMessageInfo
+
+func (m *Operator) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Operator) GetBrief() string {
+	if m != nil {
+		return m.Brief
+	}
+	return ""
+}
+
+func (m *Operator) GetSteps() []ScheduleCommand {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
 type DNStoreInfo struct {
 	Tick                 uint64                   `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
 	Shards               []logservice.DNShardInfo `protobuf:"bytes,2,rep,name=Shards,proto3" json:"Shards"`
@@ -335,7 +440,7 @@ func (m *DNStoreInfo) Reset()         { *m = DNStoreInfo{} }
 func (m *DNStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*DNStoreInfo) ProtoMessage()    {}
 func (*DNStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{4}
+	return fileDescriptor_5e1506f3aa5330eb, []int{5}
 }
 func (m *DNStoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +497,7 @@ func (m *DNState) Reset()         { *m = DNState{} }
 func (m *DNState) String() string { return proto.CompactTextString(m) }
 func (*DNState) ProtoMessage()    {}
 func (*DNState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{5}
+	return fileDescriptor_5e1506f3aa5330eb, []int{6}
 }
 func (m *DNState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,22 +533,151 @@ func (m *DNState) GetStores() map[string]DNStoreInfo {
 	return nil
 }
 
-// ClusterInfo provides a global view of all shards in the cluster. It
-// describes the logical sharding of the system, rather than physical
-// distribution of all replicas that belong to those shards.
+// CNStoreInfo contains information of a CN store.
+type CNStoreInfo struct {
+	Tick                 uint64                 `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
+	SQLAddress           string                 `protobuf:"bytes,2,opt,name=SQLAddress,proto3" json:"SQLAddress,omitempty"`
+	Labels               map[string]string      `protobuf:"bytes,3,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Version              string                 `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	Load                 logservice.CNStoreLoad `protobuf:"bytes,5,opt,name=Load,proto3" json:"Load"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CNStoreInfo) Reset()         { *m = CNStoreInfo{} }
+func (m *CNStoreInfo) String() string { return proto.CompactTextString(m) }
+func (*CNStoreInfo) ProtoMessage()    {}
+func (*CNStoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1506f3aa5330eb, []int{7}
+}
+func (m *CNStoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNStoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNStoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNStoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNStoreInfo.Merge(m, src)
+}
+func (m *CNStoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CNStoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNStoreInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNStoreInfo proto.InternalMessageInfo
+
+func (m *CNStoreInfo) GetTick() uint64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+func (m *CNStoreInfo) GetSQLAddress() string {
+	if m != nil {
+		return m.SQLAddress
+	}
+	return ""
+}
+
+func (m *CNStoreInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CNStoreInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CNStoreInfo) GetLoad() logservice.CNStoreLoad {
+	if m != nil {
+		return m.Load
+	}
+	return logservice.CNStoreLoad{}
+}
+
+// CNState contains all CN details known to the HAKeeper.
+type CNState struct {
+	// Stores is keyed by CN store UUID, it contains details found on each CN
+	// store. Each CNStoreInfo reflects what was last reported by each CN store.
+	Stores               map[string]CNStoreInfo `protobuf:"bytes,1,rep,name=Stores,proto3" json:"Stores" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CNState) Reset()         { *m = CNState{} }
+func (m *CNState) String() string { return proto.CompactTextString(m) }
+func (*CNState) ProtoMessage()    {}
+func (*CNState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1506f3aa5330eb, []int{8}
+}
+func (m *CNState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNState.Merge(m, src)
+}
+func (m *CNState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CNState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNState proto.InternalMessageInfo
+
+func (m *CNState) GetStores() map[string]CNStoreInfo {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
 type ClusterInfo struct {
-	DNShards             []metadata.DNShardRecord  `protobuf:"bytes,1,rep,name=DNShards,proto3" json:"DNShards"`
-	LogShards            []metadata.LogShardRecord `protobuf:"bytes,2,rep,name=LogShards,proto3" json:"LogShards"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	DNShards  []metadata.DNShardRecord  `protobuf:"bytes,1,rep,name=DNShards,proto3" json:"DNShards"`
+	LogShards []metadata.LogShardRecord `protobuf:"bytes,2,rep,name=LogShards,proto3" json:"LogShards"`
+	// StoreStates is keyed by store UUID, stores not listed are active.
+	StoreStates          map[string]StoreState `protobuf:"bytes,3,rep,name=StoreStates,proto3" json:"StoreStates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hakeeper.StoreState"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
 func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
 func (*ClusterInfo) ProtoMessage()    {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{6}
+	return fileDescriptor_5e1506f3aa5330eb, []int{9}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,6 +720,13 @@ func (m *ClusterInfo) GetLogShards() []metadata.LogShardRecord {
 	return nil
 }
 
+func (m *ClusterInfo) GetStoreStates() map[string]StoreState {
+	if m != nil {
+		return m.StoreStates
+	}
+	return nil
+}
+
 // LogStoreInfo contains information of all replicas found on a Log store.
 type LogStoreInfo struct {
 	Tick                 uint64                      `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
@@ -502,7 +743,7 @@ func (m *LogStoreInfo) Reset()         { *m = LogStoreInfo{} }
 func (m *LogStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*LogStoreInfo) ProtoMessage()    {}
 func (*LogStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{7}
+	return fileDescriptor_5e1506f3aa5330eb, []int{10}
 }
 func (m *LogStoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +825,7 @@ func (m *LogState) Reset()         { *m = LogState{} }
 func (m *LogState) String() string { return proto.CompactTextString(m) }
 func (*LogState) ProtoMessage()    {}
 func (*LogState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{8}
+	return fileDescriptor_5e1506f3aa5330eb, []int{11}
 }
 func (m *LogState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,20 +871,26 @@ func (m *LogState) GetStores() map[string]LogStoreInfo {
 // HAKeeperState contains all HAKeeper state required for making schedule
 // commands.
 type HAKeeperState struct {
-	Tick                 uint64      `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
-	ClusterInfo          ClusterInfo `protobuf:"bytes,2,opt,name=ClusterInfo,proto3" json:"ClusterInfo"`
-	DNState              DNState     `protobuf:"bytes,3,opt,name=DNState,proto3" json:"DNState"`
-	LogState             LogState    `protobuf:"bytes,4,opt,name=LogState,proto3" json:"LogState"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Tick        uint64      `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
+	ClusterInfo ClusterInfo `protobuf:"bytes,2,opt,name=ClusterInfo,proto3" json:"ClusterInfo"`
+	DNState     DNState     `protobuf:"bytes,3,opt,name=DNState,proto3" json:"DNState"`
+	LogState    LogState    `protobuf:"bytes,4,opt,name=LogState,proto3" json:"LogState"`
+	CNState     CNState     `protobuf:"bytes,5,opt,name=CNState,proto3" json:"CNState"`
+	// ScheduleCommands is keyed by store UUID, it contains commands pending to
+	// be executed by each store.
+	ScheduleCommands     map[string]CommandBatch `protobuf:"bytes,6,rep,name=ScheduleCommands,proto3" json:"ScheduleCommands" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Operators            map[uint64]Operator     `protobuf:"bytes,7,rep,name=Operators,proto3" json:"Operators" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Initialized          bool                    `protobuf:"varint,8,opt,name=Initialized,proto3" json:"Initialized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *HAKeeperState) Reset()         { *m = HAKeeperState{} }
 func (m *HAKeeperState) String() string { return proto.CompactTextString(m) }
 func (*HAKeeperState) ProtoMessage()    {}
 func (*HAKeeperState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{9}
+	return fileDescriptor_5e1506f3aa5330eb, []int{12}
 }
 func (m *HAKeeperState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,26 +947,64 @@ func (m *HAKeeperState) GetLogState() LogState {
 	return LogState{}
 }
 
+func (m *HAKeeperState) GetCNState() CNState {
+	if m != nil {
+		return m.CNState
+	}
+	return CNState{}
+}
+
+func (m *HAKeeperState) GetScheduleCommands() map[string]CommandBatch {
+	if m != nil {
+		return m.ScheduleCommands
+	}
+	return nil
+}
+
+func (m *HAKeeperState) GetOperators() map[uint64]Operator {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *HAKeeperState) GetInitialized() bool {
+	if m != nil {
+		return m.Initialized
+	}
+	return false
+}
+
 // RSMState contains state maintained by HAKeeper's RSM.
 type RSMState struct {
-	Tick                 uint64                  `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
-	NextID               uint64                  `protobuf:"varint,2,opt,name=NextID,proto3" json:"NextID,omitempty"`
-	Term                 uint64                  `protobuf:"varint,3,opt,name=Term,proto3" json:"Term,omitempty"`
-	ScheduleCommands     map[string]CommandBatch `protobuf:"bytes,4,rep,name=ScheduleCommands,proto3" json:"ScheduleCommands" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LogShards            map[string]uint64       `protobuf:"bytes,5,rep,name=LogShards,proto3" json:"LogShards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	DNState              DNState                 `protobuf:"bytes,6,opt,name=DNState,proto3" json:"DNState"`
-	LogState             LogState                `protobuf:"bytes,7,opt,name=LogState,proto3" json:"LogState"`
-	ClusterInfo          ClusterInfo             `protobuf:"bytes,8,opt,name=ClusterInfo,proto3" json:"ClusterInfo"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Tick             uint64                  `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
+	NextID           uint64                  `protobuf:"varint,2,opt,name=NextID,proto3" json:"NextID,omitempty"`
+	Term             uint64                  `protobuf:"varint,3,opt,name=Term,proto3" json:"Term,omitempty"`
+	ScheduleCommands map[string]CommandBatch `protobuf:"bytes,4,rep,name=ScheduleCommands,proto3" json:"ScheduleCommands" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LogShards        map[string]uint64       `protobuf:"bytes,5,rep,name=LogShards,proto3" json:"LogShards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DNState          DNState                 `protobuf:"bytes,6,opt,name=DNState,proto3" json:"DNState"`
+	LogState         LogState                `protobuf:"bytes,7,opt,name=LogState,proto3" json:"LogState"`
+	ClusterInfo      ClusterInfo             `protobuf:"bytes,8,opt,name=ClusterInfo,proto3" json:"ClusterInfo"`
+	CNState          CNState                 `protobuf:"bytes,9,opt,name=CNState,proto3" json:"CNState"`
+	// Operators is keyed by operator ID, it contains manually submitted
+	// operators that are not finished yet.
+	Operators map[uint64]Operator `protobuf:"bytes,10,rep,name=Operators,proto3" json:"Operators" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Initialized indicates whether the initial cluster info described by the
+	// bootstrap config has been applied.
+	Initialized bool `protobuf:"varint,11,opt,name=Initialized,proto3" json:"Initialized,omitempty"`
+	// TSOLimit is the upper bound of the physical time of the timestamps handed
+	// out by the TSO running on the HAKeeper leader.
+	TSOLimit             int64    `protobuf:"varint,12,opt,name=TSOLimit,proto3" json:"TSOLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RSMState) Reset()         { *m = RSMState{} }
 func (m *RSMState) String() string { return proto.CompactTextString(m) }
 func (*RSMState) ProtoMessage()    {}
 func (*RSMState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5e1506f3aa5330eb, []int{10}
+	return fileDescriptor_5e1506f3aa5330eb, []int{13}
 }
 func (m *RSMState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,87 +1089,146 @@ func (m *RSMState) GetClusterInfo() ClusterInfo {
 	return ClusterInfo{}
 }
 
+func (m *RSMState) GetCNState() CNState {
+	if m != nil {
+		return m.CNState
+	}
+	return CNState{}
+}
+
+func (m *RSMState) GetOperators() map[uint64]Operator {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func (m *RSMState) GetInitialized() bool {
+	if m != nil {
+		return m.Initialized
+	}
+	return false
+}
+
+func (m *RSMState) GetTSOLimit() int64 {
+	if m != nil {
+		return m.TSOLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("hakeeper.ConfigChangeType", ConfigChangeType_name, ConfigChangeType_value)
 	proto.RegisterEnum("hakeeper.ServiceType", ServiceType_name, ServiceType_value)
+	proto.RegisterEnum("hakeeper.StoreState", StoreState_name, StoreState_value)
 	proto.RegisterType((*Replica)(nil), "hakeeper.Replica")
 	proto.RegisterType((*ConfigChange)(nil), "hakeeper.ConfigChange")
 	proto.RegisterType((*ScheduleCommand)(nil), "hakeeper.ScheduleCommand")
 	proto.RegisterType((*CommandBatch)(nil), "hakeeper.CommandBatch")
+	proto.RegisterType((*Operator)(nil), "hakeeper.Operator")
 	proto.RegisterType((*DNStoreInfo)(nil), "hakeeper.DNStoreInfo")
 	proto.RegisterType((*DNState)(nil), "hakeeper.DNState")
 	proto.RegisterMapType((map[string]DNStoreInfo)(nil), "hakeeper.DNState.StoresEntry")
+	proto.RegisterType((*CNStoreInfo)(nil), "hakeeper.CNStoreInfo")
+	proto.RegisterMapType((map[string]string)(nil), "hakeeper.CNStoreInfo.LabelsEntry")
+	proto.RegisterType((*CNState)(nil), "hakeeper.CNState")
+	proto.RegisterMapType((map[string]CNStoreInfo)(nil), "hakeeper.CNState.StoresEntry")
 	proto.RegisterType((*ClusterInfo)(nil), "hakeeper.ClusterInfo")
+	proto.RegisterMapType((map[string]StoreState)(nil), "hakeeper.ClusterInfo.StoreStatesEntry")
 	proto.RegisterType((*LogStoreInfo)(nil), "hakeeper.LogStoreInfo")
 	proto.RegisterType((*LogState)(nil), "hakeeper.LogState")
 	proto.RegisterMapType((map[uint64]logservice.LogShardInfo)(nil), "hakeeper.LogState.ShardsEntry")
 	proto.RegisterMapType((map[string]LogStoreInfo)(nil), "hakeeper.LogState.StoresEntry")
 	proto.RegisterType((*HAKeeperState)(nil), "hakeeper.HAKeeperState")
+	proto.RegisterMapType((map[uint64]Operator)(nil), "hakeeper.HAKeeperState.OperatorsEntry")
+	proto.RegisterMapType((map[string]CommandBatch)(nil), "hakeeper.HAKeeperState.ScheduleCommandsEntry")
 	proto.RegisterType((*RSMState)(nil), "hakeeper.RSMState")
 	proto.RegisterMapType((map[string]uint64)(nil), "hakeeper.RSMState.LogShardsEntry")
+	proto.RegisterMapType((map[uint64]Operator)(nil), "hakeeper.RSMState.OperatorsEntry")
 	proto.RegisterMapType((map[string]CommandBatch)(nil), "hakeeper.RSMState.ScheduleCommandsEntry")
 }
 
 func init() { proto.RegisterFile("hakeeper.proto", fileDescriptor_5e1506f3aa5330eb) }
 
 var fileDescriptor_5e1506f3aa5330eb = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x71, 0x8e, 0xdb, 0x10, 0x46, 0xdb, 0xae, 0x89, 0x20, 0x14, 0x0b, 0xa1,
-	0x6a, 0x59, 0xbc, 0xda, 0x00, 0x02, 0x76, 0x83, 0xa0, 0x4d, 0x56, 0x50, 0x51, 0x22, 0xb0, 0xb7,
-	0x02, 0xc1, 0x05, 0x72, 0xed, 0x89, 0x13, 0xe5, 0x67, 0x22, 0xdb, 0xa9, 0xe8, 0x05, 0xb7, 0x3c,
-	0x03, 0x97, 0x5c, 0x73, 0xc1, 0x73, 0x54, 0xe2, 0xa6, 0x4f, 0x80, 0xa0, 0x4f, 0x82, 0x7c, 0x3c,
-	0x63, 0xcf, 0xa4, 0x29, 0xd0, 0xbd, 0xf3, 0x99, 0xf3, 0x9d, 0x6f, 0xce, 0xcf, 0x37, 0x33, 0x86,
-	0xe6, 0xd8, 0x9b, 0x52, 0xba, 0xa4, 0x91, 0xbd, 0x8c, 0x58, 0xc2, 0x88, 0x2e, 0xec, 0xf6, 0x3b,
-	0xe1, 0x24, 0x19, 0xaf, 0xce, 0x6c, 0x9f, 0xcd, 0x1f, 0x85, 0x2c, 0x64, 0x8f, 0x10, 0x70, 0xb6,
-	0x1a, 0xa1, 0x85, 0x06, 0x7e, 0x65, 0x81, 0xed, 0xd6, 0x8c, 0x85, 0x31, 0x8d, 0xce, 0x27, 0x3e,
-	0xe5, 0x2b, 0xcd, 0x39, 0x4d, 0xbc, 0xc0, 0x4b, 0xbc, 0xcc, 0xb6, 0xbe, 0x81, 0xba, 0x43, 0x97,
-	0xb3, 0x89, 0xef, 0x11, 0x13, 0xea, 0xee, 0xd8, 0x8b, 0x82, 0xe3, 0x81, 0xa9, 0xed, 0x6b, 0x07,
-	0x55, 0x47, 0x98, 0xe4, 0x55, 0x68, 0x70, 0xd0, 0xf1, 0xc0, 0x2c, 0xa3, 0xaf, 0x58, 0x20, 0xf7,
-	0x60, 0xeb, 0xd9, 0x92, 0xf9, 0x63, 0xb3, 0x82, 0x9e, 0xcc, 0xb0, 0x7e, 0x82, 0xed, 0x3e, 0x5b,
-	0x8c, 0x26, 0x61, 0x7f, 0xec, 0x2d, 0x42, 0x4a, 0x1e, 0xe7, 0x1b, 0x21, 0xbb, 0xd1, 0x7d, 0xd9,
-	0xce, 0xab, 0xe4, 0x8e, 0xa3, 0xea, 0xe5, 0x9f, 0xaf, 0x97, 0x9c, 0x3c, 0xa1, 0x27, 0x00, 0x59,
-	0xf0, 0xf3, 0x8b, 0x25, 0xc5, 0x7d, 0x9b, 0xdd, 0x76, 0x11, 0x25, 0xd3, 0xa7, 0x08, 0x47, 0x42,
-	0x5b, 0xbf, 0x6a, 0xf0, 0x92, 0xeb, 0x8f, 0x69, 0xb0, 0x9a, 0xd1, 0x3e, 0x9b, 0xcf, 0xbd, 0x45,
-	0x40, 0x08, 0x54, 0x4f, 0x4f, 0x79, 0x75, 0x0d, 0x07, 0xbf, 0xc9, 0xa7, 0x6a, 0x9a, 0xb8, 0x8b,
-	0xd1, 0xdd, 0xdb, 0xbc, 0x0b, 0x4f, 0x50, 0x2d, 0xec, 0x03, 0x30, 0xdc, 0xac, 0xc5, 0x98, 0x66,
-	0x05, 0xd3, 0xdc, 0x2d, 0x08, 0x24, 0xa7, 0x23, 0x23, 0xad, 0x1f, 0xd2, 0xad, 0x31, 0xb3, 0x23,
-	0x2f, 0xf1, 0xc7, 0x69, 0x7a, 0xcf, 0x69, 0x34, 0xe7, 0xcd, 0xc7, 0x6f, 0xf2, 0x14, 0x74, 0x8e,
-	0x89, 0xcd, 0xf2, 0x7e, 0xe5, 0xc0, 0xe8, 0xbe, 0x22, 0x31, 0xab, 0xf5, 0xf1, 0xec, 0xf2, 0x00,
-	0xeb, 0x5b, 0x30, 0x06, 0x43, 0x37, 0x61, 0x11, 0x3d, 0x5e, 0x8c, 0x18, 0xf2, 0x4f, 0xfc, 0x69,
-	0xce, 0x3f, 0xf1, 0xa7, 0xe4, 0x7d, 0xa8, 0xe1, 0x90, 0x05, 0xfb, 0x7d, 0x5b, 0x52, 0xcc, 0x60,
-	0x98, 0x09, 0x60, 0x31, 0x62, 0x9c, 0x9b, 0x83, 0xad, 0x5f, 0x34, 0xa8, 0xa7, 0xd4, 0x5e, 0x42,
-	0xc9, 0x53, 0xa8, 0xe1, 0x1e, 0xb1, 0xa9, 0x21, 0xc5, 0x6b, 0x45, 0x82, 0x1c, 0x62, 0x67, 0xfe,
-	0x67, 0x8b, 0x24, 0xba, 0xc8, 0x89, 0x70, 0xa9, 0xfd, 0x15, 0x18, 0x92, 0x93, 0xb4, 0xa0, 0x32,
-	0xa5, 0x17, 0x7c, 0x40, 0xe9, 0x27, 0x79, 0x1b, 0xb6, 0xce, 0xbd, 0xd9, 0x4a, 0x0c, 0x66, 0x57,
-	0x25, 0xe7, 0xa5, 0x39, 0x19, 0xe6, 0x49, 0xf9, 0x43, 0xcd, 0xfa, 0x59, 0x03, 0xa3, 0x3f, 0x5b,
-	0xc5, 0x09, 0x8d, 0xb0, 0xea, 0x8f, 0x40, 0xe7, 0x75, 0x88, 0x04, 0xef, 0xdb, 0xf9, 0x19, 0xe0,
-	0x1e, 0x87, 0xfa, 0x2c, 0xca, 0xfb, 0x27, 0xe0, 0xa4, 0x07, 0x8d, 0x13, 0x16, 0x2a, 0xfd, 0x31,
-	0x8b, 0x58, 0xe1, 0x52, 0x82, 0x8b, 0x00, 0xeb, 0x4a, 0x83, 0xed, 0xd4, 0xfa, 0xd7, 0xfe, 0xef,
-	0x83, 0xe1, 0x78, 0xa3, 0xe4, 0x30, 0x08, 0x22, 0x1a, 0xc7, 0x58, 0x64, 0xc3, 0x91, 0x97, 0xc8,
-	0x5b, 0xd0, 0xe4, 0xa2, 0x11, 0xa0, 0x0a, 0x82, 0xd6, 0x56, 0xc9, 0x9b, 0xb0, 0xf3, 0x19, 0x8b,
-	0xe3, 0xc9, 0x52, 0xc0, 0xaa, 0x08, 0x53, 0x17, 0x49, 0x0f, 0x74, 0x7e, 0xba, 0x62, 0x73, 0x0b,
-	0x2b, 0x6a, 0xcb, 0x13, 0x3f, 0x61, 0xa1, 0x38, 0xd7, 0xc5, 0xd0, 0xf3, 0x08, 0xeb, 0xf7, 0x32,
-	0xe8, 0x58, 0x52, 0x3a, 0xf7, 0x5e, 0x2e, 0x9d, 0xac, 0xad, 0x9d, 0x62, 0x34, 0x02, 0x63, 0x67,
-	0x00, 0x75, 0xf0, 0xa2, 0xb7, 0x42, 0x35, 0xe5, 0xdb, 0xa3, 0x6f, 0x95, 0x8d, 0x0b, 0x86, 0x44,
-	0x2d, 0xcb, 0xa6, 0x9a, 0xc9, 0xc6, 0x56, 0x65, 0x63, 0xae, 0x15, 0x99, 0xeb, 0x5a, 0x52, 0x4e,
-	0xfb, 0xeb, 0xff, 0xd2, 0xe2, 0x43, 0x95, 0x74, 0x6f, 0x2d, 0xe5, 0x0d, 0x62, 0xfc, 0x43, 0x83,
-	0x9d, 0xcf, 0x0f, 0xbf, 0x40, 0x50, 0xd6, 0xb5, 0x4d, 0x22, 0xf8, 0x58, 0x51, 0xec, 0x4d, 0xa5,
-	0x4b, 0x4e, 0xde, 0x07, 0x45, 0xe1, 0x8f, 0xf3, 0xb3, 0x88, 0xd2, 0x50, 0x6e, 0x56, 0xee, 0x10,
-	0x37, 0xab, 0x38, 0xb3, 0xef, 0x15, 0x73, 0x44, 0x9d, 0x18, 0x5d, 0x72, 0xb3, 0xff, 0x62, 0xfc,
-	0xc2, 0xb6, 0x7e, 0xab, 0x82, 0xee, 0xb8, 0x5f, 0xde, 0x5e, 0xc8, 0x1e, 0xd4, 0x86, 0xf4, 0xc7,
-	0x24, 0x7f, 0x24, 0xb8, 0x95, 0xdf, 0x6c, 0x15, 0xe9, 0x66, 0xfb, 0x0e, 0x5a, 0x6b, 0xf7, 0x57,
-	0x2a, 0xd9, 0x54, 0x0a, 0x07, 0xd2, 0xc3, 0xc0, 0x77, 0x5b, 0xbf, 0xea, 0x14, 0x51, 0xdc, 0xe0,
-	0x21, 0x9f, 0xc8, 0x07, 0x37, 0x93, 0xf9, 0x1b, 0x1b, 0x48, 0x73, 0x0c, 0xb2, 0x49, 0x67, 0x57,
-	0x6e, 0x69, 0xed, 0x05, 0x5a, 0x5a, 0xff, 0xbf, 0x2d, 0x5d, 0x1f, 0xbd, 0x7e, 0xb7, 0xd1, 0xb7,
-	0xbf, 0x87, 0xdd, 0x8d, 0x9d, 0xb9, 0x93, 0x78, 0xe5, 0x47, 0x48, 0x3e, 0x0f, 0x3d, 0x68, 0xaa,
-	0x1d, 0xda, 0xc0, 0x7a, 0x4f, 0x66, 0xad, 0x4a, 0xd1, 0x0f, 0x4e, 0xa0, 0xb5, 0xfe, 0x40, 0x13,
-	0x03, 0xea, 0x87, 0x41, 0x30, 0x64, 0x01, 0x6d, 0x95, 0x48, 0x13, 0xc0, 0xa1, 0x73, 0x76, 0x4e,
-	0xd1, 0xd6, 0xc8, 0x0e, 0x34, 0xdc, 0xc4, 0x8b, 0x12, 0x34, 0xcb, 0x64, 0x1b, 0x74, 0x37, 0x61,
-	0x4b, 0xb4, 0x2a, 0x0f, 0x1e, 0x2a, 0x8f, 0x6c, 0x1a, 0x9b, 0xa6, 0x96, 0xad, 0xb4, 0x4a, 0x69,
-	0xec, 0x60, 0x21, 0x4c, 0xed, 0xa8, 0x75, 0xf5, 0x77, 0xa7, 0x74, 0x79, 0xdd, 0xd1, 0xae, 0xae,
-	0x3b, 0xda, 0x5f, 0xd7, 0x1d, 0xed, 0xac, 0x86, 0x7f, 0x3b, 0xef, 0xfe, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0x72, 0x4d, 0x54, 0x5a, 0x09, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xf3, 0xd7, 0x79, 0x6e, 0xb3, 0xd9, 0x51, 0xdb, 0x35, 0x11, 0x84, 0x10, 0xa1, 0x55,
+	0x54, 0xd8, 0x2c, 0x0d, 0xac, 0xa0, 0xdb, 0x22, 0x68, 0x93, 0x8a, 0x2d, 0x84, 0xee, 0xae, 0xd3,
+	0x05, 0x04, 0x87, 0xc5, 0x8d, 0x27, 0x89, 0xd5, 0xc4, 0x13, 0xd9, 0x6e, 0x45, 0x91, 0xb8, 0xf1,
+	0x21, 0x38, 0x72, 0xe2, 0xc8, 0x89, 0x0f, 0xb1, 0xc7, 0x7e, 0x02, 0x04, 0xfd, 0x1c, 0x1c, 0x90,
+	0xc7, 0x33, 0xe3, 0x19, 0x37, 0xa5, 0x2d, 0x52, 0x6f, 0x7e, 0xf3, 0xfe, 0xcc, 0x7b, 0xef, 0xf7,
+	0x7b, 0x33, 0x63, 0x28, 0x8f, 0xed, 0x23, 0x8c, 0x67, 0xd8, 0x6f, 0xcd, 0x7c, 0x12, 0x12, 0xa4,
+	0x73, 0xb9, 0xfa, 0x60, 0xe4, 0x86, 0xe3, 0xe3, 0xc3, 0xd6, 0x80, 0x4c, 0x1f, 0x8e, 0xc8, 0x88,
+	0x3c, 0xa4, 0x06, 0x87, 0xc7, 0x43, 0x2a, 0x51, 0x81, 0x7e, 0xc5, 0x8e, 0xd5, 0xca, 0x84, 0x8c,
+	0x02, 0xec, 0x9f, 0xb8, 0x03, 0xcc, 0x56, 0xca, 0x53, 0x1c, 0xda, 0x8e, 0x1d, 0xda, 0xb1, 0xdc,
+	0xf8, 0x1a, 0x8a, 0x16, 0x9e, 0x4d, 0xdc, 0x81, 0x8d, 0x4c, 0x28, 0xf6, 0xc7, 0xb6, 0xef, 0xec,
+	0x75, 0x4d, 0xad, 0xae, 0x35, 0x73, 0x16, 0x17, 0xd1, 0xeb, 0x50, 0x62, 0x46, 0x7b, 0x5d, 0x33,
+	0x43, 0x75, 0xc9, 0x02, 0x5a, 0x86, 0xfc, 0xee, 0x8c, 0x0c, 0xc6, 0x66, 0x96, 0x6a, 0x62, 0xa1,
+	0xf1, 0x13, 0x2c, 0x76, 0x88, 0x37, 0x74, 0x47, 0x9d, 0xb1, 0xed, 0x8d, 0x30, 0x5a, 0x17, 0x1b,
+	0xd1, 0xe8, 0x46, 0xfb, 0x6e, 0x4b, 0x54, 0xc9, 0x14, 0x3b, 0xb9, 0x57, 0x7f, 0xbe, 0xb9, 0x60,
+	0x89, 0x84, 0x1e, 0x03, 0xc4, 0xce, 0x07, 0xa7, 0x33, 0x4c, 0xf7, 0x2d, 0xb7, 0xab, 0x89, 0x97,
+	0x1c, 0x3e, 0xb2, 0xb0, 0x24, 0xeb, 0xc6, 0xaf, 0x1a, 0xdc, 0xe9, 0x0f, 0xc6, 0xd8, 0x39, 0x9e,
+	0xe0, 0x0e, 0x99, 0x4e, 0x6d, 0xcf, 0x41, 0x08, 0x72, 0x2f, 0x5e, 0xb0, 0xea, 0x4a, 0x16, 0xfd,
+	0x46, 0x9f, 0xaa, 0x69, 0xd2, 0x5d, 0x8c, 0xf6, 0xea, 0xfc, 0x5d, 0x58, 0x82, 0x6a, 0x61, 0x1f,
+	0x82, 0xd1, 0x8f, 0x5b, 0x4c, 0xd3, 0xcc, 0xd2, 0x34, 0x57, 0x92, 0x00, 0x92, 0xd2, 0x92, 0x2d,
+	0x1b, 0x2f, 0x61, 0x91, 0x65, 0xb6, 0x63, 0x87, 0x83, 0x71, 0x94, 0xde, 0x01, 0xf6, 0xa7, 0xac,
+	0xf9, 0xf4, 0x1b, 0x6d, 0x82, 0xce, 0x6c, 0x02, 0x33, 0x53, 0xcf, 0x36, 0x8d, 0xf6, 0x6b, 0x52,
+	0x64, 0xb5, 0x3e, 0x96, 0x9d, 0x70, 0x68, 0x8c, 0x40, 0x7f, 0x3a, 0xc3, 0xbe, 0x1d, 0x12, 0x1f,
+	0x95, 0x21, 0x23, 0x70, 0xcd, 0xc4, 0xa0, 0xed, 0xf8, 0x2e, 0x1e, 0xd2, 0x82, 0x4b, 0x56, 0x2c,
+	0xa0, 0x47, 0x90, 0xef, 0x87, 0x78, 0x16, 0x98, 0xd9, 0xeb, 0xed, 0x15, 0x5b, 0x37, 0xbe, 0x01,
+	0xa3, 0xbb, 0xdf, 0x0f, 0x89, 0x8f, 0xf7, 0xbc, 0x21, 0xa1, 0x85, 0xb8, 0x83, 0x23, 0x51, 0x88,
+	0x3b, 0x38, 0x42, 0x8f, 0xa0, 0x40, 0xd9, 0xc4, 0xcb, 0xb8, 0xd7, 0x92, 0xa8, 0xd9, 0xdd, 0x8f,
+	0x99, 0xe6, 0x0d, 0x09, 0x0b, 0xcc, 0x8c, 0x1b, 0xbf, 0x68, 0x50, 0x8c, 0x42, 0xdb, 0x21, 0x46,
+	0x9b, 0x50, 0xa0, 0x7b, 0x04, 0xa6, 0x46, 0x43, 0xbc, 0x91, 0x64, 0xc7, 0x4c, 0x5a, 0xb1, 0x7e,
+	0xd7, 0x0b, 0xfd, 0x53, 0x11, 0x88, 0x2e, 0x55, 0x9f, 0x81, 0x21, 0x29, 0x51, 0x05, 0xb2, 0x47,
+	0xf8, 0x94, 0x31, 0x21, 0xfa, 0x44, 0xef, 0x40, 0xfe, 0xc4, 0x9e, 0x1c, 0x73, 0x06, 0xac, 0xa8,
+	0xc1, 0x59, 0x69, 0x56, 0x6c, 0xf3, 0x38, 0xf3, 0x91, 0xd6, 0xf8, 0x39, 0x03, 0x46, 0xe7, 0x8a,
+	0xaa, 0x6b, 0x00, 0xfd, 0xe7, 0xbd, 0x6d, 0xc7, 0xf1, 0x71, 0x10, 0xb0, 0x56, 0x4b, 0x2b, 0x68,
+	0x03, 0x0a, 0x3d, 0xfb, 0x10, 0x4f, 0x78, 0xc3, 0xdf, 0x92, 0x78, 0x97, 0x84, 0x6e, 0xc5, 0x36,
+	0x34, 0x73, 0x8b, 0x39, 0x44, 0xd3, 0xfa, 0x15, 0xf6, 0x03, 0x97, 0x78, 0x66, 0x8e, 0xc6, 0xe5,
+	0x22, 0x5a, 0x87, 0x5c, 0x8f, 0xd8, 0x8e, 0x99, 0xaf, 0x6b, 0xe9, 0x46, 0xb3, 0xa0, 0x91, 0x9a,
+	0xf5, 0x87, 0x9a, 0x56, 0x37, 0xc0, 0x90, 0xf6, 0x98, 0xd3, 0x9d, 0x65, 0xb9, 0x3b, 0x25, 0xb9,
+	0x0d, 0x11, 0x42, 0x9d, 0xab, 0x11, 0xea, 0xdc, 0x26, 0x42, 0x9d, 0xf9, 0x08, 0xfd, 0x16, 0x21,
+	0x34, 0x39, 0x0e, 0x42, 0xec, 0x53, 0x84, 0x36, 0x40, 0x67, 0x4c, 0xe3, 0x09, 0xde, 0x6b, 0x89,
+	0xe3, 0x90, 0x69, 0x2c, 0x3c, 0x20, 0xbe, 0x18, 0x25, 0x6e, 0x8e, 0xb6, 0xa0, 0xd4, 0x23, 0x23,
+	0x85, 0xc1, 0x66, 0xe2, 0xcb, 0x55, 0x8a, 0x73, 0xe2, 0x80, 0x9e, 0xb0, 0xd2, 0x68, 0x0b, 0x38,
+	0xd6, 0xf7, 0xa5, 0xfc, 0x93, 0x24, 0x5b, 0x92, 0x61, 0x0c, 0xb8, 0xec, 0x5a, 0x3d, 0x80, 0x4a,
+	0xda, 0x60, 0x4e, 0xa7, 0xd6, 0xe4, 0x4e, 0x95, 0xdb, 0xcb, 0xd2, 0x18, 0x0b, 0x67, 0xb9, 0x51,
+	0x67, 0x1a, 0x2c, 0x46, 0xd9, 0xfe, 0x27, 0x97, 0xeb, 0x60, 0x58, 0xf6, 0x30, 0x54, 0xc9, 0x2c,
+	0x2f, 0xa1, 0xfb, 0x50, 0x66, 0xe7, 0x1b, 0x37, 0xca, 0x52, 0xa3, 0xd4, 0x2a, 0x7a, 0x1b, 0x96,
+	0x3e, 0x23, 0x41, 0xe0, 0xce, 0xb8, 0x59, 0x4c, 0x60, 0x75, 0x11, 0x6d, 0x81, 0xce, 0x2e, 0x82,
+	0xc0, 0xcc, 0xd3, 0x8e, 0x55, 0x65, 0x2a, 0xf7, 0xc8, 0x88, 0x5f, 0x41, 0xc9, 0xb1, 0x21, 0x3c,
+	0x1a, 0xbf, 0x67, 0x40, 0xa7, 0x25, 0x45, 0xbc, 0xdc, 0x82, 0x82, 0x02, 0x7b, 0x2d, 0x69, 0x08,
+	0xb7, 0x69, 0xc5, 0x06, 0x2a, 0x31, 0x39, 0xf6, 0x9c, 0xd5, 0x99, 0xcb, 0xbd, 0x2f, 0xa5, 0x75,
+	0x1f, 0x0c, 0x29, 0xb4, 0x0c, 0x56, 0x2e, 0x06, 0xab, 0xa5, 0xd2, 0xda, 0x4c, 0x15, 0x29, 0x4e,
+	0x46, 0x09, 0xb0, 0xea, 0xf3, 0xab, 0x66, 0xe5, 0x5d, 0x35, 0xe8, 0x6a, 0x2a, 0xe5, 0x39, 0xc3,
+	0xf2, 0x4f, 0x0e, 0x96, 0x9e, 0x6c, 0x7f, 0x41, 0x8d, 0xe2, 0xae, 0xcd, 0x23, 0xc1, 0xc7, 0xca,
+	0x44, 0xcd, 0x99, 0xc4, 0x44, 0xc9, 0xfa, 0xa0, 0x4c, 0xe0, 0xba, 0x38, 0xcd, 0xcd, 0x6c, 0xfa,
+	0x11, 0xc0, 0x14, 0xfc, 0x11, 0xc0, 0x44, 0xf4, 0x41, 0x82, 0x23, 0xe5, 0x89, 0xd1, 0x46, 0x17,
+	0xfb, 0xcf, 0xe1, 0x17, 0x88, 0xaf, 0x8b, 0x43, 0xc9, 0xcc, 0xa7, 0x37, 0xea, 0xa8, 0x1b, 0x31,
+	0x11, 0xbd, 0x84, 0x4a, 0xea, 0x92, 0x0b, 0xcc, 0x02, 0x05, 0xfc, 0x41, 0xe2, 0xab, 0x74, 0x28,
+	0x7d, 0x29, 0x2a, 0xf8, 0x5f, 0x08, 0x86, 0x3e, 0x87, 0x12, 0xbf, 0x8e, 0x03, 0xb3, 0x98, 0x3e,
+	0x03, 0xd4, 0xc8, 0xc2, 0x50, 0x0e, 0x99, 0xb8, 0x47, 0xc3, 0xb8, 0xe7, 0xb9, 0xa1, 0x6b, 0x4f,
+	0xdc, 0x1f, 0xb1, 0x63, 0xea, 0x75, 0xad, 0xa9, 0x5b, 0xf2, 0x52, 0xf5, 0x3b, 0x58, 0x99, 0x9b,
+	0xde, 0x8d, 0xc8, 0x22, 0xbf, 0x4f, 0x64, 0xfe, 0x3d, 0x83, 0xb2, 0x9a, 0xe1, 0x1c, 0x5e, 0x37,
+	0xd5, 0xa8, 0x12, 0x6a, 0xdc, 0x55, 0xa6, 0xdf, 0x1f, 0x05, 0xd0, 0xad, 0xfe, 0x97, 0x97, 0x33,
+	0x6f, 0x15, 0x0a, 0xfb, 0xf8, 0x87, 0x50, 0x3c, 0x40, 0x99, 0x24, 0x5e, 0x4d, 0x59, 0xe9, 0xd5,
+	0xf4, 0xed, 0x1c, 0x28, 0x73, 0xb4, 0xe1, 0xcd, 0x24, 0x0b, 0xbe, 0xdb, 0x0d, 0x51, 0xfc, 0x44,
+	0xbe, 0x09, 0xf2, 0xe9, 0x5b, 0x5b, 0x04, 0x15, 0x36, 0xf1, 0x21, 0x9e, 0xf8, 0xc8, 0x33, 0x50,
+	0xf8, 0x1f, 0x33, 0x50, 0xbc, 0xf6, 0x0c, 0xa4, 0x66, 0x55, 0xbf, 0xf9, 0xac, 0xf2, 0x11, 0x2a,
+	0x5d, 0x73, 0x84, 0x76, 0x65, 0x86, 0xc3, 0xa5, 0xbd, 0xb9, 0x21, 0xb9, 0x8d, 0x0b, 0xe4, 0x46,
+	0x55, 0xd0, 0x0f, 0xfa, 0x4f, 0x7b, 0xee, 0xd4, 0x0d, 0xcd, 0xc5, 0xba, 0xd6, 0xcc, 0x5a, 0x42,
+	0xbe, 0x5d, 0xe2, 0x6f, 0x41, 0x59, 0x45, 0xf6, 0xaa, 0xb7, 0x52, 0xee, 0x56, 0xc7, 0x66, 0xed,
+	0x7b, 0xa8, 0xa4, 0x7f, 0x83, 0x90, 0x01, 0xc5, 0x6d, 0xc7, 0xd9, 0x27, 0x0e, 0xae, 0x2c, 0xa0,
+	0x32, 0x80, 0x85, 0xa7, 0xe4, 0x04, 0x53, 0x59, 0x43, 0x4b, 0x50, 0xea, 0x87, 0xb6, 0x1f, 0x52,
+	0x31, 0x83, 0x16, 0x41, 0xef, 0x87, 0x64, 0x46, 0xa5, 0x2c, 0x42, 0x50, 0x3e, 0xf0, 0x6d, 0x2f,
+	0x18, 0x62, 0xbf, 0x87, 0x6d, 0x07, 0xfb, 0x95, 0xdc, 0xda, 0xa6, 0xf2, 0x7b, 0x13, 0xc5, 0x8b,
+	0x1a, 0x10, 0xaf, 0x54, 0x16, 0xa2, 0x78, 0x5d, 0x8f, 0x8b, 0x34, 0x7c, 0x47, 0x88, 0x99, 0xb5,
+	0xf7, 0x00, 0x92, 0x17, 0x07, 0xba, 0xc3, 0x6e, 0xad, 0xed, 0x41, 0xe8, 0x9e, 0x44, 0xce, 0x77,
+	0x61, 0x89, 0x2e, 0x74, 0x7d, 0xdb, 0xf5, 0x5c, 0x6f, 0x54, 0xd1, 0x76, 0x2a, 0x67, 0x7f, 0xd7,
+	0x16, 0x5e, 0x9d, 0xd7, 0xb4, 0xb3, 0xf3, 0x9a, 0xf6, 0xd7, 0x79, 0x4d, 0x3b, 0x2c, 0xd0, 0x1f,
+	0xd5, 0xf7, 0xff, 0x1d, 0x00, 0x01, 0xc9, 0xc0, 0xf9, 0x15, 0x0f, 0x00, 0x00,
 }
 
 func (m *Replica) Marshal() (dAtA []byte, err error) {
@@ -1066,6 +1410,59 @@ func (m *CommandBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Operator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Brief) > 0 {
+		i -= len(m.Brief)
+		copy(dAtA[i:], m.Brief)
+		i = encodeVarintHakeeper(dAtA, i, uint64(len(m.Brief)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintHakeeper(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DNStoreInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1163,7 +1560,7 @@ func (m *DNState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+func (m *CNStoreInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1173,12 +1570,12 @@ func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CNStoreInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1187,17 +1584,160 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.LogShards) > 0 {
-		for iNdEx := len(m.LogShards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHakeeper(dAtA, i, uint64(size))
-			}
-			i--
+	{
+		size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHakeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintHakeeper(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintHakeeper(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHakeeper(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SQLAddress) > 0 {
+		i -= len(m.SQLAddress)
+		copy(dAtA[i:], m.SQLAddress)
+		i = encodeVarintHakeeper(dAtA, i, uint64(len(m.SQLAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tick != 0 {
+		i = encodeVarintHakeeper(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CNState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CNState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stores) > 0 {
+		for k := range m.Stores {
+			v := m.Stores[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHakeeper(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StoreStates) > 0 {
+		for k := range m.StoreStates {
+			v := m.StoreStates[k]
+			baseI := i
+			i = encodeVarintHakeeper(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHakeeper(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LogShards) > 0 {
+		for iNdEx := len(m.LogShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
 		}
 	}
@@ -1382,6 +1922,72 @@ func (m *HAKeeperState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Initialized {
+		i--
+		if m.Initialized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Operators) > 0 {
+		for k := range m.Operators {
+			v := m.Operators[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintHakeeper(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ScheduleCommands) > 0 {
+		for k := range m.ScheduleCommands {
+			v := m.ScheduleCommands[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintHakeeper(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.CNState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHakeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.LogState.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1444,6 +2050,53 @@ func (m *RSMState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TSOLimit != 0 {
+		i = encodeVarintHakeeper(dAtA, i, uint64(m.TSOLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Initialized {
+		i--
+		if m.Initialized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Operators) > 0 {
+		for k := range m.Operators {
+			v := m.Operators[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHakeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintHakeeper(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintHakeeper(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.CNState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHakeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size, err := m.ClusterInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1624,6 +2277,31 @@ func (m *CommandBatch) Size() (n int) {
 	return n
 }
 
+func (m *Operator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovHakeeper(uint64(m.ID))
+	}
+	l = len(m.Brief)
+	if l > 0 {
+		n += 1 + l + sovHakeeper(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovHakeeper(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DNStoreInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1666,22 +2344,84 @@ func (m *DNState) Size() (n int) {
 	return n
 }
 
-func (m *ClusterInfo) Size() (n int) {
+func (m *CNStoreInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.DNShards) > 0 {
-		for _, e := range m.DNShards {
-			l = e.Size()
-			n += 1 + l + sovHakeeper(uint64(l))
-		}
+	if m.Tick != 0 {
+		n += 1 + sovHakeeper(uint64(m.Tick))
 	}
-	if len(m.LogShards) > 0 {
-		for _, e := range m.LogShards {
-			l = e.Size()
-			n += 1 + l + sovHakeeper(uint64(l))
+	l = len(m.SQLAddress)
+	if l > 0 {
+		n += 1 + l + sovHakeeper(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHakeeper(uint64(len(k))) + 1 + len(v) + sovHakeeper(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovHakeeper(uint64(l))
+	}
+	l = m.Load.Size()
+	n += 1 + l + sovHakeeper(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CNState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for k, v := range m.Stores {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovHakeeper(uint64(len(k))) + 1 + l + sovHakeeper(uint64(l))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DNShards) > 0 {
+		for _, e := range m.DNShards {
+			l = e.Size()
+			n += 1 + l + sovHakeeper(uint64(l))
+		}
+	}
+	if len(m.LogShards) > 0 {
+		for _, e := range m.LogShards {
+			l = e.Size()
+			n += 1 + l + sovHakeeper(uint64(l))
+		}
+	}
+	if len(m.StoreStates) > 0 {
+		for k, v := range m.StoreStates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHakeeper(uint64(len(k))) + 1 + sovHakeeper(uint64(v))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -1768,6 +2508,29 @@ func (m *HAKeeperState) Size() (n int) {
 	n += 1 + l + sovHakeeper(uint64(l))
 	l = m.LogState.Size()
 	n += 1 + l + sovHakeeper(uint64(l))
+	l = m.CNState.Size()
+	n += 1 + l + sovHakeeper(uint64(l))
+	if len(m.ScheduleCommands) > 0 {
+		for k, v := range m.ScheduleCommands {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovHakeeper(uint64(len(k))) + 1 + l + sovHakeeper(uint64(l))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Operators) > 0 {
+		for k, v := range m.Operators {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovHakeeper(uint64(k)) + 1 + l + sovHakeeper(uint64(l))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
+		}
+	}
+	if m.Initialized {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1812,6 +2575,23 @@ func (m *RSMState) Size() (n int) {
 	n += 1 + l + sovHakeeper(uint64(l))
 	l = m.ClusterInfo.Size()
 	n += 1 + l + sovHakeeper(uint64(l))
+	l = m.CNState.Size()
+	n += 1 + l + sovHakeeper(uint64(l))
+	if len(m.Operators) > 0 {
+		for k, v := range m.Operators {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovHakeeper(uint64(k)) + 1 + l + sovHakeeper(uint64(l))
+			n += mapEntrySize + 1 + sovHakeeper(uint64(mapEntrySize))
+		}
+	}
+	if m.Initialized {
+		n += 2
+	}
+	if m.TSOLimit != 0 {
+		n += 1 + sovHakeeper(uint64(m.TSOLimit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2274,6 +3054,142 @@ func (m *CommandBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Operator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHakeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brief", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brief = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, ScheduleCommand{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHakeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DNStoreInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2558,7 +3474,7 @@ func (m *DNState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *CNStoreInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2581,17 +3497,36 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CNStoreInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CNStoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQLAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHakeeper
@@ -2601,29 +3536,27 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHakeeper
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHakeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DNShards = append(m.DNShards, metadata.DNShardRecord{})
-			if err := m.DNShards[len(m.DNShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SQLAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2650,148 +3583,107 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogShards = append(m.LogShards, metadata.LogShardRecord{})
-			if err := m.LogShards[len(m.LogShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHakeeper(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthHakeeper
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHakeeper
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogStoreInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
-			}
-			m.Tick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHakeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tick |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHakeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHakeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceAddress = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GossipAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2819,11 +3711,11 @@ func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GossipAddress = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2850,8 +3742,7 @@ func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, logservice.LogReplicaInfo{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2877,7 +3768,7 @@ func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogState) Unmarshal(dAtA []byte) error {
+func (m *CNState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2900,15 +3791,15 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CNState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CNState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2935,11 +3826,11 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shards == nil {
-				m.Shards = make(map[uint64]logservice.LogShardInfo)
+			if m.Stores == nil {
+				m.Stores = make(map[string]CNStoreInfo)
 			}
-			var mapkey uint64
-			mapvalue := &logservice.LogShardInfo{}
+			var mapkey string
+			mapvalue := &CNStoreInfo{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2959,6 +3850,7 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowHakeeper
@@ -2968,11 +3860,24 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
@@ -2999,7 +3904,7 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &logservice.LogShardInfo{}
+					mapvalue = &CNStoreInfo{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -3019,25 +3924,76 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Shards[mapkey] = *mapvalue
+			m.Stores[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHakeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHakeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHakeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
 			if msglen < 0 {
@@ -3050,11 +4006,79 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stores == nil {
-				m.Stores = make(map[string]LogStoreInfo)
+			m.DNShards = append(m.DNShards, metadata.DNShardRecord{})
+			if err := m.DNShards[len(m.DNShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogShards = append(m.LogShards, metadata.LogShardRecord{})
+			if err := m.LogShards[len(m.LogShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoreStates == nil {
+				m.StoreStates = make(map[string]StoreState)
 			}
 			var mapkey string
-			mapvalue := &LogStoreInfo{}
+			var mapvalue StoreState
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -3103,7 +4127,6 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowHakeeper
@@ -3113,26 +4136,11 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= StoreState(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthHakeeper
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthHakeeper
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LogStoreInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipHakeeper(dAtA[iNdEx:])
@@ -3148,7 +4156,7 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Stores[mapkey] = *mapvalue
+			m.StoreStates[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3172,7 +4180,7 @@ func (m *LogState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
+func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,10 +4203,10 @@ func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HAKeeperState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogStoreInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HAKeeperState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogStoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3222,9 +4230,9 @@ func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHakeeper
@@ -3234,28 +4242,91 @@ func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHakeeper
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHakeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClusterInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RaftAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GossipAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3272,25 +4343,767 @@ func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DNState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, logservice.LogReplicaInfo{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHakeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHakeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shards == nil {
+				m.Shards = make(map[uint64]logservice.LogShardInfo)
+			}
+			var mapkey uint64
+			mapvalue := &logservice.LogShardInfo{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &logservice.LogShardInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Shards[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stores == nil {
+				m.Stores = make(map[string]LogStoreInfo)
+			}
+			var mapkey string
+			mapvalue := &LogStoreInfo{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LogStoreInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stores[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHakeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHakeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HAKeeperState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HAKeeperState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClusterInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DNState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CNState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleCommands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleCommands == nil {
+				m.ScheduleCommands = make(map[string]CommandBatch)
+			}
+			var mapkey string
+			mapvalue := &CommandBatch{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CommandBatch{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ScheduleCommands[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operators == nil {
+				m.Operators = make(map[uint64]Operator)
+			}
+			var mapkey uint64
+			mapvalue := &Operator{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Operator{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Operators[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogState", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHakeeper
@@ -3300,25 +5113,12 @@ func (m *HAKeeperState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHakeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LogState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Initialized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHakeeper(dAtA[iNdEx:])
@@ -3768,6 +5568,193 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CNState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHakeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operators == nil {
+				m.Operators = make(map[uint64]Operator)
+			}
+			var mapkey uint64
+			mapvalue := &Operator{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHakeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHakeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Operator{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHakeeper(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthHakeeper
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Operators[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Initialized = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSOLimit", wireType)
+			}
+			m.TSOLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHakeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSOLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHakeeper(dAtA[iNdEx:])
@@ -67,6 +67,39 @@ func TestDNStateUpdate(t *testing.T) {
 	assert.Equal(t, hb.Shards, dninfo.Shards)
 }
 
+func TestCNStateUpdate(t *testing.T) {
+	s := NewCNState()
+	hb := pb.CNStoreHeartbeat{
+		UUID:       "uuid1",
+		SQLAddress: "127.0.0.1:6001",
+		Labels:     map[string]string{"zone": "z1"},
+		Version:    "v0.5.0",
+		Load:       pb.CNStoreLoad{Connections: 10},
+	}
+	s.Update(hb, 1)
+	assert.Equal(t, 1, len(s.Stores))
+	cninfo, ok := s.Stores[hb.UUID]
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), cninfo.Tick)
+	assert.Equal(t, hb.SQLAddress, cninfo.SQLAddress)
+	assert.Equal(t, hb.Labels, cninfo.Labels)
+	assert.Equal(t, hb.Version, cninfo.Version)
+	assert.Equal(t, hb.Load, cninfo.Load)
+	assert.True(t, cninfo.HasLabels(nil))
+	assert.True(t, cninfo.HasLabels(map[string]string{"zone": "z1"}))
+	assert.False(t, cninfo.HasLabels(map[string]string{"zone": "z2"}))
+	assert.False(t, cninfo.HasLabels(map[string]string{"rack": "r1"}))
+
+	hb.SQLAddress = "127.0.0.1:6002"
+	hb.Load = pb.CNStoreLoad{Connections: 20, MemoryUsage: 1024}
+	s.Update(hb, 2)
+	assert.Equal(t, 1, len(s.Stores))
+	cninfo = s.Stores[hb.UUID]
+	assert.Equal(t, uint64(2), cninfo.Tick)
+	assert.Equal(t, hb.SQLAddress, cninfo.SQLAddress)
+	assert.Equal(t, hb.Load, cninfo.Load)
+}
+
 func TestUpdateLogStateStore(t *testing.T) {
 	s := NewLogState()
 	hb := pb.LogStoreHeartbeat{
@@ -174,3 +207,16 @@ func TestUpdateLogStateStore(t *testing.T) {
 	// shard2 didn't change to hb2.Shard[1]
 	assert.Equal(t, hb.Replicas[1].LogShardInfo, shard2)
 }
+
+func TestSetStoreState(t *testing.T) {
+	var c ClusterInfo
+	assert.False(t, c.IsDraining("uuid1"))
+
+	c.SetStoreState("uuid1", StoreDraining)
+	assert.True(t, c.IsDraining("uuid1"))
+	assert.False(t, c.IsDraining("uuid2"))
+
+	c.SetStoreState("uuid1", StoreActive)
+	assert.False(t, c.IsDraining("uuid1"))
+	assert.Equal(t, 0, len(c.StoreStates))
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -34,6 +35,7 @@ const (
 	MethodType_GET_TRUNCATE MethodType = 5
 	MethodType_CONNECT      MethodType = 6
 	MethodType_CONNECT_RO   MethodType = 7
+	MethodType_TSO_ALLOCATE MethodType = 8
 )
 
 var MethodType_name = map[int32]string{
@@ -45,6 +47,7 @@ var MethodType_name = map[int32]string{
 	5: "GET_TRUNCATE",
 	6: "CONNECT",
 	7: "CONNECT_RO",
+	8: "TSO_ALLOCATE",
 }
 
 var MethodType_value = map[string]int32{
@@ -56,6 +59,7 @@ var MethodType_value = map[string]int32{
 	"GET_TRUNCATE": 5,
 	"CONNECT":      6,
 	"CONNECT_RO":   7,
+	"TSO_ALLOCATE": 8,
 }
 
 func (x MethodType) String() string {
@@ -82,6 +86,7 @@ const (
 	ErrorCode_IndexAlreadyTruncated ErrorCode = 100
 	ErrorCode_OutOfRange            ErrorCode = 101
 	ErrorCode_NotLeaseHolder        ErrorCode = 102
+	ErrorCode_NotTSOLeader          ErrorCode = 103
 	ErrorCode_OtherSystemError      ErrorCode = 1000
 )
 
@@ -99,6 +104,7 @@ var ErrorCode_name = map[int32]string{
 	100:  "IndexAlreadyTruncated",
 	101:  "OutOfRange",
 	102:  "NotLeaseHolder",
+	103:  "NotTSOLeader",
 	1000: "OtherSystemError",
 }
 
@@ -116,6 +122,7 @@ var ErrorCode_value = map[string]int32{
 	"IndexAlreadyTruncated": 100,
 	"OutOfRange":            101,
 	"NotLeaseHolder":        102,
+	"NotTSOLeader":          103,
 	"OtherSystemError":      1000,
 }
 
@@ -470,6 +477,150 @@ func (m *DNStoreHeartbeat) GetShards() []DNShardInfo {
 	return nil
 }
 
+// CNStoreLoad is the load of a CN store.
+type CNStoreLoad struct {
+	// Connections is the number of client connections on the CN store.
+	Connections uint64 `protobuf:"varint,1,opt,name=Connections,proto3" json:"Connections,omitempty"`
+	// MemoryUsage is the memory used by the CN store in bytes.
+	MemoryUsage          uint64   `protobuf:"varint,2,opt,name=MemoryUsage,proto3" json:"MemoryUsage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CNStoreLoad) Reset()         { *m = CNStoreLoad{} }
+func (m *CNStoreLoad) String() string { return proto.CompactTextString(m) }
+func (*CNStoreLoad) ProtoMessage()    {}
+func (*CNStoreLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{5}
+}
+func (m *CNStoreLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNStoreLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNStoreLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNStoreLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNStoreLoad.Merge(m, src)
+}
+func (m *CNStoreLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *CNStoreLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNStoreLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNStoreLoad proto.InternalMessageInfo
+
+func (m *CNStoreLoad) GetConnections() uint64 {
+	if m != nil {
+		return m.Connections
+	}
+	return 0
+}
+
+func (m *CNStoreLoad) GetMemoryUsage() uint64 {
+	if m != nil {
+		return m.MemoryUsage
+	}
+	return 0
+}
+
+// CNStoreHeartbeat is the periodic message sent to the HAKeeper by CN stores.
+type CNStoreHeartbeat struct {
+	// UUID is the uuid of the CN Store.
+	UUID string `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	// SQLAddress is the address of the MySQL protocol service of the CN store.
+	SQLAddress string `protobuf:"bytes,2,opt,name=SQLAddress,proto3" json:"SQLAddress,omitempty"`
+	// Labels are the labels of the CN store, used to select the CN stores when
+	// discovering the SQL endpoints.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Version is the version of the CN store.
+	Version string `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	// Load is the load of the CN store.
+	Load                 CNStoreLoad `protobuf:"bytes,5,opt,name=Load,proto3" json:"Load"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CNStoreHeartbeat) Reset()         { *m = CNStoreHeartbeat{} }
+func (m *CNStoreHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*CNStoreHeartbeat) ProtoMessage()    {}
+func (*CNStoreHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{6}
+}
+func (m *CNStoreHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNStoreHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNStoreHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNStoreHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNStoreHeartbeat.Merge(m, src)
+}
+func (m *CNStoreHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *CNStoreHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNStoreHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNStoreHeartbeat proto.InternalMessageInfo
+
+func (m *CNStoreHeartbeat) GetUUID() string {
+	if m != nil {
+		return m.UUID
+	}
+	return ""
+}
+
+func (m *CNStoreHeartbeat) GetSQLAddress() string {
+	if m != nil {
+		return m.SQLAddress
+	}
+	return ""
+}
+
+func (m *CNStoreHeartbeat) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CNStoreHeartbeat) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CNStoreHeartbeat) GetLoad() CNStoreLoad {
+	if m != nil {
+		return m.Load
+	}
+	return CNStoreLoad{}
+}
+
 type RSMState struct {
 	Index                uint64            `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
 	LeaseHolderID        uint64            `protobuf:"varint,2,opt,name=LeaseHolderID,proto3" json:"LeaseHolderID,omitempty"`
@@ -484,7 +635,7 @@ func (m *RSMState) Reset()         { *m = RSMState{} }
 func (m *RSMState) String() string { return proto.CompactTextString(m) }
 func (*RSMState) ProtoMessage()    {}
 func (*RSMState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{5}
+	return fileDescriptor_fd1040c5381ab5a7, []int{7}
 }
 func (m *RSMState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +704,7 @@ func (m *LogRecord) Reset()         { *m = LogRecord{} }
 func (m *LogRecord) String() string { return proto.CompactTextString(m) }
 func (*LogRecord) ProtoMessage()    {}
 func (*LogRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{6}
+	return fileDescriptor_fd1040c5381ab5a7, []int{8}
 }
 func (m *LogRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,25 +748,27 @@ func (m *LogRecord) GetData() []byte {
 }
 
 type Request struct {
-	Method               MethodType `protobuf:"varint,1,opt,name=Method,proto3,enum=logservice.MethodType" json:"Method,omitempty"`
-	Name                 string     `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	ShardID              uint64     `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
-	Index                uint64     `protobuf:"varint,4,opt,name=Index,proto3" json:"Index,omitempty"`
-	MaxSize              uint64     `protobuf:"varint,5,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
-	Timeout              int64      `protobuf:"varint,6,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
-	DNShardID            uint64     `protobuf:"varint,7,opt,name=DNShardID,proto3" json:"DNShardID,omitempty"`
-	DNID                 uint64     `protobuf:"varint,8,opt,name=DNID,proto3" json:"DNID,omitempty"`
-	PayloadSize          uint64     `protobuf:"varint,9,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Method      MethodType `protobuf:"varint,1,opt,name=Method,proto3,enum=logservice.MethodType" json:"Method,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	ShardID     uint64     `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Index       uint64     `protobuf:"varint,4,opt,name=Index,proto3" json:"Index,omitempty"`
+	MaxSize     uint64     `protobuf:"varint,5,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
+	Timeout     int64      `protobuf:"varint,6,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	DNShardID   uint64     `protobuf:"varint,7,opt,name=DNShardID,proto3" json:"DNShardID,omitempty"`
+	DNID        uint64     `protobuf:"varint,8,opt,name=DNID,proto3" json:"DNID,omitempty"`
+	PayloadSize uint64     `protobuf:"varint,9,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
+	// TSOCount is the number of timestamps requested by TSO_ALLOCATE.
+	TSOCount             uint32   `protobuf:"varint,10,opt,name=TSOCount,proto3" json:"TSOCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{7}
+	return fileDescriptor_fd1040c5381ab5a7, []int{9}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,24 +860,33 @@ func (m *Request) GetPayloadSize() uint64 {
 	return 0
 }
 
+func (m *Request) GetTSOCount() uint32 {
+	if m != nil {
+		return m.TSOCount
+	}
+	return 0
+}
+
 type Response struct {
-	Method               MethodType `protobuf:"varint,1,opt,name=Method,proto3,enum=logservice.MethodType" json:"Method,omitempty"`
-	ErrorCode            ErrorCode  `protobuf:"varint,2,opt,name=ErrorCode,proto3,enum=logservice.ErrorCode" json:"ErrorCode,omitempty"`
-	ErrorMessage         string     `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
-	ShardID              uint64     `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
-	Index                uint64     `protobuf:"varint,5,opt,name=Index,proto3" json:"Index,omitempty"`
-	LastIndex            uint64     `protobuf:"varint,6,opt,name=LastIndex,proto3" json:"LastIndex,omitempty"`
-	PayloadSize          uint64     `protobuf:"varint,7,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Method       MethodType `protobuf:"varint,1,opt,name=Method,proto3,enum=logservice.MethodType" json:"Method,omitempty"`
+	ErrorCode    ErrorCode  `protobuf:"varint,2,opt,name=ErrorCode,proto3,enum=logservice.ErrorCode" json:"ErrorCode,omitempty"`
+	ErrorMessage string     `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	ShardID      uint64     `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Index        uint64     `protobuf:"varint,5,opt,name=Index,proto3" json:"Index,omitempty"`
+	LastIndex    uint64     `protobuf:"varint,6,opt,name=LastIndex,proto3" json:"LastIndex,omitempty"`
+	PayloadSize  uint64     `protobuf:"varint,7,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
+	// Timestamp is the first timestamp allocated by TSO_ALLOCATE.
+	Timestamp            timestamp.Timestamp `protobuf:"bytes,8,opt,name=Timestamp,proto3" json:"Timestamp"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{8}
+	return fileDescriptor_fd1040c5381ab5a7, []int{10}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,6 +964,13 @@ func (m *Response) GetPayloadSize() uint64 {
 	return 0
 }
 
+func (m *Response) GetTimestamp() timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return timestamp.Timestamp{}
+}
+
 type LogRecordResponse struct {
 	Records              []LogRecord `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -813,7 +982,7 @@ func (m *LogRecordResponse) Reset()         { *m = LogRecordResponse{} }
 func (m *LogRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*LogRecordResponse) ProtoMessage()    {}
 func (*LogRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{9}
+	return fileDescriptor_fd1040c5381ab5a7, []int{11}
 }
 func (m *LogRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,6 +1018,168 @@ func (m *LogRecordResponse) GetRecords() []LogRecord {
 	return nil
 }
 
+// LogShardSnapshot is the metadata of an exported Log shard. User records of
+// the shard starting from FirstIndex are exported in chunks.
+type LogShardSnapshot struct {
+	// ShardID is the ID of the exported Log shard.
+	ShardID uint64 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	// FirstIndex is the first index requested by the export.
+	FirstIndex uint64 `protobuf:"varint,2,opt,name=FirstIndex,proto3" json:"FirstIndex,omitempty"`
+	// LastIndex is the last index of the shard when the export started.
+	LastIndex uint64 `protobuf:"varint,3,opt,name=LastIndex,proto3" json:"LastIndex,omitempty"`
+	// LeaseHolderID is the lease holder of the shard when the export started.
+	LeaseHolderID uint64 `protobuf:"varint,4,opt,name=LeaseHolderID,proto3" json:"LeaseHolderID,omitempty"`
+	// TruncatedIndex is the truncated index of the shard when the export started.
+	TruncatedIndex uint64 `protobuf:"varint,5,opt,name=TruncatedIndex,proto3" json:"TruncatedIndex,omitempty"`
+	// Chunks is the number of exported chunks.
+	Chunks uint64 `protobuf:"varint,6,opt,name=Chunks,proto3" json:"Chunks,omitempty"`
+	// Records is the number of exported user records.
+	Records uint64 `protobuf:"varint,7,opt,name=Records,proto3" json:"Records,omitempty"`
+	// LeaseHistory is the lease history of the shard when the export started,
+	// log index -> lease holder ID.
+	LeaseHistory         map[uint64]uint64 `protobuf:"bytes,8,rep,name=LeaseHistory,proto3" json:"LeaseHistory,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogShardSnapshot) Reset()         { *m = LogShardSnapshot{} }
+func (m *LogShardSnapshot) String() string { return proto.CompactTextString(m) }
+func (*LogShardSnapshot) ProtoMessage()    {}
+func (*LogShardSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{12}
+}
+func (m *LogShardSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogShardSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogShardSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogShardSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogShardSnapshot.Merge(m, src)
+}
+func (m *LogShardSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogShardSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogShardSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogShardSnapshot proto.InternalMessageInfo
+
+func (m *LogShardSnapshot) GetShardID() uint64 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetFirstIndex() uint64 {
+	if m != nil {
+		return m.FirstIndex
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetLastIndex() uint64 {
+	if m != nil {
+		return m.LastIndex
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetLeaseHolderID() uint64 {
+	if m != nil {
+		return m.LeaseHolderID
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetTruncatedIndex() uint64 {
+	if m != nil {
+		return m.TruncatedIndex
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetChunks() uint64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetRecords() uint64 {
+	if m != nil {
+		return m.Records
+	}
+	return 0
+}
+
+func (m *LogShardSnapshot) GetLeaseHistory() map[uint64]uint64 {
+	if m != nil {
+		return m.LeaseHistory
+	}
+	return nil
+}
+
+// LogShardSnapshotChunk is a chunk of user records of an exported Log shard.
+type LogShardSnapshotChunk struct {
+	Records              []LogRecord `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LogShardSnapshotChunk) Reset()         { *m = LogShardSnapshotChunk{} }
+func (m *LogShardSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*LogShardSnapshotChunk) ProtoMessage()    {}
+func (*LogShardSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{13}
+}
+func (m *LogShardSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogShardSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogShardSnapshotChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogShardSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogShardSnapshotChunk.Merge(m, src)
+}
+func (m *LogShardSnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogShardSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogShardSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogShardSnapshotChunk proto.InternalMessageInfo
+
+func (m *LogShardSnapshotChunk) GetRecords() []LogRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("logservice.MethodType", MethodType_name, MethodType_value)
 	proto.RegisterEnum("logservice.ErrorCode", ErrorCode_name, ErrorCode_value)
@@ -858,80 +1189,104 @@ func init() {
 	proto.RegisterType((*LogStoreHeartbeat)(nil), "logservice.LogStoreHeartbeat")
 	proto.RegisterType((*DNShardInfo)(nil), "logservice.DNShardInfo")
 	proto.RegisterType((*DNStoreHeartbeat)(nil), "logservice.DNStoreHeartbeat")
+	proto.RegisterType((*CNStoreLoad)(nil), "logservice.CNStoreLoad")
+	proto.RegisterType((*CNStoreHeartbeat)(nil), "logservice.CNStoreHeartbeat")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.CNStoreHeartbeat.LabelsEntry")
 	proto.RegisterType((*RSMState)(nil), "logservice.RSMState")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "logservice.RSMState.LeaseHistoryEntry")
 	proto.RegisterType((*LogRecord)(nil), "logservice.LogRecord")
 	proto.RegisterType((*Request)(nil), "logservice.Request")
 	proto.RegisterType((*Response)(nil), "logservice.Response")
 	proto.RegisterType((*LogRecordResponse)(nil), "logservice.LogRecordResponse")
+	proto.RegisterType((*LogShardSnapshot)(nil), "logservice.LogShardSnapshot")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "logservice.LogShardSnapshot.LeaseHistoryEntry")
+	proto.RegisterType((*LogShardSnapshotChunk)(nil), "logservice.LogShardSnapshotChunk")
 }
 
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6b, 0x1b, 0x47,
-	0x14, 0xce, 0x4a, 0xab, 0x5f, 0xcf, 0xb2, 0x18, 0x0f, 0x75, 0xba, 0x35, 0xc1, 0x31, 0xa2, 0x04,
-	0x13, 0xa8, 0x0c, 0x36, 0x86, 0xd2, 0x16, 0x8a, 0xac, 0xdd, 0x26, 0x0e, 0xf2, 0xca, 0x8c, 0xe4,
-	0x43, 0x0b, 0x25, 0x8c, 0xb4, 0xa3, 0xd5, 0x36, 0xd2, 0x8e, 0xba, 0x3b, 0x32, 0x56, 0xaf, 0xa5,
-	0xd7, 0xfe, 0x5d, 0x39, 0xe6, 0xd2, 0x6b, 0x28, 0x3e, 0xf5, 0xd6, 0x3f, 0xa0, 0x97, 0x32, 0x33,
-	0xbb, 0xd2, 0xc8, 0xc6, 0x6d, 0x72, 0x9b, 0xf7, 0xcd, 0xfb, 0xf9, 0xcd, 0xf7, 0x96, 0x05, 0x34,
-	0xe5, 0x61, 0xca, 0x92, 0xeb, 0x68, 0xc4, 0x5a, 0xf3, 0x84, 0x0b, 0x8e, 0x61, 0x8d, 0xec, 0x7d,
-	0x11, 0x46, 0x62, 0xb2, 0x18, 0xb6, 0x46, 0x7c, 0x76, 0x14, 0xf2, 0x90, 0x1f, 0x29, 0x97, 0xe1,
-	0x62, 0xac, 0x2c, 0x65, 0xa8, 0x93, 0x0e, 0x6d, 0xfe, 0x6d, 0x41, 0xbd, 0xcb, 0xc3, 0xfe, 0x84,
-	0x26, 0xc1, 0x79, 0x3c, 0xe6, 0xd8, 0x81, 0x8a, 0x36, 0x5c, 0xc7, 0x3a, 0xb0, 0x0e, 0x6d, 0x92,
-	0x9b, 0xf8, 0x0c, 0xaa, 0x84, 0xcd, 0xa7, 0xd1, 0x88, 0xa6, 0x4e, 0xe1, 0xa0, 0x78, 0xb8, 0x75,
-	0xfc, 0xac, 0x65, 0xb4, 0x62, 0x66, 0x69, 0xe5, 0x8e, 0x5e, 0x2c, 0x92, 0x25, 0x59, 0xc5, 0xe1,
-	0x4f, 0xa0, 0xe4, 0xcd, 0xf9, 0x68, 0xe2, 0x14, 0x55, 0x6e, 0x6d, 0xe0, 0x3d, 0xa8, 0x76, 0x19,
-	0x0d, 0x58, 0x72, 0xee, 0x3a, 0xb6, 0xba, 0x58, 0xd9, 0x18, 0x83, 0x3d, 0x60, 0xc9, 0xcc, 0x29,
-	0x29, 0x5c, 0x9d, 0xf7, 0xbe, 0x86, 0xed, 0x8d, 0x02, 0x18, 0x41, 0xf1, 0x0d, 0x5b, 0x66, 0x0d,
-	0xcb, 0xa3, 0x2c, 0x74, 0x4d, 0xa7, 0x0b, 0xe6, 0x14, 0x0e, 0xac, 0xc3, 0x1a, 0xd1, 0xc6, 0x57,
-	0x85, 0x2f, 0xad, 0xe6, 0x35, 0x34, 0xba, 0x3c, 0xcc, 0xe2, 0xd5, 0xc8, 0xdf, 0x6d, 0x52, 0xa0,
-	0xd2, 0x6c, 0x1d, 0x3b, 0x0f, 0x0d, 0x77, 0x56, 0x7d, 0xfb, 0xfe, 0xe9, 0xa3, 0x77, 0xef, 0x9f,
-	0x5a, 0x64, 0x93, 0xba, 0x27, 0x50, 0xcb, 0xd3, 0xba, 0xaa, 0xae, 0x4d, 0xd6, 0x40, 0xf3, 0x0f,
-	0x0b, 0x76, 0xa4, 0xbb, 0xe0, 0x09, 0x7b, 0xc9, 0x68, 0x22, 0x86, 0x8c, 0x0a, 0x39, 0xde, 0xd5,
-	0x55, 0xc6, 0x75, 0x8d, 0xa8, 0x33, 0x3e, 0x80, 0x2d, 0x42, 0xc7, 0xa2, 0x1d, 0x04, 0x09, 0x4b,
-	0xd3, 0x6c, 0x02, 0x13, 0xc2, 0xcf, 0xa0, 0xd1, 0xd7, 0x9d, 0xe5, 0x4e, 0x45, 0xe5, 0x74, 0x07,
-	0xc5, 0x9f, 0xc3, 0xf6, 0x0b, 0x9e, 0xa6, 0xd1, 0x3c, 0x77, 0xb3, 0x95, 0xdb, 0x26, 0x88, 0xbf,
-	0x31, 0x1e, 0xb6, 0xa4, 0x1e, 0x76, 0xef, 0xce, 0xec, 0x06, 0x5b, 0x67, 0xb6, 0x9c, 0x7e, 0xfd,
-	0xa4, 0x4d, 0x0f, 0xb6, 0x5c, 0xff, 0x43, 0xf4, 0xf3, 0xdf, 0xf4, 0xfc, 0x08, 0xc8, 0xf5, 0x3f,
-	0x80, 0x9c, 0x53, 0x28, 0xab, 0x84, 0xb9, 0x06, 0x3f, 0x35, 0x5b, 0x35, 0x1a, 0xc9, 0xfa, 0xcc,
-	0x9c, 0x9b, 0xff, 0x58, 0x50, 0x25, 0xfd, 0x8b, 0xbe, 0xa0, 0x82, 0x49, 0x71, 0x9c, 0xc7, 0x01,
-	0xbb, 0xc9, 0x3a, 0xd4, 0x86, 0x24, 0xab, 0xcb, 0x68, 0xca, 0x5e, 0xf2, 0xa9, 0x96, 0xa2, 0xee,
-	0x71, 0x13, 0x94, 0xd4, 0x0f, 0x92, 0x45, 0x3c, 0xa2, 0x82, 0x05, 0x3a, 0x89, 0x96, 0xf2, 0x1d,
-	0x14, 0xbf, 0x82, 0xba, 0x0e, 0x8c, 0x52, 0xc1, 0x93, 0xa5, 0x63, 0xdf, 0xdf, 0x98, 0xbc, 0x9f,
-	0x96, 0xe9, 0xa8, 0x37, 0x66, 0x23, 0x76, 0xef, 0x5b, 0xd8, 0xb9, 0xe7, 0xf2, 0x7f, 0x9a, 0xb7,
-	0x4d, 0xcd, 0x9f, 0x42, 0x4d, 0xbd, 0xe2, 0x88, 0x27, 0xc1, 0x03, 0xd3, 0x63, 0xb0, 0x5d, 0x2a,
-	0xa8, 0x8a, 0xad, 0x13, 0x75, 0x6e, 0xfe, 0x56, 0x80, 0x0a, 0x61, 0x3f, 0x2f, 0x58, 0x2a, 0x70,
-	0x0b, 0xca, 0x17, 0x4c, 0x4c, 0x78, 0xa0, 0xc2, 0x1a, 0xc7, 0x8f, 0xcd, 0x49, 0xf4, 0xcd, 0x60,
-	0x39, 0x67, 0x24, 0xf3, 0x92, 0xf9, 0x7c, 0x3a, 0xcb, 0xf7, 0x4f, 0x9d, 0x4d, 0x6d, 0x14, 0x37,
-	0xb5, 0xb1, 0xea, 0xc9, 0x36, 0x7b, 0x72, 0xa0, 0x72, 0x41, 0x6f, 0xfa, 0xd1, 0x2f, 0x2c, 0x5b,
-	0xff, 0xdc, 0x94, 0x37, 0x83, 0x68, 0xc6, 0xf8, 0x42, 0x38, 0xe5, 0x03, 0xeb, 0xb0, 0x48, 0x72,
-	0x53, 0xaa, 0x2c, 0x57, 0x81, 0xeb, 0x54, 0xb4, 0xca, 0x56, 0x80, 0x9a, 0xd2, 0x3f, 0x77, 0x9d,
-	0xaa, 0xfe, 0x9a, 0xc8, 0xb3, 0x5c, 0xb7, 0x4b, 0xba, 0x9c, 0x72, 0x1a, 0xa8, 0x4a, 0x35, 0x75,
-	0x65, 0x42, 0xcd, 0x5f, 0x0b, 0x72, 0x43, 0xd2, 0x39, 0x8f, 0x53, 0xf6, 0xd1, 0x44, 0x9c, 0x40,
-	0xcd, 0x4b, 0x12, 0x9e, 0x74, 0x78, 0xa0, 0xd9, 0x68, 0x1c, 0xef, 0x9a, 0x21, 0xab, 0x4b, 0xb2,
-	0xf6, 0xc3, 0x4d, 0xa8, 0x2b, 0xe3, 0x82, 0xa5, 0x29, 0x0d, 0x59, 0xb6, 0xde, 0x1b, 0x98, 0xc9,
-	0xa6, 0xfd, 0x00, 0x9b, 0x25, 0x93, 0xcd, 0x27, 0x50, 0xeb, 0xd2, 0x54, 0xe8, 0x9b, 0xb2, 0x66,
-	0x66, 0x05, 0xdc, 0x65, 0xa1, 0x72, 0x9f, 0x85, 0x57, 0xea, 0xfb, 0xa5, 0x45, 0xb4, 0x62, 0xe3,
-	0x54, 0x2a, 0x44, 0x22, 0xa9, 0x63, 0x29, 0x85, 0xef, 0xde, 0xfb, 0x74, 0xc8, 0xdb, 0x6c, 0x1b,
-	0x73, 0xdf, 0xe7, 0x37, 0x00, 0x6b, 0xaa, 0x30, 0x40, 0xb9, 0x43, 0xbc, 0xf6, 0xc0, 0x43, 0x8f,
-	0xf0, 0x16, 0x54, 0x5c, 0xaf, 0x3f, 0x20, 0xbd, 0xef, 0x91, 0x25, 0x2f, 0xda, 0x97, 0x97, 0x9e,
-	0xef, 0xa2, 0x02, 0xae, 0x82, 0x4d, 0xbc, 0xb6, 0x8b, 0x8a, 0xb8, 0x0e, 0xd5, 0x01, 0xb9, 0xf2,
-	0x3b, 0x32, 0xc0, 0xc6, 0x08, 0xea, 0x2f, 0xbc, 0xc1, 0xeb, 0x15, 0x52, 0x92, 0x29, 0x3a, 0x3d,
-	0xdf, 0xf7, 0x3a, 0x03, 0x54, 0xc6, 0x0d, 0x80, 0xcc, 0x78, 0x4d, 0x7a, 0xa8, 0xf2, 0xfc, 0xf7,
-	0x82, 0xf1, 0x1e, 0xd2, 0xd5, 0xe7, 0xca, 0xd4, 0xa5, 0x33, 0x15, 0x21, 0x4b, 0x16, 0xe9, 0xd0,
-	0x78, 0xc4, 0xa6, 0x2c, 0x40, 0x05, 0x59, 0xe4, 0x3c, 0xbe, 0xa6, 0xd3, 0x28, 0x50, 0x1c, 0xa3,
-	0x22, 0xc6, 0xd0, 0xc8, 0x90, 0x3c, 0xc6, 0x36, 0xb0, 0x8c, 0x37, 0x54, 0xc2, 0x8f, 0x01, 0x6f,
-	0x62, 0x92, 0x4b, 0x54, 0x96, 0xf9, 0x09, 0xfb, 0x89, 0x8d, 0x04, 0x0b, 0x50, 0x05, 0xef, 0xc0,
-	0xb6, 0x4a, 0xec, 0x73, 0x41, 0x18, 0x0d, 0x96, 0xa8, 0x2a, 0x4b, 0xf6, 0x97, 0xa9, 0x60, 0xb3,
-	0xce, 0x94, 0xa7, 0x2c, 0x40, 0x35, 0xfc, 0x19, 0xec, 0xaa, 0xb7, 0x6a, 0x4f, 0x13, 0xe9, 0xb3,
-	0xfa, 0xe0, 0xa0, 0x40, 0x4e, 0xd9, 0x5b, 0x88, 0xde, 0x98, 0xd0, 0x38, 0x64, 0x88, 0xc9, 0x4e,
-	0x7c, 0x2e, 0x8c, 0x2f, 0x17, 0x1a, 0xe3, 0x5d, 0x40, 0x3d, 0x31, 0x61, 0x89, 0xce, 0xaa, 0x87,
-	0xfe, 0xab, 0x72, 0xd6, 0x7e, 0x7b, 0xbb, 0x6f, 0xbd, 0xbb, 0xdd, 0xb7, 0xfe, 0xbc, 0xdd, 0xb7,
-	0x7e, 0x38, 0x31, 0x7e, 0x1f, 0x66, 0x54, 0x24, 0xd1, 0x0d, 0x4f, 0xa2, 0x30, 0x8a, 0x73, 0x23,
-	0x66, 0x47, 0xf3, 0x37, 0xe1, 0xd1, 0x7c, 0x78, 0xb4, 0x7e, 0xe6, 0x61, 0x59, 0xfd, 0x4b, 0x9c,
-	0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x15, 0x41, 0x99, 0x9a, 0x08, 0x00, 0x00,
+	// 1265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xce, 0x4a, 0xab, 0xbf, 0x91, 0xed, 0xd2, 0x44, 0x9c, 0x6e, 0x85, 0xc0, 0x31, 0x84, 0x22,
+	0x30, 0x02, 0x54, 0x46, 0x1d, 0x04, 0x48, 0x7f, 0x80, 0x56, 0x96, 0x94, 0xc4, 0x81, 0xbc, 0x4a,
+	0xa8, 0x75, 0x81, 0x16, 0x28, 0x02, 0x4a, 0x4b, 0x4b, 0xdb, 0x48, 0x4b, 0x75, 0x97, 0x32, 0xa2,
+	0x3e, 0x42, 0x4f, 0x7d, 0x84, 0xf6, 0x41, 0x7a, 0xcf, 0x31, 0x97, 0x5e, 0x83, 0x22, 0xa7, 0xde,
+	0xfa, 0x00, 0x3d, 0xb4, 0x20, 0xb9, 0xbb, 0xa2, 0xe4, 0xfc, 0xb8, 0x05, 0x7a, 0xe3, 0x7c, 0xfc,
+	0x66, 0x38, 0xf3, 0x91, 0x33, 0x2b, 0x01, 0x9a, 0xf0, 0x51, 0xcc, 0xa2, 0xf3, 0x60, 0xc8, 0x1a,
+	0xb3, 0x88, 0x0b, 0x8e, 0x61, 0x89, 0xd4, 0x3e, 0x1a, 0x05, 0x62, 0x3c, 0x1f, 0x34, 0x86, 0x7c,
+	0x7a, 0x30, 0xe2, 0x23, 0x7e, 0xa0, 0x28, 0x83, 0xf9, 0x99, 0xb2, 0x94, 0xa1, 0x56, 0xda, 0xb5,
+	0xf6, 0x9e, 0x08, 0xa6, 0x2c, 0x16, 0x74, 0x3a, 0xd3, 0x40, 0xfd, 0x4f, 0x0b, 0x36, 0xba, 0x7c,
+	0xd4, 0x1f, 0xd3, 0xc8, 0x3f, 0x0e, 0xcf, 0x38, 0x76, 0xa0, 0xa4, 0x8d, 0xb6, 0x63, 0xed, 0x59,
+	0xfb, 0x36, 0x49, 0x4d, 0x7c, 0x04, 0x65, 0xc2, 0x66, 0x93, 0x60, 0x48, 0x63, 0x27, 0xb7, 0x97,
+	0xdf, 0xaf, 0x1e, 0xde, 0x6c, 0x18, 0xb9, 0x99, 0x51, 0x1a, 0x29, 0xb1, 0x13, 0x8a, 0x68, 0x41,
+	0x32, 0x3f, 0x7c, 0x15, 0x0a, 0x9d, 0x19, 0x1f, 0x8e, 0x9d, 0xbc, 0x8a, 0xad, 0x0d, 0x5c, 0x83,
+	0x72, 0x97, 0x51, 0x9f, 0x45, 0xc7, 0x6d, 0xc7, 0x56, 0x1b, 0x99, 0x8d, 0x31, 0xd8, 0x1e, 0x8b,
+	0xa6, 0x4e, 0x41, 0xe1, 0x6a, 0x5d, 0xfb, 0x0c, 0x36, 0x57, 0x0e, 0xc0, 0x08, 0xf2, 0x4f, 0xd9,
+	0x22, 0x49, 0x58, 0x2e, 0xe5, 0x41, 0xe7, 0x74, 0x32, 0x67, 0x4e, 0x6e, 0xcf, 0xda, 0xaf, 0x10,
+	0x6d, 0x7c, 0x9a, 0xbb, 0x6b, 0xd5, 0xcf, 0x61, 0xab, 0xcb, 0x47, 0x89, 0xbf, 0x2a, 0xf9, 0xde,
+	0xaa, 0x04, 0x2a, 0x4c, 0xf5, 0xd0, 0x79, 0x53, 0x71, 0x47, 0xe5, 0xe7, 0x2f, 0x6f, 0x5c, 0x79,
+	0xf1, 0xf2, 0x86, 0x45, 0x56, 0xa5, 0xbb, 0x0e, 0x95, 0x34, 0x6c, 0x5b, 0x9d, 0x6b, 0x93, 0x25,
+	0x50, 0xff, 0xcd, 0x82, 0x6d, 0x49, 0x17, 0x3c, 0x62, 0x0f, 0x18, 0x8d, 0xc4, 0x80, 0x51, 0x21,
+	0xcb, 0x3b, 0x3d, 0x4d, 0xb4, 0xae, 0x10, 0xb5, 0xc6, 0x7b, 0x50, 0x25, 0xf4, 0x4c, 0x34, 0x7d,
+	0x3f, 0x62, 0x71, 0x9c, 0x54, 0x60, 0x42, 0xf8, 0x26, 0x6c, 0xf5, 0x75, 0x66, 0x29, 0x29, 0xaf,
+	0x48, 0x6b, 0x28, 0xfe, 0x10, 0x36, 0xef, 0xf3, 0x38, 0x0e, 0x66, 0x29, 0xcd, 0x56, 0xb4, 0x55,
+	0x10, 0x7f, 0x6e, 0x5c, 0x6c, 0x41, 0x5d, 0x6c, 0x6d, 0xad, 0x76, 0x43, 0xad, 0x23, 0x5b, 0x56,
+	0xbf, 0xbc, 0xd2, 0x7a, 0x07, 0xaa, 0x6d, 0xf7, 0x32, 0xef, 0xe7, 0xed, 0xf2, 0x7c, 0x0b, 0xa8,
+	0xed, 0x5e, 0x42, 0x9c, 0x3b, 0x50, 0x54, 0x01, 0xd3, 0x37, 0xf8, 0xbe, 0x99, 0xaa, 0x91, 0x48,
+	0x92, 0x67, 0x42, 0xae, 0x3f, 0x86, 0x6a, 0x4b, 0x87, 0xef, 0x72, 0xea, 0x4b, 0x89, 0x5b, 0x3c,
+	0x0c, 0xd9, 0x50, 0x04, 0x3c, 0x8c, 0x93, 0x4c, 0x4d, 0x48, 0x32, 0x4e, 0xd8, 0x94, 0x47, 0x8b,
+	0xd3, 0x98, 0x8e, 0x58, 0x92, 0xaf, 0x09, 0xd5, 0x7f, 0xca, 0x01, 0x6a, 0x5d, 0x26, 0xe5, 0x5d,
+	0x80, 0xfe, 0xe3, 0xee, 0xea, 0x75, 0x1a, 0x08, 0xfe, 0x12, 0x8a, 0x5d, 0x3a, 0x60, 0x13, 0x79,
+	0x8b, 0xb2, 0xa4, 0x7d, 0xb3, 0xa4, 0xf5, 0x13, 0x1a, 0x9a, 0xaa, 0x1b, 0x2b, 0xf1, 0x93, 0xa2,
+	0x7f, 0xc5, 0xa2, 0x38, 0xe0, 0x61, 0x72, 0xc3, 0xa9, 0x89, 0x3f, 0x06, 0x5b, 0x16, 0xac, 0xda,
+	0x67, 0x4d, 0x2c, 0x43, 0x8f, 0x44, 0x2c, 0x45, 0xad, 0x7d, 0x02, 0x55, 0xe3, 0x0c, 0xb3, 0xb7,
+	0x2a, 0xef, 0xea, 0xad, 0xbf, 0x2c, 0x28, 0x93, 0xfe, 0x49, 0x5f, 0x50, 0xc1, 0x24, 0xed, 0x38,
+	0xf4, 0xd9, 0xb3, 0x44, 0x5d, 0x6d, 0xc8, 0x27, 0xd9, 0x65, 0x34, 0x66, 0x0f, 0xf8, 0x44, 0x37,
+	0xbc, 0x56, 0x76, 0x15, 0x94, 0x0f, 0xdc, 0x8b, 0xe6, 0xe1, 0x90, 0x0a, 0xe6, 0xeb, 0x20, 0x7a,
+	0x60, 0xac, 0xa1, 0xf8, 0x21, 0x6c, 0x68, 0xc7, 0x20, 0x16, 0x3c, 0x5a, 0x38, 0xf6, 0xc5, 0xb9,
+	0x94, 0xe6, 0xd3, 0x30, 0x89, 0x5a, 0xbe, 0x15, 0xdf, 0xda, 0x17, 0xb0, 0x7d, 0x81, 0xf2, 0xae,
+	0xc9, 0x62, 0x9b, 0xd5, 0xdf, 0x81, 0x8a, 0xea, 0x95, 0x21, 0x8f, 0xfc, 0x37, 0x54, 0x8f, 0xc1,
+	0x6e, 0x53, 0x41, 0x95, 0xef, 0x06, 0x51, 0xeb, 0xfa, 0x2f, 0x39, 0x28, 0x11, 0xf6, 0xfd, 0x9c,
+	0xc5, 0x02, 0x37, 0xa0, 0x78, 0xc2, 0xc4, 0x98, 0xfb, 0xca, 0x6d, 0xeb, 0xf0, 0x9a, 0x59, 0x89,
+	0xde, 0xf1, 0x16, 0x33, 0x46, 0x12, 0x96, 0x8c, 0xe7, 0xd2, 0x69, 0x7a, 0x13, 0x6a, 0x6d, 0x76,
+	0x60, 0x7e, 0xb5, 0x03, 0xb3, 0x9c, 0x6c, 0x33, 0x27, 0x07, 0x4a, 0x27, 0xf4, 0x59, 0x3f, 0xf8,
+	0x81, 0x25, 0x43, 0x36, 0x35, 0xe5, 0x8e, 0x17, 0x4c, 0x19, 0x9f, 0x0b, 0xa7, 0xb8, 0x67, 0xed,
+	0xe7, 0x49, 0x6a, 0xca, 0x5e, 0x4e, 0x7b, 0xad, 0xed, 0x94, 0x74, 0x2f, 0x67, 0x80, 0xaa, 0xd2,
+	0x3d, 0x6e, 0x3b, 0x65, 0xb5, 0xa1, 0xd6, 0xb2, 0x9f, 0x1e, 0xd1, 0xc5, 0x84, 0x53, 0x5f, 0x9d,
+	0x54, 0xd1, 0xfd, 0x64, 0x40, 0xf2, 0x2b, 0xe0, 0xf5, 0x7b, 0x2d, 0x3e, 0x0f, 0x85, 0x03, 0x7b,
+	0xd6, 0xfe, 0x26, 0xc9, 0xec, 0xfa, 0xaf, 0x39, 0x39, 0xa3, 0xe2, 0x19, 0x0f, 0x63, 0xf6, 0xaf,
+	0x45, 0xba, 0x0d, 0x95, 0x4e, 0x14, 0xf1, 0xa8, 0xc5, 0x7d, 0xad, 0xd4, 0xd6, 0xe1, 0x8e, 0xe9,
+	0x92, 0x6d, 0x92, 0x25, 0x0f, 0xd7, 0x61, 0x43, 0x19, 0x27, 0x2c, 0x56, 0x03, 0x40, 0x0f, 0xd8,
+	0x15, 0xcc, 0x54, 0xda, 0x7e, 0x83, 0xd2, 0x05, 0x53, 0xe9, 0xeb, 0x50, 0xe9, 0xd2, 0x58, 0xe8,
+	0x9d, 0xa2, 0x56, 0x2d, 0x03, 0xd6, 0x15, 0x2a, 0x5d, 0x54, 0xe8, 0x2e, 0x54, 0xbc, 0xf4, 0xfb,
+	0xad, 0xc4, 0xad, 0x1e, 0x5e, 0x6d, 0x2c, 0xbf, 0xe8, 0xd9, 0x5e, 0xd2, 0xce, 0x4b, 0x72, 0xfd,
+	0x21, 0x6c, 0x67, 0x4f, 0x33, 0xd3, 0xf1, 0x0e, 0x94, 0x34, 0x22, 0x07, 0xa0, 0xec, 0x9b, 0x9d,
+	0x0b, 0x63, 0x5f, 0xee, 0x26, 0xd1, 0x52, 0x6e, 0xfd, 0xef, 0x1c, 0xa0, 0xf4, 0xbb, 0xd7, 0x0f,
+	0xe9, 0x2c, 0x1e, 0x73, 0xf1, 0x96, 0xb1, 0xbf, 0x0b, 0x70, 0x2f, 0x88, 0xd2, 0xaa, 0x75, 0xd3,
+	0x18, 0xc8, 0xaa, 0x28, 0xf9, 0x75, 0x51, 0x2e, 0x8c, 0x0b, 0xfb, 0x72, 0xe3, 0xa2, 0xf0, 0xda,
+	0x71, 0x71, 0x0d, 0x8a, 0xad, 0xf1, 0x3c, 0x7c, 0x1a, 0x27, 0xea, 0x27, 0x96, 0xcc, 0x3e, 0x55,
+	0x42, 0xcb, 0x9e, 0x9a, 0x98, 0xac, 0x0d, 0x98, 0xb2, 0x12, 0xaa, 0xf1, 0xba, 0xdf, 0x06, 0xa9,
+	0x16, 0xff, 0xff, 0xa0, 0x71, 0x61, 0x67, 0xfd, 0x50, 0x55, 0xc8, 0x7f, 0xbc, 0xd1, 0x5b, 0x3f,
+	0x5a, 0x00, 0xcb, 0xbe, 0xc1, 0x00, 0xc5, 0x16, 0xe9, 0x34, 0xbd, 0x0e, 0xba, 0x82, 0xab, 0x50,
+	0x6a, 0x77, 0xfa, 0x1e, 0xe9, 0x7d, 0x8d, 0x2c, 0xb9, 0xd1, 0x7c, 0xf4, 0xa8, 0xe3, 0xb6, 0x51,
+	0x0e, 0x97, 0xc1, 0x26, 0x9d, 0x66, 0x1b, 0xe5, 0xf1, 0x06, 0x94, 0x3d, 0x72, 0xea, 0xb6, 0xa4,
+	0x83, 0x8d, 0x11, 0x6c, 0xdc, 0xef, 0x78, 0x4f, 0x32, 0xa4, 0x20, 0x43, 0xb4, 0x7a, 0xae, 0xdb,
+	0x69, 0x79, 0xa8, 0x88, 0xb7, 0x00, 0x12, 0xe3, 0x09, 0xe9, 0xa1, 0x92, 0xa4, 0x7b, 0xfd, 0xde,
+	0x93, 0x66, 0xb7, 0xdb, 0x53, 0xf4, 0xf2, 0xad, 0x9f, 0x73, 0x46, 0xbb, 0x4a, 0x67, 0x97, 0x2b,
+	0x53, 0x27, 0x93, 0x0c, 0x20, 0x64, 0xc9, 0x63, 0x5b, 0x34, 0x1c, 0xb2, 0x09, 0xf3, 0x51, 0x4e,
+	0xc6, 0x39, 0x0e, 0xcf, 0xe9, 0x24, 0xf0, 0x95, 0x2c, 0x28, 0x8f, 0x31, 0x6c, 0x25, 0x48, 0xea,
+	0x63, 0x1b, 0x58, 0xd2, 0x56, 0xa8, 0x80, 0xaf, 0x01, 0x5e, 0xc5, 0x64, 0xab, 0xa1, 0xa2, 0x8c,
+	0x4f, 0xd8, 0x77, 0x6c, 0x28, 0x98, 0x8f, 0x4a, 0x78, 0x1b, 0x36, 0x55, 0x60, 0x97, 0x0b, 0xc2,
+	0xa8, 0xbf, 0x40, 0x65, 0x79, 0x64, 0x7f, 0x11, 0x0b, 0x36, 0x6d, 0x4d, 0x78, 0xcc, 0x7c, 0x54,
+	0xc1, 0x1f, 0xc0, 0x8e, 0x7a, 0x67, 0xcd, 0x49, 0x24, 0x39, 0xd9, 0xe3, 0x43, 0xbe, 0xac, 0xbb,
+	0x37, 0x17, 0xbd, 0x33, 0x42, 0xc3, 0x11, 0x43, 0x4c, 0x66, 0xe2, 0x72, 0x61, 0xbc, 0x62, 0x74,
+	0x26, 0x03, 0xba, 0x5c, 0x78, 0xfd, 0x9e, 0xfe, 0xf1, 0x8b, 0x46, 0x78, 0x07, 0x50, 0x4f, 0x8c,
+	0x59, 0xa4, 0xcf, 0xd1, 0x32, 0xfc, 0x51, 0x3a, 0x6a, 0x3e, 0x7f, 0xb5, 0x6b, 0xbd, 0x78, 0xb5,
+	0x6b, 0xfd, 0xfe, 0x6a, 0xd7, 0xfa, 0xe6, 0xb6, 0xf1, 0x17, 0x60, 0x4a, 0x45, 0x14, 0x3c, 0xe3,
+	0x51, 0x30, 0x0a, 0xc2, 0xd4, 0x08, 0xd9, 0xc1, 0xec, 0xe9, 0xe8, 0x60, 0x36, 0x38, 0x58, 0xbe,
+	0x85, 0x41, 0x51, 0xfd, 0xfc, 0xbf, 0xfd, 0xcf, 0x00, 0x3c, 0x54, 0xfa, 0x4d, 0x5e, 0x0c, 0x00,
+	0x00,
 }
 
 func (m *LogShardInfo) Marshal() (dAtA []byte, err error) {
@@ -1194,7 +1549,7 @@ func (m *DNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RSMState) Marshal() (dAtA []byte, err error) {
+func (m *CNStoreLoad) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1204,12 +1559,12 @@ func (m *RSMState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RSMState) MarshalTo(dAtA []byte) (int, error) {
+func (m *CNStoreLoad) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RSMState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CNStoreLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1218,40 +1573,20 @@ func (m *RSMState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.LeaseHistory) > 0 {
-		for k := range m.LeaseHistory {
-			v := m.LeaseHistory[k]
-			baseI := i
-			i = encodeVarintLogservice(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintLogservice(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TruncatedIndex != 0 {
-		i = encodeVarintLogservice(dAtA, i, uint64(m.TruncatedIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LeaseHolderID != 0 {
-		i = encodeVarintLogservice(dAtA, i, uint64(m.LeaseHolderID))
+	if m.MemoryUsage != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.MemoryUsage))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Index != 0 {
-		i = encodeVarintLogservice(dAtA, i, uint64(m.Index))
+	if m.Connections != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Connections))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogRecord) Marshal() (dAtA []byte, err error) {
+func (m *CNStoreHeartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1261,12 +1596,12 @@ func (m *LogRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *CNStoreHeartbeat) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1275,19 +1610,153 @@ func (m *LogRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
 	}
-	if m.Index != 0 {
-		i = encodeVarintLogservice(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Version)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SQLAddress) > 0 {
+		i -= len(m.SQLAddress)
+		copy(dAtA[i:], m.SQLAddress)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.SQLAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UUID) > 0 {
+		i -= len(m.UUID)
+		copy(dAtA[i:], m.UUID)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.UUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RSMState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RSMState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RSMState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LeaseHistory) > 0 {
+		for k := range m.LeaseHistory {
+			v := m.LeaseHistory[k]
+			baseI := i
+			i = encodeVarintLogservice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintLogservice(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TruncatedIndex != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.TruncatedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeaseHolderID != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.LeaseHolderID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -1314,6 +1783,11 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TSOCount != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.TSOCount))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.PayloadSize != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.PayloadSize))
 		i--
@@ -1388,6 +1862,16 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.PayloadSize != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.PayloadSize))
 		i--
@@ -1469,6 +1953,124 @@ func (m *LogRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogShardSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogShardSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogShardSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LeaseHistory) > 0 {
+		for k := range m.LeaseHistory {
+			v := m.LeaseHistory[k]
+			baseI := i
+			i = encodeVarintLogservice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintLogservice(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Records != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Records))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TruncatedIndex != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.TruncatedIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeaseHolderID != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.LeaseHolderID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastIndex != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.LastIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstIndex != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.FirstIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogShardSnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogShardSnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogShardSnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLogservice(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLogservice(v)
 	base := offset
@@ -1603,28 +2205,17 @@ func (m *DNStoreHeartbeat) Size() (n int) {
 	return n
 }
 
-func (m *RSMState) Size() (n int) {
+func (m *CNStoreLoad) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovLogservice(uint64(m.Index))
-	}
-	if m.LeaseHolderID != 0 {
-		n += 1 + sovLogservice(uint64(m.LeaseHolderID))
+	if m.Connections != 0 {
+		n += 1 + sovLogservice(uint64(m.Connections))
 	}
-	if m.TruncatedIndex != 0 {
-		n += 1 + sovLogservice(uint64(m.TruncatedIndex))
-	}
-	if len(m.LeaseHistory) > 0 {
-		for k, v := range m.LeaseHistory {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovLogservice(uint64(k)) + 1 + sovLogservice(uint64(v))
-			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
-		}
+	if m.MemoryUsage != 0 {
+		n += 1 + sovLogservice(uint64(m.MemoryUsage))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1632,16 +2223,79 @@ func (m *RSMState) Size() (n int) {
 	return n
 }
 
-func (m *LogRecord) Size() (n int) {
+func (m *CNStoreHeartbeat) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovLogservice(uint64(m.Index))
-	}
-	l = len(m.Data)
+	l = len(m.UUID)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	l = len(m.SQLAddress)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	l = m.Load.Size()
+	n += 1 + l + sovLogservice(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RSMState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLogservice(uint64(m.Index))
+	}
+	if m.LeaseHolderID != 0 {
+		n += 1 + sovLogservice(uint64(m.LeaseHolderID))
+	}
+	if m.TruncatedIndex != 0 {
+		n += 1 + sovLogservice(uint64(m.TruncatedIndex))
+	}
+	if len(m.LeaseHistory) > 0 {
+		for k, v := range m.LeaseHistory {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovLogservice(uint64(k)) + 1 + sovLogservice(uint64(v))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovLogservice(uint64(m.Index))
+	}
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
@@ -1685,6 +2339,9 @@ func (m *Request) Size() (n int) {
 	if m.PayloadSize != 0 {
 		n += 1 + sovLogservice(uint64(m.PayloadSize))
 	}
+	if m.TSOCount != 0 {
+		n += 1 + sovLogservice(uint64(m.TSOCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1719,6 +2376,8 @@ func (m *Response) Size() (n int) {
 	if m.PayloadSize != 0 {
 		n += 1 + sovLogservice(uint64(m.PayloadSize))
 	}
+	l = m.Timestamp.Size()
+	n += 1 + l + sovLogservice(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1743,6 +2402,65 @@ func (m *LogRecordResponse) Size() (n int) {
 	return n
 }
 
+func (m *LogShardSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovLogservice(uint64(m.ShardID))
+	}
+	if m.FirstIndex != 0 {
+		n += 1 + sovLogservice(uint64(m.FirstIndex))
+	}
+	if m.LastIndex != 0 {
+		n += 1 + sovLogservice(uint64(m.LastIndex))
+	}
+	if m.LeaseHolderID != 0 {
+		n += 1 + sovLogservice(uint64(m.LeaseHolderID))
+	}
+	if m.TruncatedIndex != 0 {
+		n += 1 + sovLogservice(uint64(m.TruncatedIndex))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovLogservice(uint64(m.Chunks))
+	}
+	if m.Records != 0 {
+		n += 1 + sovLogservice(uint64(m.Records))
+	}
+	if len(m.LeaseHistory) > 0 {
+		for k, v := range m.LeaseHistory {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovLogservice(uint64(k)) + 1 + sovLogservice(uint64(v))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogShardSnapshotChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovLogservice(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2511,7 +3229,7 @@ func (m *DNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RSMState) Unmarshal(dAtA []byte) error {
+func (m *CNStoreLoad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2534,17 +3252,17 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RSMState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CNStoreLoad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RSMState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CNStoreLoad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
 			}
-			m.Index = 0
+			m.Connections = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -2554,16 +3272,16 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Connections |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHolderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsage", wireType)
 			}
-			m.LeaseHolderID = 0
+			m.MemoryUsage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -2573,16 +3291,67 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaseHolderID |= uint64(b&0x7F) << shift
+				m.MemoryUsage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TruncatedIndex = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CNStoreHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CNStoreHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CNStoreHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UUID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -2592,14 +3361,59 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TruncatedIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQLAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SQLAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2626,11 +3440,11 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LeaseHistory == nil {
-				m.LeaseHistory = make(map[uint64]uint64)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var mapkey uint64
-			var mapvalue uint64
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -2650,6 +3464,7 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowLogservice
@@ -2659,12 +3474,26 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowLogservice
@@ -2674,11 +3503,24 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -2694,9 +3536,281 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.LeaseHistory[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSMState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RSMState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RSMState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHolderID", wireType)
+			}
+			m.LeaseHolderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseHolderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedIndex", wireType)
+			}
+			m.TruncatedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TruncatedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseHistory == nil {
+				m.LeaseHistory = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LeaseHistory[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
 			if err != nil {
@@ -2980,9 +4094,263 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNShardID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNShardID", wireType)
+			}
+			m.DNShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DNShardID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNID", wireType)
+			}
+			m.DNID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DNID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSOCount", wireType)
+			}
+			m.TSOCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TSOCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= MethodType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= ErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
+			}
+			m.LastIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.DNShardID = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -2992,16 +4360,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DNShardID |= uint64(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DNID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.DNID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3011,16 +4379,81 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DNID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
 			}
-			m.PayloadSize = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3030,11 +4463,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, LogRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -3057,7 +4505,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *LogShardSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3080,17 +4528,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogShardSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogShardSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
 			}
-			m.Method = 0
+			m.ShardID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3100,16 +4548,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Method |= MethodType(b&0x7F) << shift
+				m.ShardID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstIndex", wireType)
 			}
-			m.ErrorCode = 0
+			m.FirstIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3119,16 +4567,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorCode |= ErrorCode(b&0x7F) << shift
+				m.FirstIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
 			}
-			var stringLen uint64
+			m.LastIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3138,29 +4586,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLogservice
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogservice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHolderID", wireType)
 			}
-			m.ShardID = 0
+			m.LeaseHolderID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3170,16 +4605,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardID |= uint64(b&0x7F) << shift
+				m.LeaseHolderID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedIndex", wireType)
 			}
-			m.Index = 0
+			m.TruncatedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3189,16 +4624,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.TruncatedIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			m.LastIndex = 0
+			m.Chunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3208,16 +4643,16 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastIndex |= uint64(b&0x7F) << shift
+				m.Chunks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.PayloadSize = 0
+			m.Records = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -3227,11 +4662,110 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				m.Records |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseHistory == nil {
+				m.LeaseHistory = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LeaseHistory[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -3254,7 +4788,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *LogShardSnapshotChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3277,10 +4811,10 @@ func (m *LogRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogShardSnapshotChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogShardSnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
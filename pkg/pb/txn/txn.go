@@ -17,6 +17,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 )
 
 const (
@@ -64,10 +66,40 @@ func (m TxnRequest) DebugString() string {
 	return buffer.String()
 }
 
+// GetTargetDN returns the target DNShard of the request, the request is
+// sent to and handled by the target DN.
+func (m TxnRequest) GetTargetDN() metadata.DNShard {
+	switch m.Method {
+	case TxnMethod_Read, TxnMethod_Write:
+		return m.CNRequest.Target
+	case TxnMethod_Commit:
+		return m.CommitRequest.DNShards[0]
+	case TxnMethod_Rollback:
+		return m.RollbackRequest.DNShards[0]
+	case TxnMethod_Prepare:
+		return *m.PrepareRequest.DNShard
+	case TxnMethod_GetStatus:
+		return *m.GetStatusRequest.DNShard
+	case TxnMethod_CommitDNShard:
+		return *m.CommitDNShardRequest.DNShard
+	case TxnMethod_RollbackDNShard:
+		return *m.RollbackDNShardRequest.DNShard
+	default:
+		panic(fmt.Sprintf("unknown txn request method: %s", m.Method.String()))
+	}
+}
+
 // DebugString returns debug string
 func (m TxnError) DebugString() string {
-	// TODO: impl
-	return ""
+	var buffer bytes.Buffer
+
+	buffer.WriteString("code: ")
+	buffer.WriteString(fmt.Sprintf("%d", m.Code))
+	buffer.WriteString(", ")
+
+	buffer.WriteString("message: ")
+	buffer.WriteString(m.Message)
+	return buffer.String()
 }
 
 // DebugString returns debug string
@@ -173,10 +173,14 @@ type TxnMeta struct {
 	CommitTS *timestamp.Timestamp `protobuf:"bytes,5,opt,name=CommitTS,proto3" json:"CommitTS,omitempty"`
 	// Coordinator the coordinator DN. CN uses the first DN of the transaction operation
 	// as the Coordinator.
-	Coordinator          *metadata.DNShard `protobuf:"bytes,6,opt,name=Coordinator,proto3" json:"Coordinator,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Coordinator *metadata.DNShard `protobuf:"bytes,6,opt,name=Coordinator,proto3" json:"Coordinator,omitempty"`
+	// DNShards all DNs involved in a 2pc transaction, the first one is the coordinator. It is
+	// set in the Prepared records, and used to find the coordinator and the participants of
+	// the transaction during the recovery.
+	DNShards             []metadata.DNShard `protobuf:"bytes,7,rep,name=DNShards,proto3" json:"DNShards"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *TxnMeta) Reset()         { *m = TxnMeta{} }
@@ -254,6 +258,13 @@ func (m *TxnMeta) GetCoordinator() *metadata.DNShard {
 	return nil
 }
 
+func (m *TxnMeta) GetDNShards() []metadata.DNShard {
+	if m != nil {
+		return m.DNShards
+	}
+	return nil
+}
+
 // CNOpRequest cn read/write request, CN -> DN. If data is written to more than one DN (>1) in a
 // single transaction, then the transaction becomes a 2pc transaction.
 type CNOpRequest struct {
@@ -400,9 +411,15 @@ type TxnRequest struct {
 	CommitDNShardRequest *TxnCommitDNShardRequest `protobuf:"bytes,9,opt,name=CommitDNShardRequest,proto3" json:"CommitDNShardRequest,omitempty"`
 	// TxnRollbackDNShardRequest corresponds to TxnMethod.RollbackDNShard
 	RollbackDNShardRequest *TxnRollbackDNShardRequest `protobuf:"bytes,10,opt,name=RollbackDNShardRequest,proto3" json:"RollbackDNShardRequest,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                   `json:"-"`
-	XXX_unrecognized       []byte                     `json:"-"`
-	XXX_sizecache          int32                      `json:"-"`
+	// RequestID request id, assigned by the RPC TxnSender to match the response.
+	RequestID uint64 `protobuf:"varint,11,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	// Timeout the remaining time in nanoseconds before the deadline of the request,
+	// set by the RPC TxnSender when the request is written. The DN stops handling
+	// the request after the timeout, the sender no longer waits for the response.
+	Timeout              int64    `protobuf:"varint,12,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TxnRequest) Reset()         { *m = TxnRequest{} }
@@ -508,6 +525,20 @@ func (m *TxnRequest) GetRollbackDNShardRequest() *TxnRollbackDNShardRequest {
 	return nil
 }
 
+func (m *TxnRequest) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *TxnRequest) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 // TxnResponse response of TxnRequest.
 type TxnResponse struct {
 	// Txn transaction metadata. TxnResponse.TxnMeta and TxnRequest.TxnMeta may differ
@@ -535,9 +566,11 @@ type TxnResponse struct {
 	CommitDNShardResponse *TxnCommitDNShardResponse `protobuf:"bytes,10,opt,name=CommitDNShardResponse,proto3" json:"CommitDNShardResponse,omitempty"`
 	// TxnRollbackDNShardResponse corresponds to TxnMethod.RollbackDNShard response
 	RollbackDNShardResponse *TxnRollbackDNShardResponse `protobuf:"bytes,11,opt,name=RollbackDNShardResponse,proto3" json:"RollbackDNShardResponse,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                    `json:"-"`
-	XXX_unrecognized        []byte                      `json:"-"`
-	XXX_sizecache           int32                       `json:"-"`
+	// RequestID same as TxnRequest.RequestID
+	RequestID            uint64   `protobuf:"varint,12,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TxnResponse) Reset()         { *m = TxnResponse{} }
@@ -650,6 +683,110 @@ func (m *TxnResponse) GetRollbackDNShardResponse() *TxnRollbackDNShardResponse {
 	return nil
 }
 
+func (m *TxnResponse) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+// TxnRequestBatch the TxnRequests sent to the same DN in one RPC frame.
+type TxnRequestBatch struct {
+	Requests             []TxnRequest `protobuf:"bytes,1,rep,name=Requests,proto3" json:"Requests"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TxnRequestBatch) Reset()         { *m = TxnRequestBatch{} }
+func (m *TxnRequestBatch) String() string { return proto.CompactTextString(m) }
+func (*TxnRequestBatch) ProtoMessage()    {}
+func (*TxnRequestBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f782e76b37adb9a, []int{5}
+}
+func (m *TxnRequestBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnRequestBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnRequestBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnRequestBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnRequestBatch.Merge(m, src)
+}
+func (m *TxnRequestBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnRequestBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnRequestBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnRequestBatch proto.InternalMessageInfo
+
+func (m *TxnRequestBatch) GetRequests() []TxnRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// TxnResponseBatch the TxnResponses sent back in one RPC frame, the responses are
+// matched to the requests by the RequestID.
+type TxnResponseBatch struct {
+	Responses            []TxnResponse `protobuf:"bytes,1,rep,name=Responses,proto3" json:"Responses"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TxnResponseBatch) Reset()         { *m = TxnResponseBatch{} }
+func (m *TxnResponseBatch) String() string { return proto.CompactTextString(m) }
+func (*TxnResponseBatch) ProtoMessage()    {}
+func (*TxnResponseBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f782e76b37adb9a, []int{6}
+}
+func (m *TxnResponseBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnResponseBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnResponseBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnResponseBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnResponseBatch.Merge(m, src)
+}
+func (m *TxnResponseBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnResponseBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnResponseBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnResponseBatch proto.InternalMessageInfo
+
+func (m *TxnResponseBatch) GetResponses() []TxnResponse {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
 // TxnCommitRequest CN sent the commit request to coordinator DN.
 type TxnCommitRequest struct {
 	// DNShards DNs for which data has been written in the current transaction.
@@ -665,7 +802,7 @@ func (m *TxnCommitRequest) Reset()         { *m = TxnCommitRequest{} }
 func (m *TxnCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnCommitRequest) ProtoMessage()    {}
 func (*TxnCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{5}
+	return fileDescriptor_4f782e76b37adb9a, []int{7}
 }
 func (m *TxnCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +856,7 @@ func (m *TxnCommitResponse) Reset()         { *m = TxnCommitResponse{} }
 func (m *TxnCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnCommitResponse) ProtoMessage()    {}
 func (*TxnCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{6}
+	return fileDescriptor_4f782e76b37adb9a, []int{8}
 }
 func (m *TxnCommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +898,7 @@ func (m *TxnRollbackRequest) Reset()         { *m = TxnRollbackRequest{} }
 func (m *TxnRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnRollbackRequest) ProtoMessage()    {}
 func (*TxnRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{7}
+	return fileDescriptor_4f782e76b37adb9a, []int{9}
 }
 func (m *TxnRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +945,7 @@ func (m *TxnRollbackResponse) Reset()         { *m = TxnRollbackResponse{} }
 func (m *TxnRollbackResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnRollbackResponse) ProtoMessage()    {}
 func (*TxnRollbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{8}
+	return fileDescriptor_4f782e76b37adb9a, []int{10}
 }
 func (m *TxnRollbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +995,7 @@ func (m *TxnPrepareRequest) Reset()         { *m = TxnPrepareRequest{} }
 func (m *TxnPrepareRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnPrepareRequest) ProtoMessage()    {}
 func (*TxnPrepareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{9}
+	return fileDescriptor_4f782e76b37adb9a, []int{11}
 }
 func (m *TxnPrepareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1049,7 @@ func (m *TxnPrepareResponse) Reset()         { *m = TxnPrepareResponse{} }
 func (m *TxnPrepareResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnPrepareResponse) ProtoMessage()    {}
 func (*TxnPrepareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{10}
+	return fileDescriptor_4f782e76b37adb9a, []int{12}
 }
 func (m *TxnPrepareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,7 +1091,7 @@ func (m *TxnGetStatusRequest) Reset()         { *m = TxnGetStatusRequest{} }
 func (m *TxnGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnGetStatusRequest) ProtoMessage()    {}
 func (*TxnGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{11}
+	return fileDescriptor_4f782e76b37adb9a, []int{13}
 }
 func (m *TxnGetStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1138,7 @@ func (m *TxnGetStatusResponse) Reset()         { *m = TxnGetStatusResponse{} }
 func (m *TxnGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnGetStatusResponse) ProtoMessage()    {}
 func (*TxnGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{12}
+	return fileDescriptor_4f782e76b37adb9a, []int{14}
 }
 func (m *TxnGetStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1181,7 @@ func (m *TxnCommitDNShardRequest) Reset()         { *m = TxnCommitDNShardRequest
 func (m *TxnCommitDNShardRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnCommitDNShardRequest) ProtoMessage()    {}
 func (*TxnCommitDNShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{13}
+	return fileDescriptor_4f782e76b37adb9a, []int{15}
 }
 func (m *TxnCommitDNShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1091,7 +1228,7 @@ func (m *TxnCommitDNShardResponse) Reset()         { *m = TxnCommitDNShardRespon
 func (m *TxnCommitDNShardResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnCommitDNShardResponse) ProtoMessage()    {}
 func (*TxnCommitDNShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{14}
+	return fileDescriptor_4f782e76b37adb9a, []int{16}
 }
 func (m *TxnCommitDNShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1270,7 @@ func (m *TxnRollbackDNShardRequest) Reset()         { *m = TxnRollbackDNShardReq
 func (m *TxnRollbackDNShardRequest) String() string { return proto.CompactTextString(m) }
 func (*TxnRollbackDNShardRequest) ProtoMessage()    {}
 func (*TxnRollbackDNShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{15}
+	return fileDescriptor_4f782e76b37adb9a, []int{17}
 }
 func (m *TxnRollbackDNShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1180,7 +1317,7 @@ func (m *TxnRollbackDNShardResponse) Reset()         { *m = TxnRollbackDNShardRe
 func (m *TxnRollbackDNShardResponse) String() string { return proto.CompactTextString(m) }
 func (*TxnRollbackDNShardResponse) ProtoMessage()    {}
 func (*TxnRollbackDNShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{16}
+	return fileDescriptor_4f782e76b37adb9a, []int{18}
 }
 func (m *TxnRollbackDNShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1211,6 +1348,10 @@ var xxx_messageInfo_TxnRollbackDNShardResponse proto.InternalMessageInfo
 
 // TxnError all explicit errors in transaction operations.
 type TxnError struct {
+	// Code error code, see moerr
+	Code int32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// Message error message
+	Message              string   `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1220,7 +1361,7 @@ func (m *TxnError) Reset()         { *m = TxnError{} }
 func (m *TxnError) String() string { return proto.CompactTextString(m) }
 func (*TxnError) ProtoMessage()    {}
 func (*TxnError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{17}
+	return fileDescriptor_4f782e76b37adb9a, []int{19}
 }
 func (m *TxnError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,6 +1390,20 @@ func (m *TxnError) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxnError proto.InternalMessageInfo
 
+func (m *TxnError) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxnError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("txn.TxnStatus", TxnStatus_name, TxnStatus_value)
 	proto.RegisterEnum("txn.TxnMethod", TxnMethod_name, TxnMethod_value)
@@ -1257,6 +1412,8 @@ func init() {
 	proto.RegisterType((*CNOpResponse)(nil), "txn.CNOpResponse")
 	proto.RegisterType((*TxnRequest)(nil), "txn.TxnRequest")
 	proto.RegisterType((*TxnResponse)(nil), "txn.TxnResponse")
+	proto.RegisterType((*TxnRequestBatch)(nil), "txn.TxnRequestBatch")
+	proto.RegisterType((*TxnResponseBatch)(nil), "txn.TxnResponseBatch")
 	proto.RegisterType((*TxnCommitRequest)(nil), "txn.TxnCommitRequest")
 	proto.RegisterType((*TxnCommitResponse)(nil), "txn.TxnCommitResponse")
 	proto.RegisterType((*TxnRollbackRequest)(nil), "txn.TxnRollbackRequest")
@@ -1275,67 +1432,74 @@ func init() {
 func init() { proto.RegisterFile("txn.proto", fileDescriptor_4f782e76b37adb9a) }
 
 var fileDescriptor_4f782e76b37adb9a = []byte{
-	// 957 bytes of a gzipped FileDescriptorProto
+	// 1064 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0xf3, 0x9f, 0x93, 0x9f, 0x75, 0x4e, 0xff, 0xbc, 0xd5, 0x92, 0xad, 0xac, 0x15, 0x2a,
-	0x0b, 0x24, 0xd0, 0xc2, 0x0d, 0x48, 0x95, 0xda, 0x94, 0x5d, 0x7a, 0xb1, 0x6d, 0x35, 0xb1, 0x40,
-	0x70, 0x83, 0x26, 0xf5, 0x90, 0x5a, 0x9b, 0x78, 0x8c, 0x33, 0x41, 0xe6, 0x86, 0x37, 0xe0, 0x05,
-	0x78, 0xa2, 0xbd, 0x42, 0xfb, 0x04, 0x08, 0xfa, 0x24, 0xc8, 0xe3, 0x99, 0x24, 0x76, 0xe2, 0x15,
-	0x85, 0x3b, 0xcf, 0x9c, 0xef, 0xfb, 0xce, 0xf1, 0x9c, 0xcf, 0xc7, 0x03, 0x75, 0x11, 0xf9, 0xbd,
-	0x20, 0xe4, 0x82, 0x63, 0x51, 0x44, 0xfe, 0xc1, 0xc7, 0x63, 0x4f, 0xdc, 0xcd, 0x47, 0xbd, 0x5b,
-	0x3e, 0xed, 0x8f, 0xf9, 0x98, 0xf7, 0x65, 0x6c, 0x34, 0xff, 0x51, 0xae, 0xe4, 0x42, 0x3e, 0x25,
-	0x9c, 0x83, 0x47, 0xc2, 0x9b, 0xb2, 0x99, 0xa0, 0xd3, 0x40, 0x6d, 0xb4, 0xa7, 0x4c, 0x50, 0x97,
-	0x0a, 0x9a, 0xac, 0xed, 0xdf, 0x0b, 0x50, 0x75, 0x22, 0xff, 0x15, 0x13, 0x14, 0xdb, 0x50, 0xb8,
-	0xbc, 0xb0, 0x8c, 0x43, 0xe3, 0xa8, 0x49, 0x0a, 0x97, 0x17, 0xf8, 0x3e, 0x54, 0x86, 0x82, 0x8a,
-	0xf9, 0xcc, 0x2a, 0x1c, 0x1a, 0x47, 0xed, 0xe3, 0x76, 0x2f, 0x2e, 0xc6, 0x89, 0xfc, 0x64, 0x97,
-	0xa8, 0x28, 0x7e, 0x01, 0x30, 0xf4, 0x69, 0x30, 0xbb, 0xe3, 0xc2, 0x19, 0x5a, 0xc5, 0x43, 0xe3,
-	0xa8, 0x71, 0xbc, 0xd3, 0x5b, 0x66, 0x76, 0xf4, 0xd3, 0x79, 0xe9, 0xcd, 0x9f, 0x4f, 0xb7, 0xc8,
-	0x0a, 0x1a, 0x3f, 0x03, 0xb8, 0x09, 0x59, 0x40, 0x43, 0xe6, 0x3a, 0x43, 0xab, 0x94, 0xcf, 0x25,
-	0x2b, 0x38, 0xfc, 0x04, 0x6a, 0x03, 0x3e, 0x9d, 0x7a, 0x71, 0xbe, 0xf2, 0x3b, 0x38, 0x0b, 0x14,
-	0x9e, 0x40, 0x63, 0xc0, 0x79, 0xe8, 0x7a, 0x3e, 0x15, 0x3c, 0xb4, 0x2a, 0x92, 0xd4, 0xe9, 0x2d,
-	0x4e, 0xe3, 0xe2, 0x6a, 0x78, 0x47, 0x43, 0x97, 0xac, 0xa2, 0xec, 0x00, 0x1a, 0x83, 0xab, 0xeb,
-	0x80, 0xb0, 0x9f, 0xe6, 0x6c, 0x26, 0x70, 0x0f, 0x2a, 0xd7, 0xc1, 0x80, 0xbb, 0x4c, 0x9e, 0x51,
-	0x8b, 0xa8, 0x15, 0x5a, 0x50, 0xbd, 0xa1, 0xbf, 0x4c, 0x38, 0x75, 0xe5, 0x41, 0x35, 0x89, 0x5e,
-	0x62, 0x1f, 0x2a, 0x0e, 0x0d, 0xc7, 0x4c, 0xa8, 0x53, 0x59, 0x4f, 0xa8, 0x8e, 0x44, 0xc1, 0xec,
-	0x23, 0x68, 0x26, 0x19, 0x67, 0x01, 0xf7, 0x67, 0x29, 0x69, 0x23, 0x25, 0x6d, 0xff, 0x51, 0x02,
-	0x70, 0x22, 0x5f, 0xd7, 0xf6, 0x0c, 0x8a, 0x4e, 0xe4, 0x4b, 0x50, 0xe3, 0xb8, 0xa9, 0x1b, 0x15,
-	0xb7, 0x55, 0x65, 0x88, 0xc3, 0x71, 0x47, 0x5f, 0x31, 0x71, 0xc7, 0xdd, 0x6c, 0x47, 0x93, 0x5d,
-	0xa2, 0xa2, 0x88, 0x50, 0x7a, 0x31, 0xa1, 0x63, 0x59, 0x75, 0x8b, 0xc8, 0x67, 0xec, 0x41, 0x7d,
-	0x70, 0xa5, 0xd2, 0xa9, 0x46, 0x99, 0x92, 0xbe, 0x72, 0x44, 0x64, 0x09, 0xc1, 0x2f, 0xa1, 0x95,
-	0x9c, 0xbe, 0xe6, 0x24, 0x8d, 0xda, 0xd5, 0x29, 0x53, 0x41, 0x92, 0xc6, 0xe2, 0x19, 0x3c, 0x22,
-	0x7c, 0x32, 0x19, 0xd1, 0xdb, 0xd7, 0x9a, 0x9e, 0xb4, 0x6c, 0x5f, 0xd3, 0x33, 0x61, 0x92, 0xc5,
-	0xe3, 0x29, 0xb4, 0x95, 0x63, 0xb4, 0x42, 0x55, 0x2a, 0xec, 0x69, 0x85, 0x74, 0x94, 0x64, 0xd0,
-	0x78, 0x01, 0xe6, 0x4b, 0x26, 0x94, 0xd5, 0x95, 0x42, 0x4d, 0x2a, 0x58, 0x5a, 0x21, 0x1b, 0x27,
-	0x6b, 0x0c, 0xbc, 0x81, 0x9d, 0xe4, 0xcd, 0xb4, 0xc1, 0x94, 0x52, 0x5d, 0x2a, 0x3d, 0x49, 0x1f,
-	0x46, 0x1a, 0x43, 0x36, 0x32, 0xf1, 0x1b, 0xd8, 0xd3, 0xaf, 0x9a, 0xd1, 0x04, 0xa9, 0xd9, 0xcd,
-	0x9e, 0x50, 0x46, 0x35, 0x87, 0x6d, 0xff, 0x56, 0x86, 0x86, 0x34, 0x94, 0xb2, 0x5e, 0x37, 0xd7,
-	0x51, 0xff, 0xdf, 0x4b, 0x1f, 0x40, 0xcd, 0x89, 0xfc, 0xaf, 0xc2, 0x90, 0x87, 0xca, 0x4a, 0x2d,
-	0xcd, 0x96, 0x9b, 0x64, 0x11, 0xc6, 0xcf, 0xd3, 0x5f, 0x84, 0x72, 0x51, 0x67, 0xc5, 0x79, 0x49,
-	0x80, 0xa4, 0x3f, 0x9c, 0x53, 0x68, 0x6b, 0x47, 0x29, 0x62, 0x25, 0xdd, 0xfd, 0x74, 0x94, 0x64,
-	0xd0, 0x71, 0xf7, 0x97, 0x86, 0x52, 0x0a, 0xd5, 0x74, 0xf7, 0xb3, 0x71, 0xb2, 0xc6, 0x88, 0x6d,
-	0xbc, 0x70, 0x95, 0x12, 0xa9, 0xa5, 0x6d, 0x9c, 0x09, 0x93, 0x2c, 0x1e, 0x5f, 0x42, 0x67, 0xc5,
-	0x54, 0x4a, 0x24, 0x71, 0xcf, 0xe3, 0x0d, 0x3e, 0x54, 0x32, 0xeb, 0x1c, 0x1c, 0xc2, 0x6e, 0xc6,
-	0x4f, 0x4a, 0x2c, 0xb1, 0xcd, 0x7b, 0x39, 0x56, 0x54, 0x82, 0x9b, 0xb9, 0xf8, 0x1d, 0xec, 0xaf,
-	0xd9, 0x49, 0xc9, 0x36, 0xa4, 0xec, 0xd3, 0x5c, 0x37, 0x2a, 0xe1, 0x3c, 0xbe, 0x3d, 0x05, 0x33,
-	0x3b, 0x25, 0xf0, 0x04, 0x6a, 0x0a, 0x36, 0xb3, 0x8c, 0xc3, 0xe2, 0xbb, 0x26, 0xea, 0x02, 0x88,
-	0xcf, 0xa0, 0xe5, 0x7a, 0x33, 0x3a, 0x9a, 0xb0, 0x4f, 0x6f, 0x06, 0xd7, 0x81, 0x90, 0x7e, 0xad,
-	0x91, 0xf4, 0xa6, 0xbd, 0x0d, 0x9d, 0x35, 0x57, 0xd8, 0x97, 0x80, 0xeb, 0xa3, 0xe6, 0x3f, 0x55,
-	0x61, 0xef, 0xc2, 0xf6, 0x06, 0xcf, 0xd8, 0x73, 0x99, 0x36, 0x33, 0x7a, 0x3e, 0x84, 0xaa, 0xe2,
-	0xa9, 0xcf, 0x6f, 0xc3, 0x8f, 0x4a, 0x23, 0x52, 0xd5, 0x14, 0xfe, 0x6d, 0x35, 0x3b, 0xf2, 0xc5,
-	0x32, 0x5e, 0xb3, 0xcf, 0x65, 0x8d, 0x6b, 0x33, 0xec, 0x21, 0xe5, 0xd8, 0x7b, 0xb0, 0xb3, 0xc9,
-	0x91, 0xf6, 0x0b, 0xd8, 0xcf, 0x99, 0x73, 0x0f, 0xd3, 0x3f, 0x00, 0x2b, 0xcf, 0xa4, 0xf6, 0xd7,
-	0xf0, 0x38, 0x77, 0xee, 0x3d, 0x2c, 0xcb, 0x13, 0x38, 0xc8, 0xf7, 0xac, 0x0d, 0xcb, 0xf1, 0xf5,
-	0xfc, 0x07, 0xa8, 0x2f, 0x6e, 0x44, 0x08, 0x50, 0x39, 0xbb, 0x15, 0xde, 0xcf, 0xcc, 0xdc, 0xc2,
-	0x26, 0xd4, 0xf4, 0x8d, 0xc5, 0x34, 0xb0, 0x0d, 0x90, 0xd4, 0x2c, 0x3c, 0x7f, 0x6c, 0x16, 0xb0,
-	0x05, 0x75, 0xb5, 0x66, 0xae, 0x59, 0x8c, 0xc1, 0x67, 0x23, 0x1e, 0xca, 0x60, 0x09, 0x1b, 0x50,
-	0x95, 0x2b, 0xe6, 0x9a, 0xe5, 0xe7, 0xbf, 0xca, 0x04, 0x6a, 0x98, 0xd6, 0xa0, 0x44, 0x18, 0x75,
-	0xcd, 0x2d, 0xac, 0x43, 0xf9, 0xdb, 0xd0, 0x13, 0xcc, 0x34, 0xe2, 0xac, 0x89, 0x96, 0x59, 0x88,
-	0x85, 0x74, 0xd5, 0x66, 0x31, 0x16, 0x52, 0x35, 0x98, 0xa5, 0x38, 0xe5, 0xa2, 0x2d, 0x66, 0x19,
-	0x3b, 0xfa, 0xff, 0xac, 0xde, 0xce, 0xac, 0xe0, 0xf6, 0xf2, 0xaf, 0xab, 0x37, 0xab, 0xe7, 0xa7,
-	0x6f, 0xff, 0xee, 0x1a, 0x6f, 0xee, 0xbb, 0xc6, 0xdb, 0xfb, 0xae, 0xf1, 0xd7, 0x7d, 0xd7, 0xf8,
-	0xfe, 0xa3, 0x95, 0x3b, 0xe8, 0x94, 0x8a, 0xd0, 0x8b, 0x78, 0xe8, 0x8d, 0x3d, 0x5f, 0x2f, 0x7c,
-	0xd6, 0x0f, 0x5e, 0x8f, 0xfb, 0xc1, 0xa8, 0x2f, 0x22, 0x7f, 0x54, 0x91, 0x17, 0xcd, 0x93, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x76, 0xde, 0x0a, 0x50, 0xca, 0x0a, 0x00, 0x00,
+	0x14, 0x5e, 0xe7, 0x3f, 0x27, 0x3f, 0x75, 0xa7, 0x7f, 0xde, 0xaa, 0x64, 0x23, 0x6b, 0x85, 0xc2,
+	0x02, 0x09, 0xdb, 0x2e, 0x12, 0x02, 0xa9, 0x52, 0x9b, 0xb0, 0xbb, 0xbd, 0xe8, 0x8f, 0x26, 0x11,
+	0x08, 0x6e, 0xd0, 0xa4, 0x1e, 0x52, 0x6b, 0x13, 0xdb, 0xd8, 0x13, 0x14, 0x6e, 0xb8, 0xe4, 0x65,
+	0x78, 0x91, 0xbd, 0x63, 0x9f, 0x00, 0x41, 0x9f, 0x04, 0x79, 0x7c, 0x26, 0xb1, 0x9d, 0x18, 0x6d,
+	0xe1, 0xce, 0x67, 0xce, 0xf7, 0x7d, 0x67, 0x3c, 0xf3, 0xcd, 0x99, 0x81, 0xaa, 0x58, 0x38, 0x5d,
+	0xcf, 0x77, 0x85, 0x4b, 0xf2, 0x62, 0xe1, 0x1c, 0x7e, 0x3a, 0xb1, 0xc5, 0xdd, 0x7c, 0xdc, 0xbd,
+	0x75, 0x67, 0xbd, 0x89, 0x3b, 0x71, 0x7b, 0x32, 0x37, 0x9e, 0xff, 0x28, 0x23, 0x19, 0xc8, 0xaf,
+	0x88, 0x73, 0xb8, 0x25, 0xec, 0x19, 0x0f, 0x04, 0x9b, 0x79, 0x38, 0xd0, 0x9c, 0x71, 0xc1, 0x2c,
+	0x26, 0x58, 0x14, 0x9b, 0x7f, 0xe4, 0xa0, 0x3c, 0x5a, 0x38, 0x97, 0x5c, 0x30, 0xd2, 0x84, 0xdc,
+	0xc5, 0xc0, 0xd0, 0xda, 0x5a, 0xa7, 0x4e, 0x73, 0x17, 0x03, 0xf2, 0x21, 0x94, 0x86, 0x82, 0x89,
+	0x79, 0x60, 0xe4, 0xda, 0x5a, 0xa7, 0x79, 0xdc, 0xec, 0x86, 0x93, 0x19, 0x2d, 0x9c, 0x68, 0x94,
+	0x62, 0x96, 0x7c, 0x09, 0x30, 0x74, 0x98, 0x17, 0xdc, 0xb9, 0x62, 0x34, 0x34, 0xf2, 0x6d, 0xad,
+	0x53, 0x3b, 0xde, 0xed, 0xae, 0x2a, 0x8f, 0xd4, 0xd7, 0x79, 0xe1, 0xed, 0x9f, 0x4f, 0x1e, 0xd1,
+	0x18, 0x9a, 0xbc, 0x00, 0xb8, 0xf1, 0xb9, 0xc7, 0x7c, 0x6e, 0x8d, 0x86, 0x46, 0x21, 0x9b, 0x4b,
+	0x63, 0x38, 0xf2, 0x19, 0x54, 0xfa, 0xee, 0x6c, 0x66, 0x87, 0xf5, 0x8a, 0xff, 0xc2, 0x59, 0xa2,
+	0xc8, 0x09, 0xd4, 0xfa, 0xae, 0xeb, 0x5b, 0xb6, 0xc3, 0x84, 0xeb, 0x1b, 0x25, 0x49, 0xda, 0xee,
+	0x2e, 0x57, 0x63, 0x70, 0x35, 0xbc, 0x63, 0xbe, 0x45, 0xe3, 0x28, 0x72, 0x02, 0x15, 0x1c, 0x0f,
+	0x8c, 0x72, 0x3b, 0xbf, 0x91, 0x81, 0xff, 0xb4, 0x04, 0x9a, 0x1e, 0xd4, 0xfa, 0x57, 0xd7, 0x1e,
+	0xe5, 0x3f, 0xcd, 0x79, 0x20, 0xc8, 0x3e, 0x94, 0xae, 0xbd, 0xbe, 0x6b, 0x71, 0xb9, 0xb0, 0x0d,
+	0x8a, 0x11, 0x31, 0xa0, 0x7c, 0xc3, 0x7e, 0x99, 0xba, 0xcc, 0x92, 0xab, 0x5b, 0xa7, 0x2a, 0x24,
+	0x3d, 0x28, 0x8d, 0x98, 0x3f, 0xe1, 0x02, 0x97, 0x32, 0xb3, 0x26, 0xc2, 0xcc, 0x0e, 0xd4, 0xa3,
+	0x8a, 0x81, 0xe7, 0x3a, 0x41, 0x42, 0x5a, 0x4b, 0x48, 0x9b, 0xbf, 0x15, 0x01, 0x46, 0x0b, 0x47,
+	0xcd, 0xed, 0x29, 0xe4, 0x47, 0x0b, 0x47, 0x82, 0x6a, 0xc7, 0x75, 0xb5, 0xbb, 0xa1, 0x17, 0xb0,
+	0x42, 0x98, 0x0e, 0x6d, 0x70, 0xc9, 0xc5, 0x9d, 0x6b, 0xa5, 0x6d, 0x10, 0x8d, 0x52, 0xcc, 0x12,
+	0x02, 0x85, 0x97, 0x53, 0x36, 0x91, 0xb3, 0x6e, 0x50, 0xf9, 0x4d, 0xba, 0x50, 0xed, 0x5f, 0x61,
+	0x39, 0xdc, 0x5d, 0x5d, 0xd2, 0x63, 0x4b, 0x44, 0x57, 0x10, 0xf2, 0x15, 0x34, 0xa2, 0x2d, 0x53,
+	0x9c, 0x68, 0x77, 0xf7, 0x54, 0xc9, 0x44, 0x92, 0x26, 0xb1, 0xe4, 0x0c, 0xb6, 0xa8, 0x3b, 0x9d,
+	0x8e, 0xd9, 0xed, 0x1b, 0x45, 0x8f, 0xf6, 0xf9, 0x40, 0xd1, 0x53, 0x69, 0x9a, 0xc6, 0x93, 0x53,
+	0x68, 0xa2, 0xcd, 0x94, 0x42, 0x59, 0x2a, 0xec, 0x2b, 0x85, 0x64, 0x96, 0xa6, 0xd0, 0x64, 0x00,
+	0xfa, 0x2b, 0x2e, 0xf0, 0x7c, 0xa0, 0x42, 0x45, 0x2a, 0x18, 0x4a, 0x21, 0x9d, 0xa7, 0x6b, 0x0c,
+	0x72, 0x03, 0xbb, 0xd1, 0x9f, 0x29, 0x57, 0xa2, 0x52, 0x55, 0x2a, 0x1d, 0x25, 0x17, 0x23, 0x89,
+	0xa1, 0x1b, 0x99, 0xe4, 0x1b, 0xd8, 0x57, 0xbf, 0x9a, 0xd2, 0x04, 0xa9, 0xd9, 0x4a, 0xaf, 0x50,
+	0x4a, 0x35, 0x83, 0x4d, 0x8e, 0xa0, 0x8a, 0x9f, 0x17, 0x03, 0xa3, 0xd6, 0xd6, 0x3a, 0x05, 0xba,
+	0x1a, 0x08, 0x8d, 0x18, 0x9e, 0x45, 0x77, 0x2e, 0x8c, 0x7a, 0x5b, 0xeb, 0xe4, 0xa9, 0x0a, 0xcd,
+	0xdf, 0x8b, 0x50, 0x93, 0x46, 0x44, 0xcb, 0xb6, 0x32, 0x9d, 0xf8, 0xff, 0x3d, 0xf8, 0x11, 0x54,
+	0x46, 0x0b, 0xe7, 0x6b, 0xdf, 0x77, 0x7d, 0xb4, 0x60, 0x43, 0xb1, 0xe5, 0x20, 0x5d, 0xa6, 0xc9,
+	0xe7, 0xc9, 0x93, 0x84, 0xee, 0xdb, 0x8e, 0x39, 0x36, 0x4a, 0xd0, 0xe4, 0x81, 0x3b, 0x85, 0xa6,
+	0x72, 0x22, 0x12, 0x4b, 0x49, 0xd7, 0x24, 0xb3, 0x34, 0x85, 0x0e, 0x5d, 0xb3, 0x32, 0x22, 0x2a,
+	0x94, 0x93, 0xae, 0x49, 0xe7, 0xe9, 0x1a, 0x23, 0xb4, 0xff, 0xd2, 0x8d, 0x28, 0x52, 0x49, 0xda,
+	0x3f, 0x95, 0xa6, 0x69, 0x3c, 0x79, 0x05, 0xdb, 0x31, 0x33, 0xa2, 0x48, 0xe4, 0xba, 0xc7, 0x1b,
+	0xfc, 0x8b, 0x32, 0xeb, 0x1c, 0x32, 0x84, 0xbd, 0x94, 0x0f, 0x51, 0x2c, 0xb2, 0xdb, 0x07, 0x19,
+	0x16, 0x46, 0xc1, 0xcd, 0x5c, 0xf2, 0x1d, 0x1c, 0xac, 0xd9, 0x10, 0x65, 0x6b, 0x52, 0xf6, 0x49,
+	0xa6, 0x8b, 0x51, 0x38, 0x8b, 0x9f, 0xf4, 0x71, 0x3d, 0xe5, 0x63, 0x73, 0x00, 0x5b, 0xab, 0xae,
+	0x79, 0xce, 0xc4, 0xed, 0x1d, 0x79, 0x0e, 0x15, 0x8c, 0x03, 0x43, 0x93, 0x57, 0xc3, 0xd6, 0xb2,
+	0x38, 0xe2, 0xf0, 0x62, 0x50, 0x30, 0xf3, 0x35, 0xe8, 0x31, 0xcb, 0x47, 0x32, 0x2f, 0xa0, 0xaa,
+	0x06, 0x94, 0x8e, 0xbe, 0xd2, 0x41, 0x64, 0x24, 0xb4, 0x02, 0x9a, 0x33, 0xa9, 0x94, 0x6c, 0x7e,
+	0xf1, 0xbb, 0x4a, 0x7b, 0xcf, 0xbb, 0x8a, 0x3c, 0x85, 0x86, 0x65, 0x07, 0x6c, 0x3c, 0xe5, 0xcf,
+	0x6f, 0xfa, 0xd7, 0x9e, 0x90, 0xa7, 0xab, 0x42, 0x93, 0x83, 0xe6, 0x0e, 0x6c, 0xaf, 0x79, 0xd8,
+	0xbc, 0x00, 0xb2, 0xde, 0x50, 0xff, 0xd3, 0x2c, 0xcc, 0x3d, 0xd8, 0xd9, 0xe0, 0x70, 0x73, 0x2e,
+	0xcb, 0xa6, 0x1a, 0xec, 0xc7, 0x50, 0x46, 0x1e, 0x36, 0x8b, 0x75, 0x7d, 0xaa, 0x10, 0x89, 0xd9,
+	0xe4, 0xde, 0x77, 0x36, 0xbb, 0x40, 0xe2, 0x65, 0x71, 0x32, 0xe7, 0x72, 0x8e, 0x6b, 0x9d, 0xfa,
+	0x21, 0xd3, 0x31, 0xf7, 0x61, 0x77, 0xd3, 0xf9, 0x31, 0x5f, 0xc2, 0x41, 0x46, 0x37, 0x7f, 0x98,
+	0xfe, 0x21, 0x18, 0x59, 0x47, 0xca, 0x7c, 0x0d, 0x8f, 0x33, 0xbb, 0xfb, 0xc3, 0xaa, 0x1c, 0xc1,
+	0x61, 0xf6, 0x09, 0x33, 0xbf, 0x58, 0x35, 0xdb, 0xb0, 0x19, 0x2f, 0x1f, 0x3e, 0x45, 0x5a, 0x50,
+	0xcf, 0x9e, 0x4b, 0x1e, 0x04, 0x6c, 0xc2, 0xa5, 0xd7, 0xaa, 0x54, 0x85, 0xcf, 0x7e, 0x80, 0xea,
+	0xf2, 0x69, 0x49, 0x00, 0x4a, 0x67, 0xb7, 0xc2, 0xfe, 0x99, 0xeb, 0x8f, 0x48, 0x1d, 0x2a, 0xea,
+	0xe9, 0xa7, 0x6b, 0xa4, 0x09, 0x10, 0xfd, 0xa1, 0xb0, 0x9d, 0x89, 0x9e, 0x23, 0x0d, 0xa8, 0x62,
+	0xcc, 0x2d, 0x3d, 0x1f, 0x82, 0xcf, 0xc6, 0xae, 0x2f, 0x93, 0x05, 0x52, 0x83, 0xb2, 0x8c, 0xb8,
+	0xa5, 0x17, 0x9f, 0xfd, 0x2a, 0x0b, 0xe0, 0x45, 0x51, 0x81, 0x02, 0xe5, 0xcc, 0xd2, 0x1f, 0x91,
+	0x2a, 0x14, 0xbf, 0xf5, 0x6d, 0xc1, 0x75, 0x2d, 0xac, 0x1a, 0x69, 0xe9, 0xb9, 0x50, 0x48, 0xfd,
+	0xa3, 0x9e, 0x0f, 0x85, 0x70, 0x0e, 0x7a, 0x21, 0x2c, 0xb9, 0xdc, 0x44, 0xbd, 0x48, 0xb6, 0xd5,
+	0x9b, 0x05, 0xd7, 0x42, 0x2f, 0x91, 0x9d, 0xd5, 0x4b, 0x44, 0x0d, 0x96, 0xcf, 0x4f, 0xdf, 0xfd,
+	0xdd, 0xd2, 0xde, 0xde, 0xb7, 0xb4, 0x77, 0xf7, 0x2d, 0xed, 0xaf, 0xfb, 0x96, 0xf6, 0xfd, 0x27,
+	0xb1, 0xc7, 0xfc, 0x8c, 0x09, 0xdf, 0x5e, 0xb8, 0xbe, 0x3d, 0xb1, 0x1d, 0x15, 0x38, 0xbc, 0xe7,
+	0xbd, 0x99, 0xf4, 0xbc, 0x71, 0x4f, 0x2c, 0x9c, 0x71, 0x49, 0xbe, 0xd8, 0x4f, 0xfe, 0x19, 0x00,
+	0xdb, 0xfc, 0x97, 0x7f, 0x13, 0x0c, 0x00, 0x00,
 }
 
 func (m *TxnMeta) Marshal() (dAtA []byte, err error) {
@@ -1362,6 +1526,20 @@ func (m *TxnMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DNShards) > 0 {
+		for iNdEx := len(m.DNShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DNShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Coordinator != nil {
 		{
 			size, err := m.Coordinator.MarshalToSizedBuffer(dAtA[:i])
@@ -1530,6 +1708,16 @@ func (m *TxnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeout != 0 {
+		i = encodeVarintTxn(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTxn(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.RollbackDNShardRequest != nil {
 		{
 			size, err := m.RollbackDNShardRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -1661,6 +1849,11 @@ func (m *TxnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RequestID != 0 {
+		i = encodeVarintTxn(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.RollbackDNShardResponse != nil {
 		{
 			size, err := m.RollbackDNShardResponse.MarshalToSizedBuffer(dAtA[:i])
@@ -1782,6 +1975,88 @@ func (m *TxnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxnRequestBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRequestBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnResponseBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnResponseBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnResponseBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxnCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2230,6 +2505,18 @@ func (m *TxnError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTxn(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintTxn(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -2271,6 +2558,12 @@ func (m *TxnMeta) Size() (n int) {
 		l = m.Coordinator.Size()
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	if len(m.DNShards) > 0 {
+		for _, e := range m.DNShards {
+			l = e.Size()
+			n += 1 + l + sovTxn(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2356,6 +2649,12 @@ func (m *TxnRequest) Size() (n int) {
 		l = m.RollbackDNShardRequest.Size()
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	if m.RequestID != 0 {
+		n += 1 + sovTxn(uint64(m.RequestID))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovTxn(uint64(m.Timeout))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2410,6 +2709,45 @@ func (m *TxnResponse) Size() (n int) {
 		l = m.RollbackDNShardResponse.Size()
 		n += 1 + l + sovTxn(uint64(l))
 	}
+	if m.RequestID != 0 {
+		n += 1 + sovTxn(uint64(m.RequestID))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnRequestBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTxn(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnResponseBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTxn(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2603,8 +2941,15 @@ func (m *TxnError) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Code != 0 {
+		n += 1 + sovTxn(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTxn(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -2838,6 +3183,40 @@ func (m *TxnMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNShards = append(m.DNShards, metadata.DNShard{})
+			if err := m.DNShards[len(m.DNShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -3434,6 +3813,44 @@ func (m *TxnRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -3847,6 +4264,195 @@ func (m *TxnResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnRequestBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnRequestBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, TxnRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnResponseBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnResponseBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnResponseBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, TxnResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -4776,6 +5382,57 @@ func (m *TxnError) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: TxnError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
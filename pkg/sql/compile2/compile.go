@@ -16,18 +16,21 @@ package compile2
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -226,6 +229,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 		}
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  overload.Deletion,
+			Idx: int(qry.Steps[0]),
 			Arg: scp,
 		})
 	case plan.Query_INSERT:
@@ -235,6 +239,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 		}
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  overload.Insert,
+			Idx: int(qry.Steps[0]),
 			Arg: arg,
 		})
 	case plan.Query_UPDATE:
@@ -244,6 +249,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 		}
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  overload.Update,
+			Idx: int(qry.Steps[0]),
 			Arg: scp,
 		})
 	default:
@@ -265,6 +271,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 			},
 		})
 	}
+	markAnalyzeBounds(rs, -1)
 	return rs, nil
 }
 
@@ -298,6 +305,8 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
 			Attributes:   make([]string, len(n.TableDef.Cols)),
+			NodeId:       n.NodeId,
+			Filter:       constructScanFilter(n, c.proc),
 		}
 		for i, col := range n.TableDef.Cols {
 			src.Attributes[i] = col.Name
@@ -329,6 +338,13 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_AGG:
+		ds, err := c.compileSummary(n, ns)
+		if err != nil {
+			return nil, err
+		}
+		if ds != nil {
+			return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
+		}
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
 			return nil, err
@@ -388,6 +404,7 @@ func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Restrict,
+			Idx: int(n.NodeId),
 			Arg: constructRestrict(n),
 		})
 	}
@@ -398,6 +415,7 @@ func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Projection,
+			Idx: int(n.NodeId),
 			Arg: constructProjection(n),
 		})
 	}
@@ -454,6 +472,7 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 			for i := range rs {
 				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 					Op:  overload.Product,
+					Idx: int(n.NodeId),
 					Arg: constructProduct(n, c.proc),
 				})
 			}
@@ -461,6 +480,7 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 			for i := range rs {
 				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 					Op:  overload.Join,
+					Idx: int(n.NodeId),
 					Arg: constructJoin(n, c.proc),
 				})
 			}
@@ -469,6 +489,7 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 				Op:  overload.Semi,
+				Idx: int(n.NodeId),
 				Arg: constructSemi(n, c.proc),
 			})
 		}
@@ -476,6 +497,7 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 				Op:  overload.Left,
+				Idx: int(n.NodeId),
 				Arg: constructLeft(n, c.proc),
 			})
 		}
@@ -483,6 +505,7 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 				Op:  overload.Complement,
+				Idx: int(n.NodeId),
 				Arg: constructComplement(n, c.proc),
 			})
 		}
@@ -517,6 +540,7 @@ func (c *Compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Top,
+			Idx: int(n.NodeId),
 			Arg: constructTop(n, c.proc),
 		})
 	}
@@ -527,6 +551,7 @@ func (c *Compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeTop,
+		Idx: int(n.NodeId),
 		Arg: constructMergeTop(n, c.proc),
 	})
 
@@ -546,6 +571,7 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Order,
+			Idx: int(n.NodeId),
 			Arg: constructOrder(n, c.proc),
 		})
 	}
@@ -556,6 +582,7 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOrder,
+		Idx: int(n.NodeId),
 		Arg: constructMergeOrder(n, c.proc),
 	})
 
@@ -579,6 +606,7 @@ func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOffset,
+		Idx: int(n.NodeId),
 		Arg: constructMergeOffset(n, c.proc),
 	})
 
@@ -598,6 +626,7 @@ func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Limit,
+			Idx: int(n.NodeId),
 			Arg: constructLimit(n, c.proc),
 		})
 	}
@@ -608,6 +637,7 @@ func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeLimit,
+		Idx: int(n.NodeId),
 		Arg: constructMergeLimit(n, c.proc),
 	})
 
@@ -627,6 +657,7 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Group,
+			Idx: int(n.NodeId),
 			Arg: constructGroup(n, ns[n.Children[0]]),
 		})
 	}
@@ -637,6 +668,7 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeGroup,
+		Idx: int(n.NodeId),
 		Arg: constructMergeGroup(n, true),
 	})
 
@@ -652,6 +684,150 @@ func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Sc
 	return []*Scope{rs}
 }
 
+// compileSummary answers count, min and max over a whole table by the
+// summarizer of the storage engine, which needs not read every row. Only
+// the aggregates answered by the metadata are eligible, i.e. count(*) and
+// count, min and max of the single primary key, which is the sort key
+// without null value. It returns nil if the aggregates are not eligible or
+// the engine does not support summarizer
+func (c *Compile) compileSummary(n *plan.Node, ns []*plan.Node) (*Scope, error) {
+	cn := ns[n.Children[0]]
+	if len(n.GroupBy) != 0 || cn.NodeType != plan.Node_TABLE_SCAN || len(cn.FilterList) != 0 {
+		return nil, nil
+	}
+	attrs := make([]string, len(n.AggList))
+	for i, expr := range n.AggList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 1 {
+			return nil, nil
+		}
+		switch f.F.Func.ObjName {
+		case "starcount", "min", "max":
+		case "count":
+			if uint64(f.F.Func.Obj)&function.Distinct != 0 {
+				return nil, nil
+			}
+		default:
+			return nil, nil
+		}
+		col, ok := f.F.Args[0].Expr.(*plan.Expr_Col)
+		if !ok {
+			return nil, nil
+		}
+		scol, ok := cn.ProjectList[col.Col.ColPos].Expr.(*plan.Expr_Col)
+		if !ok {
+			return nil, nil
+		}
+		attrs[i] = cn.TableDef.Cols[scol.Col.ColPos].Name
+	}
+
+	snap := engine.Snapshot(c.proc.Snapshot)
+	db, err := c.e.Database(cn.ObjRef.SchemaName, snap)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(cn.TableDef.Name, snap)
+	if err != nil {
+		return nil, err
+	}
+	pks := rel.GetPrimaryKeys(snap)
+	for i, expr := range n.AggList {
+		if expr.Expr.(*plan.Expr_F).F.Func.ObjName == "starcount" {
+			continue
+		}
+		if len(pks) != 1 || pks[0].Name != attrs[i] {
+			return nil, nil
+		}
+	}
+	keys, _ := rel.GetPriKeyOrHideKey(snap)
+	if len(keys) != 1 {
+		return nil, nil
+	}
+	rds := rel.NewReader(1, nil, nil, snap)
+	if len(rds) == 0 {
+		return nil, nil
+	}
+	rd, ok := rds[0].(engine.SummarizerReader)
+	if !ok {
+		return nil, nil
+	}
+	summarizer := rd.NewSummarizer()
+	if summarizer == nil {
+		return nil, nil
+	}
+
+	bat := batch.NewWithSize(len(n.AggList))
+	for i, expr := range n.AggList {
+		var v any
+		switch expr.Expr.(*plan.Expr_F).F.Func.ObjName {
+		case "starcount":
+			// the primary key or the hidden key has no null value
+			v, err = summarizer.Count(keys[0].Name, nil)
+		case "count":
+			v, err = summarizer.Count(attrs[i], nil)
+		case "min":
+			v, err = summarizer.Min(attrs[i], nil)
+		case "max":
+			v, err = summarizer.Max(attrs[i], nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cnt, ok := v.(uint64); ok {
+			v = int64(cnt)
+		}
+		typ := types.Type{
+			Oid:       types.T(expr.Typ.Id),
+			Width:     expr.Typ.Width,
+			Size:      expr.Typ.Size,
+			Scale:     expr.Typ.Scale,
+			Precision: expr.Typ.Precision,
+		}
+		if bat.Vecs[i], err = newSummaryVector(typ, v); err != nil {
+			return nil, err
+		}
+		if bat.Vecs[i] == nil {
+			return nil, nil
+		}
+	}
+	bat.InitZsOne(1)
+	ds := &Scope{
+		Magic:      Normal,
+		DataSource: &Source{Bat: bat},
+	}
+	ds.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+	return ds, nil
+}
+
+// newSummaryVector returns a vector of one row with the value v, which is
+// null if v is nil. It returns nil if v is not of the type of the vector,
+// e.g. the decimal of the storage engine, and the aggregate is then
+// computed by reading the rows
+func newSummaryVector(typ types.Type, v any) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	if v == nil {
+		nulls.Add(vec.Nsp, 0)
+	}
+	switch col := vec.Col.(type) {
+	case *types.Bytes:
+		bs, ok := v.([]byte)
+		if !ok && v != nil {
+			return nil, nil
+		}
+		return vec, col.Append([][]byte{bs})
+	default:
+		elem := reflect.TypeOf(col).Elem()
+		val := reflect.ValueOf(v)
+		if v == nil {
+			val = reflect.Zero(elem)
+		} else if val.Type() != elem {
+			return nil, nil
+		}
+		arg := reflect.Append(reflect.MakeSlice(reflect.TypeOf(col), 0, 1), val)
+		return vec, vector.Append(vec, arg.Interface())
+	}
+}
+
 func rewriteExprListForAggNode(es []*plan.Expr, groupSize int32) {
 	for i := range es {
 		rewriteExprForAggNode(es[i], groupSize)
@@ -689,3 +865,52 @@ func joinType(n *plan.Node, ns []*plan.Node) (bool, plan.Node_JoinFlag) {
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join typ '%v' not support now", n.JoinType)))
 	}
 }
+
+// markAnalyzeBounds marks the first and the last instructions of each plan node
+// in the scope tree, an instruction is the first one of its node if it receives
+// data from other nodes, and the last one if it sends data to other nodes.
+// next is the index of the node consuming the output of s, and the index of the
+// node producing the output of s is returned, both are -1 if there is none.
+func markAnalyzeBounds(s *Scope, next int) int {
+	consumer := next
+	for _, in := range s.Instructions {
+		if overload.Analyzable(in.Op) {
+			consumer = in.Idx
+			break
+		}
+	}
+	producers := make([]int, len(s.PreScopes))
+	for i := range s.PreScopes {
+		producers[i] = markAnalyzeBounds(s.PreScopes[i], consumer)
+	}
+	prev := -1
+	for i := range s.Instructions {
+		in := &s.Instructions[i]
+		if !overload.Analyzable(in.Op) {
+			continue
+		}
+		if prev == -1 {
+			in.IsFirst = true
+			for _, p := range producers {
+				if p == in.Idx {
+					in.IsFirst = false
+				}
+			}
+		} else {
+			in.IsFirst = prev != in.Idx
+		}
+		prev = in.Idx
+	}
+	for i := len(s.Instructions) - 1; i >= 0; i-- {
+		in := &s.Instructions[i]
+		if !overload.Analyzable(in.Op) {
+			continue
+		}
+		in.IsLast = next != in.Idx
+		next = in.Idx
+	}
+	if prev == -1 && len(producers) > 0 {
+		return producers[0]
+	}
+	return prev
+}
@@ -0,0 +1,259 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSummaryVector(t *testing.T) {
+	vec, err := newSummaryVector(types.Type{Oid: types.T_int64, Size: 8}, int64(3))
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, vec.Col)
+
+	vec, err = newSummaryVector(types.Type{Oid: types.T_int64, Size: 8}, nil)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(vec.Nsp, 0))
+
+	vec, err = newSummaryVector(types.Type{Oid: types.T_varchar, Size: 24}, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), vec.Col.(*types.Bytes).Get(0))
+
+	// the values of unexpected types are computed by reading the rows
+	vec, err = newSummaryVector(types.Type{Oid: types.T_decimal64, Size: 8}, "1.5")
+	require.NoError(t, err)
+	require.Nil(t, vec)
+	vec, err = newSummaryVector(types.Type{Oid: types.T_varchar, Size: 24}, int32(1))
+	require.NoError(t, err)
+	require.Nil(t, vec)
+}
+
+// testCompilerContext resolves the tables of the engine for building the plans
+type testCompilerContext struct {
+	e    engine.Engine
+	db   string
+	snap engine.Snapshot
+}
+
+func (c *testCompilerContext) DefaultDatabase() string {
+	return c.db
+}
+
+func (c *testCompilerContext) DatabaseExists(name string) bool {
+	_, err := c.e.Database(name, c.snap)
+	return err == nil
+}
+
+func (c *testCompilerContext) relation(dbName string, tableName string) engine.Relation {
+	if len(dbName) == 0 {
+		dbName = c.db
+	}
+	db, err := c.e.Database(dbName, c.snap)
+	if err != nil {
+		return nil
+	}
+	rel, err := db.Relation(tableName, c.snap)
+	if err != nil {
+		return nil
+	}
+	return rel
+}
+
+func (c *testCompilerContext) Resolve(dbName string, tableName string) (*plan2.ObjectRef, *plan2.TableDef) {
+	rel := c.relation(dbName, tableName)
+	if rel == nil {
+		return nil, nil
+	}
+	if len(dbName) == 0 {
+		dbName = c.db
+	}
+	var cols []*plan2.ColDef
+	for _, def := range rel.TableDefs(c.snap) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			cols = append(cols, newTestColDef(attr.Attr))
+		}
+	}
+	return &plan2.ObjectRef{SchemaName: dbName, ObjName: tableName},
+		&plan2.TableDef{Name: tableName, Cols: cols}
+}
+
+func (c *testCompilerContext) ResolveVariable(string, bool, bool) (interface{}, error) {
+	return nil, nil
+}
+
+func (c *testCompilerContext) GetPrimaryKeyDef(dbName string, tableName string) []*plan2.ColDef {
+	rel := c.relation(dbName, tableName)
+	if rel == nil {
+		return nil
+	}
+	var defs []*plan2.ColDef
+	for _, key := range rel.GetPrimaryKeys(c.snap) {
+		defs = append(defs, newTestColDef(*key))
+	}
+	return defs
+}
+
+func (c *testCompilerContext) GetHideKeyDef(dbName string, tableName string) *plan2.ColDef {
+	rel := c.relation(dbName, tableName)
+	if rel == nil {
+		return nil
+	}
+	if key := rel.GetHideKey(c.snap); key != nil {
+		return newTestColDef(*key)
+	}
+	return nil
+}
+
+func (c *testCompilerContext) Cost(*plan2.ObjectRef, *plan2.Expr) *plan2.Cost {
+	return &plan2.Cost{}
+}
+
+func newTestColDef(attr engine.Attribute) *plan2.ColDef {
+	return &plan2.ColDef{
+		Name: attr.Name,
+		Typ: &plan2.Type{
+			Id:        plan.Type_TypeId(attr.Type.Oid),
+			Width:     attr.Type.Width,
+			Precision: attr.Type.Precision,
+			Scale:     attr.Type.Scale,
+			Size:      attr.Type.Size,
+		},
+		Primary: attr.Primary,
+	}
+}
+
+// runTestSQL runs the sql in the txn, and returns the columns of the result
+// and whether the aggregates are answered by the summarizer
+func runTestSQL(t *testing.T, e engine.Engine, txn moengine.Txn, sql string) ([]*vector.Vector, bool) {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stmts))
+	ctx := &testCompilerContext{e: e, db: "db", snap: txn.GetCtx()}
+	var pn *plan2.Plan
+	if _, ok := stmts[0].(*tree.Select); ok {
+		qry, err := plan2.NewBaseOptimizer(ctx).Optimize(stmts[0])
+		require.NoError(t, err)
+		pn = &plan2.Plan{Plan: &plan2.Plan_Query{Query: qry}}
+	} else {
+		pn, err = plan2.BuildPlan(ctx, stmts[0])
+		require.NoError(t, err)
+	}
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Snapshot = txn.GetCtx()
+	var vecs []*vector.Vector
+	c := New("db", sql, "", e, proc)
+	require.NoError(t, c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+		if bat == nil || len(bat.Zs) == 0 {
+			return nil
+		}
+		// the batch is cleaned after it is filled
+		require.Nil(t, vecs)
+		for _, vec := range bat.Vecs {
+			dup, err := vector.Dup(vec, proc.Mp)
+			if err != nil {
+				return err
+			}
+			vecs = append(vecs, dup)
+		}
+		return nil
+	}))
+	summarized := hasSummary(c.scope)
+	require.NoError(t, c.Run(0))
+	return vecs, summarized
+}
+
+func hasSummary(s *Scope) bool {
+	if s == nil {
+		return false
+	}
+	if s.DataSource != nil && s.DataSource.Bat != nil {
+		return true
+	}
+	for _, ps := range s.PreScopes {
+		if hasSummary(ps) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCompileSummary(t *testing.T) {
+	mockio.ResetFS()
+	tae, err := db.Open(testutils.InitTestEnv("compile2", t), nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	e := moengine.NewEngine(tae)
+
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Create(0, "db", 0, txn.GetCtx()))
+	runTestSQL(t, e, txn, "create table t (a int primary key, b int)")
+	dbase, err := e.Database("db", txn.GetCtx())
+	require.NoError(t, err)
+	rel, err := dbase.Relation("t", txn.GetCtx())
+	require.NoError(t, err)
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int32, Size: 4, Width: 32})
+	bat.Vecs[0].Col = []int32{3, 1, 2, 5}
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int32, Size: 4, Width: 32})
+	bat.Vecs[1].Col = []int32{30, 0, 20, 50}
+	nulls.Add(bat.Vecs[1].Nsp, 1)
+	require.NoError(t, rel.Write(0, bat, txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	// count, min and max of the primary key are answered by the summarizer
+	vecs, summarized := runTestSQL(t, e, txn, "select count(*), count(a), min(a), max(a) from t")
+	require.True(t, summarized)
+	require.Equal(t, 4, len(vecs))
+	require.Equal(t, []int64{4}, vecs[0].Col)
+	require.Equal(t, []int64{4}, vecs[1].Col)
+	require.Equal(t, []int32{1}, vecs[2].Col)
+	require.Equal(t, []int32{5}, vecs[3].Col)
+
+	// the aggregates of other columns, or with filters, read the rows
+	vecs, summarized = runTestSQL(t, e, txn, "select count(b), max(b) from t")
+	require.False(t, summarized)
+	require.Equal(t, 2, len(vecs))
+	require.Equal(t, []int64{3}, vecs[0].Col)
+	require.Equal(t, []int32{50}, vecs[1].Col)
+	vecs, summarized = runTestSQL(t, e, txn, "select count(*) from t where b > 20")
+	require.False(t, summarized)
+	require.Equal(t, 1, len(vecs))
+	require.Equal(t, []int64{2}, vecs[0].Col)
+	require.NoError(t, txn.Commit())
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/complement"
@@ -62,7 +64,10 @@ func init() {
 
 func dupInstruction(in vm.Instruction) vm.Instruction {
 	rin := vm.Instruction{
-		Op: in.Op,
+		Op:      in.Op,
+		Idx:     in.Idx,
+		IsFirst: in.IsFirst,
+		IsLast:  in.IsLast,
 	}
 	switch arg := in.Arg.(type) {
 	case *top.Argument:
@@ -484,3 +489,161 @@ func exprRelPos(expr *plan.Expr) int32 {
 	}
 	return -1
 }
+
+var scanFilterOps = map[string]int{
+	"=":  overload.EQ,
+	"<":  overload.LT,
+	"<=": overload.LE,
+	">":  overload.GT,
+	">=": overload.GE,
+}
+
+// constructScanFilter converts the filters of the table scan node to the
+// filter pushed down to the readers of the relation, which is used to skip the
+// blocks that cannot match. Only comparisons between a column and constants
+// are converted, filters are still evaluated by the restrict operator.
+func constructScanFilter(n *plan.Node, proc *process.Process) extend.Extend {
+	var e extend.Extend
+	for _, expr := range n.FilterList {
+		e = andScanFilter(e, newScanFilter(n, expr, proc))
+	}
+	return e
+}
+
+func newScanFilter(n *plan.Node, expr *plan.Expr, proc *process.Process) extend.Extend {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := f.F.Args
+	switch name := f.F.Func.GetObjName(); name {
+	case "and":
+		// each side of a conjunction can be pushed down alone
+		return andScanFilter(newScanFilter(n, args[0], proc), newScanFilter(n, args[1], proc))
+	case "or":
+		left := newScanFilter(n, args[0], proc)
+		right := newScanFilter(n, args[1], proc)
+		if left == nil || right == nil {
+			return nil
+		}
+		return &extend.BinaryExtend{Op: overload.Or, Left: left, Right: right}
+	case "=", "<", "<=", ">", ">=":
+		return newScanComparison(n, scanFilterOps[name], args[0], args[1], proc)
+	case "between":
+		// the binder rewrites "a between 1 and 2" to "a >= 1 and a <= 2"
+		if len(args) != 3 {
+			return nil
+		}
+		return andScanFilter(newScanComparison(n, overload.GE, args[0], args[1], proc),
+			newScanComparison(n, overload.LE, args[0], args[2], proc))
+	case "in":
+		// the binder rewrites "a in (1, 2)" to "a = 1 or a = 2" unless the
+		// list is not a tuple
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return nil
+		}
+		var e extend.Extend
+		for _, item := range list.List.List {
+			eq := newScanComparison(n, overload.EQ, args[0], item, proc)
+			if eq == nil {
+				return nil
+			}
+			if e == nil {
+				e = eq
+			} else {
+				e = &extend.BinaryExtend{Op: overload.Or, Left: e, Right: eq}
+			}
+		}
+		return e
+	}
+	return nil
+}
+
+func andScanFilter(left, right extend.Extend) extend.Extend {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	}
+	return &extend.BinaryExtend{Op: overload.And, Left: left, Right: right}
+}
+
+// newScanComparison returns nil unless the comparison is between a column and
+// a constant
+func newScanComparison(n *plan.Node, op int, left, right *plan.Expr, proc *process.Process) extend.Extend {
+	l, r := newScanOperand(n, left, proc), newScanOperand(n, right, proc)
+	if l == nil || r == nil {
+		return nil
+	}
+	_, lok := l.(*extend.Attribute)
+	_, rok := r.(*extend.Attribute)
+	if lok == rok {
+		return nil
+	}
+	return &extend.BinaryExtend{Op: op, Left: l, Right: r}
+}
+
+func newScanOperand(n *plan.Node, expr *plan.Expr, proc *process.Process) extend.Extend {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		col := n.TableDef.Cols[e.Col.ColPos]
+		return &extend.Attribute{Name: col.Name, Type: types.T(col.Typ.Id)}
+	case *plan.Expr_C:
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil
+		}
+		return &extend.ValueExtend{V: vec}
+	case *plan.Expr_F:
+		// the column may be cast to the type of the constant, which keeps
+		// the order of the values when no value is changed by the cast
+		if e.F.Func.GetObjName() != "cast" {
+			return nil
+		}
+		if _, ok := e.F.Args[0].Expr.(*plan.Expr_Col); !ok {
+			return nil
+		}
+		if isLosslessCast(types.T(e.F.Args[0].Typ.Id), types.T(expr.Typ.Id)) {
+			return newScanOperand(n, e.F.Args[0], proc)
+		}
+	}
+	return nil
+}
+
+func isLosslessCast(from, to types.T) bool {
+	fromSize, toSize := from.ToType().Size, to.ToType().Size
+	switch {
+	case isSignedInteger(from) && isSignedInteger(to):
+		return fromSize <= toSize
+	case isUnsignedInteger(from) && isUnsignedInteger(to):
+		return fromSize <= toSize
+	case isUnsignedInteger(from) && isSignedInteger(to):
+		return fromSize < toSize
+	case from == types.T_float32 && to == types.T_float64:
+		return true
+	case (from == types.T_char || from == types.T_varchar) &&
+		(to == types.T_char || to == types.T_varchar):
+		return true
+	}
+	return false
+}
+
+func isSignedInteger(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return true
+	}
+	return false
+}
+
+func isUnsignedInteger(t types.T) bool {
+	switch t {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
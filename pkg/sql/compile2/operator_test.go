@@ -0,0 +1,63 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstructScanFilter(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<20, host.New(1<<20))))
+	mock := plan2.NewMockOptimizer()
+	kases := []struct {
+		sql    string
+		filter string
+	}{
+		{"SELECT * FROM NATION WHERE N_NATIONKEY = 1", "n_nationkey = 1"},
+		{"SELECT * FROM NATION WHERE N_NATIONKEY BETWEEN 1 AND 3", "n_nationkey >= 1 and n_nationkey <= 3"},
+		{"SELECT * FROM NATION WHERE N_NATIONKEY IN (1, 2)", "n_nationkey = 1 or n_nationkey = 2"},
+		{"SELECT * FROM NATION WHERE N_NAME = 'CHINA' OR N_NAME = 'JAPAN'", "n_name = CHINA or n_name = JAPAN"},
+		// only the comparison of the conjunction is pushed down
+		{"SELECT * FROM NATION WHERE N_NATIONKEY > 1 AND N_NAME LIKE 'a%'", "n_nationkey > 1"},
+		// the disjunction can't be pushed down as a whole
+		{"SELECT * FROM NATION WHERE N_NATIONKEY > 1 OR N_NAME LIKE 'a%'", ""},
+		{"SELECT * FROM NATION WHERE N_NATIONKEY > N_REGIONKEY", ""},
+	}
+	for _, kase := range kases {
+		stmts, err := mysql.Parse(kase.sql)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(mock.CurrentContext(), stmts[0])
+		require.NoError(t, err)
+		var filter string
+		for _, n := range pn.GetQuery().Nodes {
+			if n.NodeType == plan.Node_TABLE_SCAN {
+				if e := constructScanFilter(n, proc); e != nil {
+					filter = strings.Join(strings.Fields(e.String()), " ")
+				}
+			}
+		}
+		require.Equal(t, kase.filter, filter, kase.sql)
+	}
+}
@@ -305,6 +305,7 @@ func (s *Scope) Run(e engine.Engine) (err error) {
 			return err
 		}
 	} else {
+		defer s.analyzeReader()
 		if _, err = p.Run(s.DataSource.R, s.Proc); err != nil {
 			return err
 		}
@@ -312,6 +313,18 @@ func (s *Scope) Run(e engine.Engine) (err error) {
 	return nil
 }
 
+// analyzeReader records the number of blocks read and skipped by the reader
+// of the scope for explain analyze.
+func (s *Scope) analyzeReader() {
+	rd, ok := s.DataSource.R.(engine.StatsReader)
+	if !ok {
+		return
+	}
+	if anal := s.Proc.GetAnalyze(int(s.DataSource.NodeId)); anal != nil {
+		anal.AddBlocks(rd.Stats())
+	}
+}
+
 // MergeRun range and run the scope's pre-scopes by go-routine, and finally run itself to do merge work.
 func (s *Scope) MergeRun(e engine.Engine) error {
 	errChan := make(chan error, len(s.PreScopes))
@@ -398,7 +411,7 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 		Op:  overload.Merge,
 		Arg: &merge.Argument{},
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(s.Proc.GetContext())
 	s.Proc.Cancel = cancel
 	s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	for i := range ss {
@@ -440,7 +453,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		rds = rel.NewReader(mcpu, s.DataSource.Filter, s.NodeInfo.Data, snap)
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -451,6 +464,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
 				Attributes:   s.DataSource.Attributes,
+				NodeId:       s.DataSource.NodeId,
 			},
 		}
 		ss[i].Proc = process.New(mheap.New(s.Proc.Mp.Gm))
@@ -458,6 +472,8 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		ss[i].Proc.Lim = s.Proc.Lim
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
+		ss[i].Proc.Ctx = s.Proc.Ctx
+		ss[i].Proc.Stats = s.Proc.Stats
 	}
 	{
 		var flg bool
@@ -472,7 +488,9 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*top.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeTop,
+					Op:     overload.MergeTop,
+					Idx:    in.Idx,
+					IsLast: in.IsLast,
 					Arg: &mergetop.Argument{
 						Fs:    arg.Fs,
 						Limit: arg.Limit,
@@ -480,7 +498,9 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Top,
+						Op:      overload.Top,
+						Idx:     in.Idx,
+						IsFirst: in.IsFirst,
 						Arg: &top.Argument{
 							Fs:    arg.Fs,
 							Limit: arg.Limit,
@@ -492,14 +512,18 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*order.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeOrder,
+					Op:     overload.MergeOrder,
+					Idx:    in.Idx,
+					IsLast: in.IsLast,
 					Arg: &mergeorder.Argument{
 						Fs: arg.Fs,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Order,
+						Op:      overload.Order,
+						Idx:     in.Idx,
+						IsFirst: in.IsFirst,
 						Arg: &order.Argument{
 							Fs: arg.Fs,
 						},
@@ -510,14 +534,18 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*limit.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeLimit,
+					Op:     overload.MergeLimit,
+					Idx:    in.Idx,
+					IsLast: in.IsLast,
 					Arg: &mergelimit.Argument{
 						Limit: arg.Limit,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Limit,
+						Op:      overload.Limit,
+						Idx:     in.Idx,
+						IsFirst: in.IsFirst,
 						Arg: &limit.Argument{
 							Limit: arg.Limit,
 						},
@@ -528,14 +556,18 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*group.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeGroup,
+					Op:     overload.MergeGroup,
+					Idx:    in.Idx,
+					IsLast: in.IsLast,
 					Arg: &mergegroup.Argument{
 						NeedEval: false,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Group,
+						Op:      overload.Group,
+						Idx:     in.Idx,
+						IsFirst: in.IsFirst,
 						Arg: &group.Argument{
 							Aggs:  arg.Aggs,
 							Exprs: arg.Exprs,
@@ -548,14 +580,18 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*offset.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeOffset,
+					Op:     overload.MergeOffset,
+					Idx:    in.Idx,
+					IsLast: in.IsLast,
 					Arg: &mergeoffset.Argument{
 						Offset: arg.Offset,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Offset,
+						Op:      overload.Offset,
+						Idx:     in.Idx,
+						IsFirst: in.IsFirst,
 						Arg: &offset.Argument{
 							Offset: arg.Offset,
 						},
@@ -579,7 +615,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 			s.Instructions = s.Instructions[:2]
 		}
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(s.Proc.GetContext())
 	s.Magic = Merge
 	s.PreScopes = ss
 	s.Proc.Cancel = cancel
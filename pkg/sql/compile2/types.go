@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -50,6 +51,10 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// NodeId, id of the table scan node which reads the relation.
+	NodeId int32
+	// Filter, filters of the table scan node pushed down to the readers.
+	Filter extend.Extend
 }
 
 // Col is the information of attribute
@@ -368,89 +368,93 @@ const UNCOMMITTED = 57690
 const SERIALIZABLE = 57691
 const LOCAL = 57692
 const EXCEPT = 57693
-const CURRENT_TIMESTAMP = 57694
-const DATABASE = 57695
-const CURRENT_TIME = 57696
-const LOCALTIME = 57697
-const LOCALTIMESTAMP = 57698
-const UTC_DATE = 57699
-const UTC_TIME = 57700
-const UTC_TIMESTAMP = 57701
-const REPLACE = 57702
-const CONVERT = 57703
-const SEPARATOR = 57704
-const CURRENT_DATE = 57705
-const CURRENT_USER = 57706
-const CURRENT_ROLE = 57707
-const SECOND_MICROSECOND = 57708
-const MINUTE_MICROSECOND = 57709
-const MINUTE_SECOND = 57710
-const HOUR_MICROSECOND = 57711
-const HOUR_SECOND = 57712
-const HOUR_MINUTE = 57713
-const DAY_MICROSECOND = 57714
-const DAY_SECOND = 57715
-const DAY_MINUTE = 57716
-const DAY_HOUR = 57717
-const YEAR_MONTH = 57718
-const SQL_TSI_HOUR = 57719
-const SQL_TSI_DAY = 57720
-const SQL_TSI_WEEK = 57721
-const SQL_TSI_MONTH = 57722
-const SQL_TSI_QUARTER = 57723
-const SQL_TSI_YEAR = 57724
-const SQL_TSI_SECOND = 57725
-const SQL_TSI_MINUTE = 57726
-const RECURSIVE = 57727
-const MATCH = 57728
-const AGAINST = 57729
-const BOOLEAN = 57730
-const LANGUAGE = 57731
-const WITH = 57732
-const QUERY = 57733
-const EXPANSION = 57734
-const ADDDATE = 57735
-const BIT_AND = 57736
-const BIT_OR = 57737
-const BIT_XOR = 57738
-const CAST = 57739
-const COUNT = 57740
-const APPROX_COUNT_DISTINCT = 57741
-const APPROX_PERCENTILE = 57742
-const CURDATE = 57743
-const CURTIME = 57744
-const DATE_ADD = 57745
-const DATE_SUB = 57746
-const EXTRACT = 57747
-const GROUP_CONCAT = 57748
-const MAX = 57749
-const MID = 57750
-const MIN = 57751
-const NOW = 57752
-const POSITION = 57753
-const SESSION_USER = 57754
-const STD = 57755
-const STDDEV = 57756
-const STDDEV_POP = 57757
-const STDDEV_SAMP = 57758
-const SUBDATE = 57759
-const SUBSTR = 57760
-const SUBSTRING = 57761
-const SUM = 57762
-const SYSDATE = 57763
-const SYSTEM_USER = 57764
-const TRANSLATE = 57765
-const TRIM = 57766
-const VARIANCE = 57767
-const VAR_POP = 57768
-const VAR_SAMP = 57769
-const AVG = 57770
-const ROW = 57771
-const OUTFILE = 57772
-const HEADER = 57773
-const MAX_FILE_SIZE = 57774
-const FORCE_QUOTE = 57775
-const UNUSED = 57776
+const KILL = 57694
+const CURRENT_TIMESTAMP = 57695
+const DATABASE = 57696
+const CURRENT_TIME = 57697
+const LOCALTIME = 57698
+const LOCALTIMESTAMP = 57699
+const UTC_DATE = 57700
+const UTC_TIME = 57701
+const UTC_TIMESTAMP = 57702
+const REPLACE = 57703
+const CONVERT = 57704
+const SEPARATOR = 57705
+const CURRENT_DATE = 57706
+const CURRENT_USER = 57707
+const CURRENT_ROLE = 57708
+const SECOND_MICROSECOND = 57709
+const MINUTE_MICROSECOND = 57710
+const MINUTE_SECOND = 57711
+const HOUR_MICROSECOND = 57712
+const HOUR_SECOND = 57713
+const HOUR_MINUTE = 57714
+const DAY_MICROSECOND = 57715
+const DAY_SECOND = 57716
+const DAY_MINUTE = 57717
+const DAY_HOUR = 57718
+const YEAR_MONTH = 57719
+const SQL_TSI_HOUR = 57720
+const SQL_TSI_DAY = 57721
+const SQL_TSI_WEEK = 57722
+const SQL_TSI_MONTH = 57723
+const SQL_TSI_QUARTER = 57724
+const SQL_TSI_YEAR = 57725
+const SQL_TSI_SECOND = 57726
+const SQL_TSI_MINUTE = 57727
+const RECURSIVE = 57728
+const MATCH = 57729
+const AGAINST = 57730
+const BOOLEAN = 57731
+const LANGUAGE = 57732
+const WITH = 57733
+const QUERY = 57734
+const EXPANSION = 57735
+const ADDDATE = 57736
+const BIT_AND = 57737
+const BIT_OR = 57738
+const BIT_XOR = 57739
+const CAST = 57740
+const COUNT = 57741
+const APPROX_COUNT_DISTINCT = 57742
+const APPROX_PERCENTILE = 57743
+const CURDATE = 57744
+const CURTIME = 57745
+const DATE_ADD = 57746
+const DATE_SUB = 57747
+const EXTRACT = 57748
+const TIMESTAMPADD = 57749
+const TIMESTAMPDIFF = 57750
+const GROUP_CONCAT = 57751
+const MAX = 57752
+const MID = 57753
+const MIN = 57754
+const NOW = 57755
+const POSITION = 57756
+const SESSION_USER = 57757
+const STD = 57758
+const STDDEV = 57759
+const STDDEV_POP = 57760
+const STDDEV_SAMP = 57761
+const SUBDATE = 57762
+const SUBSTR = 57763
+const SUBSTRING = 57764
+const SUM = 57765
+const SYSDATE = 57766
+const SYSTEM_USER = 57767
+const TRANSLATE = 57768
+const TRIM = 57769
+const VARIANCE = 57770
+const VAR_POP = 57771
+const VAR_SAMP = 57772
+const AVG = 57773
+const ROW = 57774
+const OUTFILE = 57775
+const HEADER = 57776
+const MAX_FILE_SIZE = 57777
+const FORCE_QUOTE = 57778
+const ROW_GROUP_SIZE = 57779
+const UNUSED = 57780
 
 var yyToknames = [...]string{
 	"$end",
@@ -821,6 +825,7 @@ var yyToknames = [...]string{
 	"SERIALIZABLE",
 	"LOCAL",
 	"EXCEPT",
+	"KILL",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -875,6 +880,8 @@ var yyToknames = [...]string{
 	"DATE_ADD",
 	"DATE_SUB",
 	"EXTRACT",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
 	"GROUP_CONCAT",
 	"MAX",
 	"MID",
@@ -903,6 +910,7 @@ var yyToknames = [...]string{
 	"HEADER",
 	"MAX_FILE_SIZE",
 	"FORCE_QUOTE",
+	"ROW_GROUP_SIZE",
 	"UNUSED",
 	"';'",
 	"'@'",
@@ -914,2187 +922,2230 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6500
+//line mysql_sql.y:6593
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 354,
-	-2, 335,
-	-1, 57,
-	189, 502,
-	-2, 538,
-	-1, 66,
-	216, 244,
-	217, 244,
-	-2, 264,
-	-1, 316,
-	58, 1322,
-	453, 1322,
-	-2, 92,
-	-1, 335,
-	58, 665,
-	453, 665,
-	-2, 500,
-	-1, 336,
-	58, 493,
-	453, 493,
-	-2, 501,
-	-1, 342,
-	17, 355,
-	-2, 318,
-	-1, 572,
-	17, 355,
-	-2, 318,
-	-1, 594,
-	54, 1349,
-	-2, 1356,
-	-1, 602,
-	54, 1350,
-	-2, 1364,
-	-1, 604,
-	54, 1346,
-	-2, 1366,
-	-1, 605,
-	54, 1347,
-	-2, 1367,
-	-1, 610,
-	54, 1348,
-	-2, 1373,
+	-1, 54,
+	17, 365,
+	-2, 346,
+	-1, 59,
+	189, 513,
+	-2, 549,
+	-1, 68,
+	216, 251,
+	217, 251,
+	-2, 271,
+	-1, 321,
+	58, 1337,
+	457, 1337,
+	-2, 95,
+	-1, 340,
+	58, 677,
+	457, 677,
+	-2, 511,
+	-1, 341,
+	58, 504,
+	457, 504,
+	-2, 512,
+	-1, 350,
+	17, 366,
+	-2, 325,
+	-1, 581,
+	17, 366,
+	-2, 325,
+	-1, 603,
+	54, 1365,
+	-2, 1371,
 	-1, 611,
-	54, 1351,
-	-2, 1374,
-	-1, 612,
-	54, 1352,
-	-2, 1375,
+	54, 1366,
+	-2, 1379,
 	-1, 613,
-	54, 791,
-	-2, 1376,
-	-1, 614,
-	54, 792,
-	-2, 1377,
-	-1, 615,
-	54, 793,
-	-2, 1378,
-	-1, 617,
-	54, 1353,
-	-2, 1380,
-	-1, 618,
-	54, 810,
+	54, 1362,
 	-2, 1381,
-	-1, 619,
-	54, 809,
+	-1, 614,
+	54, 1363,
 	-2, 1382,
+	-1, 619,
+	54, 1364,
+	-2, 1388,
+	-1, 621,
+	54, 1367,
+	-2, 1390,
 	-1, 622,
-	54, 1354,
-	-2, 1385,
+	54, 803,
+	-2, 1391,
 	-1, 623,
-	54, 1355,
-	-2, 1386,
-	-1, 629,
-	54, 884,
-	-2, 1267,
-	-1, 630,
-	54, 895,
-	-2, 1327,
+	54, 804,
+	-2, 1392,
+	-1, 624,
+	54, 805,
+	-2, 1393,
+	-1, 626,
+	54, 1368,
+	-2, 1395,
+	-1, 627,
+	54, 825,
+	-2, 1396,
+	-1, 628,
+	54, 824,
+	-2, 1397,
 	-1, 631,
-	54, 897,
-	-2, 1337,
-	-1, 632,
-	54, 885,
+	54, 1369,
+	-2, 1400,
+	-1, 634,
+	54, 1370,
+	-2, 1403,
+	-1, 640,
+	54, 899,
+	-2, 1282,
+	-1, 641,
+	54, 910,
 	-2, 1342,
-	-1, 787,
-	1, 528,
-	56, 528,
-	452, 528,
-	-2, 535,
-	-1, 910,
-	17, 354,
-	-2, 723,
-	-1, 960,
-	119, 1037,
-	-2, 1035,
-	-1, 962,
-	119, 442,
-	-2, 1032,
-	-1, 963,
-	119, 443,
-	-2, 1033,
-	-1, 1161,
-	1, 529,
-	56, 529,
-	452, 529,
-	-2, 535,
-	-1, 1219,
-	54, 940,
-	-2, 1344,
-	-1, 1220,
-	54, 941,
-	-2, 1345,
-	-1, 1619,
-	75, 535,
-	115, 535,
-	149, 535,
-	152, 535,
-	-2, 575,
-	-1, 1621,
-	250, 690,
-	-2, 671,
-	-1, 1742,
-	75, 535,
-	115, 535,
-	149, 535,
-	152, 535,
-	-2, 576,
-	-1, 1770,
-	250, 690,
-	-2, 672,
-	-1, 2162,
-	55, 550,
-	56, 550,
-	-2, 535,
-	-1, 2166,
-	55, 550,
-	56, 550,
-	-2, 535,
-	-1, 2178,
-	55, 554,
-	56, 554,
-	-2, 535,
-	-1, 2181,
-	55, 555,
-	56, 555,
-	-2, 535,
+	-1, 642,
+	54, 912,
+	-2, 1352,
+	-1, 643,
+	54, 900,
+	-2, 1358,
+	-1, 947,
+	1, 539,
+	56, 539,
+	456, 539,
+	-2, 546,
+	-1, 1073,
+	17, 365,
+	-2, 735,
+	-1, 1123,
+	119, 1052,
+	-2, 1050,
+	-1, 1125,
+	119, 453,
+	-2, 1047,
+	-1, 1126,
+	119, 454,
+	-2, 1048,
+	-1, 1176,
+	1, 540,
+	56, 540,
+	456, 540,
+	-2, 546,
+	-1, 1234,
+	54, 955,
+	-2, 1360,
+	-1, 1235,
+	54, 956,
+	-2, 1361,
+	-1, 1640,
+	75, 546,
+	115, 546,
+	149, 546,
+	152, 546,
+	-2, 586,
+	-1, 1642,
+	250, 702,
+	-2, 683,
+	-1, 1765,
+	75, 546,
+	115, 546,
+	149, 546,
+	152, 546,
+	-2, 587,
+	-1, 1793,
+	250, 702,
+	-2, 684,
+	-1, 2202,
+	55, 561,
+	56, 561,
+	-2, 546,
+	-1, 2207,
+	55, 561,
+	56, 561,
+	-2, 546,
+	-1, 2219,
+	55, 565,
+	56, 565,
+	-2, 546,
+	-1, 2222,
+	55, 566,
+	56, 566,
+	-2, 546,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 20072
+const yyLast = 20533
 
 var yyAct = [...]int{
-	777, 2166, 1222, 2168, 2165, 2173, 2139, 635, 2113, 1815,
-	766, 2003, 633, 653, 2084, 2128, 1782, 2065, 1979, 2066,
-	559, 1982, 1738, 1956, 84, 524, 1613, 292, 1148, 840,
-	1911, 1813, 557, 1814, 1805, 303, 87, 1967, 1507, 462,
-	84, 305, 1884, 1771, 393, 1680, 296, 19, 1397, 337,
-	337, 1697, 1804, 1800, 763, 512, 1700, 583, 1709, 83,
-	1503, 593, 823, 1491, 1705, 1540, 663, 52, 1373, 1519,
-	1512, 1531, 1666, 394, 1558, 1508, 1154, 1547, 1557, 415,
-	718, 942, 634, 84, 1442, 298, 528, 847, 567, 957,
-	1223, 960, 951, 52, 943, 1307, 1404, 1293, 1210, 644,
-	3, 952, 1237, 312, 312, 760, 295, 12, 293, 6,
-	294, 5, 1746, 816, 1367, 1162, 779, 1224, 428, 343,
-	51, 761, 586, 342, 1221, 735, 500, 19, 793, 307,
-	791, 1130, 792, 285, 1121, 464, 842, 288, 439, 820,
-	879, 404, 406, 849, 414, 568, 385, 52, 309, 752,
-	1137, 308, 450, 80, 299, 479, 549, 1829, 1734, 1612,
-	774, 945, 533, 585, 1133, 535, 79, 2031, 23, 39,
-	24, 1349, 412, 1492, 1368, 79, 2020, 531, 510, 339,
-	1356, 425, 810, 79, 499, 77, 65, 12, 344, 6,
-	72, 5, 79, 400, 79, 362, 405, 402, 79, 2053,
-	23, 39, 24, 805, 806, 355, 1359, 715, 372, 40,
-	712, 795, 536, 79, 75, 23, 39, 24, 769, 1468,
-	410, 409, 523, 75, 2051, 522, 525, 526, 525, 526,
-	494, 714, 386, 2069, 2070, 1912, 1913, 1914, 1915, 490,
-	75, 2088, 75, 1909, 1495, 1991, 75, 1496, 1994, 1497,
-	408, 1832, 1614, 773, 1336, 442, 1520, 1521, 1522, 1523,
-	433, 75, 401, 1376, 1374, 1371, 1375, 1377, 1541, 1370,
-	1369, 817, 1376, 1374, 1544, 1375, 1377, 1135, 373, 1883,
-	68, 69, 1133, 70, 71, 84, 432, 1791, 1790, 481,
-	480, 485, 1787, 1731, 491, 431, 1609, 1524, 84, 492,
-	493, 1900, 753, 2030, 1692, 1688, 2079, 1691, 2174, 1890,
-	1213, 1214, 1215, 2158, 2093, 2055, 357, 2050, 2005, 486,
-	1543, 1211, 1981, 2100, 466, 2028, 354, 353, 755, 1878,
-	2149, 1847, 2068, 446, 1968, 1969, 1970, 1972, 1971, 1846,
-	467, 57, 67, 76, 341, 38, 2011, 349, 2001, 2002,
-	407, 2005, 369, 2057, 2058, 1559, 52, 52, 406, 532,
-	545, 66, 64, 63, 488, 1357, 2175, 2033, 2034, 2169,
-	430, 472, 2140, 442, 1379, 1380, 1381, 1382, 1570, 1567,
-	1568, 1569, 337, 1835, 1564, 427, 1563, 1562, 1560, 394,
-	394, 394, 511, 489, 521, 520, 1689, 483, 1443, 513,
-	1353, 534, 411, 444, 443, 514, 1989, 516, 754, 484,
-	487, 505, 405, 1184, 415, 1141, 781, 589, 589, 482,
-	1410, 1214, 1215, 1516, 1941, 471, 562, 435, 436, 1869,
-	717, 515, 476, 1610, 1873, 297, 312, 352, 1707, 1706,
-	1182, 1181, 1395, 1561, 1180, 539, 732, 348, 432, 84,
-	84, 84, 84, 48, 537, 538, 808, 736, 809, 49,
-	749, 1179, 807, 374, 2131, 375, 2153, 2117, 570, 831,
-	1498, 1407, 1347, 713, 1346, 1335, 337, 337, 432, 337,
-	1329, 1174, 1146, 1115, 466, 860, 720, 767, 564, 502,
-	445, 517, 1980, 52, 525, 526, 50, 337, 337, 356,
-	467, 366, 429, 750, 52, 2056, 895, 588, 588, 367,
-	2032, 544, 529, 337, 1212, 337, 1492, 787, 84, 525,
-	526, 444, 443, 571, 573, 1484, 437, 402, 572, 2135,
-	504, 312, 800, 768, 337, 1517, 786, 1376, 1374, 818,
-	1375, 1377, 2126, 548, 552, 1136, 337, 394, 556, 337,
-	478, 1687, 1156, 788, 1565, 1566, 798, 1690, 1532, 527,
-	2015, 530, 1350, 2132, 518, 832, 782, 78, 496, 312,
-	576, 577, 578, 579, 580, 723, 78, 337, 337, 839,
-	84, 710, 415, 1331, 78, 848, 582, 569, 801, 857,
-	1186, 1119, 401, 78, 776, 78, 1486, 780, 434, 78,
-	312, 843, 771, 737, 738, 739, 740, 789, 790, 796,
-	397, 748, 797, 547, 78, 841, 1591, 844, 783, 772,
-	854, 553, 554, 555, 1409, 765, 756, 1874, 1875, 775,
-	1513, 1516, 312, 1942, 1944, 1945, 1946, 1943, 1871, 802,
-	824, 912, 1870, 824, 785, 770, 1485, 824, 727, 728,
-	1385, 550, 519, 377, 1132, 1226, 1225, 794, 1880, 1308,
-	834, 397, 551, 923, 364, 1365, 365, 372, 856, 854,
-	819, 363, 361, 360, 368, 814, 370, 371, 1879, 2129,
-	2130, 861, 837, 1308, 399, 1448, 784, 1387, 418, 423,
-	424, 1670, 826, 815, 833, 1665, 830, 1864, 2148, 835,
-	1952, 1841, 379, 378, 1131, 910, 2164, 838, 1950, 2145,
-	827, 828, 829, 2110, 73, 949, 949, 954, 376, 836,
-	2146, 1234, 913, 914, 915, 916, 845, 1948, 1451, 911,
-	1236, 1450, 731, 1739, 848, 399, 1951, 919, 1387, 2147,
-	730, 962, 1231, 1517, 1949, 917, 2094, 2040, 1510, 563,
-	1987, 1986, 1511, 1514, 855, 856, 854, 963, 938, 405,
-	1958, 1938, 887, 1947, 1386, 894, 893, 903, 904, 896,
-	897, 898, 899, 900, 901, 902, 895, 468, 469, 470,
-	560, 406, 84, 84, 1417, 468, 469, 470, 560, 403,
-	380, 52, 855, 856, 854, 292, 558, 1937, 468, 469,
-	470, 1682, 1176, 1936, 1515, 1935, 2089, 1129, 1934, 931,
-	956, 337, 1931, 843, 1925, 1117, 948, 1151, 1153, 1922,
-	1921, 1116, 1149, 1150, 468, 469, 470, 560, 1887, 844,
-	1830, 1823, 337, 1822, 2078, 405, 561, 1821, 955, 855,
-	856, 854, 402, 2123, 561, 1820, 420, 421, 422, 1817,
-	1676, 589, 1300, 84, 941, 1675, 961, 1683, 1674, 1206,
-	1673, 1208, 1113, 1480, 1114, 312, 1298, 1299, 1297, 1165,
-	1166, 1167, 1312, 721, 1126, 855, 856, 854, 1168, 1177,
-	1232, 1233, 2061, 561, 1957, 2022, 1191, 2009, 894, 893,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	1163, 2008, 1140, 1939, 1932, 938, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 824, 824,
-	824, 1302, 1303, 1928, 1170, 1216, 1172, 1173, 1171, 1169,
-	1199, 794, 1927, 1926, 1885, 1318, 1866, 1724, 1202, 1831,
-	1183, 588, 468, 469, 470, 1203, 1204, 1205, 1398, 1320,
-	893, 903, 904, 896, 897, 898, 899, 900, 901, 902,
-	895, 1192, 1642, 1193, 1737, 1735, 1229, 1187, 1188, 1189,
-	855, 856, 854, 1200, 1723, 1684, 1529, 1528, 1593, 1272,
-	896, 897, 898, 899, 900, 901, 902, 895, 1430, 1774,
-	1301, 1227, 1228, 1527, 1230, 2062, 855, 856, 854, 2178,
-	1267, 1268, 1269, 1270, 1271, 1295, 1526, 1277, 1278, 1279,
-	1280, 1309, 1143, 1142, 939, 934, 1314, 855, 856, 854,
-	2156, 933, 1323, 1985, 1777, 1310, 1311, 2037, 722, 2036,
-	1772, 1458, 2016, 1429, 1413, 1457, 1785, 1786, 1413, 2183,
-	1965, 1773, 1902, 1580, 1334, 855, 856, 854, 1630, 1901,
-	1313, 1315, 1316, 2177, 2176, 855, 856, 854, 1322, 1725,
-	1319, 1722, 1321, 1649, 1653, 1655, 1657, 1659, 1660, 1662,
-	1721, 1570, 1567, 1568, 1569, 1778, 1696, 1644, 1645, 1646,
-	1647, 1628, 1629, 1650, 1619, 1631, 1601, 1632, 1633, 1634,
-	1635, 1636, 1637, 1638, 1639, 1640, 1641, 1648, 898, 899,
-	900, 901, 902, 895, 1546, 1652, 1654, 1656, 1658, 1661,
-	855, 856, 854, 1139, 2159, 1545, 1337, 1461, 1145, 432,
-	864, 865, 866, 867, 868, 869, 870, 862, 736, 1907,
-	2155, 2154, 1459, 1454, 337, 346, 1643, 337, 1139, 2143,
-	432, 906, 337, 909, 1456, 345, 1455, 1362, 1453, 1352,
-	1422, 855, 856, 854, 1784, 1144, 1509, 907, 908, 905,
-	1895, 894, 893, 903, 904, 896, 897, 898, 899, 900,
-	901, 902, 895, 2121, 1419, 1392, 1139, 2142, 855, 856,
-	854, 1780, 855, 856, 854, 337, 575, 2116, 2115, 1897,
-	2076, 1351, 1897, 2071, 1412, 84, 84, 1195, 2059, 1403,
-	855, 856, 854, 1779, 1781, 2048, 2047, 1394, 1384, 1364,
-	1341, 1897, 2026, 1342, 1897, 2025, 1344, 1317, 894, 893,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	1400, 1401, 1460, 1418, 751, 1360, 1361, 1339, 780, 1897,
-	2024, 402, 574, 19, 1340, 1824, 2134, 1354, 1717, 1388,
-	1897, 2023, 2014, 2013, 495, 1348, 473, 1389, 474, 1390,
-	474, 1787, 852, 52, 1363, 1963, 1964, 855, 856, 854,
-	855, 856, 854, 1775, 1163, 1963, 1962, 1383, 894, 893,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	1396, 1413, 1393, 1906, 1905, 1904, 1903, 1402, 1716, 1437,
-	1399, 1715, 719, 12, 1391, 6, 850, 5, 1897, 1896,
-	1408, 475, 1440, 1441, 1324, 1414, 1599, 1411, 1415, 1416,
-	855, 856, 854, 855, 856, 854, 1198, 1604, 949, 1620,
-	1472, 949, 1413, 1585, 1475, 1413, 1571, 1651, 855, 856,
-	854, 1413, 1421, 1133, 848, 1118, 337, 1413, 1420, 1602,
-	337, 337, 1198, 1338, 337, 476, 1478, 1406, 1424, 1425,
-	1426, 1427, 1428, 910, 1432, 1333, 1332, 432, 1433, 1434,
-	1435, 1436, 1479, 1469, 1327, 1326, 1506, 1198, 1197, 84,
-	476, 1439, 1139, 1138, 725, 724, 1330, 1305, 1467, 1195,
-	1147, 581, 79, 52, 1474, 1438, 1445, 1295, 546, 1449,
-	2179, 2125, 1447, 2119, 1487, 1489, 2101, 84, 1551, 2163,
-	1471, 1590, 2098, 1462, 1530, 1452, 824, 405, 2096, 1464,
-	1463, 1584, 824, 1473, 1583, 1476, 2039, 1477, 1481, 1470,
-	1582, 1482, 1977, 855, 856, 854, 1961, 1959, 1954, 1916,
-	75, 1525, 1699, 855, 856, 854, 855, 856, 854, 1893,
-	1892, 1483, 855, 856, 854, 1891, 1888, 1533, 1534, 1490,
-	903, 904, 896, 897, 898, 899, 900, 901, 902, 895,
-	1535, 1536, 1581, 1598, 1877, 1862, 1801, 1798, 1537, 1797,
-	1701, 584, 1710, 1889, 1595, 1577, 1713, 1678, 1671, 337,
-	1296, 1597, 1550, 1576, 855, 856, 854, 75, 1366, 1551,
-	1343, 84, 1325, 1196, 1553, 1185, 1589, 855, 856, 854,
-	1664, 1575, 1178, 940, 1572, 855, 856, 854, 937, 936,
-	935, 1578, 1579, 1159, 1586, 1574, 1588, 932, 880, 1594,
-	929, 927, 926, 855, 856, 854, 1618, 1573, 925, 1592,
-	920, 1600, 892, 1605, 891, 1596, 1617, 855, 856, 854,
-	1603, 890, 1695, 889, 1681, 888, 1556, 886, 885, 855,
-	856, 854, 884, 883, 1679, 882, 52, 1608, 1668, 881,
-	2106, 878, 1555, 322, 877, 321, 325, 317, 855, 856,
-	854, 1627, 1667, 1663, 1667, 876, 1669, 313, 1672, 875,
-	874, 873, 872, 1677, 855, 856, 854, 871, 332, 733,
-	306, 716, 1554, 477, 2104, 1686, 2067, 337, 337, 1378,
-	1304, 84, 719, 1194, 1702, 1703, 1704, 1125, 1685, 1122,
-	1123, 432, 497, 1743, 855, 856, 854, 1711, 1128, 1714,
-	1506, 1708, 855, 856, 854, 745, 1127, 742, 741, 1694,
-	746, 452, 455, 456, 457, 453, 1732, 454, 458, 1719,
-	338, 1328, 452, 455, 456, 457, 453, 1726, 454, 458,
-	1788, 1728, 1729, 743, 2081, 1727, 1806, 1808, 744, 1806,
-	1806, 1718, 1730, 565, 566, 1164, 1740, 447, 1792, 432,
-	1493, 1768, 1795, 1796, 1720, 1793, 1794, 501, 452, 455,
-	456, 457, 453, 824, 454, 458, 1799, 1149, 1150, 1803,
-	1802, 1807, 894, 893, 903, 904, 896, 897, 898, 899,
-	900, 901, 902, 895, 747, 1500, 456, 457, 1606, 1157,
-	804, 1809, 1810, 1833, 1499, 1607, 1811, 894, 893, 903,
-	904, 896, 897, 898, 899, 900, 901, 902, 895, 846,
-	460, 1112, 1819, 1226, 1225, 503, 1837, 345, 315, 314,
-	318, 507, 508, 2120, 2044, 2042, 320, 1996, 1827, 1995,
-	1993, 1919, 1812, 1917, 1736, 1693, 1616, 1615, 324, 1549,
-	346, 506, 1548, 1405, 719, 2108, 2107, 459, 1423, 1345,
-	345, 284, 757, 2107, 2108, 358, 1, 1865, 84, 1273,
-	509, 1840, 729, 417, 441, 726, 440, 438, 74, 1681,
-	1306, 1238, 664, 944, 950, 1955, 2080, 2112, 2038, 1788,
-	2083, 652, 1808, 636, 1863, 1825, 1838, 1839, 1988, 1842,
-	1843, 1844, 1845, 1881, 1867, 1848, 1849, 1850, 1851, 1852,
-	1853, 1854, 1855, 1856, 1857, 1858, 1859, 1860, 1861, 1494,
-	1920, 1886, 1908, 1990, 1910, 1358, 1894, 1826, 1355, 498,
-	1465, 1466, 677, 667, 928, 668, 711, 419, 666, 1818,
-	1542, 1953, 347, 416, 319, 323, 758, 1898, 327, 759,
-	359, 466, 329, 330, 331, 1882, 1611, 333, 334, 1789,
-	1918, 1712, 1698, 1235, 2172, 2162, 2138, 467, 2118, 432,
-	1933, 2004, 432, 432, 432, 2157, 2049, 2099, 432, 2092,
-	52, 2000, 1834, 310, 811, 540, 383, 1899, 1978, 391,
-	734, 1518, 1923, 1924, 1372, 1155, 1134, 1998, 1929, 1930,
-	1966, 1587, 762, 1974, 1975, 1976, 311, 1984, 1973, 2029,
-	1960, 1983, 350, 1158, 351, 1161, 1160, 1217, 863, 1294,
-	1999, 1992, 894, 893, 903, 904, 896, 897, 898, 899,
-	900, 901, 902, 895, 930, 84, 2006, 2007, 918, 591,
-	1446, 643, 432, 637, 1539, 1538, 1783, 799, 26, 461,
-	853, 958, 665, 1444, 86, 1175, 959, 1997, 432, 1828,
-	2085, 651, 650, 649, 2012, 648, 451, 449, 448, 302,
-	841, 301, 851, 2021, 894, 893, 903, 904, 896, 897,
-	898, 899, 900, 901, 902, 895, 2064, 2063, 2018, 2027,
-	2019, 1733, 1876, 1940, 2035, 1872, 2043, 2041, 2045, 2046,
-	1868, 2010, 1742, 1741, 1769, 1770, 1776, 2052, 2054, 1626,
-	1622, 1624, 1625, 1623, 1621, 1504, 1505, 1502, 2060, 1501,
-	2087, 1124, 1120, 946, 953, 2072, 2073, 2074, 2075, 2091,
-	2017, 426, 778, 2086, 81, 300, 1201, 11, 18, 17,
-	16, 47, 46, 45, 2095, 44, 2097, 2090, 15, 8,
-	43, 42, 41, 14, 13, 37, 36, 35, 34, 33,
-	32, 2102, 31, 30, 2105, 2103, 29, 28, 27, 2114,
-	9, 56, 55, 2109, 54, 53, 20, 432, 21, 432,
-	2111, 22, 2077, 62, 61, 60, 767, 2122, 767, 2124,
-	59, 58, 25, 10, 2127, 7, 4, 2087, 2137, 2,
-	0, 0, 0, 0, 2133, 0, 432, 0, 0, 0,
-	2086, 2136, 2141, 0, 0, 767, 2144, 0, 0, 0,
-	0, 0, 2114, 2150, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2160, 0, 0, 0, 0, 0,
-	0, 0, 2161, 0, 0, 0, 0, 0, 0, 0,
-	2171, 2170, 0, 0, 0, 0, 0, 0, 0, 0,
-	2181, 0, 2182, 2180, 0, 2171, 0, 0, 0, 0,
-	0, 1075, 1062, 0, 1024, 1077, 996, 1012, 1085, 1014,
-	1015, 1049, 974, 1033, 211, 1010, 966, 999, 1000, 968,
-	1007, 969, 997, 1026, 155, 995, 1065, 1036, 180, 1083,
-	182, 0, 0, 240, 195, 0, 2152, 1029, 1067, 1031,
-	1054, 1023, 1050, 982, 1043, 1078, 1011, 1047, 1079, 0,
-	0, 0, 0, 468, 469, 470, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 1046, 1072, 1009, 0,
-	0, 983, 1076, 1030, 1048, 0, 967, 1044, 0, 972,
-	975, 1084, 1070, 1004, 1005, 0, 0, 0, 0, 0,
-	0, 0, 1027, 1032, 1051, 1020, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1001, 0, 1040, 0, 0,
-	0, 977, 973, 0, 1025, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 1074, 1111, 149, 275, 976, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 1095,
-	1096, 1097, 1098, 1099, 1107, 1108, 0, 981, 0, 1002,
-	1052, 0, 965, 1061, 1068, 1022, 269, 1071, 1019, 1018,
-	1102, 0, 1101, 244, 1103, 1104, 179, 1066, 998, 1008,
-	1003, 1006, 230, 213, 1073, 1039, 218, 228, 183, 255,
-	222, 260, 246, 268, 1055, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 1100, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1109, 0, 1110,
-	281, 162, 964, 264, 0, 209, 1063, 970, 980, 978,
-	1016, 1041, 1042, 205, 280, 1057, 1060, 1058, 1086, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 971,
-	0, 241, 262, 274, 265, 1017, 989, 1028, 273, 992,
-	990, 1056, 991, 1045, 1088, 199, 200, 201, 202, 1013,
-	0, 142, 1037, 1021, 1089, 1090, 1091, 1092, 1093, 1094,
-	994, 1069, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 988, 993, 987, 1034, 1035,
-	1080, 1081, 1082, 1053, 979, 1064, 984, 986, 985, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1059, 1038,
-	124, 0, 181, 1087, 224, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 0,
-	0, 0, 1105, 1106, 277, 278, 279, 263, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1275, 1274, 1276, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 0, 0, 277, 278,
-	279, 263, 79, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	645, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 689,
-	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	638, 0, 0, 592, 679, 678, 654, 0, 0, 0,
-	138, 655, 0, 660, 0, 656, 659, 657, 658, 0,
-	0, 681, 0, 0, 0, 0, 0, 590, 642, 0,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 639, 640, 0, 0, 0, 0, 673, 0, 641,
-	0, 0, 675, 0, 662, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	661, 671, 676, 149, 631, 669, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 687,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	670, 0, 230, 213, 698, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 685, 209, 697, 680, 682, 683,
-	686, 690, 691, 629, 632, 692, 694, 696, 699, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 630, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 674, 199, 200, 201, 202, 688,
+	937, 1237, 2209, 2207, 2206, 2214, 2176, 646, 2170, 664,
+	2145, 1838, 926, 2028, 2163, 2095, 1857, 1805, 2094, 2007,
+	2004, 568, 1981, 1759, 533, 86, 2016, 1163, 297, 1000,
+	308, 1836, 566, 1837, 1828, 89, 1992, 470, 1527, 301,
+	20, 86, 310, 1794, 1718, 1910, 1238, 401, 644, 1413,
+	342, 342, 1827, 521, 1721, 85, 674, 54, 1823, 1523,
+	1701, 1510, 602, 983, 1726, 1730, 592, 1389, 1539, 1687,
+	1532, 1551, 1560, 1578, 1105, 402, 1577, 1528, 1169, 1461,
+	1567, 423, 645, 303, 54, 86, 1007, 920, 729, 576,
+	1120, 1114, 1115, 923, 878, 1420, 537, 1325, 1106, 1252,
+	1311, 1225, 655, 3, 53, 300, 12, 298, 6, 299,
+	5, 976, 1383, 951, 1177, 436, 939, 1373, 1769, 351,
+	312, 350, 20, 921, 895, 1253, 1236, 595, 509, 980,
+	953, 952, 290, 412, 414, 1002, 447, 1145, 1136, 54,
+	1009, 1039, 472, 317, 317, 393, 422, 293, 577, 558,
+	912, 314, 313, 458, 1152, 487, 304, 82, 2022, 1939,
+	1853, 1758, 934, 1108, 594, 542, 81, 352, 24, 40,
+	25, 344, 544, 81, 420, 24, 40, 25, 348, 1511,
+	1367, 2056, 413, 1148, 1384, 2045, 433, 79, 12, 1513,
+	6, 519, 5, 370, 540, 408, 970, 410, 81, 1273,
+	81, 81, 81, 507, 1517, 965, 966, 380, 81, 955,
+	24, 40, 25, 532, 77, 929, 531, 534, 535, 2082,
+	545, 77, 726, 2080, 502, 723, 2149, 1487, 67, 418,
+	417, 2014, 74, 534, 535, 2098, 2099, 498, 1635, 2066,
+	394, 2069, 1636, 409, 1637, 1942, 725, 363, 77, 77,
+	77, 41, 349, 1760, 933, 1354, 77, 1374, 441, 416,
+	2017, 2018, 2019, 2020, 1561, 1148, 1564, 450, 1540, 1541,
+	1542, 1543, 1392, 1390, 1387, 1391, 1393, 1150, 1386, 1385,
+	977, 1392, 1390, 381, 1391, 1393, 1909, 1814, 1813, 489,
+	86, 440, 500, 501, 1810, 1755, 499, 1632, 1926, 488,
+	1713, 913, 439, 86, 1709, 2108, 2195, 1228, 1229, 1230,
+	2215, 1712, 493, 1426, 1229, 1230, 1563, 2055, 1226, 1916,
+	2122, 2079, 70, 71, 2030, 72, 73, 915, 2129, 1269,
+	474, 1266, 2053, 454, 2097, 1268, 1265, 1267, 1271, 1272,
+	494, 1904, 2006, 1270, 475, 2026, 2027, 377, 2030, 2084,
+	2186, 54, 54, 414, 1395, 1396, 1397, 1398, 365, 415,
+	1993, 1994, 1995, 1997, 1996, 1873, 480, 1872, 362, 361,
+	346, 2073, 2036, 438, 2216, 1514, 1899, 541, 2086, 2087,
+	450, 2058, 2059, 59, 69, 78, 554, 39, 496, 357,
+	342, 497, 530, 529, 2210, 2177, 1861, 402, 402, 402,
+	1710, 413, 1462, 68, 66, 65, 520, 914, 435, 514,
+	522, 523, 419, 525, 479, 452, 451, 543, 491, 2064,
+	1371, 1199, 423, 1156, 941, 598, 598, 484, 524, 1756,
+	492, 495, 1895, 302, 571, 1536, 1728, 1727, 728, 1411,
+	490, 1195, 443, 444, 548, 1544, 1276, 1277, 1278, 1279,
+	1280, 1281, 1274, 1275, 892, 968, 440, 86, 86, 86,
+	86, 2166, 1197, 1196, 546, 547, 579, 896, 909, 969,
+	1194, 597, 597, 382, 724, 967, 383, 1503, 2200, 360,
+	2174, 1518, 1423, 317, 342, 342, 440, 342, 1239, 356,
+	1365, 54, 1364, 474, 445, 49, 374, 927, 526, 1353,
+	511, 50, 54, 1347, 375, 342, 342, 475, 1189, 910,
+	553, 1227, 2005, 1161, 534, 535, 1130, 1425, 534, 535,
+	1020, 880, 342, 573, 342, 2057, 947, 86, 452, 451,
+	1966, 580, 582, 410, 581, 1171, 1511, 453, 51, 2085,
+	513, 960, 364, 342, 437, 561, 946, 1537, 978, 565,
+	1708, 991, 1058, 1151, 486, 342, 402, 948, 342, 936,
+	2167, 1711, 940, 504, 958, 536, 559, 539, 80, 1900,
+	1901, 52, 1368, 942, 992, 80, 527, 560, 317, 409,
+	928, 585, 586, 587, 588, 589, 342, 342, 999, 86,
+	721, 423, 931, 883, 1008, 961, 591, 578, 1017, 1505,
+	80, 538, 80, 80, 80, 984, 887, 888, 984, 943,
+	80, 1003, 984, 897, 898, 899, 900, 317, 2188, 1001,
+	908, 957, 2161, 405, 1614, 1004, 949, 950, 1147, 932,
+	962, 1326, 956, 1552, 2040, 916, 925, 562, 563, 564,
+	935, 1897, 1349, 405, 945, 1896, 1021, 1201, 317, 1504,
+	1134, 1075, 442, 385, 930, 1016, 1014, 1533, 1536, 372,
+	1789, 373, 380, 1401, 528, 954, 371, 369, 368, 376,
+	994, 378, 379, 997, 1086, 974, 2164, 2165, 1146, 1381,
+	317, 979, 1663, 1867, 1179, 1392, 1390, 944, 1391, 1393,
+	891, 986, 426, 431, 432, 990, 1074, 407, 890, 1014,
+	1403, 975, 387, 386, 1082, 993, 1073, 998, 557, 2208,
+	995, 1906, 987, 988, 989, 1905, 1326, 407, 1467, 1771,
+	1403, 1691, 476, 477, 478, 569, 1112, 1112, 1117, 1890,
+	1686, 996, 1579, 1076, 1077, 1078, 1079, 1005, 1977, 1967,
+	1969, 1970, 1971, 1968, 1066, 1067, 1059, 1060, 1061, 1062,
+	1063, 1064, 1065, 1058, 413, 1590, 1587, 1588, 1589, 1080,
+	1975, 1584, 2204, 1583, 1582, 1580, 1241, 1240, 1651, 1101,
+	1537, 384, 1048, 2182, 1976, 1530, 2139, 1402, 556, 1531,
+	1534, 570, 414, 1670, 1674, 1676, 1678, 1680, 1681, 1683,
+	1603, 1590, 1587, 1588, 1589, 1249, 1974, 1665, 1666, 1667,
+	1668, 1649, 1650, 1671, 1251, 1652, 572, 1653, 1654, 1655,
+	1656, 1657, 1658, 1659, 1660, 1661, 1662, 1669, 1111, 75,
+	1581, 1094, 2123, 1164, 1165, 1673, 1675, 1677, 1679, 1682,
+	413, 1535, 1318, 2111, 476, 477, 478, 569, 476, 477,
+	478, 1703, 1610, 2074, 1775, 388, 1316, 1317, 1315, 2012,
+	428, 429, 430, 1246, 2011, 1779, 1664, 1015, 1016, 1014,
+	1797, 1983, 1104, 1057, 1056, 1066, 1067, 1059, 1060, 1061,
+	1062, 1063, 1064, 1065, 1058, 1768, 1015, 1016, 1014, 1770,
+	1772, 1774, 1961, 1776, 1777, 1778, 1780, 1781, 1782, 1784,
+	1785, 1786, 1787, 570, 1008, 1800, 411, 1704, 1960, 567,
+	1858, 1795, 1125, 1015, 1016, 1014, 2185, 1808, 1809, 1973,
+	1959, 1616, 1796, 1015, 1016, 1014, 1126, 1790, 1059, 1060,
+	1061, 1062, 1063, 1064, 1065, 1058, 1119, 476, 477, 478,
+	569, 1585, 1586, 1061, 1062, 1063, 1064, 1065, 1058, 1433,
+	1739, 54, 86, 86, 1956, 1972, 1801, 2184, 1963, 1950,
+	2150, 1788, 1947, 1946, 1738, 297, 1748, 1940, 1913, 1846,
+	1845, 1844, 1191, 1015, 1016, 1014, 1843, 1840, 1767, 1123,
+	1697, 342, 1166, 1168, 1003, 1696, 1144, 1015, 1016, 1014,
+	1131, 2118, 1473, 1783, 1962, 1695, 570, 1694, 1004, 1132,
+	1773, 1499, 342, 1747, 1015, 1016, 1014, 1330, 1118, 881,
+	410, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1022, 508,
+	1160, 598, 2107, 86, 2090, 1015, 1016, 1014, 1982, 1221,
+	2047, 1223, 1129, 1124, 2034, 1807, 2033, 1529, 1470, 1964,
+	1957, 1469, 1128, 1449, 984, 984, 984, 1953, 1141, 1247,
+	1248, 1192, 1952, 1180, 1181, 1182, 1951, 1159, 1183, 1015,
+	1016, 1014, 1803, 1941, 1015, 1016, 1014, 597, 1672, 1911,
+	1892, 1218, 1219, 1220, 317, 1101, 1178, 1185, 1155, 1187,
+	1015, 1016, 1014, 1856, 1802, 1804, 1854, 1414, 1448, 1705,
+	1549, 1548, 1244, 1547, 1546, 1206, 1231, 1186, 1184, 1188,
+	954, 1198, 1515, 1158, 1285, 1157, 1217, 1336, 1290, 1102,
+	1015, 1016, 1014, 1097, 1214, 1299, 1300, 1301, 1302, 1303,
+	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1202, 1203, 1204,
+	1320, 1321, 1096, 1207, 882, 1208, 476, 477, 478, 2219,
+	1327, 1355, 1810, 2193, 440, 1332, 2091, 354, 1215, 1477,
+	2062, 2010, 1429, 1476, 1798, 896, 1935, 353, 1338, 342,
+	1934, 2061, 342, 1319, 2041, 440, 1933, 342, 1015, 1016,
+	1014, 1990, 1378, 1015, 1016, 1014, 1370, 1928, 1015, 1016,
+	1014, 1313, 1015, 1016, 1014, 1288, 1289, 1927, 1015, 1016,
+	1014, 1359, 1429, 2224, 1360, 1328, 1329, 1362, 584, 1749,
+	1408, 2218, 2217, 1154, 2196, 1746, 1921, 2192, 2191, 1341,
+	342, 1154, 2180, 1154, 2179, 1745, 1376, 1377, 1352, 940,
+	86, 86, 1849, 1717, 1419, 1331, 1333, 1334, 1015, 1016,
+	1014, 2173, 2172, 2120, 2119, 1337, 1400, 1339, 1923, 2105,
+	1923, 2100, 1640, 1380, 1015, 1016, 1014, 1741, 1210, 2088,
+	1416, 1417, 1740, 1624, 1372, 1369, 1566, 1434, 1923, 2051,
+	1565, 20, 1357, 1480, 410, 1923, 2050, 1478, 1358, 1015,
+	1016, 1014, 1923, 2049, 1015, 1016, 1014, 1475, 54, 1474,
+	1737, 1472, 1366, 1438, 1736, 1435, 1375, 1622, 1404, 1379,
+	1923, 2048, 1405, 1428, 1406, 1613, 1430, 1412, 1607, 1431,
+	1432, 1399, 1015, 1016, 1014, 1178, 1015, 1016, 1014, 1015,
+	1016, 1014, 1407, 1606, 1410, 1409, 1335, 1015, 1016, 1014,
+	1015, 1016, 1014, 1415, 911, 1418, 1456, 12, 879, 6,
+	583, 5, 2039, 2038, 2187, 1015, 1016, 1014, 1424, 1440,
+	1441, 1442, 1605, 1444, 1445, 1427, 1604, 503, 1451, 1988,
+	1989, 482, 1452, 1453, 1454, 1455, 1112, 1429, 1491, 1112,
+	1988, 1987, 1494, 1342, 1015, 1016, 1014, 1598, 1015, 1016,
+	1014, 1133, 1008, 1641, 342, 1148, 1459, 1460, 342, 342,
+	1464, 1073, 342, 1468, 481, 1497, 1932, 1931, 482, 1015,
+	1016, 1014, 1597, 440, 1488, 2220, 1595, 1481, 1012, 1498,
+	984, 1930, 1929, 1625, 1526, 86, 984, 1923, 1922, 1458,
+	1447, 54, 1213, 1627, 1015, 1016, 1014, 1486, 1015, 1016,
+	1014, 1594, 1446, 1493, 1429, 1608, 1457, 1422, 1313, 413,
+	484, 1490, 1466, 86, 1571, 1550, 1429, 1591, 1429, 1437,
+	1471, 1593, 1010, 1015, 1016, 1014, 1348, 1482, 1489, 1323,
+	1492, 1483, 1496, 1576, 1495, 483, 1575, 1500, 1501, 1429,
+	1436, 1502, 1210, 1015, 1016, 1014, 1545, 1574, 1162, 1509,
+	1322, 1506, 1508, 1213, 1356, 1015, 1016, 1014, 1015, 1016,
+	1014, 1351, 1350, 1553, 1554, 590, 1573, 1345, 1344, 1015,
+	1016, 1014, 1015, 1016, 1014, 555, 1592, 1213, 1212, 484,
+	1596, 1154, 1153, 1599, 1600, 2160, 1601, 1602, 885, 884,
+	1555, 1556, 81, 1618, 1557, 2154, 1570, 2130, 342, 2127,
+	1620, 2125, 2110, 2023, 1615, 2002, 1986, 1984, 1979, 1571,
+	1619, 86, 1242, 1243, 1720, 1245, 1612, 1919, 1621, 1918,
+	1685, 1282, 1283, 1284, 1917, 1286, 1287, 879, 1609, 1914,
+	1903, 1295, 1296, 1297, 1298, 1611, 1617, 1888, 1824, 1821,
+	77, 1639, 1820, 1722, 593, 1731, 1734, 1699, 1692, 1638,
+	1314, 77, 1626, 1623, 1382, 1361, 460, 463, 464, 465,
+	461, 1716, 462, 466, 1343, 1702, 54, 1211, 1200, 1143,
+	1193, 1103, 1100, 1099, 1700, 1098, 1689, 1095, 1631, 1040,
+	1092, 1090, 1340, 1089, 1088, 1083, 1055, 1054, 1053, 1052,
+	1648, 1628, 1688, 1690, 1688, 1684, 1051, 1693, 1050, 1049,
+	1047, 1046, 1698, 1045, 1044, 1715, 1056, 1066, 1067, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1058, 1723, 1724, 1725,
+	342, 342, 1707, 460, 463, 464, 465, 461, 1043, 462,
+	466, 1042, 440, 1766, 1041, 1038, 1142, 455, 1037, 1036,
+	1742, 1729, 1706, 1526, 1732, 1035, 1735, 1034, 460, 463,
+	464, 465, 461, 1744, 462, 466, 1033, 1032, 1031, 893,
+	727, 1915, 984, 1743, 485, 1137, 1138, 1174, 2135, 2133,
+	2096, 1811, 2183, 1394, 1209, 1140, 505, 1829, 1831, 1751,
+	1829, 1829, 1815, 1754, 905, 311, 1818, 1819, 903, 906,
+	440, 1791, 902, 904, 1816, 1817, 1763, 901, 2203, 907,
+	1822, 464, 465, 1826, 1346, 2142, 574, 575, 1179, 1164,
+	1165, 1520, 1830, 1752, 1753, 1479, 1825, 1057, 1056, 1066,
+	1067, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1058, 1835,
+	1633, 510, 1832, 1833, 1172, 1834, 343, 1629, 964, 1859,
+	1519, 2158, 1006, 468, 1630, 1241, 1240, 86, 516, 517,
+	1127, 512, 2155, 2115, 1842, 2113, 2071, 2070, 1863, 2068,
+	1789, 1057, 1056, 1066, 1067, 1059, 1060, 1061, 1062, 1063,
+	1064, 1065, 1058, 2024, 1944, 1847, 1848, 1851, 1855, 1762,
+	1761, 1714, 1569, 515, 1179, 1850, 1057, 1056, 1066, 1067,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1058, 354, 353,
+	1568, 86, 1421, 879, 2137, 2136, 2136, 1866, 353, 1439,
+	1862, 1363, 289, 1702, 2137, 467, 366, 1, 1291, 1771,
+	518, 889, 1811, 425, 449, 1831, 886, 1889, 448, 1864,
+	1865, 1907, 1868, 1869, 1870, 1871, 1891, 446, 1874, 1875,
+	1876, 1877, 1878, 1879, 1880, 1881, 1882, 1883, 1884, 1885,
+	1886, 1887, 1893, 76, 1324, 1945, 675, 1912, 1107, 1920,
+	1113, 1980, 2141, 2169, 2109, 2144, 1937, 663, 647, 2063,
+	1634, 2013, 2065, 1924, 2015, 1516, 1925, 1978, 1936, 1512,
+	506, 1484, 1485, 688, 678, 1091, 679, 722, 474, 1943,
+	427, 677, 1841, 1562, 355, 1069, 424, 1072, 367, 1908,
+	1757, 1812, 475, 1733, 1958, 440, 54, 1719, 440, 440,
+	440, 1070, 1071, 1068, 440, 1057, 1056, 1066, 1067, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1058, 1250, 1948, 1949,
+	347, 2213, 2202, 2175, 1954, 1955, 1991, 1463, 2153, 1999,
+	2000, 2001, 2009, 1998, 1775, 2029, 2194, 2008, 2078, 2128,
+	2121, 2025, 1860, 315, 971, 1779, 549, 391, 1057, 1056,
+	1066, 1067, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1058,
+	86, 2031, 2032, 2003, 399, 1768, 894, 440, 1538, 1770,
+	1772, 1774, 1388, 1776, 1777, 1778, 1780, 1781, 1782, 1784,
+	1785, 1786, 1787, 440, 1170, 1149, 922, 316, 2054, 2037,
+	1001, 1985, 358, 1173, 359, 1176, 1175, 1232, 2046, 1023,
+	1312, 1093, 1081, 600, 2076, 1465, 654, 1790, 648, 1559,
+	1558, 2042, 1806, 959, 2052, 27, 469, 1013, 2060, 1121,
+	676, 2077, 2067, 88, 1190, 1122, 2075, 1938, 2146, 662,
+	661, 2081, 2083, 660, 659, 459, 457, 456, 307, 306,
+	1011, 1788, 2089, 2093, 2092, 2043, 2044, 2021, 2101, 2102,
+	2103, 2104, 1852, 1902, 1965, 1898, 1894, 2035, 1767, 1765,
+	1764, 1792, 1793, 1799, 2072, 2114, 1647, 2116, 2117, 2112,
+	1643, 1645, 1646, 1783, 1644, 1642, 1524, 1525, 1522, 1521,
+	1773, 1139, 1135, 1109, 1116, 434, 938, 83, 305, 1216,
+	2132, 2148, 2131, 11, 2134, 18, 17, 16, 48, 47,
+	2147, 2152, 46, 2138, 45, 15, 440, 2106, 440, 2124,
+	2140, 2126, 2151, 8, 44, 43, 42, 927, 2157, 927,
+	2159, 14, 19, 13, 38, 37, 36, 35, 34, 33,
+	2171, 32, 2168, 31, 30, 29, 28, 9, 58, 57,
+	56, 440, 55, 21, 22, 23, 64, 63, 62, 2178,
+	61, 60, 927, 2181, 2148, 2190, 26, 10, 7, 2162,
+	4, 2, 0, 2147, 2189, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2171, 2197, 0, 0, 2201,
+	0, 2205, 0, 0, 0, 0, 0, 0, 0, 0,
+	2212, 0, 2211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2223, 2222, 2221, 2212, 0, 0, 0, 0,
+	0, 0, 0, 841, 828, 2199, 790, 843, 762, 778,
+	851, 780, 781, 815, 740, 799, 215, 776, 732, 765,
+	766, 734, 773, 735, 763, 792, 159, 761, 831, 802,
+	184, 849, 186, 0, 0, 244, 199, 0, 0, 795,
+	833, 797, 820, 789, 816, 748, 809, 844, 777, 813,
+	845, 0, 0, 0, 0, 87, 0, 0, 0, 0,
+	0, 0, 142, 0, 0, 0, 0, 0, 812, 838,
+	775, 0, 0, 749, 842, 796, 814, 0, 733, 810,
+	0, 738, 741, 850, 836, 770, 771, 0, 0, 0,
+	0, 0, 0, 0, 793, 798, 817, 786, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 767, 0, 806,
+	0, 0, 0, 743, 739, 0, 791, 0, 133, 249,
+	263, 143, 240, 276, 147, 247, 139, 214, 236, 135,
+	261, 246, 196, 178, 179, 134, 0, 231, 157, 170,
+	154, 212, 0, 840, 877, 153, 279, 742, 271, 137,
+	138, 270, 211, 258, 262, 197, 191, 136, 260, 195,
+	190, 182, 161, 174, 224, 189, 225, 175, 201, 200,
+	202, 861, 862, 863, 864, 865, 873, 874, 0, 747,
+	0, 768, 818, 0, 731, 827, 834, 788, 273, 837,
+	785, 784, 868, 0, 867, 248, 869, 870, 183, 832,
+	764, 774, 769, 772, 234, 217, 839, 805, 222, 232,
+	187, 259, 226, 264, 250, 272, 821, 227, 129, 251,
+	156, 198, 140, 141, 152, 158, 160, 162, 163, 207,
+	208, 220, 239, 252, 253, 254, 155, 148, 233, 149,
+	172, 150, 130, 241, 151, 131, 221, 257, 866, 169,
+	229, 194, 132, 193, 223, 256, 255, 280, 287, 288,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 875,
+	0, 876, 286, 166, 730, 268, 0, 213, 829, 736,
+	746, 744, 782, 807, 808, 209, 285, 823, 826, 824,
+	852, 237, 0, 0, 0, 0, 0, 177, 219, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 737, 0, 245, 266, 278, 269, 783, 755, 794,
+	277, 758, 756, 822, 757, 811, 854, 203, 204, 205,
+	206, 779, 0, 146, 803, 787, 855, 856, 857, 858,
+	859, 860, 760, 835, 165, 171, 0, 173, 145, 218,
+	168, 275, 180, 210, 176, 242, 181, 188, 230, 274,
+	216, 235, 144, 265, 243, 192, 167, 0, 754, 759,
+	753, 800, 801, 846, 847, 848, 819, 745, 830, 750,
+	752, 751, 1443, 0, 1057, 1056, 1066, 1067, 1059, 1060,
+	1061, 1062, 1063, 1064, 1065, 1058, 0, 0, 0, 0,
+	0, 825, 804, 128, 0, 185, 853, 228, 164, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 120, 121, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 122, 123, 124, 125, 126, 127, 871, 872, 281,
+	282, 283, 284, 267, 841, 828, 0, 790, 843, 762,
+	778, 851, 780, 781, 815, 740, 799, 215, 776, 732,
+	765, 766, 734, 773, 735, 763, 792, 159, 761, 831,
+	802, 184, 849, 186, 0, 0, 244, 199, 0, 0,
+	795, 833, 797, 820, 789, 816, 748, 809, 844, 777,
+	813, 845, 0, 0, 0, 0, 476, 477, 478, 0,
+	0, 0, 0, 142, 0, 0, 0, 0, 0, 812,
+	838, 775, 0, 0, 749, 842, 796, 814, 0, 733,
+	810, 0, 738, 741, 850, 836, 770, 771, 0, 0,
+	0, 0, 0, 0, 0, 793, 798, 817, 786, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 767, 0,
+	806, 0, 0, 0, 743, 739, 0, 791, 0, 133,
+	249, 263, 143, 240, 276, 147, 247, 139, 214, 236,
+	135, 261, 246, 196, 178, 179, 134, 0, 231, 157,
+	170, 154, 212, 0, 840, 877, 153, 279, 742, 271,
+	137, 138, 270, 211, 258, 262, 197, 191, 136, 260,
+	195, 190, 182, 161, 174, 224, 189, 225, 175, 201,
+	200, 202, 861, 862, 863, 864, 865, 873, 874, 0,
+	747, 0, 768, 818, 0, 731, 827, 834, 788, 273,
+	837, 785, 784, 868, 0, 867, 248, 869, 870, 183,
+	832, 764, 774, 769, 772, 234, 217, 839, 805, 222,
+	232, 187, 259, 226, 264, 250, 272, 821, 227, 129,
+	251, 156, 198, 140, 141, 152, 158, 160, 162, 163,
+	207, 208, 220, 239, 252, 253, 254, 155, 148, 233,
+	149, 172, 150, 130, 241, 151, 131, 221, 257, 866,
+	169, 229, 194, 132, 193, 223, 256, 255, 280, 287,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	875, 0, 876, 286, 166, 730, 268, 0, 213, 829,
+	736, 746, 744, 782, 807, 808, 209, 285, 823, 826,
+	824, 852, 237, 0, 0, 0, 0, 0, 177, 219,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 737, 0, 245, 266, 278, 269, 783, 755,
+	794, 277, 758, 756, 822, 757, 811, 854, 203, 204,
+	205, 206, 779, 0, 146, 803, 787, 855, 856, 857,
+	858, 859, 860, 760, 835, 165, 171, 0, 173, 145,
+	218, 168, 275, 180, 210, 176, 242, 181, 188, 230,
+	274, 216, 235, 144, 265, 243, 192, 167, 0, 754,
+	759, 753, 800, 801, 846, 847, 848, 819, 745, 830,
+	750, 752, 751, 1057, 1056, 1066, 1067, 1059, 1060, 1061,
+	1062, 1063, 1064, 1065, 1058, 0, 0, 0, 0, 0,
+	0, 0, 825, 804, 128, 0, 185, 853, 228, 164,
+	683, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 0, 0, 0, 0, 656, 0, 0, 0,
+	159, 0, 0, 0, 184, 0, 186, 0, 0, 244,
+	199, 0, 2156, 0, 0, 700, 706, 0, 871, 872,
+	281, 282, 283, 284, 267, 0, 649, 0, 0, 601,
+	690, 689, 665, 0, 0, 0, 142, 666, 0, 671,
+	0, 667, 670, 668, 669, 0, 0, 692, 0, 0,
+	0, 0, 0, 599, 653, 0, 657, 1057, 1056, 1066,
+	1067, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1058, 0,
+	0, 0, 0, 0, 0, 0, 0, 650, 651, 0,
+	0, 0, 0, 684, 0, 652, 0, 0, 686, 0,
+	673, 0, 133, 249, 263, 143, 240, 276, 147, 247,
+	139, 214, 236, 135, 261, 246, 196, 178, 179, 134,
+	0, 231, 157, 170, 154, 212, 672, 682, 687, 153,
+	642, 680, 271, 137, 138, 270, 211, 258, 262, 197,
+	191, 136, 260, 195, 190, 182, 161, 174, 224, 189,
+	225, 175, 201, 200, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 273, 0, 0, 698, 0, 0, 0, 248,
+	0, 0, 183, 0, 0, 0, 681, 0, 234, 217,
+	709, 0, 222, 232, 187, 259, 226, 264, 250, 272,
+	0, 227, 129, 251, 156, 198, 140, 141, 152, 158,
+	160, 162, 163, 207, 208, 220, 239, 252, 253, 254,
+	155, 148, 233, 149, 172, 150, 130, 241, 151, 131,
+	221, 257, 0, 169, 229, 194, 132, 193, 223, 256,
+	255, 280, 287, 288, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1293, 1292, 1294, 286, 166, 0, 268,
+	696, 213, 708, 691, 693, 694, 697, 701, 702, 640,
+	643, 703, 705, 707, 710, 237, 0, 0, 0, 0,
+	0, 177, 219, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 266, 278,
+	641, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	685, 203, 204, 205, 206, 699, 0, 146, 0, 0,
+	0, 1750, 0, 0, 0, 0, 0, 0, 165, 171,
+	0, 173, 145, 218, 168, 275, 180, 210, 176, 242,
+	181, 188, 230, 274, 216, 235, 144, 265, 243, 192,
+	167, 0, 716, 695, 715, 717, 718, 714, 719, 720,
+	704, 658, 0, 712, 711, 713, 1057, 1056, 1066, 1067,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1058, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 0, 185,
+	0, 228, 164, 603, 604, 605, 606, 607, 608, 609,
+	610, 611, 612, 613, 614, 615, 632, 633, 616, 617,
+	105, 618, 619, 620, 621, 622, 623, 624, 625, 626,
+	627, 628, 629, 630, 631, 634, 635, 636, 637, 638,
+	639, 0, 0, 281, 282, 283, 284, 267, 81, 0,
+	683, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 0, 0, 0, 0, 656, 0, 0, 0,
+	159, 0, 0, 0, 184, 0, 186, 0, 0, 244,
+	199, 0, 0, 0, 0, 700, 706, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 649, 0, 0, 601,
+	690, 689, 665, 0, 0, 0, 142, 666, 0, 671,
+	0, 667, 670, 668, 669, 0, 0, 692, 0, 0,
+	0, 0, 0, 599, 653, 0, 657, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 650, 651, 0,
+	0, 0, 0, 684, 0, 652, 0, 0, 686, 0,
+	673, 0, 133, 249, 263, 143, 240, 276, 147, 247,
+	139, 214, 236, 135, 261, 246, 196, 178, 179, 134,
+	0, 231, 157, 170, 154, 212, 672, 682, 687, 153,
+	642, 680, 271, 137, 138, 270, 211, 258, 262, 197,
+	191, 136, 260, 195, 190, 182, 161, 174, 224, 189,
+	225, 175, 201, 200, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 273, 0, 0, 698, 0, 0, 0, 248,
+	0, 0, 183, 0, 0, 0, 681, 0, 234, 217,
+	709, 0, 222, 232, 187, 259, 226, 264, 250, 272,
+	0, 227, 129, 251, 156, 198, 140, 141, 152, 158,
+	160, 162, 163, 207, 208, 220, 239, 252, 253, 254,
+	155, 148, 233, 149, 172, 150, 130, 241, 151, 131,
+	221, 257, 0, 169, 229, 194, 132, 193, 223, 256,
+	255, 280, 287, 288, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 166, 0, 268,
+	696, 213, 708, 691, 693, 694, 697, 701, 702, 640,
+	643, 703, 705, 707, 710, 237, 0, 0, 0, 0,
+	0, 177, 219, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 266, 278,
+	641, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	685, 203, 204, 205, 206, 699, 0, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 165, 171,
+	0, 173, 145, 218, 168, 275, 180, 210, 176, 242,
+	181, 188, 230, 274, 216, 235, 144, 265, 243, 192,
+	167, 0, 716, 695, 715, 717, 718, 714, 719, 720,
+	704, 658, 0, 712, 711, 713, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 0, 185,
+	80, 228, 164, 603, 604, 605, 606, 607, 608, 609,
+	610, 611, 612, 613, 614, 615, 632, 633, 616, 617,
+	105, 618, 619, 620, 621, 622, 623, 624, 625, 626,
+	627, 628, 629, 630, 631, 634, 635, 636, 637, 638,
+	639, 683, 0, 281, 282, 283, 284, 267, 0, 0,
+	0, 215, 0, 0, 0, 0, 0, 656, 0, 0,
+	0, 159, 985, 0, 0, 184, 0, 186, 0, 0,
+	244, 199, 0, 0, 0, 0, 700, 706, 0, 0,
+	0, 0, 0, 0, 981, 0, 0, 649, 0, 0,
+	601, 690, 689, 665, 0, 0, 0, 142, 666, 0,
+	671, 0, 667, 670, 668, 669, 0, 0, 692, 0,
+	0, 0, 0, 0, 599, 653, 0, 657, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 650, 651,
+	0, 0, 0, 0, 684, 0, 652, 0, 0, 982,
+	0, 673, 0, 133, 249, 263, 143, 240, 276, 147,
+	247, 139, 214, 236, 135, 261, 246, 196, 178, 179,
+	134, 0, 231, 157, 170, 154, 212, 672, 682, 687,
+	153, 642, 680, 271, 137, 138, 270, 211, 258, 262,
+	197, 191, 136, 260, 195, 190, 182, 161, 174, 224,
+	189, 225, 175, 201, 200, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 273, 0, 0, 698, 0, 0, 0,
+	248, 0, 0, 183, 0, 0, 0, 681, 0, 234,
+	217, 709, 0, 222, 232, 187, 259, 226, 264, 250,
+	272, 0, 227, 129, 251, 156, 198, 140, 141, 152,
+	158, 160, 162, 163, 207, 208, 220, 239, 252, 253,
+	254, 155, 148, 233, 149, 172, 150, 130, 241, 151,
+	131, 221, 257, 0, 169, 229, 194, 132, 193, 223,
+	256, 255, 280, 287, 288, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 166, 0,
+	268, 696, 213, 708, 691, 693, 694, 697, 701, 702,
+	640, 643, 703, 705, 707, 710, 237, 0, 0, 0,
+	0, 0, 177, 219, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 266,
+	278, 641, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 685, 203, 204, 205, 206, 699, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	171, 0, 173, 145, 218, 168, 275, 180, 210, 176,
+	242, 181, 188, 230, 274, 216, 235, 144, 265, 243,
+	192, 167, 0, 716, 695, 715, 717, 718, 714, 719,
+	720, 704, 658, 0, 712, 711, 713, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 0,
+	185, 0, 228, 164, 603, 604, 605, 606, 607, 608,
+	609, 610, 611, 612, 613, 614, 615, 632, 633, 616,
+	617, 105, 618, 619, 620, 621, 622, 623, 624, 625,
+	626, 627, 628, 629, 630, 631, 634, 635, 636, 637,
+	638, 639, 683, 0, 281, 282, 283, 284, 267, 0,
+	0, 0, 215, 0, 0, 0, 0, 0, 656, 0,
+	0, 0, 159, 2198, 0, 0, 184, 0, 186, 0,
+	0, 244, 199, 0, 0, 0, 0, 700, 706, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 649, 0,
+	0, 601, 690, 689, 665, 0, 0, 0, 142, 666,
+	0, 671, 0, 667, 670, 668, 669, 0, 0, 692,
+	0, 0, 0, 0, 0, 599, 653, 0, 657, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 650,
+	651, 0, 0, 0, 0, 684, 0, 652, 0, 0,
+	686, 0, 673, 0, 133, 249, 263, 143, 240, 276,
+	147, 247, 139, 214, 236, 135, 261, 246, 196, 178,
+	179, 134, 0, 231, 157, 170, 154, 212, 672, 682,
+	687, 153, 642, 680, 271, 137, 138, 270, 211, 258,
+	262, 197, 191, 136, 260, 195, 190, 182, 161, 174,
+	224, 189, 225, 175, 201, 200, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 273, 0, 0, 698, 0, 0,
+	0, 248, 0, 0, 183, 0, 0, 0, 681, 0,
+	234, 217, 709, 0, 222, 232, 187, 259, 226, 264,
+	250, 272, 0, 227, 129, 251, 156, 198, 140, 141,
+	152, 158, 160, 162, 163, 207, 208, 220, 239, 252,
+	253, 254, 155, 148, 233, 149, 172, 150, 130, 241,
+	151, 131, 221, 257, 0, 169, 229, 194, 132, 193,
+	223, 256, 255, 280, 287, 288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 166,
+	0, 268, 696, 213, 708, 691, 693, 694, 697, 701,
+	702, 640, 643, 703, 705, 707, 710, 237, 0, 0,
+	0, 0, 0, 177, 219, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	266, 278, 641, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 685, 203, 204, 205, 206, 699, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	165, 171, 0, 173, 145, 218, 168, 275, 180, 210,
+	176, 242, 181, 188, 230, 274, 216, 235, 144, 265,
+	243, 192, 167, 0, 716, 695, 715, 717, 718, 714,
+	719, 720, 704, 658, 0, 712, 711, 713, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	0, 185, 0, 228, 164, 603, 604, 605, 606, 607,
+	608, 609, 610, 611, 612, 613, 614, 615, 632, 633,
+	616, 617, 105, 618, 619, 620, 621, 622, 623, 624,
+	625, 626, 627, 628, 629, 630, 631, 634, 635, 636,
+	637, 638, 639, 683, 0, 281, 282, 283, 284, 267,
+	0, 0, 0, 215, 0, 0, 0, 0, 0, 656,
+	0, 0, 0, 159, 985, 0, 0, 184, 0, 186,
+	0, 0, 244, 199, 0, 0, 0, 0, 700, 706,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 649,
+	0, 0, 601, 690, 689, 665, 0, 0, 0, 142,
+	666, 0, 671, 0, 667, 670, 668, 669, 0, 0,
+	692, 0, 0, 0, 0, 0, 599, 653, 0, 657,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	650, 651, 0, 0, 0, 0, 684, 0, 652, 0,
+	0, 686, 0, 673, 0, 133, 249, 263, 143, 240,
+	276, 147, 247, 139, 214, 236, 135, 261, 246, 196,
+	178, 179, 134, 0, 231, 157, 170, 154, 212, 672,
+	682, 687, 153, 642, 680, 271, 137, 138, 270, 211,
+	258, 262, 197, 191, 136, 260, 195, 190, 182, 161,
+	174, 224, 189, 225, 175, 201, 200, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 273, 0, 0, 698, 0,
+	0, 0, 248, 0, 0, 183, 0, 0, 0, 681,
+	0, 234, 217, 709, 0, 222, 232, 187, 259, 226,
+	264, 250, 272, 0, 227, 129, 251, 156, 198, 140,
+	141, 152, 158, 160, 162, 163, 207, 208, 220, 239,
+	252, 253, 254, 155, 148, 233, 149, 172, 150, 130,
+	241, 151, 131, 221, 257, 0, 169, 229, 194, 132,
+	193, 223, 256, 255, 280, 287, 288, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	166, 0, 268, 696, 213, 708, 691, 693, 694, 697,
+	701, 702, 640, 643, 703, 705, 707, 710, 237, 0,
+	0, 0, 0, 0, 177, 219, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 266, 278, 641, 0, 0, 0, 277, 0, 0,
+	0, 0, 0, 685, 203, 204, 205, 206, 699, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 165, 171, 0, 173, 145, 218, 168, 275, 180,
+	210, 176, 242, 181, 188, 230, 274, 216, 235, 144,
+	265, 243, 192, 167, 0, 716, 695, 715, 717, 718,
+	714, 719, 720, 704, 658, 0, 712, 711, 713, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 0, 185, 0, 228, 164, 603, 604, 605, 606,
+	607, 608, 609, 610, 611, 612, 613, 614, 615, 632,
+	633, 616, 617, 105, 618, 619, 620, 621, 622, 623,
+	624, 625, 626, 627, 628, 629, 630, 631, 634, 635,
+	636, 637, 638, 639, 0, 0, 281, 282, 283, 284,
+	267, 683, 0, 0, 1450, 0, 0, 0, 0, 0,
+	0, 215, 0, 0, 0, 0, 0, 656, 0, 0,
+	0, 159, 0, 0, 0, 184, 0, 186, 0, 0,
+	244, 199, 0, 0, 0, 0, 700, 706, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 649, 0, 0,
+	601, 690, 689, 665, 0, 0, 0, 142, 666, 0,
+	671, 0, 667, 670, 668, 669, 0, 0, 692, 0,
+	0, 0, 0, 0, 599, 653, 0, 657, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 650, 651,
+	0, 0, 0, 0, 684, 0, 652, 0, 0, 686,
+	0, 673, 0, 133, 249, 263, 143, 240, 276, 147,
+	247, 139, 214, 236, 135, 261, 246, 196, 178, 179,
+	134, 0, 231, 157, 170, 154, 212, 672, 682, 687,
+	153, 642, 680, 271, 137, 138, 270, 211, 258, 262,
+	197, 191, 136, 260, 195, 190, 182, 161, 174, 224,
+	189, 225, 175, 201, 200, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 273, 0, 0, 698, 0, 0, 0,
+	248, 0, 0, 183, 0, 0, 0, 681, 0, 234,
+	217, 709, 0, 222, 232, 187, 259, 226, 264, 250,
+	272, 0, 227, 129, 251, 156, 198, 140, 141, 152,
+	158, 160, 162, 163, 207, 208, 220, 239, 252, 253,
+	254, 155, 148, 233, 149, 172, 150, 130, 241, 151,
+	131, 221, 257, 0, 169, 229, 194, 132, 193, 223,
+	256, 255, 280, 287, 288, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 166, 0,
+	268, 696, 213, 708, 691, 693, 694, 697, 701, 702,
+	640, 643, 703, 705, 707, 710, 237, 0, 0, 0,
+	0, 0, 177, 219, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 266,
+	278, 641, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 685, 203, 204, 205, 206, 699, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	171, 0, 173, 145, 218, 168, 275, 180, 210, 176,
+	242, 181, 188, 230, 274, 216, 235, 144, 265, 243,
+	192, 167, 0, 716, 695, 715, 717, 718, 714, 719,
+	720, 704, 658, 0, 712, 711, 713, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 0,
+	185, 0, 228, 164, 603, 604, 605, 606, 607, 608,
+	609, 610, 611, 612, 613, 614, 615, 632, 633, 616,
+	617, 105, 618, 619, 620, 621, 622, 623, 624, 625,
+	626, 627, 628, 629, 630, 631, 634, 635, 636, 637,
+	638, 639, 683, 0, 281, 282, 283, 284, 267, 0,
+	0, 0, 215, 0, 0, 0, 0, 0, 656, 0,
+	0, 0, 159, 0, 0, 0, 184, 0, 186, 0,
+	0, 244, 199, 0, 0, 0, 0, 700, 706, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 649, 0,
+	0, 601, 690, 689, 665, 0, 0, 0, 142, 666,
+	0, 671, 0, 667, 670, 668, 669, 0, 0, 692,
+	0, 0, 0, 0, 0, 599, 653, 0, 657, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 650,
+	651, 596, 0, 0, 0, 684, 0, 652, 0, 0,
+	686, 0, 673, 0, 133, 249, 263, 143, 240, 276,
+	147, 247, 139, 214, 236, 135, 261, 246, 196, 178,
+	179, 134, 0, 231, 157, 170, 154, 212, 672, 682,
+	687, 153, 642, 680, 271, 137, 138, 270, 211, 258,
+	262, 197, 191, 136, 260, 195, 190, 182, 161, 174,
+	224, 189, 225, 175, 201, 200, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 273, 0, 0, 698, 0, 0,
+	0, 248, 0, 0, 183, 0, 0, 0, 681, 0,
+	234, 217, 709, 0, 222, 232, 187, 259, 226, 264,
+	250, 272, 0, 227, 129, 251, 156, 198, 140, 141,
+	152, 158, 160, 162, 163, 207, 208, 220, 239, 252,
+	253, 254, 155, 148, 233, 149, 172, 150, 130, 241,
+	151, 131, 221, 257, 0, 169, 229, 194, 132, 193,
+	223, 256, 255, 280, 287, 288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 166,
+	0, 268, 696, 213, 708, 691, 693, 694, 697, 701,
+	702, 640, 643, 703, 705, 707, 710, 237, 0, 0,
+	0, 0, 0, 177, 219, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	266, 278, 641, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 685, 203, 204, 205, 206, 699, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	165, 171, 0, 173, 145, 218, 168, 275, 180, 210,
+	176, 242, 181, 188, 230, 274, 216, 235, 144, 265,
+	243, 192, 167, 0, 716, 695, 715, 717, 718, 714,
+	719, 720, 704, 658, 0, 712, 711, 713, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	0, 185, 0, 228, 164, 603, 604, 605, 606, 607,
+	608, 609, 610, 611, 612, 613, 614, 615, 632, 633,
+	616, 617, 105, 618, 619, 620, 621, 622, 623, 624,
+	625, 626, 627, 628, 629, 630, 631, 634, 635, 636,
+	637, 638, 639, 683, 0, 281, 282, 283, 284, 267,
+	0, 0, 0, 215, 0, 0, 0, 0, 0, 656,
+	0, 0, 0, 159, 0, 0, 0, 184, 0, 186,
+	0, 0, 244, 199, 0, 0, 0, 0, 700, 706,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 649,
+	0, 0, 601, 690, 689, 665, 0, 0, 0, 142,
+	666, 0, 671, 0, 667, 670, 668, 669, 0, 0,
+	692, 0, 0, 0, 0, 0, 599, 653, 0, 657,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	650, 651, 0, 0, 0, 0, 684, 0, 652, 0,
+	0, 686, 0, 673, 0, 133, 249, 263, 143, 240,
+	276, 147, 247, 139, 214, 236, 135, 261, 246, 196,
+	178, 179, 134, 0, 231, 157, 170, 154, 212, 672,
+	682, 687, 153, 642, 680, 271, 137, 138, 270, 211,
+	258, 262, 197, 191, 136, 260, 195, 190, 182, 161,
+	174, 224, 189, 225, 175, 201, 200, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 273, 0, 0, 698, 0,
+	0, 0, 248, 0, 0, 183, 0, 0, 0, 681,
+	0, 234, 217, 709, 0, 222, 232, 187, 259, 226,
+	264, 250, 272, 0, 227, 129, 251, 156, 198, 140,
+	141, 152, 158, 160, 162, 163, 207, 208, 220, 239,
+	252, 253, 254, 155, 148, 233, 149, 172, 150, 130,
+	241, 151, 131, 221, 257, 0, 169, 229, 194, 132,
+	193, 223, 256, 255, 280, 287, 288, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	166, 0, 268, 696, 213, 708, 691, 693, 694, 697,
+	701, 702, 640, 643, 703, 705, 707, 710, 237, 0,
+	0, 0, 0, 0, 177, 219, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 266, 278, 641, 0, 0, 0, 277, 0, 0,
+	0, 0, 0, 685, 203, 204, 205, 206, 699, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 165, 171, 0, 173, 145, 218, 168, 275, 180,
+	210, 176, 242, 181, 188, 230, 274, 216, 235, 144,
+	265, 243, 192, 167, 0, 716, 695, 715, 717, 718,
+	714, 719, 720, 704, 658, 0, 712, 711, 713, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 0, 185, 0, 228, 164, 603, 604, 605, 606,
+	607, 608, 609, 610, 611, 612, 613, 614, 615, 632,
+	633, 616, 617, 105, 618, 619, 620, 621, 622, 623,
+	624, 625, 626, 627, 628, 629, 630, 631, 634, 635,
+	636, 637, 638, 639, 683, 0, 281, 282, 283, 284,
+	267, 0, 0, 0, 215, 0, 1233, 0, 0, 0,
+	656, 0, 0, 0, 159, 0, 0, 0, 184, 0,
+	186, 0, 0, 244, 199, 0, 0, 0, 0, 700,
+	706, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	649, 0, 0, 601, 690, 689, 665, 0, 0, 0,
+	142, 666, 0, 671, 0, 667, 670, 668, 669, 0,
+	0, 692, 0, 0, 0, 0, 0, 0, 653, 0,
+	657, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 650, 651, 0, 0, 0, 0, 684, 0, 652,
+	0, 0, 686, 0, 673, 0, 133, 249, 263, 143,
+	240, 276, 147, 247, 139, 214, 236, 135, 261, 246,
+	196, 178, 179, 134, 0, 231, 157, 170, 154, 212,
+	672, 682, 687, 153, 642, 680, 271, 137, 138, 270,
+	211, 258, 262, 197, 191, 136, 260, 195, 190, 182,
+	161, 174, 224, 189, 225, 175, 201, 200, 202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 0, 698,
+	0, 0, 0, 248, 0, 0, 183, 0, 0, 0,
+	681, 0, 234, 217, 709, 0, 222, 232, 187, 259,
+	226, 264, 250, 272, 0, 227, 129, 251, 156, 198,
+	140, 141, 152, 158, 160, 162, 163, 207, 208, 220,
+	239, 252, 253, 254, 155, 148, 233, 149, 172, 150,
+	130, 241, 151, 131, 221, 257, 0, 169, 229, 194,
+	132, 193, 223, 256, 255, 280, 1234, 1235, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 166, 0, 268, 696, 213, 708, 691, 693, 694,
+	697, 701, 702, 640, 643, 703, 705, 707, 710, 237,
+	0, 0, 0, 0, 0, 177, 219, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 266, 278, 641, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 685, 203, 204, 205, 206, 699,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 165, 171, 0, 173, 145, 218, 168, 275,
+	180, 210, 176, 242, 181, 188, 230, 274, 216, 235,
+	144, 265, 243, 192, 167, 0, 716, 695, 715, 717,
+	718, 714, 719, 720, 704, 658, 0, 712, 711, 713,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 128, 0, 185, 0, 228, 164, 603, 604, 605,
+	606, 607, 608, 609, 610, 611, 612, 613, 614, 615,
+	632, 633, 616, 617, 105, 618, 619, 620, 621, 622,
+	623, 624, 625, 626, 627, 628, 629, 630, 631, 634,
+	635, 636, 637, 638, 639, 683, 0, 281, 282, 283,
+	284, 267, 0, 0, 0, 215, 0, 0, 0, 0,
+	0, 656, 0, 0, 0, 159, 0, 0, 0, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	700, 706, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 649, 0, 0, 601, 690, 689, 665, 0, 0,
+	0, 142, 666, 0, 671, 0, 667, 670, 668, 669,
+	0, 0, 692, 0, 0, 0, 0, 0, 0, 653,
+	0, 657, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 650, 651, 0, 0, 0, 0, 684, 0,
+	652, 0, 0, 686, 0, 673, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 672, 682, 687, 153, 642, 680, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 273, 0, 0,
+	698, 0, 0, 0, 248, 0, 0, 183, 0, 0,
+	0, 681, 0, 234, 217, 709, 0, 222, 232, 187,
+	259, 226, 264, 250, 272, 0, 227, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 166, 0, 268, 696, 213, 708, 691, 693,
+	694, 697, 701, 702, 640, 643, 703, 705, 707, 710,
+	237, 0, 0, 0, 0, 0, 177, 219, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 266, 278, 641, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 685, 203, 204, 205, 206,
+	699, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 716, 695, 715,
+	717, 718, 714, 719, 720, 704, 658, 0, 712, 711,
+	713, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 0, 185, 0, 228, 164, 603, 604,
+	605, 606, 607, 608, 609, 610, 611, 612, 613, 614,
+	615, 632, 633, 616, 617, 105, 618, 619, 620, 621,
+	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
+	634, 635, 636, 637, 638, 639, 0, 0, 281, 282,
+	283, 284, 267, 327, 0, 326, 330, 322, 0, 0,
+	0, 0, 0, 0, 0, 215, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 337, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 340, 0, 0, 341, 0, 0,
 	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 705, 684, 704, 706, 707,
-	703, 708, 709, 693, 647, 0, 701, 700, 702, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 78, 224, 160, 594, 595, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
-	608, 103, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
-	627, 628, 672, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 645, 0,
-	0, 0, 155, 825, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 689, 695, 0,
-	0, 0, 0, 0, 0, 821, 0, 0, 638, 0,
-	0, 592, 679, 678, 654, 0, 0, 0, 138, 655,
-	0, 660, 0, 656, 659, 657, 658, 0, 0, 681,
-	0, 0, 0, 0, 0, 590, 642, 0, 646, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 639,
-	640, 0, 0, 0, 0, 673, 0, 641, 0, 0,
-	822, 0, 662, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 661, 671,
-	676, 149, 631, 669, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 687, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 670, 0,
-	230, 213, 698, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 685, 209, 697, 680, 682, 683, 686, 690,
-	691, 629, 632, 692, 694, 696, 699, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 630, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 674, 199, 200, 201, 202, 688, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 705, 684, 704, 706, 707, 703, 708,
-	709, 693, 647, 0, 701, 700, 702, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 594, 595, 596, 597, 598, 599,
-	600, 601, 602, 603, 604, 605, 606, 607, 608, 103,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
-	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
-	672, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 645, 0, 0, 0,
-	155, 2151, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 689, 695, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 638, 0, 0, 592,
-	679, 678, 654, 0, 0, 0, 138, 655, 0, 660,
-	0, 656, 659, 657, 658, 0, 0, 681, 0, 0,
-	0, 0, 0, 590, 642, 0, 646, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 639, 640, 0,
-	0, 0, 0, 673, 0, 641, 0, 0, 675, 0,
-	662, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 661, 671, 676, 149,
-	631, 669, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 687, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 670, 0, 230, 213,
-	698, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	685, 209, 697, 680, 682, 683, 686, 690, 691, 629,
-	632, 692, 694, 696, 699, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	630, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	674, 199, 200, 201, 202, 688, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 705, 684, 704, 706, 707, 703, 708, 709, 693,
-	647, 0, 701, 700, 702, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 594, 595, 596, 597, 598, 599, 600, 601,
-	602, 603, 604, 605, 606, 607, 608, 103, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 672, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 825,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 0, 0, 277, 278,
-	279, 263, 672, 0, 0, 1431, 0, 0, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 645, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 689, 695, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 638, 0,
-	0, 592, 679, 678, 654, 0, 0, 0, 138, 655,
-	0, 660, 0, 656, 659, 657, 658, 0, 0, 681,
-	0, 0, 0, 0, 0, 590, 642, 0, 646, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 639,
-	640, 0, 0, 0, 0, 673, 0, 641, 0, 0,
-	675, 0, 662, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 661, 671,
-	676, 149, 631, 669, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 687, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 670, 0,
-	230, 213, 698, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 685, 209, 697, 680, 682, 683, 686, 690,
-	691, 629, 632, 692, 694, 696, 699, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 630, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 674, 199, 200, 201, 202, 688, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 705, 684, 704, 706, 707, 703, 708,
-	709, 693, 647, 0, 701, 700, 702, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 594, 595, 596, 597, 598, 599,
-	600, 601, 602, 603, 604, 605, 606, 607, 608, 103,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
-	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
-	672, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 645, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 689, 695, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 638, 0, 0, 592,
-	679, 678, 654, 0, 0, 0, 138, 655, 0, 660,
-	0, 656, 659, 657, 658, 0, 0, 681, 0, 0,
-	0, 0, 0, 590, 642, 0, 646, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 639, 640, 587,
-	0, 0, 0, 673, 0, 641, 0, 0, 675, 0,
-	662, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 661, 671, 676, 149,
-	631, 669, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 687, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 670, 0, 230, 213,
-	698, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	685, 209, 697, 680, 682, 683, 686, 690, 691, 629,
-	632, 692, 694, 696, 699, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	630, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	674, 199, 200, 201, 202, 688, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 705, 684, 704, 706, 707, 703, 708, 709, 693,
-	647, 0, 701, 700, 702, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 594, 595, 596, 597, 598, 599, 600, 601,
-	602, 603, 604, 605, 606, 607, 608, 103, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 618, 619, 620,
-	621, 622, 623, 624, 625, 626, 627, 628, 672, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 645, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 689, 695, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 592, 679, 678,
-	654, 0, 0, 0, 138, 655, 0, 660, 0, 656,
-	659, 657, 658, 0, 0, 681, 0, 0, 0, 0,
-	0, 590, 642, 0, 646, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 639, 640, 0, 0, 0,
-	0, 673, 0, 641, 0, 0, 675, 0, 662, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 661, 671, 676, 149, 631, 669,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 687, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 670, 0, 230, 213, 698, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 685, 209,
-	697, 680, 682, 683, 686, 690, 691, 629, 632, 692,
-	694, 696, 699, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 630, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 674, 199,
-	200, 201, 202, 688, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 705,
-	684, 704, 706, 707, 703, 708, 709, 693, 647, 0,
-	701, 700, 702, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 103, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 618, 619, 620, 621, 622,
-	623, 624, 625, 626, 627, 628, 672, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 1218, 0,
-	0, 0, 645, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 689, 695, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 638, 0, 0, 592, 679, 678, 654, 0,
-	0, 0, 138, 655, 0, 660, 0, 656, 659, 657,
-	658, 0, 0, 681, 0, 0, 0, 0, 0, 0,
-	642, 0, 646, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 639, 640, 0, 0, 0, 0, 673,
-	0, 641, 0, 0, 675, 0, 662, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 661, 671, 676, 149, 631, 669, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 687, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 670, 0, 230, 213, 698, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 1219, 1220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 162, 0, 264, 685, 209, 697, 680,
-	682, 683, 686, 690, 691, 629, 632, 692, 694, 696,
-	699, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 630, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 674, 199, 200, 201,
-	202, 688, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 705, 684, 704,
-	706, 707, 703, 708, 709, 693, 647, 0, 701, 700,
-	702, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 594, 595,
-	596, 597, 598, 599, 600, 601, 602, 603, 604, 605,
-	606, 607, 608, 103, 609, 610, 611, 612, 613, 614,
-	615, 616, 617, 618, 619, 620, 621, 622, 623, 624,
-	625, 626, 627, 628, 672, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	645, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 689,
-	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	638, 0, 0, 592, 679, 678, 654, 0, 0, 0,
-	138, 655, 0, 660, 0, 656, 659, 657, 658, 0,
-	0, 681, 0, 0, 0, 0, 0, 0, 642, 0,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 639, 640, 0, 0, 0, 0, 673, 0, 641,
-	0, 0, 675, 0, 662, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	661, 671, 676, 149, 631, 669, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 687,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	670, 0, 230, 213, 698, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 685, 209, 697, 680, 682, 683,
-	686, 690, 691, 629, 632, 692, 694, 696, 699, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 630, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 674, 199, 200, 201, 202, 688,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 0, 0, 1273, 153, 279, 0, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 320, 319,
+	323, 0, 0, 0, 0, 0, 325, 273, 0, 0,
+	0, 0, 0, 0, 248, 0, 0, 183, 329, 0,
+	0, 0, 0, 234, 217, 0, 0, 222, 232, 187,
+	259, 226, 321, 250, 272, 0, 345, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 166, 1269, 268, 1266, 213, 0, 0, 1268,
+	1265, 1267, 1271, 1272, 209, 285, 0, 1270, 0, 0,
+	237, 0, 0, 0, 324, 328, 331, 219, 332, 333,
+	0, 0, 334, 335, 336, 0, 0, 338, 339, 0,
+	0, 0, 245, 266, 278, 269, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 203, 204, 205, 206,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1276, 1277, 1278, 1279, 1280, 1281, 1274, 1275, 0, 0,
+	0, 0, 128, 0, 185, 0, 228, 164, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 120, 121, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	122, 123, 124, 125, 126, 127, 0, 0, 281, 282,
+	283, 284, 267, 327, 0, 326, 330, 322, 0, 0,
+	0, 0, 0, 0, 0, 215, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 337, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 340, 0, 0, 341, 0, 0,
 	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 705, 684, 704, 706, 707,
-	703, 708, 709, 693, 647, 0, 701, 700, 702, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 594, 595, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
-	608, 103, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
-	627, 628, 0, 0, 277, 278, 279, 263, 322, 0,
-	321, 325, 317, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 332, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 335,
-	0, 0, 336, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 1258, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 314, 318, 0, 0, 0, 0,
-	0, 320, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 324, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 316, 246, 268,
-	0, 340, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 1254, 264,
-	1251, 209, 0, 0, 1253, 1250, 1252, 1256, 1257, 205,
-	280, 0, 1255, 0, 0, 233, 0, 0, 0, 319,
-	323, 326, 215, 327, 328, 0, 0, 329, 330, 331,
-	0, 0, 333, 334, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
-	1246, 1247, 1248, 1249, 1261, 1262, 1263, 1264, 1265, 1266,
-	1259, 1260, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 0,
-	277, 278, 279, 263, 322, 0, 321, 325, 317, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 155, 0, 0, 332,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 335, 0, 0, 336, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 0, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	314, 318, 0, 0, 0, 0, 0, 320, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 324,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 316, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 282, 283,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 162, 0, 264, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
-	0, 233, 0, 0, 0, 319, 323, 326, 215, 327,
-	328, 0, 0, 329, 330, 331, 0, 0, 333, 334,
-	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 0, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 327, 0, 326, 330, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 337,
+	0, 0, 0, 0, 0, 0, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 0, 0, 0, 153, 279, 0, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 320, 319,
+	323, 0, 0, 0, 0, 0, 325, 273, 0, 0,
+	0, 0, 0, 0, 248, 0, 0, 183, 329, 0,
+	0, 0, 0, 234, 217, 0, 0, 222, 232, 187,
+	259, 226, 321, 250, 272, 0, 227, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 320,
+	319, 323, 0, 0, 0, 0, 0, 325, 0, 0,
+	0, 286, 166, 0, 268, 0, 213, 0, 0, 329,
+	0, 0, 0, 0, 209, 285, 0, 0, 0, 0,
+	237, 0, 0, 917, 324, 328, 331, 219, 332, 333,
+	0, 0, 334, 335, 336, 0, 0, 338, 339, 0,
+	0, 0, 245, 266, 278, 269, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 203, 204, 205, 206,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 324, 328, 918, 0, 332,
+	919, 0, 0, 334, 335, 336, 0, 0, 338, 339,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 0, 185, 0, 228, 164, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 120, 121, 103, 104, 105, 106, 107, 108, 109,
 	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 0, 0, 277, 278, 279, 263,
-	79, 0, 23, 39, 24, 0, 0, 0, 0, 0,
-	0, 0, 211, 286, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
-	0, 85, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 290,
-	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
-	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 281, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 287, 289, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 78, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	211, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1513, 1516,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1517, 269, 0, 0, 0, 1510, 0, 1509, 244,
-	1511, 1514, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1515, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 211, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 155, 382,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 85, 395, 396,
-	0, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 387, 149, 275, 399,
-	267, 133, 398, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 381, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 384, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 392, 388, 389, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 390, 163, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 0, 211, 277, 278,
-	279, 263, 858, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 859,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 855, 856, 854,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 395, 396, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 387, 149, 275, 399, 267, 133, 398,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	122, 123, 124, 125, 126, 127, 0, 0, 281, 282,
+	283, 284, 267, 81, 0, 24, 40, 25, 0, 0,
+	0, 0, 0, 0, 0, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 296, 0, 0, 87, 0, 0, 0, 0, 0,
+	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 0, 0, 0, 153, 279, 0, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 0, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 248, 0, 0, 183, 0, 0,
+	0, 0, 0, 234, 217, 0, 0, 222, 232, 187,
+	259, 226, 264, 250, 272, 0, 227, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 166, 0, 268, 0, 213, 0, 0, 0,
+	0, 0, 0, 0, 209, 285, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 0, 177, 219, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 266, 278, 269, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 203, 204, 205, 206,
+	292, 294, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 0, 185, 80, 228, 164, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 120, 121, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	122, 123, 124, 125, 126, 127, 215, 0, 281, 282,
+	283, 284, 267, 0, 0, 0, 159, 0, 0, 0,
+	184, 0, 186, 0, 0, 244, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 0, 0, 0, 0,
 	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 392, 388, 389, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 390, 163, 0, 0, 0, 0,
+	0, 0, 0, 0, 1533, 1536, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 249,
+	263, 143, 240, 276, 147, 247, 139, 214, 236, 135,
+	261, 246, 196, 178, 179, 134, 0, 231, 157, 170,
+	154, 212, 0, 0, 0, 153, 279, 0, 271, 137,
+	138, 270, 211, 258, 262, 197, 191, 136, 260, 195,
+	190, 182, 161, 174, 224, 189, 225, 175, 201, 200,
+	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1537, 273, 0,
+	0, 0, 1530, 0, 1529, 248, 1531, 1534, 183, 0,
+	0, 0, 0, 0, 234, 217, 0, 0, 222, 232,
+	187, 259, 226, 264, 250, 272, 0, 227, 129, 251,
+	156, 198, 140, 141, 152, 158, 160, 162, 163, 207,
+	208, 220, 239, 252, 253, 254, 155, 148, 233, 149,
+	172, 150, 130, 241, 151, 131, 221, 257, 1535, 169,
+	229, 194, 132, 193, 223, 256, 255, 280, 287, 288,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 166, 0, 268, 0, 213, 0, 0,
+	0, 0, 0, 0, 0, 209, 285, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 0, 177, 219, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 266, 278, 269, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 203, 204, 205,
+	206, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 165, 171, 0, 173, 145, 218,
+	168, 275, 180, 210, 176, 242, 181, 188, 230, 274,
+	216, 235, 144, 265, 243, 192, 167, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 0, 185, 0, 228, 164, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 120, 121, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 122, 123, 124, 125, 126, 127, 215, 0, 281,
+	282, 283, 284, 267, 0, 0, 0, 159, 390, 0,
+	0, 184, 0, 186, 0, 0, 244, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 87, 403, 404, 0,
+	0, 0, 0, 142, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 405, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	249, 263, 143, 240, 276, 147, 247, 139, 214, 236,
+	135, 261, 246, 196, 178, 179, 134, 0, 231, 157,
+	170, 154, 212, 0, 0, 395, 153, 279, 407, 271,
+	137, 406, 270, 211, 258, 262, 197, 191, 136, 260,
+	195, 190, 182, 161, 174, 224, 189, 225, 175, 201,
+	200, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 248, 0, 0, 183,
+	0, 0, 0, 0, 0, 234, 217, 0, 0, 222,
+	232, 187, 259, 226, 264, 250, 272, 389, 227, 129,
+	251, 156, 198, 140, 141, 152, 158, 160, 162, 163,
+	207, 208, 220, 239, 252, 253, 254, 155, 148, 233,
+	149, 172, 150, 130, 241, 151, 131, 221, 257, 0,
+	169, 229, 194, 132, 193, 223, 256, 255, 280, 287,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 166, 0, 268, 0, 213, 0,
+	0, 0, 0, 0, 0, 0, 209, 285, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 0, 177, 219,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 266, 278, 269, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 392, 203, 204,
+	205, 206, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 171, 0, 173, 145,
+	218, 168, 275, 180, 400, 396, 397, 181, 188, 230,
+	274, 216, 235, 144, 265, 243, 398, 167, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 0, 185, 0, 228, 164,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 120, 121, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 124, 125, 126, 127, 0, 0,
+	281, 282, 283, 284, 267, 215, 0, 0, 0, 0,
+	1018, 0, 0, 0, 0, 159, 0, 0, 0, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 87, 0, 0, 1019, 0, 0,
+	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1015, 1016, 1014, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 0, 0, 0, 153, 279, 0, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 248, 0, 0, 183, 0, 0,
+	0, 0, 0, 234, 217, 0, 0, 222, 232, 187,
+	259, 226, 264, 250, 272, 0, 227, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 166, 0, 268, 0, 213, 0, 0, 0,
+	0, 0, 0, 0, 209, 285, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 0, 177, 219, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 266, 278, 269, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 203, 204, 205, 206,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 0, 185, 0, 228, 164, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 120, 121, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	122, 123, 124, 125, 126, 127, 215, 0, 281, 282,
+	283, 284, 267, 0, 0, 0, 159, 0, 0, 0,
+	184, 0, 186, 0, 0, 244, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 403, 404, 0, 0,
+	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 405, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 249,
+	263, 143, 240, 276, 147, 247, 139, 214, 236, 135,
+	261, 246, 196, 178, 179, 134, 0, 231, 157, 170,
+	154, 212, 0, 0, 395, 153, 279, 407, 271, 137,
+	406, 270, 211, 258, 262, 197, 191, 136, 260, 195,
+	190, 182, 161, 174, 224, 189, 225, 175, 201, 200,
+	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	0, 0, 0, 0, 0, 248, 0, 0, 183, 0,
+	0, 0, 0, 0, 234, 217, 0, 0, 222, 232,
+	187, 259, 226, 264, 250, 272, 0, 227, 129, 251,
+	156, 198, 140, 141, 152, 158, 160, 162, 163, 207,
+	208, 220, 239, 252, 253, 254, 155, 148, 233, 149,
+	172, 150, 130, 241, 151, 131, 221, 257, 0, 169,
+	229, 194, 132, 193, 223, 256, 255, 280, 287, 288,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 166, 0, 268, 0, 213, 0, 0,
+	0, 0, 0, 0, 0, 209, 285, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 0, 177, 219, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 266, 278, 269, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 203, 204, 205,
+	206, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 165, 171, 0, 173, 145, 218,
+	168, 275, 180, 400, 396, 397, 181, 188, 230, 274,
+	216, 235, 144, 265, 243, 398, 167, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 0, 185, 0, 228, 164, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 79, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 0, 0, 211, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	101, 102, 120, 121, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 122, 123, 124, 125, 126, 127, 81, 0, 281,
+	282, 283, 284, 267, 0, 0, 0, 0, 0, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 184, 0, 186, 0, 0, 244, 199,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 1110, 87, 0,
+	0, 0, 0, 0, 0, 142, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 75, 0, 947, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 249, 263, 143, 240, 276, 147, 247, 139,
+	214, 236, 135, 261, 246, 196, 178, 179, 134, 0,
+	231, 157, 170, 154, 212, 0, 0, 0, 153, 279,
+	0, 271, 137, 138, 270, 211, 258, 262, 197, 191,
+	136, 260, 195, 190, 182, 161, 174, 224, 189, 225,
+	175, 201, 200, 202, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 273, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 183, 0, 0, 0, 0, 0, 234, 217, 0,
+	0, 222, 232, 187, 259, 226, 264, 250, 272, 0,
+	227, 129, 251, 156, 198, 140, 141, 152, 158, 160,
+	162, 163, 207, 208, 220, 239, 252, 253, 254, 155,
+	148, 233, 149, 172, 150, 130, 241, 151, 131, 221,
+	257, 0, 169, 229, 194, 132, 193, 223, 256, 255,
+	280, 287, 288, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 166, 0, 268, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 209, 285,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
+	177, 219, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 266, 278, 269,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	203, 204, 205, 206, 0, 0, 146, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 165, 171, 0,
+	173, 145, 218, 168, 275, 180, 210, 176, 242, 181,
+	188, 230, 274, 216, 235, 144, 265, 243, 192, 167,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 0, 0, 128, 0, 185, 80,
+	228, 164, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 120, 121, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 122, 123, 124, 125, 126, 127,
+	0, 0, 281, 282, 283, 284, 267, 215, 0, 550,
+	0, 0, 0, 0, 0, 0, 0, 159, 551, 0,
+	0, 184, 0, 186, 0, 0, 244, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 340, 0, 0, 341,
+	0, 0, 0, 142, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	249, 263, 143, 240, 276, 147, 247, 139, 214, 236,
+	135, 261, 246, 196, 178, 179, 134, 0, 231, 157,
+	170, 154, 212, 0, 0, 0, 153, 279, 0, 271,
+	137, 138, 270, 211, 258, 262, 197, 191, 136, 260,
+	195, 190, 182, 161, 174, 224, 189, 225, 175, 201,
+	200, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 248, 0, 0, 183,
+	0, 0, 0, 0, 0, 234, 217, 0, 0, 222,
+	232, 187, 259, 226, 264, 250, 272, 0, 227, 129,
+	251, 156, 198, 140, 141, 152, 158, 160, 162, 163,
+	207, 208, 220, 239, 252, 253, 254, 155, 148, 233,
+	149, 172, 150, 130, 241, 151, 131, 221, 257, 0,
+	169, 229, 194, 132, 193, 223, 256, 255, 280, 287,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 166, 0, 268, 0, 213, 0,
+	0, 0, 0, 0, 0, 0, 209, 285, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 0, 177, 219,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 266, 278, 269, 0, 0,
+	0, 277, 0, 0, 0, 0, 552, 0, 203, 204,
+	205, 206, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 171, 0, 173, 145,
+	218, 168, 275, 180, 210, 176, 242, 181, 188, 230,
+	274, 216, 235, 144, 265, 243, 192, 167, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 0, 185, 0, 228, 164,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 120, 121, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 124, 125, 126, 127, 215, 0,
+	281, 282, 283, 284, 267, 0, 0, 0, 159, 0,
+	0, 0, 184, 0, 186, 0, 0, 244, 199, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 87, 0, 0,
+	1084, 0, 0, 0, 142, 1085, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1087, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 249, 263, 143, 240, 276, 147, 247, 139, 214,
+	236, 135, 261, 246, 196, 178, 179, 134, 0, 231,
+	157, 170, 154, 212, 0, 0, 0, 153, 279, 0,
+	271, 137, 138, 270, 211, 258, 262, 197, 191, 136,
+	260, 195, 190, 182, 161, 174, 224, 189, 225, 175,
+	201, 200, 202, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	273, 0, 0, 0, 0, 0, 0, 248, 0, 0,
+	183, 0, 0, 0, 0, 0, 234, 217, 0, 0,
+	222, 232, 187, 259, 226, 264, 250, 272, 0, 227,
+	129, 251, 156, 198, 140, 141, 152, 158, 160, 162,
+	163, 207, 208, 220, 239, 252, 253, 254, 155, 148,
+	233, 149, 172, 150, 130, 241, 151, 131, 221, 257,
+	0, 169, 229, 194, 132, 193, 223, 256, 255, 280,
+	287, 288, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 166, 0, 268, 0, 213,
+	0, 0, 0, 0, 0, 0, 0, 209, 285, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 177,
+	219, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 266, 278, 269, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 203,
+	204, 205, 206, 0, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 165, 171, 0, 173,
+	145, 218, 168, 275, 180, 210, 176, 242, 181, 188,
+	230, 274, 216, 235, 144, 265, 243, 192, 167, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 0, 185, 0, 228,
+	164, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 120, 121, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 122, 123, 124, 125, 126, 127, 0,
+	0, 281, 282, 283, 284, 267, 215, 0, 973, 0,
+	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	184, 0, 186, 0, 0, 244, 199, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	0, 0, 0, 0, 0, 340, 0, 0, 341, 0,
 	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 78, 224, 160, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 249,
+	263, 143, 240, 276, 147, 247, 139, 214, 236, 135,
+	261, 246, 196, 178, 179, 134, 0, 231, 157, 170,
+	154, 212, 0, 0, 0, 153, 279, 0, 271, 137,
+	138, 270, 211, 258, 262, 197, 191, 136, 260, 195,
+	190, 182, 161, 174, 224, 189, 225, 175, 201, 200,
+	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	0, 0, 0, 0, 0, 248, 0, 0, 183, 0,
+	0, 0, 0, 0, 234, 217, 0, 0, 222, 232,
+	187, 259, 226, 264, 250, 272, 0, 227, 129, 251,
+	156, 198, 140, 141, 152, 158, 160, 162, 163, 207,
+	208, 220, 239, 252, 253, 254, 155, 148, 233, 149,
+	172, 150, 130, 241, 151, 131, 221, 257, 0, 169,
+	229, 194, 132, 193, 223, 256, 255, 280, 287, 288,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 166, 0, 268, 0, 213, 0, 0,
+	0, 0, 0, 0, 0, 209, 285, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 0, 177, 219, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 266, 278, 269, 0, 0, 0,
+	277, 0, 0, 0, 0, 972, 0, 203, 204, 205,
+	206, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 165, 171, 0, 173, 145, 218,
+	168, 275, 180, 210, 176, 242, 181, 188, 230, 274,
+	216, 235, 144, 265, 243, 192, 167, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 0, 185, 0, 228, 164, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 0, 0, 277, 278, 279, 263, 211,
-	0, 541, 0, 0, 0, 0, 0, 0, 0, 155,
-	542, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 335, 0,
-	0, 336, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 543, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 921,
-	0, 0, 0, 138, 922, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 924, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	101, 102, 120, 121, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 0, 277, 278, 279,
-	263, 211, 0, 813, 0, 0, 0, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	335, 0, 0, 336, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	812, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	119, 122, 123, 124, 125, 126, 127, 215, 0, 281,
+	282, 283, 284, 267, 0, 0, 0, 159, 0, 0,
+	0, 184, 0, 186, 0, 0, 244, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2143, 87, 690, 0, 0,
+	0, 0, 0, 142, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	249, 263, 143, 240, 276, 147, 247, 139, 214, 236,
+	135, 261, 246, 196, 178, 179, 134, 0, 231, 157,
+	170, 154, 212, 0, 0, 0, 153, 279, 0, 271,
+	137, 138, 270, 211, 258, 262, 197, 191, 136, 260,
+	195, 190, 182, 161, 174, 224, 189, 225, 175, 201,
+	200, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 248, 0, 0, 183,
+	0, 0, 0, 0, 0, 234, 217, 0, 0, 222,
+	232, 187, 259, 226, 264, 250, 272, 0, 227, 129,
+	251, 156, 198, 140, 141, 152, 158, 160, 162, 163,
+	207, 208, 220, 239, 252, 253, 254, 155, 148, 233,
+	149, 172, 150, 130, 241, 151, 131, 221, 257, 0,
+	169, 229, 194, 132, 193, 223, 256, 255, 280, 287,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 166, 0, 268, 0, 213, 0,
+	0, 0, 0, 0, 0, 0, 209, 285, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 0, 177, 219,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 266, 278, 269, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 203, 204,
+	205, 206, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 171, 0, 173, 145,
+	218, 168, 275, 180, 210, 176, 242, 181, 188, 230,
+	274, 216, 235, 144, 265, 243, 192, 167, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 0, 185, 0, 228, 164,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 120, 121, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 124, 125, 126, 127, 215, 0,
+	281, 282, 283, 284, 267, 0, 0, 0, 159, 0,
+	0, 0, 184, 0, 186, 0, 0, 244, 199, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2082, 85, 679,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 87, 0, 0,
+	924, 0, 0, 0, 142, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	133, 249, 263, 143, 240, 276, 147, 247, 139, 214,
+	236, 135, 261, 246, 196, 178, 179, 134, 0, 231,
+	157, 170, 154, 212, 0, 0, 0, 153, 279, 0,
+	271, 137, 138, 270, 211, 258, 262, 197, 191, 136,
+	260, 195, 190, 182, 161, 174, 224, 189, 225, 175,
+	201, 200, 202, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	273, 0, 0, 0, 0, 0, 0, 248, 0, 0,
+	183, 0, 0, 0, 0, 0, 234, 217, 0, 0,
+	222, 232, 187, 259, 226, 264, 250, 272, 0, 227,
+	129, 251, 156, 198, 140, 141, 152, 158, 160, 162,
+	163, 207, 208, 220, 239, 252, 253, 254, 155, 148,
+	233, 149, 172, 150, 130, 241, 151, 131, 221, 257,
+	0, 169, 229, 194, 132, 193, 223, 256, 255, 280,
+	287, 288, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 166, 0, 268, 0, 213,
+	0, 0, 0, 0, 0, 0, 0, 209, 285, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 177,
+	219, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 266, 278, 269, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 1507, 203,
+	204, 205, 206, 0, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 165, 171, 0, 173,
+	145, 218, 168, 275, 180, 210, 176, 242, 181, 188,
+	230, 274, 216, 235, 144, 265, 243, 192, 167, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 0, 0, 0, 0, 128, 0, 185, 0, 228,
+	164, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 120, 121, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 764,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 1488, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 1190, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	117, 118, 119, 122, 123, 124, 125, 126, 127, 215,
+	0, 281, 282, 283, 284, 267, 0, 0, 0, 159,
+	1205, 0, 0, 184, 0, 186, 0, 0, 244, 199,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 764, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
+	0, 924, 0, 0, 0, 142, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 133, 249, 263, 143, 240, 276, 147, 247, 139,
+	214, 236, 135, 261, 246, 196, 178, 179, 134, 0,
+	231, 157, 170, 154, 212, 0, 0, 0, 153, 279,
+	0, 271, 137, 138, 270, 211, 258, 262, 197, 191,
+	136, 260, 195, 190, 182, 161, 174, 224, 189, 225,
+	175, 201, 200, 202, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 273, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 183, 0, 0, 0, 0, 0, 234, 217, 0,
+	0, 222, 232, 187, 259, 226, 264, 250, 272, 0,
+	227, 129, 251, 156, 198, 140, 141, 152, 158, 160,
+	162, 163, 207, 208, 220, 239, 252, 253, 254, 155,
+	148, 233, 149, 172, 150, 130, 241, 151, 131, 221,
+	257, 0, 169, 229, 194, 132, 193, 223, 256, 255,
+	280, 287, 288, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 166, 0, 268, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 209, 285,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
+	177, 219, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 266, 278, 269,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	203, 204, 205, 206, 0, 0, 146, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 165, 171, 0,
+	173, 145, 218, 168, 275, 180, 210, 176, 242, 181,
+	188, 230, 274, 216, 235, 144, 265, 243, 192, 167,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 0, 185, 0,
+	228, 164, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 120, 121, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 122, 123, 124, 125, 126, 127,
+	215, 0, 281, 282, 283, 284, 267, 0, 0, 0,
+	159, 0, 0, 0, 184, 0, 186, 0, 0, 244,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
+	690, 0, 0, 0, 0, 0, 142, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 249, 263, 143, 240, 276, 147, 247,
+	139, 214, 236, 135, 261, 246, 196, 178, 179, 134,
+	0, 231, 157, 170, 154, 212, 0, 0, 0, 153,
+	279, 0, 271, 137, 138, 270, 211, 258, 262, 197,
+	191, 136, 260, 195, 190, 182, 161, 174, 224, 189,
+	225, 175, 201, 200, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 248,
+	0, 0, 183, 0, 0, 0, 0, 0, 234, 217,
+	0, 0, 222, 232, 187, 259, 226, 264, 250, 272,
+	0, 227, 129, 251, 156, 198, 140, 141, 152, 158,
+	160, 162, 163, 207, 208, 220, 239, 252, 253, 254,
+	155, 148, 233, 149, 172, 150, 130, 241, 151, 131,
+	221, 257, 0, 169, 229, 194, 132, 193, 223, 256,
+	255, 280, 287, 288, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 166, 0, 268,
+	0, 213, 0, 0, 0, 0, 0, 0, 0, 209,
+	285, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	0, 177, 219, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 266, 278,
+	269, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 203, 204, 205, 206, 0, 0, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 165, 171,
+	0, 173, 145, 218, 168, 275, 180, 210, 176, 242,
+	181, 188, 230, 274, 216, 235, 144, 265, 243, 192,
+	167, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 0, 185,
+	0, 228, 164, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 120, 121, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 122, 123, 124, 125, 126,
+	127, 215, 0, 281, 282, 283, 284, 267, 0, 0,
+	0, 159, 0, 0, 0, 184, 0, 186, 0, 0,
+	244, 199, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1839, 0, 0,
+	87, 0, 0, 0, 0, 0, 0, 142, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 679, 0, 0, 0, 0, 0, 138,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 133, 249, 263, 143, 240, 276, 147,
+	247, 139, 214, 236, 135, 261, 246, 196, 178, 179,
+	134, 0, 231, 157, 170, 154, 212, 0, 0, 0,
+	153, 279, 0, 271, 137, 138, 270, 211, 258, 262,
+	197, 191, 136, 260, 195, 190, 182, 161, 174, 224,
+	189, 225, 175, 201, 200, 202, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
+	248, 0, 0, 183, 0, 0, 0, 0, 0, 234,
+	217, 0, 0, 222, 232, 187, 259, 226, 264, 250,
+	272, 0, 227, 129, 251, 156, 198, 140, 141, 152,
+	158, 160, 162, 163, 207, 208, 220, 239, 252, 253,
+	254, 155, 148, 233, 149, 172, 150, 130, 241, 151,
+	131, 221, 257, 0, 169, 229, 194, 132, 193, 223,
+	256, 255, 280, 287, 288, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 166, 0,
+	268, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	209, 285, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 0, 177, 219, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 266,
+	278, 269, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 203, 204, 205, 206, 0, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	171, 0, 173, 145, 218, 168, 275, 180, 210, 176,
+	242, 181, 188, 230, 274, 216, 235, 144, 265, 243,
+	192, 167, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 0,
+	185, 0, 228, 164, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 120, 121, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 122, 123, 124, 125,
+	126, 127, 215, 0, 281, 282, 283, 284, 267, 0,
+	0, 0, 159, 0, 0, 0, 184, 0, 186, 0,
+	0, 244, 199, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 87, 0, 0, 924, 0, 0, 0, 142, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	0, 0, 0, 0, 133, 249, 263, 143, 240, 276,
+	147, 247, 139, 214, 236, 135, 261, 246, 196, 178,
+	179, 134, 0, 231, 157, 170, 154, 212, 0, 0,
+	0, 153, 279, 0, 271, 137, 138, 270, 211, 258,
+	262, 197, 191, 136, 260, 195, 190, 182, 161, 174,
+	224, 189, 225, 175, 201, 200, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 273, 0, 0, 0, 0, 0,
+	0, 248, 0, 0, 183, 0, 0, 0, 0, 0,
+	234, 217, 0, 0, 222, 232, 187, 259, 226, 264,
+	250, 272, 0, 227, 129, 251, 156, 198, 140, 141,
+	152, 158, 160, 162, 163, 207, 208, 220, 239, 252,
+	253, 254, 155, 148, 233, 149, 172, 150, 130, 241,
+	151, 131, 221, 257, 0, 169, 229, 194, 132, 193,
+	223, 256, 255, 280, 287, 288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 166,
+	0, 268, 0, 213, 0, 0, 0, 0, 0, 0,
+	0, 209, 285, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 0, 177, 219, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	266, 278, 269, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 203, 204, 205, 206, 0, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	165, 171, 0, 173, 145, 218, 168, 275, 180, 210,
+	176, 242, 181, 188, 230, 274, 216, 235, 144, 265,
+	243, 192, 167, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	0, 185, 0, 228, 164, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 120, 121,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1816, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	113, 114, 115, 116, 117, 118, 119, 122, 123, 124,
+	125, 126, 127, 215, 0, 281, 282, 283, 284, 267,
+	0, 0, 0, 159, 0, 0, 0, 184, 0, 186,
+	0, 0, 244, 199, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 87, 0, 0, 0, 0, 0, 0, 142,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1572, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 133, 249, 263, 143, 240,
+	276, 147, 247, 139, 214, 236, 135, 261, 246, 196,
+	178, 179, 134, 0, 231, 157, 170, 154, 212, 0,
+	0, 0, 153, 279, 0, 271, 137, 138, 270, 211,
+	258, 262, 197, 191, 136, 260, 195, 190, 182, 161,
+	174, 224, 189, 225, 175, 201, 200, 202, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 273, 0, 0, 0, 0,
+	0, 0, 248, 0, 0, 183, 0, 0, 0, 0,
+	0, 234, 217, 0, 0, 222, 232, 187, 259, 226,
+	264, 250, 272, 0, 227, 129, 251, 156, 198, 140,
+	141, 152, 158, 160, 162, 163, 207, 208, 220, 239,
+	252, 253, 254, 155, 148, 233, 149, 172, 150, 130,
+	241, 151, 131, 221, 257, 0, 169, 229, 194, 132,
+	193, 223, 256, 255, 280, 287, 288, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	166, 0, 268, 0, 213, 0, 0, 0, 0, 0,
+	0, 0, 209, 285, 0, 0, 0, 0, 237, 0,
+	0, 0, 0, 0, 177, 219, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 266, 278, 269, 0, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 203, 204, 205, 206, 0, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 165, 171, 0, 173, 145, 218, 168, 275, 180,
+	210, 176, 242, 181, 188, 230, 274, 216, 235, 144,
+	265, 243, 192, 167, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 0, 185, 0, 228, 164, 90, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 120,
+	121, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 122, 123,
+	124, 125, 126, 127, 215, 0, 281, 282, 283, 284,
+	267, 0, 0, 0, 159, 0, 0, 0, 184, 0,
+	186, 0, 0, 244, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	309, 0, 0, 87, 0, 0, 0, 0, 0, 0,
+	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 764, 0, 0, 0, 138, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 249, 263, 143,
+	240, 276, 147, 247, 139, 214, 236, 135, 261, 246,
+	196, 178, 179, 134, 0, 231, 157, 170, 154, 212,
+	0, 0, 0, 153, 279, 0, 271, 137, 138, 270,
+	211, 258, 262, 197, 191, 136, 260, 195, 190, 182,
+	161, 174, 224, 189, 225, 175, 201, 200, 202, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 0, 0,
+	0, 0, 0, 248, 0, 0, 183, 0, 0, 0,
+	0, 0, 234, 217, 0, 0, 222, 232, 187, 259,
+	226, 264, 250, 272, 0, 227, 129, 251, 156, 198,
+	140, 141, 152, 158, 160, 162, 163, 207, 208, 220,
+	239, 252, 253, 254, 155, 148, 233, 149, 172, 150,
+	130, 241, 151, 131, 221, 257, 0, 169, 229, 194,
+	132, 193, 223, 256, 255, 280, 287, 288, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	286, 166, 0, 268, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 209, 285, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 0, 177, 219, 0, 238, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 245, 266, 278, 269, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 203, 204, 205, 206, 0,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 165, 171, 0, 173, 145, 218, 168, 275,
+	180, 210, 176, 242, 181, 188, 230, 274, 216, 235,
+	144, 265, 243, 192, 167, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1552, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	0, 128, 0, 185, 0, 228, 164, 90, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	120, 121, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 122,
+	123, 124, 125, 126, 127, 215, 0, 281, 282, 283,
+	284, 267, 0, 0, 0, 159, 0, 0, 0, 184,
+	0, 186, 0, 0, 244, 199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 87, 0, 0, 0, 0, 0,
+	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1224, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 133, 249, 263,
+	143, 240, 276, 147, 247, 139, 214, 236, 135, 261,
+	246, 196, 178, 179, 134, 0, 231, 157, 170, 154,
+	212, 0, 0, 0, 153, 279, 0, 271, 137, 138,
+	270, 211, 258, 262, 197, 191, 136, 260, 195, 190,
+	182, 161, 174, 224, 189, 225, 175, 201, 200, 202,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 273, 0, 0,
+	0, 0, 0, 0, 248, 0, 0, 183, 0, 0,
+	0, 0, 0, 234, 217, 0, 0, 222, 232, 187,
+	259, 226, 264, 250, 272, 0, 227, 129, 251, 156,
+	198, 140, 141, 152, 158, 160, 162, 163, 207, 208,
+	220, 239, 252, 253, 254, 155, 148, 233, 149, 172,
+	150, 130, 241, 151, 131, 221, 257, 0, 169, 229,
+	194, 132, 193, 223, 256, 255, 280, 287, 288, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
+	0, 286, 166, 0, 268, 0, 213, 0, 0, 0,
+	0, 0, 0, 0, 209, 285, 0, 0, 0, 0,
+	237, 0, 0, 0, 0, 0, 177, 219, 0, 238,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 0, 245, 266, 278, 269, 0, 0, 0, 277,
+	0, 0, 0, 0, 0, 0, 203, 204, 205, 206,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 171, 0, 173, 145, 218, 168,
+	275, 180, 210, 176, 242, 181, 188, 230, 274, 216,
+	235, 144, 265, 243, 192, 167, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 128, 0, 185, 0, 228, 164, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 120, 121, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	122, 123, 124, 125, 126, 127, 215, 0, 281, 282,
+	283, 284, 267, 0, 0, 0, 159, 0, 0, 0,
+	184, 0, 186, 0, 0, 244, 199, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 0, 0, 1222, 0,
 	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 249,
+	263, 143, 240, 276, 147, 247, 139, 214, 236, 135,
+	261, 246, 196, 178, 179, 134, 0, 231, 157, 170,
+	154, 212, 0, 0, 0, 153, 279, 0, 271, 137,
+	138, 270, 211, 258, 262, 197, 191, 136, 260, 195,
+	190, 182, 161, 174, 224, 189, 225, 175, 201, 200,
+	202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	0, 0, 0, 0, 0, 248, 0, 0, 183, 0,
+	0, 0, 0, 0, 234, 217, 0, 0, 222, 232,
+	187, 259, 226, 264, 250, 272, 0, 227, 129, 251,
+	156, 198, 140, 141, 152, 158, 160, 162, 163, 207,
+	208, 220, 239, 252, 253, 254, 155, 148, 233, 149,
+	172, 150, 130, 241, 151, 131, 221, 257, 0, 169,
+	229, 194, 132, 193, 223, 256, 255, 280, 287, 288,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 1207, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	0, 0, 286, 166, 0, 268, 0, 213, 0, 0,
+	0, 0, 0, 0, 0, 209, 285, 0, 0, 0,
+	0, 237, 0, 0, 0, 0, 0, 177, 219, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 266, 278, 269, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 203, 204, 205,
+	206, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 165, 171, 0, 173, 145, 218,
+	168, 275, 180, 210, 176, 242, 181, 188, 230, 274,
+	216, 235, 144, 265, 243, 192, 167, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 335, 0,
-	0, 336, 0, 0, 0, 138, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 128, 0, 185, 0, 228, 164, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 120, 121, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 122, 123, 124, 125, 126, 127, 215, 0, 281,
+	282, 283, 284, 267, 0, 0, 0, 159, 0, 0,
+	0, 184, 0, 186, 0, 0, 244, 199, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 340, 0, 0, 341,
+	0, 0, 0, 142, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+	249, 263, 143, 240, 276, 147, 247, 139, 214, 236,
+	135, 261, 246, 196, 178, 179, 134, 0, 231, 157,
+	170, 154, 212, 0, 0, 0, 153, 279, 0, 271,
+	137, 138, 270, 211, 258, 262, 197, 191, 136, 260,
+	195, 190, 182, 161, 174, 224, 189, 225, 175, 201,
+	200, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 0, 248, 0, 0, 183,
+	0, 0, 0, 0, 0, 234, 217, 0, 0, 222,
+	232, 187, 259, 226, 264, 250, 272, 0, 227, 129,
+	251, 156, 198, 140, 141, 152, 158, 160, 162, 163,
+	207, 208, 220, 239, 252, 253, 254, 155, 148, 233,
+	149, 172, 150, 130, 241, 151, 131, 221, 257, 0,
+	169, 229, 194, 132, 193, 223, 256, 255, 280, 287,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 166, 0, 268, 0, 213, 0,
+	0, 0, 0, 0, 0, 0, 209, 285, 0, 0,
+	0, 0, 237, 0, 0, 0, 0, 0, 177, 219,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 266, 278, 269, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 203, 204,
+	205, 206, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 171, 0, 173, 145,
+	218, 168, 275, 180, 210, 176, 242, 181, 188, 230,
+	274, 216, 235, 144, 265, 243, 192, 167, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 128, 0, 185, 0, 228, 164,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 120, 121, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 124, 125, 126, 127, 215, 0,
+	281, 282, 283, 284, 267, 0, 0, 0, 159, 0,
+	0, 0, 184, 0, 186, 0, 0, 244, 199, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 87, 0, 0,
+	0, 0, 0, 0, 142, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	133, 249, 263, 143, 240, 276, 147, 247, 139, 214,
+	236, 135, 261, 246, 196, 178, 179, 134, 0, 231,
+	157, 170, 154, 212, 0, 0, 0, 153, 279, 0,
+	271, 137, 138, 270, 211, 258, 262, 197, 191, 136,
+	260, 195, 190, 182, 161, 174, 224, 189, 225, 175,
+	201, 200, 202, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	273, 0, 0, 1167, 0, 0, 0, 248, 0, 0,
+	183, 0, 0, 0, 0, 0, 234, 217, 0, 0,
+	222, 232, 187, 259, 226, 264, 250, 272, 0, 227,
+	129, 251, 156, 198, 140, 141, 152, 158, 160, 162,
+	163, 207, 208, 220, 239, 252, 253, 254, 155, 148,
+	233, 149, 172, 150, 130, 241, 151, 131, 221, 257,
+	0, 169, 229, 194, 132, 193, 223, 256, 255, 280,
+	287, 288, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 166, 0, 268, 0, 213,
+	0, 0, 0, 0, 0, 0, 0, 209, 285, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 177,
+	219, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 266, 278, 269, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 203,
+	204, 205, 206, 0, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 165, 171, 0, 173,
+	145, 218, 168, 275, 180, 210, 176, 242, 181, 188,
+	230, 274, 216, 235, 144, 265, 243, 192, 167, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 0, 0, 0, 0, 128, 0, 185, 0, 228,
+	164, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 120, 121, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 1152, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	117, 118, 119, 122, 123, 124, 125, 126, 127, 215,
+	0, 281, 282, 283, 284, 267, 0, 0, 0, 159,
+	0, 0, 0, 184, 0, 186, 0, 0, 244, 199,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 764, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
+	0, 924, 0, 0, 0, 142, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 282, 283, 0,
+	0, 133, 249, 263, 143, 240, 276, 147, 247, 139,
+	214, 236, 135, 261, 246, 196, 178, 179, 134, 0,
+	231, 157, 170, 154, 212, 0, 0, 0, 153, 279,
+	0, 271, 137, 138, 270, 211, 258, 262, 197, 191,
+	136, 260, 195, 190, 182, 161, 174, 224, 189, 225,
+	175, 201, 200, 202, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 273, 0, 0, 0, 0, 0, 0, 248, 0,
+	0, 183, 0, 0, 0, 0, 0, 234, 217, 0,
+	0, 222, 232, 187, 259, 226, 264, 250, 272, 0,
+	227, 129, 251, 156, 198, 140, 141, 152, 158, 160,
+	162, 163, 207, 208, 220, 239, 252, 253, 254, 155,
+	148, 233, 149, 172, 150, 130, 241, 151, 131, 221,
+	257, 0, 169, 229, 194, 132, 193, 223, 256, 255,
+	280, 287, 288, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 166, 0, 268, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 209, 285,
+	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
+	177, 219, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 266, 278, 963,
+	0, 0, 0, 277, 0, 0, 0, 0, 0, 0,
+	203, 204, 205, 206, 0, 0, 146, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 165, 171, 0,
+	173, 145, 218, 168, 275, 180, 210, 176, 242, 181,
+	188, 230, 274, 216, 235, 144, 265, 243, 192, 167,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 803, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 128, 0, 185, 0,
+	228, 164, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 120, 121, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 122, 123, 124, 125, 126, 127,
+	215, 0, 281, 282, 283, 284, 267, 0, 0, 0,
+	159, 0, 0, 0, 184, 0, 186, 0, 0, 244,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
+	0, 0, 0, 0, 0, 0, 142, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 249, 263, 143, 240, 276, 147, 247,
+	139, 214, 236, 135, 261, 246, 196, 178, 179, 134,
+	0, 231, 157, 170, 154, 212, 0, 0, 0, 153,
+	279, 0, 271, 137, 138, 270, 211, 258, 262, 197,
+	191, 136, 260, 195, 190, 182, 161, 174, 224, 189,
+	225, 175, 201, 200, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 248,
+	0, 0, 183, 0, 0, 0, 0, 0, 234, 217,
+	0, 0, 222, 232, 187, 259, 226, 264, 250, 272,
+	0, 227, 129, 251, 156, 198, 140, 141, 152, 158,
+	160, 162, 163, 207, 208, 220, 239, 252, 253, 254,
+	155, 148, 233, 149, 172, 150, 130, 241, 151, 131,
+	221, 257, 0, 169, 229, 194, 132, 193, 223, 256,
+	255, 280, 287, 288, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 166, 0, 268,
+	0, 213, 0, 0, 0, 0, 0, 0, 0, 209,
+	285, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	0, 177, 219, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 266, 278,
+	269, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 203, 204, 205, 206, 0, 0, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 165, 171,
+	0, 173, 145, 218, 168, 275, 180, 210, 176, 242,
+	181, 188, 230, 274, 216, 235, 144, 265, 243, 192,
+	167, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 421, 0, 128, 0, 185,
+	0, 228, 164, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 120, 121, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 122, 123, 124, 125, 126,
+	127, 215, 0, 281, 282, 283, 284, 267, 0, 0,
+	84, 159, 0, 0, 0, 184, 0, 186, 0, 0,
+	244, 199, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	87, 0, 0, 0, 0, 0, 0, 142, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 133, 249, 263, 143, 240, 276, 147,
+	247, 139, 214, 236, 135, 261, 246, 196, 178, 179,
+	134, 0, 231, 157, 170, 154, 212, 0, 0, 0,
+	153, 279, 0, 271, 137, 138, 270, 211, 258, 262,
+	197, 191, 136, 260, 195, 190, 182, 161, 174, 224,
+	189, 225, 175, 201, 200, 202, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
+	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
+	248, 0, 0, 183, 0, 0, 0, 0, 0, 234,
+	217, 0, 0, 222, 232, 187, 259, 226, 264, 250,
+	272, 0, 227, 129, 251, 156, 198, 140, 141, 152,
+	158, 160, 162, 163, 207, 208, 220, 239, 252, 253,
+	254, 155, 148, 233, 149, 172, 150, 130, 241, 151,
+	131, 221, 257, 0, 169, 229, 194, 132, 193, 223,
+	256, 255, 280, 287, 288, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 166, 0,
+	268, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	209, 285, 0, 0, 0, 0, 237, 0, 0, 0,
+	0, 0, 177, 219, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 266,
+	278, 269, 0, 0, 0, 277, 0, 0, 0, 0,
+	0, 0, 203, 204, 205, 206, 0, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	171, 0, 173, 145, 218, 168, 275, 180, 210, 176,
+	242, 181, 188, 230, 274, 216, 235, 144, 265, 243,
+	192, 167, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 128, 0,
+	185, 0, 228, 164, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 120, 121, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 122, 123, 124, 125,
+	126, 127, 215, 0, 281, 282, 283, 284, 267, 0,
+	0, 0, 159, 0, 0, 0, 184, 0, 186, 0,
+	0, 244, 199, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 87, 0, 0, 0, 0, 0, 0, 142, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 413, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 133, 249, 263, 143, 240, 276,
+	147, 247, 139, 214, 236, 135, 261, 246, 196, 178,
+	179, 134, 0, 231, 157, 170, 154, 212, 0, 0,
+	0, 153, 279, 0, 271, 137, 138, 270, 211, 258,
+	262, 197, 191, 136, 260, 195, 190, 182, 161, 174,
+	224, 189, 225, 175, 201, 200, 202, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 273, 0, 0, 0, 0, 0,
+	0, 248, 0, 0, 183, 0, 0, 0, 0, 0,
+	234, 217, 0, 0, 222, 232, 187, 259, 226, 264,
+	250, 272, 0, 227, 129, 251, 156, 198, 140, 141,
+	152, 158, 160, 162, 163, 207, 208, 220, 239, 252,
+	253, 254, 155, 148, 233, 149, 172, 150, 130, 241,
+	151, 131, 221, 257, 0, 169, 229, 194, 132, 193,
+	223, 256, 255, 280, 287, 288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 166,
+	0, 268, 0, 213, 0, 0, 0, 0, 0, 0,
+	0, 209, 285, 0, 0, 0, 0, 237, 0, 0,
+	0, 0, 0, 177, 219, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	266, 278, 269, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 203, 204, 205, 206, 0, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	165, 171, 0, 173, 145, 218, 168, 275, 180, 210,
+	176, 242, 181, 188, 230, 274, 216, 235, 144, 265,
+	243, 192, 167, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	0, 185, 0, 228, 164, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 120, 121,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	82, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
+	113, 114, 115, 116, 117, 118, 119, 122, 123, 124,
+	125, 126, 127, 0, 0, 281, 282, 283, 284, 267,
+	215, 0, 0, 0, 0, 471, 0, 0, 0, 0,
+	159, 0, 0, 0, 184, 0, 186, 0, 0, 244,
+	199, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 476,
+	477, 478, 473, 0, 0, 0, 142, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 249, 263, 143, 240, 276, 147, 247,
+	139, 214, 236, 135, 261, 246, 196, 178, 179, 134,
+	0, 231, 157, 170, 154, 212, 0, 0, 0, 153,
+	279, 0, 271, 137, 138, 270, 211, 258, 262, 197,
+	191, 136, 260, 195, 190, 182, 161, 174, 224, 189,
+	225, 175, 201, 200, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 273, 0, 0, 0, 0, 0, 0, 248,
+	0, 0, 183, 0, 0, 0, 0, 0, 234, 217,
+	0, 0, 222, 232, 187, 259, 226, 264, 250, 272,
+	0, 227, 129, 251, 156, 198, 140, 141, 152, 158,
+	160, 162, 163, 207, 208, 220, 239, 252, 253, 254,
+	155, 148, 233, 149, 172, 150, 130, 241, 151, 131,
+	221, 257, 0, 169, 229, 194, 132, 193, 223, 256,
+	255, 280, 287, 288, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 166, 0, 268,
+	0, 213, 0, 0, 0, 0, 0, 0, 0, 209,
+	285, 0, 0, 0, 0, 237, 0, 0, 0, 0,
+	0, 177, 219, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 266, 278,
+	269, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 203, 204, 205, 206, 0, 0, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 165, 171,
+	0, 173, 145, 218, 168, 275, 180, 210, 176, 242,
+	181, 188, 230, 274, 216, 235, 144, 265, 243, 192,
+	167, 0, 0, 0, 215, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 184, 0,
+	186, 0, 0, 244, 199, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 0, 185,
+	0, 228, 164, 476, 477, 478, 473, 0, 0, 0,
+	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 281, 282, 283, 284, 267, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 133, 249, 263, 143,
+	240, 276, 147, 247, 139, 214, 236, 135, 261, 246,
+	196, 178, 179, 134, 0, 231, 157, 170, 154, 212,
+	0, 0, 0, 153, 279, 0, 271, 137, 138, 270,
+	211, 258, 262, 197, 191, 136, 260, 195, 190, 182,
+	161, 174, 224, 189, 225, 175, 201, 200, 202, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 273, 0, 0, 0,
+	0, 0, 0, 248, 0, 0, 183, 0, 0, 0,
+	0, 0, 234, 217, 0, 0, 222, 232, 187, 259,
+	226, 264, 250, 272, 0, 227, 129, 251, 156, 198,
+	140, 141, 152, 158, 160, 162, 163, 207, 208, 220,
+	239, 252, 253, 254, 155, 148, 233, 149, 172, 150,
+	130, 241, 151, 131, 221, 257, 0, 169, 229, 194,
+	132, 193, 223, 256, 255, 280, 287, 288, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 166, 0, 268, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 209, 285, 0, 0, 0, 0, 237,
+	0, 0, 0, 0, 0, 177, 219, 0, 238, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
+	0, 245, 266, 278, 269, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 203, 204, 205, 206, 0,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 165, 171, 0, 173, 145, 218, 168, 275,
+	180, 210, 176, 242, 181, 188, 230, 274, 216, 235,
+	144, 265, 243, 192, 167, 0, 0, 0, 215, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 184, 0, 186, 0, 0, 244, 199, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
+	0, 128, 0, 185, 0, 228, 164, 476, 477, 478,
+	0, 0, 0, 0, 142, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 281, 282, 283,
+	284, 267, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 0, 211, 277,
-	278, 279, 263, 463, 0, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 468, 469, 470,
-	465, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 0,
-	0, 211, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	468, 469, 470, 465, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 211, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 468, 469, 470, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 282, 283, 1766, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 162, 0, 264, 0, 209, 0, 0, 0, 1766,
-	0, 0, 1164, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 1164, 0, 0, 0, 2167, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 1748, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 1836,
-	0, 142, 0, 0, 0, 0, 0, 0, 1748, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1766, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1164, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1752, 0, 277, 278, 279, 263, 0, 0,
-	1748, 0, 0, 1756, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1752, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1745, 1756, 0, 0, 1747, 1749, 1751,
-	0, 1753, 1754, 1755, 1757, 1758, 1759, 1761, 1762, 1763,
-	1764, 0, 0, 0, 1745, 0, 0, 0, 1747, 1749,
-	1751, 0, 1753, 1754, 1755, 1757, 1758, 1759, 1761, 1762,
-	1763, 1764, 0, 0, 0, 1767, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1767, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1765,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1744, 0, 0, 0,
-	1765, 0, 0, 0, 0, 1752, 0, 0, 0, 0,
-	0, 1760, 0, 0, 0, 0, 1756, 1744, 1750, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1760, 0, 0, 0, 1745, 0, 0, 1750,
-	1747, 1749, 1751, 0, 1753, 1754, 1755, 1757, 1758, 1759,
-	1761, 1762, 1763, 1764, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1767, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1765, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1744,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1760, 0, 0, 0, 0, 0,
-	0, 1750,
+	133, 249, 263, 143, 240, 276, 147, 247, 139, 214,
+	236, 135, 261, 246, 196, 178, 179, 134, 0, 231,
+	157, 170, 154, 212, 0, 0, 0, 153, 279, 0,
+	271, 137, 138, 270, 211, 258, 262, 197, 191, 136,
+	260, 195, 190, 182, 161, 174, 224, 189, 225, 175,
+	201, 200, 202, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	273, 0, 0, 0, 0, 0, 0, 248, 0, 0,
+	183, 0, 0, 0, 0, 0, 234, 217, 0, 0,
+	222, 232, 187, 259, 226, 264, 250, 272, 0, 227,
+	129, 251, 156, 198, 140, 141, 152, 158, 160, 162,
+	163, 207, 208, 220, 239, 252, 253, 254, 155, 148,
+	233, 149, 172, 150, 130, 241, 151, 131, 221, 257,
+	0, 169, 229, 194, 132, 193, 223, 256, 255, 280,
+	287, 288, 1789, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 166, 0, 268, 0, 213,
+	0, 0, 0, 0, 0, 0, 1179, 209, 285, 0,
+	0, 0, 0, 237, 0, 0, 0, 0, 0, 177,
+	219, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 266, 278, 269, 0,
+	0, 1771, 277, 0, 0, 0, 0, 0, 0, 203,
+	204, 205, 206, 0, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 165, 171, 0, 173,
+	145, 218, 168, 275, 180, 210, 176, 242, 181, 188,
+	230, 274, 216, 235, 144, 265, 243, 192, 167, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 128, 0, 185, 0, 228,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1775, 0, 0, 0,
+	0, 281, 282, 283, 284, 267, 0, 1779, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1768, 0, 0,
+	0, 1770, 1772, 1774, 0, 1776, 1777, 1778, 1780, 1781,
+	1782, 1784, 1785, 1786, 1787, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1790,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1788, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1767, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1783, 0, 0, 0, 0,
+	0, 0, 1773,
 }
 
 var yyPact = [...]int{
-	160, -1000, -299, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	202, -1000, -299, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 17783, 1770, -1000, 7914, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 247, 14787,
-	18211, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7468, 7022,
-	118, -1000, 1765, -1000, -1000, -1000, -1000, 129, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 320, -40, 338, 343,
-	573, 573, 8770, 1765, 1386, 169, 32, -1000, 17355, 668,
-	160, 175, 18211, -1000, 383, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 18363, 1811, -1000, 8417, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 245,
+	15346, 18794, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7967,
+	7517, 144, -157, -1000, 1803, -1000, -1000, -1000, -1000, 171,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 315, -35,
+	348, 354, 573, 573, 9279, 1803, 1496, 196, 41, -1000,
+	17932, 672, 202, 198, 18794, -1000, 425, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3114,74 +3165,51 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 14787, 18211, -75, 509, -1000, 207,
-	192, 188, 371, -1000, -1000, -1000, -1000, 18211, 1647, -1000,
-	-1000, -1000, 1717, 18640, 169, -1000, 1205, 1300, -1000, -1000,
-	1549, -1000, 97, -5, -20, 101, -1000, -1000, 146, -1000,
-	-1000, -1000, -1000, -1000, 49, -1000, -6, -1000, -4, -1000,
-	-1000, -1000, -115, -1000, -1000, -1000, -1000, -1000, 1203, 377,
-	1571, -183, 1660, 1728, 1386, 1755, 1731, -10, 206, 206,
-	242, 206, -1000, -1000, -1000, -1000, -1000, -1000, 553, 178,
-	-1000, -1000, -123, -139, 415, -139, -11, -1000, -1000, -1000,
-	-1000, -1000, -1000, 208, -1000, -195, -1000, 326, -1000, 315,
-	-1000, 10501, 142, 1343, 524, -1000, 562, 562, 18211, 18211,
-	18211, 562, 767, 720, 369, -1000, -1000, -1000, 1643, 1644,
-	1728, 1386, -1000, 1765, 1765, 1186, 1130, 208, 208, 208,
-	208, 208, 1336, 18211, -1000, 1427, 5262, 5262, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 177, 1547, -1000, 18211,
-	1600, -1000, 367, 808, 968, -1000, -1000, 207, 1329, -1000,
-	577, -1000, -1000, -1000, -1000, 18211, 1545, 18211, 14787, 14787,
-	14787, 14787, -1000, 1597, 1596, -1000, 1622, 1594, 1673, 18211,
-	-1000, -1000, -1000, 18993, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1178, 1765, 114, 1567, 13931, 16071, 18211, 13931, -1000,
-	-1000, -1000, -1000, -1000, -127, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 114, 13931, 13931, -84, -1000,
-	-1000, -287, 1660, 5700, -1000, -1000, 5700, -1000, -1000, 226,
-	206, -1000, 13931, 605, 16071, 885, 18211, 18211, -1000, -1000,
-	415, 415, -1000, 553, 553, -1000, -1000, -134, 1762, 6576,
-	-121, 18211, 206, 16927, 1696, -158, 336, 327, 330, -1000,
-	-1000, -186, -1000, -1000, 1325, 11363, 9627, 211, 13931, 3504,
-	-1000, -1000, 3504, 562, 562, 562, 3504, 354, -1000, -1000,
-	-1000, -1000, -1000, -1000, 18211, -1000, -1000, 1660, -1000, -1000,
-	-1000, 1728, 1660, 1728, -1000, -1000, 13931, 16071, 18211, 18211,
-	19346, 18211, 1336, 1716, 18211, 1251, -1000, -1000, 9199, 366,
-	5700, 1031, 1543, -1000, -1000, 1538, 1537, 1536, 1535, 1531,
-	1520, 1517, -1000, 1474, -1000, -1000, 1515, 1511, 1509, 1508,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1504, -1000, -1000, -1000,
-	1503, 1474, -1000, -1000, 1501, 1499, 1497, 1490, 1488, -1000,
-	-1000, -1000, -1000, 1060, -1000, -1000, -1000, -1000, 3066, 6576,
-	6576, 6576, 6576, -1000, -1000, 1443, 5700, 1486, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15346,
+	18794, -77, 563, -1000, 167, 160, 195, 418, -1000, -1000,
+	-1000, -1000, 18794, 1617, -1000, -1000, -1000, 1730, 19232, 196,
+	-1000, 1323, 1434, -1000, -1000, 1620, -1000, 97, 4, -20,
+	122, -1000, -1000, 170, -1000, -1000, -1000, -1000, -1000, 47,
+	-1000, -4, -1000, -11, -1000, -1000, -1000, -121, -1000, -1000,
+	-1000, -1000, -1000, 1286, 372, 1635, -164, 944, -1000, -1000,
+	1714, 1744, 1496, 1777, 1738, 3, 217, 217, 239, 217,
+	-1000, -1000, -1000, -1000, -1000, -1000, 565, 176, -1000, -1000,
+	-132, -140, 504, -140, 6, -1000, -1000, -1000, -1000, -1000,
+	-1000, 224, -1000, -188, -1000, 336, -1000, 314, -1000, 11029,
+	168, 1430, 689, -1000, 477, 477, 18794, 18794, 18794, 477,
+	870, 777, 404, -1000, -1000, -1000, 1686, 1687, 1744, 1496,
+	-1000, 1803, 1803, 1264, 1132, 224, 224, 224, 224, 224,
+	1420, 18794, -1000, 1500, 5744, 5744, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 192, 1616, -1000, 2228, 1525, -1000,
+	402, 934, 1064, -1000, -1000, 167, 1443, -1000, 535, -1000,
+	-1000, -1000, -1000, 18794, 1615, 18794, 15346, 15346, 15346, 15346,
+	-1000, 1666, 1661, -1000, 1657, 1653, 1668, 18794, -1000, -1000,
+	-1000, 19586, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1258,
+	1803, 113, 8048, 14484, 16639, 18794, 14484, -1000, -1000, -1000,
+	-1000, -1000, -130, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 113, 14484, 14484, -83, -1000, -1000, -1000,
+	-288, 1714, 6185, -1000, -1000, 6185, -1000, -1000, 234, 217,
+	-1000, 14484, 606, 16639, 1069, 18794, 18794, -1000, -1000, 504,
+	504, -1000, 565, 565, -1000, -1000, -136, 1801, 7067, -116,
+	18794, 217, 17501, 1724, -156, 349, 326, 341, -1000, -1000,
+	-172, -1000, -1000, 1365, 11898, 10148, 220, 14484, 3973, -1000,
+	-1000, 3973, 477, 477, 477, 3973, 436, -1000, -1000, -1000,
+	-1000, -1000, -1000, 18794, -1000, -1000, 1714, -1000, -1000, -1000,
+	1744, 1714, 1744, -1000, -1000, 14484, 16639, 18794, 18794, 19940,
+	18794, 1420, 1729, 18794, 1377, -1000, -1000, 9717, 401, 6185,
+	912, 1614, -1000, -1000, 1613, 1612, 1603, 1601, 1595, 1594,
+	1591, -1000, 1535, -1000, -1000, 1590, 1587, 1584, 1560, -1000,
+	1559, -1000, -1000, -1000, -1000, 1557, -1000, -1000, -1000, 1556,
+	1535, -1000, 1555, 1554, -1000, 1552, 1545, 1544, 1543, 1542,
+	-1000, -1000, -1000, -1000, 1824, -1000, -1000, -1000, -1000, 3532,
+	7067, 7067, 7067, 7067, -1000, -1000, 1507, 6185, 1541, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 10929, -1000, 1484, 1478, 1477, 1476, 1474, 1473,
-	961, 955, 1466, 1465, 1464, 6576, 954, 1459, -1000, -1000,
+	-1000, -1000, -1000, 11460, -1000, 1540, 1539, 1537, 1536, 1535,
+	1533, 1062, 1043, 1531, 1529, 1528, 7067, 1039, 1527, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1251, -1000, -285, -1000, 10067, 18211, 18211, -1000, 1732, 5700,
-	2186, -1000, 1722, -1000, 207, 70, -1000, -1000, -1000, -1000,
-	-1000, -1000, 364, 18211, 1290, -1000, 502, 1568, 1566, 1568,
-	-1000, -1000, -1000, -1000, 1595, -1000, 1587, -1000, -1000, 1427,
-	-1000, -1000, 597, -1000, -1000, -1000, -1000, -1000, -6, -4,
-	1288, -1000, -42, 92, -1000, -1000, 1327, -1000, -1000, -1000,
-	597, 1288, 224, 953, 952, -1000, 1100, 363, 1335, -1000,
-	797, 16499, 18211, 227, 1695, 1325, 1471, 1646, 1762, 1762,
-	1762, 415, 19346, 553, 18211, 553, -1000, -1000, 553, -1000,
-	362, 18211, 227, 1458, -1000, -1000, -1000, 334, 314, 311,
-	16071, 222, -1000, -1000, 1325, -1000, -1000, -1000, 1451, 501,
-	-1000, -1000, 6576, -1000, 714, -1000, -1000, 3504, 3504, 3504,
-	-1000, 12647, -1000, -1000, 1660, -1000, 1660, 1288, 1325, 1562,
-	1334, -1000, -1000, -1000, -1000, -1000, 1449, 1322, -1000, 1762,
-	5262, -1000, 14787, -1000, 5700, 5700, 5700, -1000, 15643, -1000,
-	15215, -1000, 240, 6138, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5700, 1723, 1723, 1723, 5700, 635, 5700, 5700, -1000,
-	665, 7020, 1723, 1723, 1723, 1723, 1723, -1000, 2620, 1723,
-	1723, 1723, 1723, 6576, 6576, 6576, 6576, 6576, 6576, 6576,
-	6576, 6576, 6576, 6576, 6576, 1436, 769, 6576, 6576, 6576,
-	1130, 1554, 1332, -1000, -1000, -1000, -1000, -1000, 574, 714,
-	5700, -1000, 7020, 7020, 807, 5700, 5700, 5700, -1000, 1161,
-	-1000, -1000, 5700, -1000, -1000, 5700, 6576, 5700, -1000, -1000,
-	1723, 1762, 1259, -1000, 1448, -1000, 1319, 1618, -1000, 361,
-	1331, -1000, 494, 1310, -1000, 1728, 714, -1000, 356, -1000,
+	-1000, 1377, -1000, -286, -1000, 10591, 18794, 18794, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3196,155 +3224,183 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1794, 6185,
+	2679, -1000, 1741, -1000, 167, 82, -1000, -1000, -1000, -1000,
+	-1000, -1000, 397, 18794, 1306, -1000, 561, 1624, 1634, 1624,
+	-1000, -1000, -1000, -1000, 1605, -1000, 1538, -1000, -1000, 1500,
+	-1000, -1000, 571, -1000, -1000, -1000, -1000, -1000, -4, -11,
+	1310, -1000, -42, 96, -1000, -1000, 1436, -1000, -1000, -1000,
+	571, 1310, 232, 1035, 1033, -1000, 992, 394, 1403, -1000,
+	798, 17070, 18794, 210, 1720, 1365, 1625, 1689, 1801, 1801,
+	1801, 504, 19940, 565, 18794, 565, -1000, -1000, 565, -1000,
+	389, 18794, 210, 1526, -1000, -1000, -1000, 343, 311, 333,
+	16639, 230, -1000, -1000, 1365, -1000, -1000, -1000, 1524, 558,
+	-1000, -1000, 7067, -1000, 835, -1000, -1000, 3973, 3973, 3973,
+	-1000, 13191, -1000, -1000, 1714, -1000, 1714, 1310, 1365, 1633,
+	1397, -1000, -1000, -1000, -1000, -1000, 1523, 1432, -1000, 1801,
+	5744, -1000, 15346, -1000, 6185, 6185, 6185, -1000, 16208, -1000,
+	15777, -1000, 237, 6626, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 6185, 1735, 1735, 1735, 6185, 746, 6185, 6185, -1000,
+	739, 7515, 1735, 1735, 1735, 7067, 1735, 1735, -1000, 51,
+	51, 3082, 1735, 1735, 1735, 1735, 7067, 7067, 7067, 7067,
+	7067, 7067, 7067, 7067, 7067, 7067, 7067, 7067, 1506, 749,
+	7067, 7067, 7067, 1132, 1404, 1384, -1000, -1000, -1000, -1000,
+	-1000, 546, 835, 6185, -1000, 7515, 7515, 932, 6185, 6185,
+	6185, -1000, 1250, -1000, -1000, 6185, -1000, -1000, 6185, 7067,
+	6185, -1000, -1000, 1735, 1801, 1298, -1000, 1520, -1000, 1422,
+	1681, -1000, 384, 1381, -1000, 553, 1416, -1000, 1744, 835,
+	-1000, 380, -1000, -1000, -1000, -1000, -1000, -80, -1000, -1000,
+	18794, 1408, 1794, 18794, 6185, -1000, -1000, 6185, 1511, -1000,
+	6185, -1000, -1000, -1000, -1000, 1810, 373, 371, 14484, -1000,
+	164, 14484, -1000, -1000, 18794, 229, 14484, -76, -76, 6185,
+	6185, 18794, 6185, -1000, -1000, -1000, 1500, 598, 1510, -224,
+	-1000, -48, -1000, 1632, 88, -1000, 1689, -1000, 548, -1000,
+	-1000, -1000, -1000, 1801, -1000, 504, -1000, 504, 565, 18794,
+	-1000, -1000, -224, 1248, -1000, -1000, -1000, 309, 1365, 14484,
+	1017, 220, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 18794,
+	18794, 202, -1000, 18794, 1799, -1000, 1362, 1592, -1000, 576,
+	619, -1000, -1000, 363, -1000, -1000, 243, -1000, -1000, -1000,
+	-1000, -1000, 1507, -1000, -1000, -1000, 1227, 1292, 835, 6185,
+	-1000, -1000, 6185, 6185, 916, 6185, 1219, 1394, 1373, -1000,
+	1217, -1000, 1808, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -81, -1000, -1000, 18211, 1297, 1732, 18211, 5700,
-	-1000, -1000, 5700, 1446, -1000, 5700, -1000, -1000, -1000, -1000,
-	1768, 355, 353, 13931, -1000, 155, 13931, -1000, -1000, 18211,
-	209, 13931, -12, -143, 5700, 5700, 18211, 5700, -1000, -1000,
-	-1000, 1427, 584, 1444, -233, -1000, -57, -1000, 1558, 108,
-	-1000, 1646, -1000, 535, -1000, -1000, -1000, -1000, 1762, -1000,
-	415, -1000, 415, 553, 18211, -1000, -1000, -233, 1151, -1000,
-	-1000, -1000, 312, 1325, 13931, 888, 211, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 18211, 18211, 160, -1000, 18211, 1760,
-	-1000, 1302, 1611, -1000, 589, 540, -1000, -1000, 352, -1000,
-	-1000, 350, -1000, -1000, -1000, -1000, -1000, 1443, -1000, -1000,
-	-1000, 1138, 1236, 714, 5700, -1000, -1000, 5700, 5700, 761,
-	5700, 1118, 1292, 1286, -1000, 1094, -1000, 1767, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 6185, 6185, 6185, 2513, 6185, 6185, 1357, 1345,
+	1022, 5303, -1000, -1000, -1000, 6185, 6185, 6185, 6185, 641,
+	1514, -1000, 826, 826, 440, 440, 440, 440, 440, 813,
+	813, -1000, -1000, -1000, 3532, 1506, 7067, 7067, 7067, 197,
+	2962, 1867, -1000, 6185, 631, -1000, 6185, 976, -1000, -1000,
+	7515, 1215, 971, 1213, 1211, -1000, 1087, 1201, 1670, 1197,
+	6185, 1799, -286, 4855, 194, 18794, -286, 18794, 18794, 4855,
+	-1000, 18794, -1000, 2679, 926, -1000, -1000, 1744, -1000, 835,
+	835, 18794, 835, 14484, 370, 542, -1000, 12760, 14484, -1000,
+	-1000, 14484, 128, -3, 1032, -145, 835, 835, 362, -1000,
+	1727, 1697, 8848, -1000, -61, -1000, -1000, -1000, 365, -1000,
+	1024, 1023, 1021, 1020, 18794, -1000, -1000, -1000, -1000, -1000,
+	544, 544, 544, 1686, -1000, 1801, 1801, 504, -1000, -12,
+	-53, -1000, 1310, 1194, -1000, -1000, -1000, -1000, 1190, -1000,
+	1796, 1776, 15346, 14915, -1000, -1000, -1000, -1000, -1000, 6185,
+	1401, 1390, 1387, 616, 1371, -1000, -1000, -1000, -1000, 6185,
+	1375, 1355, 1330, 6185, 1326, 1301, 6185, 6185, -1000, 6185,
+	6185, 779, 1280, 1276, 1247, 1232, 1359, -1000, 197, 2962,
+	762, -1000, 7067, 7067, 1229, 536, -1000, 6185, 825, 616,
+	665, -1000, -1000, 6185, -1000, -1000, -1000, 665, -1000, 7067,
+	-1000, 1221, 1796, -1000, 1187, 1338, -1000, -286, -1000, -1000,
+	1298, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1347, 1310, -1000, -1000, -1000, -1000, 14484, 1731, 210,
+	-1000, -2, 1713, -1000, -1000, -1000, -105, -96, 18794, 196,
+	18794, 1176, 1308, -1000, -1000, -1000, 652, 581, -1000, 18794,
+	653, 358, 217, 358, 644, 1504, -1000, -1000, -61, -1000,
+	922, 920, 910, 905, -39, -1000, -1000, -1000, -1000, -1000,
+	1503, 665, -1000, 781, 1019, -1000, -1000, 1801, -1000, -12,
+	-1000, 273, 282, 25, 1775, -1000, -1000, -1000, 6185, 6185,
+	1592, -1000, -1000, 835, -1000, -1000, -1000, 1157, -1000, 1470,
+	1499, -1000, 1470, 1470, 1470, 301, 301, 1501, 1501, 1502,
+	1501, -1000, 1218, -1000, -1000, -1000, 1214, -1000, -1000, 899,
+	885, 1186, 1181, 6185, -1000, -1000, -1000, -1000, -1000, -1000,
+	7067, -1000, -1000, -1000, -1000, 835, 6185, 1149, 1139, 937,
+	1133, 3365, -1000, -1000, -1000, 4855, 1298, -1000, -1000, 14484,
+	14484, -229, -5, 241, -290, -85, 1774, 1773, -1000, 1500,
+	20197, 8848, 831, -28, -1000, -1000, -1000, 1470, -1000, 1499,
+	1499, 1470, 1470, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1498, 1495, -1000, 1470, 1494, 1494, 1470, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5700, 5700, 5700,
-	5700, 5700, 977, 4824, -1000, -1000, -1000, 5700, 5700, 5700,
-	5700, 1357, 848, -1000, 991, 991, 394, 394, 394, 394,
-	394, 875, 875, -1000, -1000, -1000, 3066, 1436, 6576, 6576,
-	6576, 193, 1626, 1893, -1000, 5700, 598, -1000, 5700, 676,
-	-1000, -1000, 7020, 1092, 1122, 1090, 1088, -1000, 979, 1076,
-	1177, 1061, 5700, 1760, -285, 4380, 186, 18211, -285, 18211,
-	18211, 4380, -1000, 18211, -1000, 2186, 798, -1000, -1000, 1728,
-	-1000, 714, 714, 18211, 714, 13931, 418, 539, -1000, 12219,
-	13931, -1000, -1000, 13931, 109, 1653, -1000, -1000, -99, -91,
-	714, 714, 351, -1000, 1701, 1691, 8342, -1000, -73, -1000,
-	-1000, -1000, 217, -1000, 946, 933, 917, 916, 18211, -1000,
-	-1000, -1000, -1000, -1000, 469, 469, 469, 1643, -1000, 1762,
-	1762, 415, -1000, -8, -45, -1000, 1288, 1059, -1000, -1000,
-	-1000, -1000, 1048, -1000, 1758, 1753, 14787, 14359, -1000, -1000,
-	-1000, -1000, -1000, 5700, 1546, 1516, 1500, 239, 1280, -1000,
-	-1000, -1000, -1000, 5700, 1481, 1469, 1455, 1437, 1429, -1000,
-	5700, 5700, 1032, 1416, 1374, 1368, 1365, 1277, -1000, 193,
-	1626, 1841, -1000, 6576, 6576, 1355, 528, -1000, 5700, 892,
-	239, 728, -1000, -1000, 5700, -1000, -1000, -1000, 728, -1000,
-	6576, -1000, 1260, 1758, -1000, 1030, 1294, -1000, -285, -1000,
-	-1000, 1259, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1271, 1288, -1000, -1000, -1000, -1000, 13931, 1702,
-	227, -1000, -3, 245, -289, -86, 1751, 1750, 18211, 169,
-	18211, 1028, 1274, -1000, -1000, -1000, 932, 554, -1000, 18211,
-	618, 346, 206, 346, 614, 1434, -1000, -1000, -73, -1000,
-	795, 793, 790, 785, -48, -1000, -1000, -1000, -1000, -1000,
-	1433, 728, -1000, 741, 915, -1000, -1000, 1762, -1000, -8,
-	-1000, 274, 278, 29, 1749, -1000, -1000, -1000, 5700, 5700,
-	1611, -1000, -1000, 714, -1000, -1000, -1000, 1020, -1000, 1388,
-	1426, -1000, 1388, 1388, 1388, 303, 303, 1428, 1428, 1432,
-	1428, -1000, 1245, -1000, -1000, -1000, -1000, -1000, 1242, 1192,
-	5700, -1000, -1000, -1000, -1000, -1000, -1000, 6576, -1000, -1000,
-	-1000, -1000, 714, 5700, 1014, 1005, 918, 1003, 1601, -1000,
-	-1000, -1000, 4380, 1259, -1000, -1000, 13931, 13931, -234, -7,
-	18211, -291, 905, -1000, 1748, 904, 673, -1000, 1427, 19736,
-	8342, 960, -28, -1000, -1000, -1000, 1388, -1000, 1426, 1426,
-	1388, 1388, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1425, 1423, -1000, 1388, 1422, 1422, 1388, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 18794, 18794, -1000, 18794,
+	18794, 217, 6185, -1000, -1000, -1000, -1000, -1000, -1000, 14053,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 18211, 18211, -1000, 18211, 18211,
-	206, 5700, -1000, -1000, -1000, -1000, -1000, -1000, 13503, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 784,
-	-1000, -1000, -1000, 888, 714, 1236, -1000, -1000, -1000, 780,
-	-1000, 772, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	768, -1000, -1000, 766, -1000, -1000, -1000, -1000, 1189, -1000,
-	714, -1000, -1000, -1000, 5700, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -121, -293, 765, -1000, 879, -89, -1000, -1000,
-	1700, 173, 19624, -1000, 469, 469, 586, 469, 469, 469,
-	469, 112, 104, 469, 469, 469, 469, 469, 469, 469,
-	469, 469, 469, 469, 469, 469, 469, 1421, -1000, -1000,
-	960, -1000, -1000, 627, 6576, -1000, -1000, 876, 741, 400,
-	405, 1420, -1000, 79, 601, 581, -1000, 18211, -1000, -38,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 874, 874, -1000,
-	-1000, 763, -1000, -1000, 1402, 1431, 50, 1401, -1000, 1396,
-	1395, 18211, 1104, 1253, -1000, 1388, 5700, 23, -1000, -1000,
-	993, 986, 1240, 1238, -1000, 1073, -100, -103, -1000, 1385,
-	-1000, -1000, 1747, 169, -1000, 1745, 19736, -1000, 755, 754,
-	469, 469, 749, 873, 872, 863, 469, 469, 747, 844,
-	18993, 743, 740, 738, 732, 843, 395, 698, 679, 671,
-	18211, 1384, 824, -1000, -1000, 1626, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 695, 1383, -1000,
-	-1000, 1382, -1000, -1000, 1220, -1000, 1210, 984, 13503, 68,
-	68, 13503, 13503, 13503, 1378, 241, -1000, 13503, 1672, 967,
-	-1000, -1000, -1000, -1000, 686, -1000, 685, -1000, 214, -97,
-	-103, -1000, 1744, -92, 1743, 1741, 18211, 673, -1000, 96,
-	-1000, -1000, -1000, 728, 728, -1000, -1000, -1000, -1000, 841,
-	827, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	902, -1000, -1000, -1000, 1017, 835, 1292, -1000, -1000, -1000,
+	901, -1000, 896, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 895, -1000, -1000, 894, -1000, -1000, -1000, 6185, 6185,
+	-1000, -1000, 1156, -1000, 835, -1000, -1000, -1000, 6185, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 18794, -292, 1016, -1000,
+	1772, 1013, 840, 1726, 186, 1765, -1000, 544, 544, 568,
+	544, 544, 544, 544, 140, 138, 544, 544, 544, 544,
+	544, 544, 544, 544, 544, 544, 544, 544, 544, 544,
+	1493, -1000, -1000, 831, -1000, -1000, 659, 7067, -1000, -1000,
+	1000, 781, 403, 347, 1486, -1000, 91, 638, 634, -1000,
+	18794, -1000, -31, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	999, 999, -1000, -1000, 893, -1000, -1000, 1485, 1619, 60,
+	1480, -1000, 1475, 1473, 18794, 1140, 1342, -1000, 1470, 6185,
+	20, -1000, -1000, 1121, 1111, 1336, 1321, 1100, 1094, -1000,
+	1090, -116, -294, 892, -1000, 993, -95, -1000, -1000, 196,
+	-1000, 1768, 20197, -1000, 888, 887, 544, 544, 884, 986,
+	982, 977, 544, 544, 879, 970, 19586, 845, 833, 817,
+	919, 969, 501, 880, 731, 709, 18794, 1464, 958, -1000,
+	-1000, 2962, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 796, 1463, -1000, -1000, 1462, -1000, -1000,
+	1295, -1000, 1284, 1105, 14053, 94, 94, 14053, 14053, 14053,
+	1461, 261, -1000, 14053, 1694, 1085, -1000, -1000, -1000, -1000,
+	789, -1000, 784, -1000, -1000, -1000, -112, -78, -296, 1459,
+	-1000, -1000, 1767, -1000, 93, -1000, -1000, -1000, 665, 665,
+	-1000, -1000, -1000, -1000, 966, 964, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 125, 18211, 1197, -1000, 471, 976, 5700,
-	-227, 13503, -1000, 825, -1000, -1000, 1195, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1184, 1159, 1156, 13503, -1000, -1000,
-	-1000, 74, 103, -1000, -1000, 1672, 973, 971, 1372, 682,
-	-86, 1739, -1000, 673, 1738, 673, 673, 1150, -1000, -1000,
-	61, 170, 145, -1000, 238, -1000, -1000, -1000, -1000, -1000,
-	-1000, 131, 1142, -1000, 824, 822, -1000, 939, 1555, -1000,
-	-15, 1137, -1000, -1000, -1000, -1000, -1000, 1134, -1000, -1000,
-	469, 774, 43, -1000, -1000, -1000, -1000, -1000, 1634, 11791,
-	-102, -1000, 746, -1000, 673, -1000, -1000, -1000, 18211, 57,
-	681, 6576, 1364, 6576, 1358, 69, 1352, -1000, -1000, -1000,
-	-1000, -1000, 241, -1000, -1000, 1553, 1519, 1766, -1000, -1000,
-	-1000, -1000, 103, 103, 103, 103, -9, 648, -1000, 885,
-	-1000, 18211, -1000, 1132, -1000, -1000, -1000, 348, -1000, -1000,
-	-1000, -1000, 1349, 1737, -1000, 1117, 18211, 787, 18211, 1347,
-	453, 6576, -1000, -1000, 1775, -1000, 1773, 434, 434, -1000,
-	-1000, -1000, 1191, -1000, 440, -1000, 13075, 18211, -1000, 162,
-	63, -1000, 1121, -1000, 1083, 18211, 644, 664, -1000, -1000,
-	-1000, 669, 83, -1000, 18211, 3942, -1000, 347, 1075, -1000,
-	963, 55, -1000, -1000, 1058, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 714, 18211, -1000, 162, 1376, -1000, 641, -1000,
-	-1000, -1000, 19603, 157, -1000, -1000, 19603, 51, -1000, 153,
-	-1000, -1000, 998, -1000, 942, 1346, -1000, 51, 19736, 5700,
-	-1000, 19736, 983, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 151, 18794,
+	1267, -1000, 545, 1098, 6185, -219, 14053, -1000, 960, -1000,
+	-1000, 1225, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1207,
+	1200, 1193, 14053, -1000, -1000, -1000, 81, 117, -1000, -1000,
+	1694, 1095, 1084, 227, -103, -78, -1000, 1753, -99, 1751,
+	1750, 146, 778, 18794, 840, 65, 169, 165, -1000, 272,
+	-1000, -1000, -1000, -1000, -1000, -1000, 156, 1183, -1000, 958,
+	954, -1000, 1080, 1629, -1000, -13, 1175, -1000, -1000, -1000,
+	-1000, -1000, 1173, -1000, -1000, 544, 952, 42, -1000, -1000,
+	-1000, -1000, -1000, 1458, 768, -85, 1749, -1000, 840, 1747,
+	840, 840, -1000, 921, -1000, 1168, -1000, -1000, 63, 757,
+	7067, 1457, 7067, 1455, 74, 1453, -1000, -1000, -1000, -1000,
+	-1000, 261, -1000, -1000, 1628, 1627, 1805, -1000, -1000, -1000,
+	-1000, 117, 117, 117, 117, -7, 711, -1000, 1069, 1685,
+	12329, -117, -1000, 890, -1000, 840, -1000, -1000, -1000, -1000,
+	18794, 1451, 1746, -1000, 3066, 18794, 1695, 18794, 1441, 533,
+	7067, -1000, -1000, 1815, -1000, 1806, 431, 431, -1000, -1000,
+	-1000, -1000, 18794, -1000, 1166, -1000, -1000, -1000, 361, -1000,
+	-1000, -1000, -1000, -1000, 185, 69, -1000, 1148, -1000, 1146,
+	18794, 708, 1626, -1000, -1000, -1000, 877, 103, -1000, 1269,
+	-1000, 529, -1000, 13622, 18794, 1142, -1000, 1076, 48, -1000,
+	-1000, 1138, -1000, -1000, -1000, -1000, -1000, 18794, 4414, -1000,
+	359, -1000, 185, 1675, -1000, 697, -1000, -1000, -1000, 835,
+	18794, -1000, 655, 182, -1000, -1000, -1000, 655, 53, -1000,
+	161, -1000, -1000, 1136, -1000, 1072, 1331, -1000, 53, 20197,
+	6185, -1000, 20197, 1127, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 100, 2119, 2116, 110, 108, 2115, 2113, 2112, 2111,
-	2110, 2105, 2104, 2103, 2101, 2098, 2096, 2095, 2094, 2092,
-	2091, 2090, 2088, 2087, 2086, 2083, 2082, 2080, 2079, 2078,
-	2077, 2076, 2075, 106, 2074, 2073, 2072, 2071, 2070, 2069,
-	137, 2068, 2065, 2063, 2062, 2061, 2060, 2059, 2058, 2057,
-	126, 46, 120, 714, 66, 185, 163, 122, 2056, 85,
-	154, 2055, 2054, 28, 116, 2052, 123, 119, 88, 145,
-	101, 87, 57, 2051, 2044, 2043, 134, 2042, 2041, 2039,
-	2037, 60, 2036, 75, 35, 29, 2035, 78, 2034, 2033,
-	2032, 2031, 2030, 74, 2029, 64, 43, 2026, 2025, 2024,
-	2023, 2022, 32, 2021, 45, 2020, 2015, 2013, 2012, 2011,
-	2010, 2008, 15, 17, 19, 2007, 2006, 16, 3, 1992,
-	143, 80, 77, 96, 1991, 188, 1989, 1988, 1987, 152,
-	1986, 117, 1985, 1983, 1982, 1981, 9, 1980, 42, 1979,
-	1977, 1976, 44, 1975, 1974, 1972, 91, 36, 71, 89,
-	1971, 1970, 1969, 135, 20, 54, 0, 136, 39, 1968,
-	133, 128, 1967, 86, 195, 130, 48, 1966, 38, 65,
-	1965, 1964, 1963, 98, 61, 12, 1961, 82, 1960, 90,
-	84, 1959, 97, 1958, 124, 2, 94, 1954, 140, 1939,
-	1938, 1937, 115, 1936, 1935, 55, 112, 1934, 1933, 1932,
-	31, 1930, 33, 21, 1929, 129, 148, 1926, 1922, 1916,
-	121, 105, 76, 1915, 1914, 68, 1911, 114, 69, 125,
-	1910, 718, 1909, 113, 63, 18, 1908, 146, 1906, 232,
-	156, 139, 1905, 1904, 151, 1600, 149, 1903, 131, 10,
-	1902, 1901, 11, 1899, 25, 1897, 1896, 1895, 1891, 6,
-	1888, 1886, 1885, 1, 5, 1884, 4, 99, 1883, 51,
-	56, 1882, 53, 58, 1881, 1879, 1876, 1875, 1870, 162,
-	1863, 1862, 1860, 1859, 1858, 1857, 1856, 81, 1855, 1854,
-	1853, 1852, 62, 1851, 1850, 1849, 1848, 1847, 30, 1845,
-	1844, 22, 1843, 26, 1842, 1839, 1818, 13, 1813, 1811,
-	14, 1810, 1808, 7, 8, 1807, 1806, 52, 34, 37,
-	72, 70, 1805, 23, 1804, 92, 1803, 1802, 102, 1801,
-	95, 1800, 1798, 144, 172, 1797, 138, 1796, 1795, 1794,
-	1793, 1792, 1790, 1789, 1786, 1785, 132, 1777,
-}
-
-//line mysql_sql.y:6500
+	0, 103, 2181, 2180, 109, 107, 2178, 2177, 2176, 2171,
+	2170, 2168, 2167, 2166, 2165, 2164, 2163, 2162, 2160, 2159,
+	2158, 2157, 2156, 2155, 2154, 2153, 2151, 2149, 2148, 2147,
+	2146, 2145, 2144, 105, 2143, 2142, 2141, 2136, 2135, 2134,
+	2133, 147, 2125, 2124, 2122, 2119, 2118, 2117, 2116, 2115,
+	2113, 128, 39, 104, 819, 56, 187, 164, 127, 2109,
+	83, 156, 2108, 2107, 27, 116, 2106, 121, 119, 89,
+	148, 92, 86, 66, 2105, 2104, 2103, 138, 2102, 2101,
+	2099, 2098, 59, 2097, 77, 30, 29, 2096, 76, 2095,
+	2094, 2092, 2091, 2090, 73, 2086, 64, 117, 2084, 43,
+	2083, 2082, 2081, 2080, 2079, 32, 2077, 60, 2076, 2075,
+	2074, 2073, 2072, 2067, 2066, 2065, 14, 18, 15, 2064,
+	2063, 17, 2, 2060, 140, 94, 80, 95, 2059, 167,
+	2058, 2057, 2056, 153, 2055, 488, 2054, 2053, 2050, 2049,
+	11, 2048, 45, 2047, 2046, 2045, 47, 2044, 2043, 2040,
+	88, 35, 71, 90, 2039, 2037, 2036, 142, 21, 93,
+	0, 135, 37, 2035, 132, 130, 2033, 96, 193, 113,
+	49, 2032, 38, 72, 2030, 2029, 2028, 101, 62, 48,
+	2026, 82, 2025, 46, 79, 2023, 100, 2022, 126, 1,
+	98, 2021, 141, 2020, 2019, 2017, 114, 2016, 2015, 53,
+	118, 2014, 2013, 2012, 31, 2011, 33, 19, 2008, 120,
+	151, 2007, 2006, 2005, 123, 87, 78, 2004, 1992, 67,
+	1988, 112, 68, 124, 1986, 771, 1984, 111, 61, 20,
+	1983, 145, 1967, 240, 149, 129, 1966, 1964, 152, 1695,
+	150, 1963, 137, 12, 1962, 1961, 13, 1960, 24, 1959,
+	1958, 1956, 1955, 6, 1948, 1943, 1942, 3, 5, 1941,
+	4, 1940, 102, 1937, 44, 54, 1917, 58, 65, 1913,
+	1911, 1910, 1909, 1908, 165, 1906, 1904, 1903, 1902, 1901,
+	1900, 1897, 74, 1896, 1895, 1894, 1893, 63, 1892, 1891,
+	1890, 1889, 1888, 26, 1885, 1884, 16, 1882, 23, 1881,
+	1880, 1879, 9, 1878, 1877, 10, 1875, 1874, 7, 8,
+	1873, 1872, 52, 34, 36, 69, 70, 1871, 22, 1870,
+	91, 1868, 1866, 99, 125, 97, 1864, 1863, 146, 174,
+	1847, 136, 1838, 1836, 1834, 1833, 1831, 1830, 1828, 1827,
+	1826, 131, 1825,
+}
+
+//line mysql_sql.y:6593
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3568,6 +3624,11 @@ func (st *yySymType) keyPartsUnion() []*tree.KeyPart {
 	return v
 }
 
+func (st *yySymType) killOptionUnion() tree.KillOption {
+	v, _ := st.union.(tree.KillOption)
+	return v
+}
+
 func (st *yySymType) lengthOptUnion() int32 {
 	v, _ := st.union.(int32)
 	return v
@@ -3939,253 +4000,257 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 334, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 339, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 306, 306, 305, 305, 304, 304, 303, 303,
-	303, 302, 302, 302, 301, 301, 300, 300, 298, 298,
-	299, 297, 296, 296, 294, 294, 292, 292, 293, 293,
-	287, 287, 290, 290, 288, 288, 288, 288, 291, 286,
-	286, 286, 285, 285, 47, 47, 47, 224, 224, 46,
-	46, 238, 238, 238, 238, 238, 236, 236, 236, 236,
-	235, 235, 234, 234, 239, 239, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 41,
-	41, 41, 41, 44, 45, 232, 232, 232, 232, 232,
-	233, 233, 233, 42, 43, 43, 223, 223, 228, 228,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
-	227, 227, 222, 222, 231, 231, 231, 230, 230, 229,
-	229, 35, 35, 35, 38, 37, 221, 221, 221, 221,
-	221, 221, 221, 221, 36, 36, 36, 36, 36, 36,
-	34, 34, 33, 220, 220, 219, 40, 40, 40, 40,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 159,
-	159, 159, 325, 325, 326, 327, 328, 328, 328, 49,
-	7, 32, 32, 269, 269, 170, 170, 171, 171, 169,
-	169, 169, 169, 169, 169, 272, 273, 166, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 21, 31, 335,
-	335, 335, 29, 30, 268, 268, 268, 28, 27, 26,
-	25, 25, 24, 23, 23, 163, 163, 165, 165, 161,
-	336, 336, 244, 244, 164, 164, 22, 22, 162, 162,
-	143, 160, 160, 160, 6, 8, 8, 8, 8, 8,
-	13, 12, 11, 10, 9, 5, 4, 276, 276, 276,
-	276, 276, 276, 314, 314, 314, 315, 75, 75, 70,
-	70, 277, 277, 186, 316, 316, 284, 284, 283, 283,
-	282, 282, 73, 73, 74, 74, 62, 62, 50, 50,
-	289, 289, 289, 289, 295, 295, 266, 266, 109, 109,
-	139, 139, 140, 140, 51, 51, 52, 52, 52, 52,
-	52, 52, 322, 322, 324, 324, 323, 72, 72, 68,
-	68, 69, 69, 69, 67, 67, 66, 65, 65, 64,
-	63, 63, 63, 54, 54, 53, 53, 53, 53, 53,
-	125, 125, 125, 55, 55, 330, 330, 330, 270, 270,
-	270, 275, 275, 122, 122, 123, 123, 121, 121, 56,
-	56, 57, 57, 57, 57, 120, 120, 119, 58, 58,
-	59, 59, 61, 61, 61, 61, 130, 130, 129, 129,
-	129, 129, 78, 78, 128, 127, 127, 127, 77, 77,
-	76, 76, 71, 71, 60, 60, 126, 337, 337, 124,
-	152, 152, 152, 158, 158, 151, 151, 151, 151, 151,
-	157, 157, 153, 153, 154, 154, 154, 3, 3, 3,
-	16, 16, 16, 16, 20, 20, 332, 332, 14, 217,
-	217, 216, 216, 218, 218, 218, 218, 212, 212, 213,
-	213, 213, 213, 214, 214, 214, 215, 215, 215, 215,
-	211, 211, 210, 208, 208, 208, 209, 209, 209, 209,
-	209, 209, 155, 155, 15, 205, 205, 206, 206, 206,
-	207, 207, 199, 199, 199, 199, 19, 203, 203, 204,
-	204, 204, 204, 204, 200, 200, 202, 202, 198, 198,
-	198, 198, 198, 18, 197, 197, 195, 195, 193, 193,
-	194, 194, 192, 192, 192, 196, 196, 17, 271, 271,
-	240, 240, 243, 243, 250, 250, 251, 251, 249, 249,
-	256, 256, 255, 255, 254, 254, 253, 253, 252, 252,
-	247, 247, 246, 246, 241, 241, 241, 241, 241, 242,
-	242, 245, 245, 248, 248, 100, 100, 101, 101, 101,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 312,
-	312, 313, 103, 103, 103, 107, 107, 107, 107, 107,
-	107, 102, 102, 102, 104, 104, 104, 85, 85, 84,
-	84, 79, 79, 80, 80, 81, 81, 82, 82, 83,
-	83, 83, 83, 83, 83, 226, 226, 310, 310, 311,
-	311, 307, 307, 307, 309, 309, 309, 309, 309, 308,
-	308, 86, 137, 137, 137, 156, 156, 156, 136, 136,
-	136, 99, 99, 98, 98, 96, 96, 96, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 225, 225,
-	167, 167, 168, 168, 117, 115, 115, 116, 116, 116,
-	116, 113, 114, 112, 112, 112, 112, 112, 111, 111,
-	110, 110, 110, 201, 201, 108, 108, 106, 106, 106,
-	105, 105, 105, 257, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 175, 175, 177, 177, 177,
-	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
-	177, 177, 177, 177, 177, 177, 177, 178, 178, 183,
-	183, 321, 321, 320, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 95, 95, 95, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 281, 281, 281, 132, 132, 132, 132, 132, 132,
-	132, 132, 132, 132, 132, 132, 333, 333, 333, 317,
-	317, 318, 318, 318, 318, 318, 318, 318, 318, 318,
-	318, 318, 318, 319, 319, 319, 319, 319, 319, 319,
-	319, 319, 319, 319, 319, 319, 319, 319, 319, 319,
-	134, 134, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 187, 187, 188, 188, 278, 278,
-	278, 278, 278, 278, 279, 279, 280, 280, 280, 280,
-	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
-	274, 274, 274, 274, 274, 274, 274, 274, 274, 274,
-	274, 274, 274, 274, 274, 274, 274, 274, 176, 176,
-	176, 176, 131, 131, 131, 189, 184, 184, 185, 185,
+	1, 1, 49, 97, 97, 311, 311, 310, 310, 309,
+	309, 308, 308, 308, 307, 307, 307, 306, 306, 305,
+	305, 303, 303, 304, 302, 301, 301, 299, 299, 297,
+	297, 298, 298, 292, 292, 295, 295, 293, 293, 293,
+	293, 296, 291, 291, 291, 290, 290, 48, 48, 48,
+	228, 228, 47, 47, 242, 242, 242, 242, 242, 240,
+	240, 240, 240, 239, 239, 238, 238, 243, 243, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 42, 42, 42, 42, 45, 46, 236, 236,
+	236, 236, 236, 237, 237, 237, 43, 44, 44, 227,
+	227, 232, 232, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 226, 226, 235, 235, 235,
+	234, 234, 233, 233, 36, 36, 36, 39, 38, 225,
+	225, 225, 225, 225, 225, 225, 225, 37, 37, 37,
+	37, 37, 37, 35, 261, 261, 261, 34, 34, 33,
+	224, 224, 223, 41, 41, 41, 41, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 163, 163, 163, 330,
+	330, 331, 332, 333, 333, 333, 50, 7, 32, 32,
+	274, 274, 174, 174, 175, 175, 173, 173, 173, 173,
+	173, 173, 277, 278, 170, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 31, 340, 340, 340, 29,
+	30, 273, 273, 273, 28, 27, 26, 25, 25, 24,
+	23, 23, 167, 167, 169, 169, 165, 341, 341, 248,
+	248, 168, 168, 22, 22, 166, 166, 147, 164, 164,
+	164, 6, 8, 8, 8, 8, 8, 13, 12, 11,
+	10, 9, 5, 4, 281, 281, 281, 281, 281, 281,
+	319, 319, 319, 320, 76, 76, 71, 71, 282, 282,
+	190, 321, 321, 289, 289, 288, 288, 287, 287, 74,
+	74, 75, 75, 63, 63, 51, 51, 294, 294, 294,
+	294, 300, 300, 271, 271, 112, 112, 113, 113, 98,
+	98, 143, 143, 144, 144, 52, 52, 53, 53, 53,
+	53, 53, 53, 327, 327, 329, 329, 328, 73, 73,
+	69, 69, 70, 70, 70, 68, 68, 67, 66, 66,
+	65, 64, 64, 64, 55, 55, 54, 54, 54, 54,
+	54, 129, 129, 129, 56, 56, 335, 335, 335, 275,
+	275, 275, 280, 280, 126, 126, 127, 127, 125, 125,
+	57, 57, 58, 58, 58, 58, 124, 124, 123, 59,
+	59, 60, 60, 62, 62, 62, 62, 134, 134, 133,
+	133, 133, 133, 79, 79, 132, 131, 131, 131, 78,
+	78, 77, 77, 72, 72, 61, 61, 130, 342, 342,
+	128, 156, 156, 156, 162, 162, 155, 155, 155, 155,
+	155, 161, 161, 157, 157, 158, 158, 158, 3, 3,
+	3, 16, 16, 16, 16, 20, 20, 337, 337, 14,
+	221, 221, 220, 220, 222, 222, 222, 222, 216, 216,
+	217, 217, 217, 217, 218, 218, 218, 219, 219, 219,
+	219, 215, 215, 214, 212, 212, 212, 213, 213, 213,
+	213, 213, 213, 159, 159, 15, 209, 209, 210, 210,
+	210, 211, 211, 203, 203, 203, 203, 19, 207, 207,
+	208, 208, 208, 208, 208, 204, 204, 206, 206, 202,
+	202, 202, 202, 202, 18, 201, 201, 199, 199, 197,
+	197, 198, 198, 196, 196, 196, 200, 200, 17, 276,
+	276, 244, 244, 247, 247, 254, 254, 255, 255, 253,
+	253, 260, 260, 259, 259, 258, 258, 257, 257, 256,
+	256, 251, 251, 250, 250, 245, 245, 245, 245, 245,
+	246, 246, 249, 249, 252, 252, 103, 103, 104, 104,
+	104, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	317, 317, 318, 106, 106, 106, 110, 110, 110, 110,
+	110, 110, 105, 105, 105, 107, 107, 107, 86, 86,
+	85, 85, 85, 80, 80, 81, 81, 82, 82, 83,
+	83, 84, 84, 84, 84, 84, 84, 230, 230, 315,
+	315, 316, 316, 312, 312, 312, 314, 314, 314, 314,
+	314, 313, 313, 87, 141, 141, 141, 160, 160, 160,
+	140, 140, 140, 102, 102, 101, 101, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	229, 229, 171, 171, 172, 172, 121, 119, 119, 120,
+	120, 120, 120, 117, 118, 116, 116, 116, 116, 116,
+	115, 115, 114, 114, 114, 205, 205, 111, 111, 109,
+	109, 109, 108, 108, 108, 262, 179, 179, 179, 179,
 	179, 179, 179, 179, 179, 179, 179, 179, 179, 181,
-	181, 181, 181, 181, 173, 173, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 180, 180, 182, 182, 191,
-	191, 191, 190, 190, 190, 190, 190, 190, 190, 97,
-	97, 97, 97, 258, 172, 172, 172, 172, 172, 172,
-	172, 172, 88, 88, 88, 88, 92, 92, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 94, 93, 93, 93, 93, 91, 91, 91, 91,
-	91, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 89, 90, 138, 138, 259,
-	259, 262, 262, 260, 260, 261, 263, 263, 263, 264,
-	264, 264, 265, 265, 265, 267, 267, 142, 142, 142,
-	148, 148, 141, 141, 149, 149, 150, 150, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 329, 329, 329, 331, 331,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 182,
+	182, 187, 187, 326, 326, 325, 88, 88, 88, 88,
+	88, 88, 88, 88, 88, 96, 96, 96, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 286, 286, 286, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 338, 338, 338, 322, 322, 323, 323, 323, 323,
+	323, 323, 323, 323, 323, 323, 323, 323, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 138, 138, 137, 137, 137,
+	137, 137, 137, 137, 137, 137, 137, 137, 137, 191,
+	191, 192, 192, 283, 283, 283, 283, 283, 283, 284,
+	284, 285, 285, 285, 285, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 180, 180, 180, 180, 135, 135, 135,
+	193, 188, 188, 189, 189, 183, 183, 183, 183, 183,
+	183, 183, 183, 183, 185, 185, 185, 185, 185, 177,
+	177, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	184, 184, 186, 186, 195, 195, 195, 194, 194, 194,
+	194, 194, 194, 194, 100, 100, 100, 100, 263, 176,
+	176, 176, 176, 176, 176, 176, 176, 89, 89, 89,
+	89, 93, 93, 95, 95, 95, 95, 95, 95, 95,
+	95, 95, 95, 95, 95, 95, 95, 94, 94, 94,
+	94, 92, 92, 92, 92, 92, 90, 90, 90, 90,
+	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
+	90, 91, 142, 142, 264, 264, 267, 267, 265, 265,
+	266, 268, 268, 268, 269, 269, 269, 270, 270, 270,
+	272, 272, 146, 146, 146, 152, 152, 145, 145, 153,
+	153, 154, 154, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 334,
+	334, 334, 336, 336,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 3, 4, 4, 4, 3, 2, 4, 4, 2,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 1, 1, 2, 2, 0, 4, 2, 4,
-	1, 5, 3, 2, 1, 2, 2, 4, 4, 5,
-	2, 1, 7, 1, 3, 3, 1, 1, 1, 1,
-	2, 3, 4, 7, 2, 3, 3, 4, 5, 1,
-	1, 1, 1, 3, 2, 1, 1, 1, 1, 6,
-	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
-	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
-	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
-	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
-	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
-	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
-	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
-	0, 4, 1, 3, 1, 1, 4, 3, 4, 5,
-	4, 5, 2, 3, 1, 3, 6, 0, 3, 0,
-	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 7, 7, 1, 1, 1, 0, 1,
-	1, 1, 1, 0, 2, 0, 3, 0, 2, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 3,
-	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
-	2, 3, 0, 2, 1, 1, 2, 2, 0, 1,
-	2, 4, 1, 3, 1, 4, 3, 0, 1, 2,
-	0, 1, 2, 1, 1, 0, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 8, 11, 0, 1, 6, 0,
-	2, 1, 2, 2, 2, 2, 2, 0, 1, 2,
-	2, 2, 2, 1, 3, 2, 2, 2, 2, 2,
-	1, 3, 2, 1, 3, 2, 0, 3, 3, 5,
-	5, 4, 1, 1, 4, 1, 3, 1, 3, 2,
-	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
-	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
-	2, 2, 2, 5, 1, 1, 0, 3, 0, 1,
-	1, 2, 4, 4, 4, 0, 1, 10, 0, 1,
-	0, 6, 0, 4, 0, 3, 1, 3, 4, 5,
-	0, 3, 1, 3, 2, 3, 1, 2, 0, 6,
-	0, 2, 0, 2, 4, 5, 4, 5, 1, 6,
-	5, 0, 3, 0, 1, 0, 1, 1, 3, 2,
-	3, 3, 4, 4, 3, 3, 3, 3, 4, 4,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 4, 5, 4, 1,
-	3, 3, 0, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	3, 0, 1, 1, 3, 1, 1, 2, 1, 7,
-	7, 7, 7, 8, 5, 0, 1, 0, 1, 1,
-	1, 1, 3, 3, 1, 1, 1, 1, 1, 0,
-	1, 3, 1, 3, 5, 1, 1, 1, 1, 3,
-	5, 0, 1, 1, 2, 1, 2, 2, 1, 1,
-	2, 2, 2, 2, 2, 1, 5, 6, 1, 2,
-	0, 1, 1, 2, 5, 0, 1, 1, 1, 2,
-	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
-	2, 2, 2, 0, 3, 0, 3, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 1, 1, 1, 1,
-	3, 5, 2, 2, 2, 2, 1, 1, 2, 5,
-	6, 6, 6, 1, 1, 1, 1, 0, 2, 0,
-	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
-	2, 2, 2, 0, 1, 1, 5, 4, 4, 5,
-	5, 5, 5, 4, 5, 5, 5, 5, 5, 5,
-	5, 1, 1, 1, 4, 4, 6, 8, 6, 4,
-	5, 5, 4, 6, 6, 7, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 15, 0, 2, 0, 2, 1, 3, 3,
+	3, 1, 3, 5, 0, 2, 3, 1, 3, 1,
+	1, 1, 1, 1, 1, 0, 3, 0, 3, 0,
+	3, 0, 3, 0, 2, 1, 2, 3, 4, 3,
+	3, 1, 0, 1, 1, 0, 1, 9, 4, 7,
+	0, 3, 7, 4, 1, 3, 3, 3, 1, 0,
+	1, 1, 1, 1, 3, 1, 4, 1, 3, 1,
+	2, 1, 1, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 2, 1, 2, 2, 1,
+	1, 1, 3, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 3, 6, 3, 1,
+	1, 1, 1, 1, 1, 1, 2, 4, 6, 1,
+	4, 1, 3, 3, 3, 4, 4, 4, 3, 2,
+	4, 4, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 1, 1, 1, 2, 2, 0,
+	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
+	4, 4, 5, 3, 0, 1, 1, 2, 1, 7,
+	1, 3, 3, 1, 1, 1, 1, 2, 3, 4,
+	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
+	3, 2, 1, 1, 1, 1, 6, 1, 7, 9,
+	0, 2, 0, 1, 1, 2, 2, 2, 1, 4,
+	2, 2, 3, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 5, 1, 1, 1, 5,
+	5, 0, 1, 1, 2, 2, 3, 6, 7, 4,
+	7, 8, 0, 2, 0, 2, 2, 1, 1, 1,
+	1, 0, 1, 4, 5, 1, 3, 1, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 4, 4, 6,
+	4, 4, 6, 4, 2, 1, 5, 4, 4, 2,
+	0, 1, 3, 3, 1, 3, 1, 3, 1, 3,
+	4, 0, 1, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 0, 11, 0, 4, 7,
+	4, 0, 2, 0, 2, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
+	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
+	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
+	2, 0, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 1, 2, 2, 7, 7, 1, 1, 1, 0,
+	1, 1, 1, 1, 0, 2, 0, 3, 0, 2,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 1,
+	3, 1, 1, 4, 4, 4, 3, 2, 2, 2,
+	3, 2, 3, 0, 2, 1, 1, 2, 2, 0,
+	1, 2, 4, 1, 3, 1, 4, 3, 0, 1,
+	2, 0, 1, 2, 1, 1, 0, 1, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 8, 11, 0, 1, 6,
+	0, 2, 1, 2, 2, 2, 2, 2, 0, 1,
+	2, 2, 2, 2, 1, 3, 2, 2, 2, 2,
+	2, 1, 3, 2, 1, 3, 2, 0, 3, 3,
+	5, 5, 4, 1, 1, 4, 1, 3, 1, 3,
+	2, 1, 1, 0, 1, 1, 1, 11, 0, 2,
+	3, 2, 3, 1, 1, 1, 3, 3, 4, 0,
+	2, 2, 2, 2, 5, 1, 1, 0, 3, 0,
+	1, 1, 2, 4, 4, 4, 0, 1, 10, 0,
+	1, 0, 6, 0, 4, 0, 3, 1, 3, 4,
+	5, 0, 3, 1, 3, 2, 3, 1, 2, 0,
+	6, 0, 2, 0, 2, 4, 5, 4, 5, 1,
+	6, 5, 0, 3, 0, 1, 0, 1, 1, 3,
+	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 5, 4,
+	1, 3, 3, 0, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 3, 3, 0, 1, 1, 3, 1, 1, 2,
+	1, 7, 7, 7, 7, 8, 5, 0, 1, 0,
+	1, 1, 1, 1, 3, 3, 1, 1, 1, 1,
+	1, 0, 1, 3, 1, 3, 5, 1, 1, 1,
+	1, 3, 5, 0, 1, 1, 2, 1, 2, 2,
+	1, 1, 2, 2, 2, 2, 2, 1, 5, 6,
+	1, 2, 0, 1, 1, 2, 5, 0, 1, 1,
+	1, 2, 2, 3, 3, 1, 1, 2, 2, 2,
+	0, 1, 2, 2, 2, 0, 3, 0, 3, 1,
+	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 1, 1,
+	1, 1, 3, 5, 2, 2, 2, 2, 1, 1,
+	2, 5, 6, 6, 6, 1, 1, 1, 1, 0,
+	2, 0, 1, 1, 2, 4, 1, 2, 2, 1,
+	2, 2, 2, 2, 2, 0, 1, 1, 5, 4,
+	4, 5, 5, 5, 5, 4, 5, 5, 5, 5,
+	5, 5, 5, 1, 1, 1, 4, 4, 6, 8,
+	6, 8, 8, 4, 5, 5, 6, 4, 6, 6,
+	7, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 4, 2, 2, 4, 6, 2, 2, 4,
-	6, 4, 2, 2, 0, 1, 2, 3, 1, 1,
-	1, 1, 1, 1, 0, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 4, 2, 2,
+	4, 6, 2, 2, 4, 6, 4, 2, 2, 0,
+	1, 2, 3, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 3,
-	3, 4, 0, 1, 1, 3, 0, 1, 1, 3,
-	3, 3, 3, 2, 3, 4, 3, 4, 1, 3,
-	4, 3, 4, 1, 1, 1, 3, 4, 4, 5,
-	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 2, 3, 3, 4, 0, 1, 1,
+	3, 0, 1, 1, 3, 3, 3, 3, 2, 3,
+	4, 3, 4, 1, 3, 4, 3, 4, 1, 1,
+	1, 3, 4, 4, 5, 3, 4, 5, 6, 1,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	4, 1, 1, 3, 0, 1, 0, 3, 0, 3,
+	3, 0, 3, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 1, 2, 2, 0, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 4, 4, 1, 1, 3, 0,
-	1, 0, 3, 0, 3, 3, 0, 3, 5, 0,
-	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4221,451 +4286,459 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -334, -2, -1, -3, -4, -5, -6, -39, -21,
-	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -159, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 185, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 293, 299,
-	336, -52, -54, -17, -18, -19, -20, 181, -9, -10,
-	-11, -12, -13, 203, 202, 26, 201, 182, 120, 121,
-	123, 124, 30, -53, -322, 54, 183, -55, 407, 6,
-	452, -62, 27, -84, -156, 57, -144, -147, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 436, 437, 438, 439, 440, 441,
-	442, 443, 444, 445, 404, 220, 244, 247, 254, 120,
-	137, 131, 159, 151, 152, 128, 224, 225, 64, 123,
-	364, 350, 335, 126, 239, 241, 243, 246, 226, 147,
-	142, 238, 222, 140, 227, 28, 228, 164, 229, 230,
-	409, 346, 275, 368, 352, 251, 141, 347, 242, 349,
-	165, 169, 356, 299, 135, 136, 354, 358, 163, 200,
-	32, 406, 34, 212, 359, 167, 162, 158, 367, 255,
-	253, 161, 134, 157, 223, 38, 171, 170, 172, 329,
-	330, 331, 332, 231, 232, 287, 355, 154, 143, 279,
-	129, 18, 362, 207, 351, 300, 233, 248, 210, 256,
-	166, 168, 214, 219, 408, 252, 360, 139, 211, 240,
-	206, 363, 130, 293, 302, 234, 124, 245, 357, 366,
-	37, 315, 133, 127, 197, 121, 216, 221, 235, 236,
-	237, 258, 257, 249, 155, 213, 160, 132, 156, 122,
-	215, 365, 316, 451, 277, 318, 153, 150, 217, 190,
-	361, 353, 125, 322, 317, 148, 259, 448, 449, 450,
-	288, 274, 260, 261, 11, -160, 19, 333, -40, 334,
-	185, 54, -156, -5, -4, -33, -51, 188, -59, -60,
-	-61, -124, -126, -84, 54, -156, -235, -205, -234, -206,
-	-237, -207, -155, 20, 182, 181, 215, 10, 183, 297,
-	189, 8, 6, 298, 201, 9, 299, 301, 302, 305,
-	306, 307, 31, 310, 311, 57, 60, -156, -235, -205,
-	219, 226, -66, -67, -125, 15, 5, -271, 318, 218,
-	-199, -197, 308, 198, 197, 76, 370, 187, -335, -268,
-	353, 352, -164, 351, 344, 346, 181, 189, 354, 32,
-	356, 357, 347, 318, 125, 122, -221, 80, 130, 129,
-	-221, 218, 29, -228, 328, -227, -229, 146, 356, 357,
-	367, -222, 355, -142, -156, 58, 59, 75, 152, 149,
-	-67, -125, -66, -53, -54, -52, -54, 318, 218, 189,
-	188, 370, -324, 402, -323, -156, -270, -330, 20, -275,
-	178, 179, 180, 21, 22, -1, -73, 210, -84, 119,
-	-59, -136, -156, 335, 89, -40, -40, 334, -325, -326,
-	-327, -329, 185, 334, 333, 119, -84, 30, -127, -128,
-	-129, -130, 41, 45, 47, 42, 43, 44, 48, -337,
-	23, -152, -158, 23, -153, 60, -154, -147, 57, 58,
-	59, -52, -54, 51, 55, 11, 55, 54, 453, 58,
-	295, 309, 318, 296, 308, 190, 218, 309, 218, 344,
-	190, 300, 303, 304, 345, 51, 191, 51, -285, 367,
-	-50, 27, -69, 17, -55, -54, 16, 20, 21, -332,
-	188, 402, -195, 193, -195, 189, -195, -336, 11, 99,
-	217, 216, 348, 345, -244, 349, 350, -164, -163, 97,
-	-164, 188, 370, -269, 193, 360, 407, 128, 129, 130,
-	-232, 20, 29, 327, -205, 218, 55, 89, 19, -230,
-	89, 100, -230, -229, -229, -229, -230, -102, 29, -154,
-	60, 116, -102, 29, 119, 30, 30, -68, -69, -55,
-	-54, -67, -66, -67, 56, 56, -269, -269, -269, -269,
-	-269, 55, -324, -72, 54, -56, -57, 107, -179, -156,
-	81, -181, 57, -174, 410, 411, 412, 413, 414, 415,
-	416, 417, 418, 419, 420, 421, 422, 423, 424, 426,
-	427, 428, 429, 430, 431, 432, 433, 434, 435, 436,
-	437, 438, 439, 440, 441, 442, 443, 444, 445, 287,
-	318, 148, 288, -175, -177, -303, -298, -172, 54, 105,
-	106, 113, 82, -176, -257, 24, 84, 378, -132, -133,
-	-134, -135, -299, -297, 60, 65, 69, 71, 72, 70,
-	67, 144, 118, -54, -317, -145, -274, -280, -278, 149,
-	204, 145, 8, 111, 328, 116, 146, -281, 59, 58,
-	281, 75, 282, 283, 370, 278, 284, 193, 333, 43,
-	285, 286, 289, 377, 290, 44, 291, 280, 208, 292,
-	381, 380, 382, 374, 371, 369, 372, 373, 375, 376,
-	-56, -276, 33, -51, 54, 30, 54, -156, -121, 12,
-	119, 65, 60, -40, 56, 55, -328, 71, 72, -331,
-	163, 155, -156, 54, -220, -219, -136, -60, -60, -60,
-	-60, 41, 41, 41, 46, 41, 46, 41, -129, -156,
-	-158, 56, -236, 188, 294, 214, -234, 215, 299, 302,
-	-211, -210, -208, -155, 60, -206, -239, -136, -155, 345,
-	-236, -211, -210, 337, 447, -50, -179, -156, -65, -64,
-	-179, 190, -195, -211, 81, -205, -154, -156, -84, -163,
-	-163, -165, -336, -161, -336, 345, -121, -177, -244, -162,
-	-156, -195, -211, 318, 24, 361, 362, 126, 129, 128,
-	368, -233, 327, 20, -205, -227, -223, 60, 328, -210,
-	-231, 51, 116, -282, -179, 29, -231, -230, -230, -230,
-	-231, 115, -156, -50, -68, -50, -69, -211, -205, -156,
-	-85, -84, -157, -154, -147, -323, 23, -71, -156, -120,
-	55, -119, 11, -151, 80, 78, 79, -156, 23, 60,
-	119, -179, 96, -190, 89, 90, 91, 92, 93, 94,
-	95, 54, 54, 54, 54, 54, 54, 54, 54, -188,
-	54, 54, 54, 54, 54, 54, 54, -188, 54, 54,
-	54, 54, 54, 102, 101, 112, 105, 106, 107, 108,
-	109, 110, 111, 103, 104, 99, 81, 97, 98, 83,
-	-54, -179, -185, -177, -177, -177, -177, -257, -183, -179,
-	54, 60, 65, -156, 106, 54, 54, 54, -279, 54,
-	-187, -188, 54, 60, 60, 54, 54, 54, -177, 60,
-	54, -120, -277, -186, -316, 446, -75, 56, -70, -156,
-	-314, -315, -70, -74, -156, -67, -179, -149, -150, -141,
-	-146, -153, -154, -147, 276, 186, 20, 80, 23, 25,
-	281, 313, 83, 116, 16, 84, 149, 115, 283, 378,
-	282, 181, 47, 75, 380, 382, 381, 371, 369, 320,
-	324, 326, 323, 370, 344, 29, 10, 26, 202, 21,
-	22, 109, 183, 204, 87, 88, 205, 24, 203, 72,
-	19, 50, 11, 333, 13, 14, 284, 319, 193, 192,
-	99, 337, 189, 45, 8, 118, 27, 96, 321, 41,
-	77, 43, 97, 17, 372, 373, 31, 336, 403, 209,
-	111, 285, 286, 48, 81, 327, 70, 51, 78, 15,
-	46, 98, 184, 377, 44, 218, 325, 289, 291, 402,
-	290, 187, 6, 280, 379, 30, 201, 42, 188, 345,
-	86, 191, 71, 208, 145, 5, 76, 9, 49, 52,
-	374, 375, 376, 33, 85, 12, 292, 407, 328, 338,
-	339, 340, 341, 342, 343, 173, 174, 175, 176, 177,
-	250, 196, 194, 198, 199, 446, 447, 178, 179, 271,
-	273, 146, 19, -40, -326, 119, -71, -121, 55, 89,
-	-77, -76, 51, 52, -78, 51, -76, 41, 41, -72,
-	-238, 107, 57, 55, -209, 319, 453, 58, 56, 55,
-	-238, 191, 60, 60, 55, 18, 119, 55, -63, 25,
-	26, -84, 193, -84, -212, -213, 325, 24, -198, 52,
-	-193, -194, -192, -196, 29, -121, -121, -121, -163, -157,
-	-165, -160, -165, -161, 119, -143, -156, -212, 54, 127,
-	130, 130, 129, -205, 191, 54, 89, -231, -231, -231,
-	29, -155, -50, -50, 51, 55, 54, 56, 55, -121,
-	-57, -58, -59, -179, -179, -179, -156, 60, -156, 107,
-	-173, 81, 274, 70, 71, 72, -174, -191, 20, 260,
-	261, -184, -185, -179, -131, 21, 20, -131, -131, -179,
-	-131, 107, -185, -185, 56, -258, 65, -318, -319, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	285, 280, 286, 284, 278, 292, 287, 288, 148, 400,
-	401, 394, 395, 396, 397, 398, 399, -131, -131, -131,
-	-131, -131, -179, -333, 272, 271, 273, -131, -131, -131,
-	-131, -175, -175, -175, -175, -175, -175, -175, -175, -175,
-	-175, -175, -175, -182, -189, -257, 54, 99, 97, 98,
-	83, -177, -175, -175, 56, 55, -321, -320, 85, -179,
-	-318, -318, 65, -184, -179, -184, -184, 56, -185, -184,
-	-175, -184, -131, -121, 55, 54, 56, 55, 33, 119,
-	55, 89, 56, 55, -68, 119, 335, -156, 56, -67,
-	-219, -179, -179, 54, -179, 11, 119, 119, -210, 16,
-	407, -155, -136, 191, -211, -286, 192, 377, -289, 349,
-	-179, -179, -156, -64, -72, 81, 54, -217, 407, 327,
-	326, 322, -214, -215, 321, 323, 320, 324, 51, 266,
-	267, 268, 269, -192, -142, 115, 229, 152, -121, -163,
-	-163, -165, -156, -217, 56, 130, -211, -166, 60, -223,
-	-84, -84, -1, -156, -123, 13, 55, 119, -173, 274,
-	70, -257, 56, 55, -179, -179, -179, 23, -185, 56,
-	56, 56, 56, 11, -179, -179, -179, -179, -179, 56,
-	11, 11, -179, -179, -179, -179, -179, -185, -182, -177,
-	-175, -175, -180, 205, 80, -179, -178, -320, 87, -179,
-	55, 52, -318, 56, 11, 56, 56, 56, 52, 56,
-	55, 56, -179, -123, -186, -284, -283, -282, 33, -51,
-	-70, -277, -156, -315, -282, -156, -149, -146, -154, -147,
-	65, -68, -71, -211, 107, 107, 57, -155, 328, -155,
-	-211, -224, 407, 27, -295, 343, 338, 340, 119, 23,
-	24, -79, -80, -81, -86, -82, -136, -168, -83, 196,
-	194, 198, -311, 76, 199, 250, 77, 189, -216, -218,
-	329, 330, 331, 332, 80, -215, 60, 60, 60, 60,
-	-84, -148, 89, -148, -148, -121, -121, -163, -170, -171,
-	-169, 276, -272, 328, 319, 56, 56, -122, 14, 16,
-	-59, -156, 107, -179, 56, 56, 56, -87, -93, 116,
+	-1000, -339, -2, -1, -3, -4, -5, -6, -40, -21,
+	-7, -50, -33, -34, -36, -42, -47, -48, -49, -35,
+	-52, -16, -15, -14, 8, 10, -8, -163, -22, -23,
+	-24, -25, -26, -27, -28, -29, -30, -31, -32, 185,
+	9, 49, -37, -38, -39, -43, -44, -45, -46, 293,
+	299, 336, 369, -53, -55, -17, -18, -19, -20, 181,
+	-9, -10, -11, -12, -13, 203, 202, 26, 201, 182,
+	120, 121, 123, 124, 30, -54, -327, 54, 183, -56,
+	408, 6, 456, -63, 27, -85, -160, 57, -148, -151,
+	411, 412, 413, 414, 415, 416, 417, 418, 419, 420,
+	421, 422, 423, 426, 427, 428, 429, 430, 431, 432,
+	433, 434, 435, 436, 437, 438, 439, 440, 441, 442,
+	424, 425, 443, 444, 445, 446, 447, 448, 405, 220,
+	244, 247, 254, 120, 137, 131, 159, 151, 152, 128,
+	224, 225, 64, 123, 364, 350, 335, 126, 239, 241,
+	243, 246, 226, 147, 142, 238, 222, 140, 227, 28,
+	228, 164, 229, 230, 410, 346, 275, 368, 352, 251,
+	141, 347, 242, 349, 165, 169, 356, 299, 135, 136,
+	354, 358, 163, 200, 32, 407, 34, 212, 359, 167,
+	162, 158, 367, 255, 253, 161, 134, 157, 223, 38,
+	171, 170, 172, 329, 330, 331, 332, 231, 232, 287,
+	355, 154, 143, 279, 129, 18, 362, 207, 351, 300,
+	233, 248, 210, 256, 166, 168, 214, 219, 409, 252,
+	360, 139, 211, 240, 206, 363, 130, 293, 302, 234,
+	124, 245, 357, 366, 37, 315, 133, 127, 197, 121,
+	216, 221, 235, 236, 237, 258, 257, 249, 155, 213,
+	160, 132, 156, 122, 215, 365, 316, 455, 277, 318,
+	153, 150, 217, 190, 361, 353, 125, 322, 317, 148,
+	259, 451, 452, 453, 454, 288, 274, 260, 261, 11,
+	-164, 19, 333, -41, 334, 185, 54, -160, -5, -4,
+	-33, -52, 188, -60, -61, -62, -128, -130, -85, 54,
+	-160, -239, -209, -238, -210, -241, -211, -159, 20, 182,
+	181, 215, 10, 183, 297, 189, 8, 6, 298, 201,
+	9, 299, 301, 302, 305, 306, 307, 31, 310, 311,
+	57, 60, -160, -239, -209, 219, 226, -261, 335, 409,
+	-67, -68, -129, 15, 5, -276, 318, 218, -203, -201,
+	308, 198, 197, 76, 371, 187, -340, -273, 353, 352,
+	-168, 351, 344, 346, 181, 189, 354, 32, 356, 357,
+	347, 318, 125, 122, -225, 80, 130, 129, -225, 218,
+	29, -232, 328, -231, -233, 146, 356, 357, 367, -226,
+	355, -146, -160, 58, 59, 75, 152, 149, -68, -129,
+	-67, -54, -55, -53, -55, 318, 218, 189, 188, 371,
+	-329, 403, -328, -160, -275, -335, 20, -280, 178, 179,
+	180, 21, 22, -1, -74, 210, -85, 119, -60, -140,
+	-160, 335, 89, -41, -41, 334, -330, -331, -332, -334,
+	185, 334, 333, 119, -85, 30, -131, -132, -133, -134,
+	41, 45, 47, 42, 43, 44, 48, -342, 23, -156,
+	-162, 23, -157, 60, -158, -151, 57, 58, 59, -53,
+	-55, 51, 55, 11, 55, 54, 457, 58, 295, 309,
+	318, 296, 308, 190, 218, 309, 218, 344, 190, 300,
+	303, 304, 345, 51, 191, 51, -290, 367, 65, -51,
+	27, -70, 17, -56, -55, 16, 20, 21, -337, 188,
+	403, -199, 193, -199, 189, -199, -341, 11, 99, 217,
+	216, 348, 345, -248, 349, 350, -168, -167, 97, -168,
+	188, 371, -274, 193, 360, 408, 128, 129, 130, -236,
+	20, 29, 327, -209, 218, 55, 89, 19, -234, 89,
+	100, -234, -233, -233, -233, -234, -105, 29, -158, 60,
+	116, -105, 29, 119, 30, 30, -69, -70, -56, -55,
+	-68, -67, -68, 56, 56, -274, -274, -274, -274, -274,
+	55, -329, -73, 54, -57, -58, 107, -183, -160, 81,
+	-185, 57, -178, 411, 412, 413, 414, 415, 416, 417,
+	418, 419, 420, 421, 422, 423, 426, 427, 429, 430,
+	431, 432, 433, 434, 435, 436, 437, 438, 439, 440,
+	441, 442, 424, 425, 443, 444, 445, 446, 447, 448,
+	287, 318, 148, 288, -179, -181, -308, -303, -176, 54,
+	105, 106, 113, 82, -180, -262, 24, 84, 379, -136,
+	-137, -138, -139, -304, -302, 60, 65, 69, 71, 72,
+	70, 67, 144, 118, -55, -322, -149, -279, -285, -283,
+	149, 204, 145, 8, 111, 328, 116, 146, -286, 59,
+	58, 281, 75, 282, 283, 371, 278, 284, 193, 333,
+	43, 285, 286, 289, 378, 290, 44, 291, 280, 208,
+	292, 382, 381, 383, 375, 372, 370, 373, 374, 376,
+	377, -57, -281, 33, -52, 54, 30, 54, -160, -150,
+	276, 186, 20, 80, 23, 25, 281, 313, 83, 116,
+	16, 84, 149, 115, 283, 379, 282, 181, 47, 75,
+	381, 383, 382, 372, 370, 320, 324, 326, 323, 371,
+	344, 29, 10, 26, 202, 21, 22, 109, 183, 204,
+	87, 88, 205, 24, 203, 72, 19, 50, 11, 333,
+	13, 14, 284, 319, 193, 192, 99, 337, 189, 45,
+	8, 118, 27, 96, 321, 41, 77, 43, 97, 17,
+	373, 374, 31, 336, 404, 209, 111, 285, 286, 48,
+	81, 327, 70, 51, 78, 15, 46, 98, 184, 378,
+	44, 218, 325, 289, 291, 403, 290, 187, 6, 280,
+	380, 30, 201, 42, 188, 345, 86, 191, 71, 208,
+	145, 5, 76, 9, 49, 52, 375, 376, 377, 33,
+	85, 12, 292, 408, 328, 338, 339, 340, 341, 342,
+	343, 173, 174, 175, 176, 177, 250, 196, 194, 198,
+	199, 449, 450, 178, 179, 271, 273, 146, -125, 12,
+	119, 65, 60, -41, 56, 55, -333, 71, 72, -336,
+	163, 155, -160, 54, -224, -223, -140, -61, -61, -61,
+	-61, 41, 41, 41, 46, 41, 46, 41, -133, -160,
+	-162, 56, -240, 188, 294, 214, -238, 215, 299, 302,
+	-215, -214, -212, -159, 60, -210, -243, -140, -159, 345,
+	-240, -215, -214, 337, 450, -51, -183, -160, -66, -65,
+	-183, 190, -199, -215, 81, -209, -158, -160, -85, -167,
+	-167, -169, -341, -165, -341, 345, -125, -181, -248, -166,
+	-160, -199, -215, 318, 24, 361, 362, 126, 129, 128,
+	368, -237, 327, 20, -209, -231, -227, 60, 328, -214,
+	-235, 51, 116, -287, -183, 29, -235, -234, -234, -234,
+	-235, 115, -160, -51, -69, -51, -70, -215, -209, -160,
+	-86, -85, -161, -158, -151, -328, 23, -72, -160, -124,
+	55, -123, 11, -155, 80, 78, 79, -160, 23, 60,
+	119, -183, 96, -194, 89, 90, 91, 92, 93, 94,
+	95, 54, 54, 54, 54, 54, 54, 54, 54, -192,
+	54, 54, 54, 54, 54, 54, 54, 54, -192, 54,
+	54, 54, 54, 54, 54, 54, 102, 101, 112, 105,
+	106, 107, 108, 109, 110, 111, 103, 104, 99, 81,
+	97, 98, 83, -55, -183, -189, -181, -181, -181, -181,
+	-262, -187, -183, 54, 60, 65, -160, 106, 54, 54,
+	54, -284, 54, -191, -192, 54, 60, 60, 54, 54,
+	54, -181, 60, 54, -124, -282, -190, -321, 449, -76,
+	56, -71, -160, -319, -320, -71, -75, -160, -68, -183,
+	-153, -154, -145, -150, -157, -158, -151, 19, -41, -331,
+	119, -72, -125, 55, 89, -78, -77, 51, 52, -79,
+	51, -77, 41, 41, -73, -242, 107, 57, 55, -213,
+	319, 457, 58, 56, 55, -242, 191, 60, 60, 55,
+	18, 119, 55, -64, 25, 26, -85, 193, -85, -216,
+	-217, 325, 24, -202, 52, -197, -198, -196, -200, 29,
+	-125, -125, -125, -167, -161, -169, -164, -169, -165, 119,
+	-147, -160, -216, 54, 127, 130, 130, 129, -209, 191,
+	54, 89, -235, -235, -235, 29, -159, -51, -51, 51,
+	55, 54, 56, 55, -125, -58, -59, -60, -183, -183,
+	-183, -160, 60, -160, 107, -177, 81, 274, 70, 71,
+	72, -178, -195, 20, 260, 261, -188, -189, -183, -135,
+	21, 20, -135, -135, -183, -135, 107, -189, -189, 56,
+	-263, 65, -323, -324, 384, 385, 386, 387, 388, 389,
+	390, 391, 392, 393, 394, 285, 280, 286, 284, 278,
+	292, 287, 288, 148, 401, 402, 395, 396, 397, 398,
+	399, 400, -135, -135, -135, -179, -135, -135, -324, -324,
+	-183, -338, 272, 271, 273, -135, -135, -135, -135, -179,
+	-179, -179, -179, -179, -179, -179, -179, -179, -179, -179,
+	-179, -186, -193, -262, 54, 99, 97, 98, 83, -181,
+	-179, -179, 56, 55, -326, -325, 85, -183, -323, -323,
+	65, -188, -183, -188, -188, 56, -189, -188, -179, -188,
+	-135, -125, 55, 54, 56, 55, 33, 119, 55, 89,
+	56, 55, -69, 119, 335, -160, 56, -68, -223, -183,
+	-183, 54, -183, 11, 119, 119, -214, 16, 408, -159,
+	-140, 191, -215, -97, 333, -97, -183, -183, -160, -65,
+	-73, 81, 54, -221, 408, 327, 326, 322, -218, -219,
+	321, 323, 320, 324, 51, 266, 267, 268, 269, -196,
+	-146, 115, 229, 152, -125, -167, -167, -169, -160, -221,
+	56, 130, -215, -170, 60, -227, -85, -85, -1, -160,
+	-127, 13, 55, 119, -177, 274, 70, -262, 56, 55,
+	-183, -183, -183, 23, -189, 56, 56, 56, 56, 11,
+	-183, -183, -183, 99, -183, -183, 55, 55, 56, 11,
+	11, -183, -183, -183, -183, -183, -189, -186, -181, -179,
+	-179, -184, 205, 80, -183, -182, -325, 87, -183, 55,
+	52, -323, 56, 11, 56, 56, 56, 52, 56, 55,
+	56, -183, -127, -190, -289, -288, -287, 33, -52, -71,
+	-282, -160, -320, -287, -160, -153, -150, -158, -151, 65,
+	-69, -72, -215, 107, 107, 57, -159, 328, -159, -215,
+	-228, 408, -291, 192, 378, 60, -294, 349, 119, 23,
+	24, -80, -81, -82, -87, -83, -140, -172, -84, 196,
+	194, 198, -316, 76, 199, 250, 77, 189, -220, -222,
+	329, 330, 331, 332, 80, -219, 60, 60, 60, 60,
+	-85, -152, 89, -152, -152, -125, -125, -167, -174, -175,
+	-173, 276, -277, 328, 319, 56, 56, -126, 14, 16,
+	-60, -160, 107, -183, 56, 56, 56, -88, -94, 116,
 	149, 204, 148, 147, 145, 315, 316, 140, 141, 142,
-	139, 56, -179, 56, 56, 56, 56, 56, -179, -179,
-	11, 56, 56, 56, 56, 56, -180, 80, -177, -174,
-	56, 88, -179, 86, -87, -102, -179, -102, -175, 56,
-	-122, 56, 55, -277, 56, -155, 16, 23, -212, 299,
-	188, -266, 448, -293, 338, 16, 16, -51, -84, 56,
-	55, -88, -92, -89, -91, -90, -94, -93, 149, 150,
-	116, 153, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 30, 204, 145, 146, 147, 148, 165, 131,
-	151, 405, 173, 132, 174, 133, 175, 134, 176, 135,
-	136, 177, 137, -83, -156, 77, -310, -311, -195, -310,
-	77, 54, -218, 65, 65, 65, 65, -215, 54, -102,
-	-104, -154, 60, 116, 60, -121, -169, 277, 31, 118,
-	279, 29, 275, 16, -179, -185, 56, -259, -261, 54,
-	-260, 54, -259, -259, -259, -95, 136, 135, -95, -263,
-	54, -263, -264, 54, -263, 56, 56, 56, -179, -174,
-	-179, 56, 56, 56, 19, 56, 56, -282, -155, -155,
-	-224, 300, -84, -109, 449, 60, 16, 60, -291, 60,
-	-72, -100, -101, -118, 313, 220, -196, 224, 64, 225,
-	335, 226, 189, 228, 229, 230, 200, 231, 232, 233,
-	328, 234, 235, 236, 237, 296, 5, 262, -81, -99,
-	-98, -96, 70, 81, 29, 313, -97, 64, 115, 243,
-	221, 244, -117, -167, 194, 76, 77, 301, -168, -265,
-	316, 315, -259, -260, -260, -259, -259, 54, 54, -259,
-	-262, 54, -262, -259, -307, -308, -156, -308, -156, -307,
-	-307, -195, -179, -200, -202, -136, 54, 65, -273, -166,
-	65, 65, 65, 65, 56, -179, -287, -244, -139, 450,
-	65, 60, 340, 23, -240, 210, 55, -118, -148, -148,
-	-142, 115, -148, -148, -148, -148, 227, 227, -148, -148,
-	-148, -148, -148, -148, -148, -148, -148, -148, -148, -148,
-	-148, -148, 54, -96, 70, -175, 60, -104, -105, 29,
-	242, 238, -106, 29, 222, 223, -108, 54, 250, 77,
-	77, -84, -267, 317, -138, 60, -138, 65, 54, 52,
-	259, 54, 54, 54, -308, 56, 56, 55, -259, -179,
-	278, 56, 56, 56, 55, 56, 55, 56, -294, 343,
-	-290, -288, 338, 339, 340, 341, 54, 16, -51, 16,
-	-118, 65, 65, -148, -148, 65, 60, 60, 60, -148,
-	-148, 65, 60, -158, 65, 65, 65, 65, 29, 60,
-	-107, 29, 238, 242, 239, 240, 241, 65, 29, 65,
-	29, 65, 29, -156, 54, -312, -313, 60, 65, 54,
-	-201, 54, 56, 55, 56, 56, -200, -309, 266, 267,
-	268, 270, 269, -309, -200, -200, -200, 54, -226, -225,
-	251, 81, -203, -202, -63, 56, 65, 65, -296, 192,
-	-292, 342, -288, 16, 340, 16, 16, -140, -156, -291,
-	-241, 252, 253, -242, -248, 255, -102, -102, 60, 60,
-	-103, 221, -85, 56, 55, 89, 56, -179, -111, -110,
-	403, -200, 60, 56, 56, 56, 56, -200, 251, -204,
-	200, 64, 407, 264, 265, -63, 56, 56, -302, 54,
-	65, -293, 16, -291, 16, -291, -291, 56, 55, -246,
-	256, 54, -244, 54, -244, 77, 267, 222, 223, 56,
-	-313, 60, 56, -115, -116, -113, -114, 51, 347, 248,
-	249, 56, -203, -203, -203, -203, 56, -148, 60, 263,
-	-306, 30, 56, -301, -300, -137, -297, -156, 343, 60,
-	-291, -156, -243, 257, 65, -175, 54, -175, 54, -245,
-	254, 54, -225, -114, 51, -113, 51, 10, 9, -117,
-	65, -154, -305, -304, -303, 56, 55, 119, -250, 54,
-	16, 56, -239, 56, -239, 54, 89, -175, -112, 245,
-	246, 30, 129, -112, 55, 89, -300, -156, -251, -249,
-	210, -242, 56, 56, -239, 65, 56, 70, 29, 247,
-	-304, 29, -179, 119, 56, 55, 57, -247, 258, 56,
-	-156, -249, -252, 33, 65, -256, -253, 54, -118, 212,
-	-256, -118, -255, -254, 257, 213, 56, 55, 57, 54,
-	-254, -253, -185, 56,
+	139, 56, -183, 56, 56, 56, -183, 56, 56, -183,
+	-183, -183, -183, 11, 56, 56, 56, 56, 56, -184,
+	80, -181, -178, 56, 88, -183, 86, -88, -105, -183,
+	-105, -179, 56, -126, 56, 55, -282, 56, -159, 16,
+	23, -216, 299, 27, -300, 343, 338, 340, -52, -85,
+	56, 55, -89, -93, -90, -92, -91, -95, -94, 149,
+	150, 116, 153, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 30, 204, 145, 146, 147, 148, 165,
+	131, 151, 406, 173, 132, 174, 133, 175, 134, 176,
+	135, 136, 177, 137, -84, -160, 77, -315, -316, -199,
+	-315, 77, 54, -222, 65, 65, 65, 65, -219, 54,
+	-105, -107, -158, 60, 116, 60, -125, -173, 277, 31,
+	118, 279, 29, 275, 16, -183, -189, 56, -264, -266,
+	54, -265, 54, -264, -264, -264, -96, 136, 135, -96,
+	-268, 54, -268, -269, 54, -268, 56, 56, 55, 55,
+	56, 56, -183, -178, -183, 56, 56, 56, 19, 56,
+	56, -287, -159, -159, -228, 300, 188, -271, 451, -298,
+	338, 16, 16, -73, -103, -104, -122, 313, 220, -200,
+	224, 64, 225, 335, 226, 189, 228, 229, 230, 200,
+	231, 232, 233, 328, 234, 235, 236, 237, 296, 5,
+	262, -82, -102, -101, -99, 70, 81, 29, 313, -100,
+	64, 115, 243, 221, 244, -121, -171, 194, 76, 77,
+	301, -172, -270, 316, 315, -264, -265, -265, -264, -264,
+	54, 54, -264, -267, 54, -267, -264, -312, -313, -160,
+	-313, -160, -312, -312, -199, -183, -204, -206, -140, 54,
+	65, -278, -170, 65, 65, 65, 65, -183, -183, 56,
+	-183, -85, -112, 452, 60, 16, 60, -296, 60, 23,
+	-244, 210, 55, -122, -152, -152, -146, 115, -152, -152,
+	-152, -152, 227, 227, -152, -152, -152, -152, -152, -152,
+	-152, -152, -152, -152, -152, -152, -152, -152, 54, -99,
+	70, -179, 60, -107, -108, 29, 242, 238, -109, 29,
+	222, 223, -111, 54, 250, 77, 77, -85, -272, 317,
+	-142, 60, -142, 65, 54, 52, 259, 54, 54, 54,
+	-313, 56, 56, 55, -264, -183, 278, 56, 56, 56,
+	55, 56, 55, 56, 56, 56, -292, -248, -143, 453,
+	65, 60, 340, -52, 16, -122, 65, 65, -152, -152,
+	65, 60, 60, 60, -152, -152, 65, 60, -162, 65,
+	65, 65, 65, 29, 60, -110, 29, 238, 242, 239,
+	240, 241, 65, 29, 65, 29, 65, 29, -160, 54,
+	-317, -318, 60, 65, 54, -205, 54, 56, 55, 56,
+	56, -204, -314, 266, 267, 268, 270, 269, -314, -204,
+	-204, -204, 54, -230, -229, 251, 81, -207, -206, -64,
+	56, 65, 65, -299, 343, -295, -293, 338, 339, 340,
+	341, -113, 454, 54, 16, -245, 252, 253, -246, -252,
+	255, -105, -105, 60, 60, -106, 221, -86, 56, 55,
+	89, 56, -183, -115, -114, 404, -204, 60, 56, 56,
+	56, 56, -204, 251, -208, 200, 64, 408, 264, 265,
+	-64, 56, 56, -301, 192, -297, 342, -293, 16, 340,
+	16, 16, -98, 225, 65, -144, -160, -296, -250, 256,
+	54, -248, 54, -248, 77, 267, 222, 223, 56, -318,
+	60, 56, -119, -120, -117, -118, 51, 347, 248, 249,
+	56, -207, -207, -207, -207, 56, -152, 60, 263, -307,
+	54, 65, -298, 16, -296, 16, -296, -296, 60, 56,
+	55, -247, 257, 65, -179, 54, -179, 54, -249, 254,
+	54, -229, -118, 51, -117, 51, 10, 9, -121, 65,
+	-158, -311, 30, 56, -306, -305, -141, -302, -160, 343,
+	60, -296, -160, -254, 54, 16, 56, -243, 56, -243,
+	54, 89, -179, -116, 245, 246, 30, 129, -116, -310,
+	-309, -308, 56, 55, 119, -255, -253, 210, -246, 56,
+	56, -243, 65, 56, 70, 29, 247, 55, 89, -305,
+	-160, 56, 55, 57, -251, 258, 56, -309, 29, -183,
+	119, -253, -256, 33, 65, -160, -260, -257, 54, -122,
+	212, -260, -122, -259, -258, 257, 213, 56, 55, 57,
+	54, -258, -257, -189, 56,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	21, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	447, 448, 449, 0, 0, 274, 0, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 237, 210, 0, 0,
-	181, 161, 162, 163, 119, 120, 121, 122, 0, 0,
-	0, 334, -2, 450, 451, 452, 453, -2, 275, 276,
-	277, 278, 279, 199, 200, 201, -2, 0, 174, 0,
-	166, 166, 0, 354, 0, 0, 0, 365, 0, 378,
-	20, 312, 0, 317, 629, 665, 666, 667, 1356, 1357,
-	1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
-	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
-	1388, 1389, 1390, 1391, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
-	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
-	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
-	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
-	1342, 1343, 1344, 1345, 0, 190, 0, 0, 194, 0,
-	0, 0, 271, 186, 187, 188, 189, 0, 0, 400,
-	401, 424, 427, 430, 0, 180, 0, 0, 80, 495,
-	82, 497, 0, 86, 88, 89, -2, 93, 94, 95,
-	96, 97, 98, 99, 0, 101, 1235, 103, 1296, 106,
-	107, 108, 0, 117, 118, -2, -2, 492, 0, 0,
-	1285, 62, -2, 0, 0, 0, 370, 456, 526, 526,
-	0, 526, 539, 503, 504, 505, 524, 525, 0, 0,
-	247, 248, 0, 264, 255, 264, 0, 239, 240, 241,
-	245, 246, 265, 213, 175, 176, 165, 0, 170, 0,
-	164, 0, 0, 133, 0, 138, 0, 0, 1234, 1300,
-	1250, 0, 1268, 0, 159, 152, 153, 1027, 1196, 0,
-	349, 0, 355, 354, 354, 0, 354, 213, 213, 213,
-	213, 213, 342, 0, 344, 347, 0, 0, 379, 380,
-	375, 376, 377, 381, 382, 3, 0, 0, 316, 0,
-	387, 191, 668, 0, 0, 195, 196, 0, 0, 202,
-	0, 205, 1392, 1393, 1394, 0, 0, 0, 0, 0,
-	0, 0, 415, 0, 0, 414, 0, 0, 0, 0,
-	428, 429, 431, 0, 433, 434, 442, 443, 444, 445,
-	446, 0, 354, 76, 0, 0, 0, 0, 0, 499,
-	87, 116, 90, 91, 0, 111, 113, 115, 114, 100,
-	112, 102, 104, 105, 110, 76, 0, 0, 0, 63,
-	337, 0, 318, 0, 367, 369, 0, 371, 372, 0,
-	526, 457, 0, 0, 0, 0, 0, 0, 260, 261,
-	255, 255, 249, 257, 0, 262, 263, 0, 387, 0,
-	0, 0, 526, 0, 0, 0, 0, 168, 0, 173,
-	123, 128, 126, 127, 129, 0, 0, 0, 0, 0,
-	157, 158, 0, 0, 0, 0, 0, 146, 149, 621,
-	622, 623, 150, 151, 0, 1028, 1029, 318, 350, 366,
-	368, 349, -2, 0, 363, 364, 0, 0, 0, 0,
-	0, 0, 343, 0, 0, 395, 389, 391, 435, 28,
-	0, 918, 665, 923, -2, 1357, 1358, 1359, 1360, 1361,
-	1362, 1363, -2, 1365, -2, -2, 1368, 1369, 1370, 1372,
-	-2, -2, -2, -2, -2, -2, 1379, -2, -2, -2,
-	1383, 1384, -2, -2, 1387, 1388, 1389, 1390, 1391, -2,
-	-2, -2, -2, 934, 736, 737, 738, 739, 0, 0,
-	0, 0, 0, 746, 747, 0, 759, 0, 753, 754,
-	755, 756, 38, 39, 954, 955, 956, 957, 958, 959,
-	960, 961, 879, 723, 0, 0, 0, 864, 854, 0,
-	874, 892, 0, 0, 0, 0, 893, 0, 40, 41,
-	870, 871, 872, 873, 875, 876, 877, 878, 880, 881,
-	882, 883, 886, 887, 888, 889, 890, 891, 894, 896,
-	866, 867, 868, 869, 858, 859, 860, 861, 862, 863,
-	395, 286, 304, 288, 0, 293, 0, 630, 354, 0,
-	0, 192, 0, 197, 0, 0, 204, 206, 207, 208,
-	1395, 1396, 272, 0, 387, 183, 0, 418, 412, 0,
-	405, 416, 417, 408, 0, 410, 0, 406, 407, 347,
-	432, 426, 0, 77, 78, 79, 81, 92, 0, 0,
-	70, 480, 486, 483, 493, 496, 0, 84, 498, 109,
-	0, 65, 0, 0, 0, 338, 351, 28, 356, 357,
-	360, 0, 0, 467, 0, 494, 518, -2, 387, 387,
-	387, 255, 0, 257, 0, 257, 252, 256, 0, 266,
-	268, 0, 467, 1327, 214, 177, 178, 0, 0, 172,
-	0, 0, 130, 131, 132, 139, 134, 136, 0, 0,
-	140, 154, 155, 156, 310, 311, 141, 0, 0, 0,
-	145, 0, 160, 336, 318, 340, 318, 280, 281, 0,
-	283, 627, 284, 440, 441, 345, 0, 0, 422, 387,
-	0, 396, 0, 392, 0, 0, 0, 436, 0, 438,
-	0, 913, 0, 0, 942, 943, 944, 945, 946, 947,
-	948, 906, 902, 902, 902, 0, 902, 0, 0, 840,
-	0, 0, 902, 902, 902, 902, 902, 841, 0, 902,
-	902, 902, 902, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 908, 0, 742, 743, 744, 745, 748, 0, 760,
-	0, 898, 0, 0, 0, 906, 906, 906, 843, 0,
-	844, 855, 0, 847, 848, 906, 0, 906, 852, 853,
-	902, 387, 287, 301, 0, 305, 0, 0, 297, 299,
-	292, 294, 0, 0, 314, 349, 388, 669, 0, 1034,
-	-2, 1036, -2, -2, 1038, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
-	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
-	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
-	1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
-	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
-	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
-	1184, 1185, 0, 198, 203, 0, 0, 354, 0, 0,
-	402, 419, 0, 0, 403, 0, 404, 409, 411, 425,
-	0, 71, 75, 0, 482, 0, 0, 485, 83, 0,
-	0, 0, 59, 320, 0, 0, 0, 0, 359, 361,
-	362, 347, 0, 0, 459, 468, 0, 527, 0, 0,
-	523, -2, 530, 0, 536, 238, 242, 243, 387, 258,
-	255, 259, 255, 257, 0, 267, 270, 459, 0, 179,
-	167, 169, 0, 125, 0, 0, 0, 142, 143, 144,
-	147, 148, 339, 341, 0, 0, 20, 348, 0, 385,
-	390, 397, 398, 910, 911, 912, 437, 439, 29, 393,
-	914, 0, 916, 919, 924, 925, 921, 0, 939, -2,
-	-2, 0, 907, 908, 0, 903, 904, 0, 0, 0,
-	0, 0, 0, 0, 856, 0, 953, 0, 811, 812,
-	813, 814, 815, 816, 817, 818, 819, 820, 821, 822,
-	823, 824, 825, 826, 827, 828, 829, 830, 831, 832,
-	833, 834, 835, 836, 837, 838, 839, 0, 0, 0,
-	0, 0, 0, 0, 806, 807, 808, 0, 0, 0,
-	0, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 735, 926, 937, 938, 0, 0, 0, 0,
-	0, 935, 930, 0, 740, 0, 757, 761, 0, 0,
-	899, 900, 0, 0, 908, 0, 0, 865, 0, 0,
-	0, 0, 0, 385, 304, 306, 0, 0, 304, 0,
-	0, 0, 313, 0, 285, 0, 0, 273, 209, 349,
-	184, 185, 420, 0, 413, 0, 0, 0, 481, 0,
-	0, 484, 85, 0, 67, 0, 60, 61, 324, 0,
-	352, 353, 29, 358, 0, 0, 631, 458, 0, 469,
-	470, 471, 472, 473, 0, 0, 0, 0, 0, 519,
-	520, 521, 522, 531, 1030, 1030, 1030, 0, 250, 387,
-	387, 255, 269, 215, 0, 171, 124, 0, 227, 135,
-	282, 628, 0, 423, 383, 0, 0, 0, 915, 917,
-	920, 922, 794, 0, 0, 0, 0, 0, 0, 783,
-	777, 778, 857, 0, 0, 0, 0, 0, 0, 802,
-	0, 0, 0, 0, 0, 0, 0, 0, 927, 935,
-	931, 0, 928, 0, 0, 909, 0, 762, 0, 0,
-	0, 0, 901, 795, 0, 799, 842, 845, 0, 849,
-	0, 851, 0, 383, 302, 0, 307, 308, 304, 291,
-	298, 290, 300, 295, 296, 315, 670, 1035, 1032, 1033,
-	193, 182, 0, 69, 72, 73, 74, 487, 0, 488,
-	467, 66, 0, 0, 326, 48, 0, 0, 0, 0,
-	0, 0, 632, 633, 635, 636, 0, 0, 638, 692,
-	0, 647, 526, 647, 0, 0, 649, 650, 460, 461,
-	0, 0, 0, 0, 0, 475, 476, 477, 478, 479,
-	0, 0, 1031, 0, 0, 253, 251, 387, 211, 216,
-	217, 0, 221, 0, 0, 137, 346, 373, 0, 0,
-	399, 30, 394, 909, 779, 780, 781, 0, 764, 1009,
-	1013, 767, 1009, 1009, 1009, 773, 773, 1016, 1016, 1019,
-	1016, 782, 0, 801, 784, 785, 788, 786, 0, 0,
-	0, 800, 789, 790, 776, 905, 929, 0, 936, 932,
-	741, 749, 758, 0, 0, 0, 0, 0, 0, 787,
-	374, 303, 0, 289, 421, 491, 0, 0, 67, 0,
-	0, 328, 0, 325, 0, 0, 0, 454, 347, -2,
-	0, -2, 1022, 963, 964, 965, 1009, 967, 1013, 1013,
-	1009, 1009, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 0, 0, 986, 1009, 1011, 1011, 1009, 1006, 968,
-	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
-	979, 980, 981, 637, 693, 659, 659, 648, 659, 659,
-	526, 0, 462, 463, 464, 465, 466, 474, 0, 532,
-	533, 624, 625, 626, 534, 254, 218, 219, 220, 0,
-	223, 224, 226, 0, 384, 386, 750, 765, 1010, 0,
-	766, 0, 768, 769, 770, 771, 774, 775, 772, 982,
-	0, 983, 984, 0, 985, 798, 803, 804, 0, 933,
-	763, 751, 752, 796, 0, 846, 850, 309, 489, 490,
-	64, 68, 50, 330, 0, 327, 0, 321, 323, 58,
-	0, 540, -2, 577, 1030, 1030, 0, 1030, 1030, 1030,
-	1030, 0, 0, 1030, 1030, 1030, 1030, 1030, 1030, 1030,
-	1030, 1030, 1030, 1030, 1030, 1030, 1030, 0, 634, 661,
-	-2, 673, 675, 0, 0, 678, 679, 0, 0, 0,
-	0, 715, 685, 0, 0, 951, 952, 0, 691, 1025,
-	1023, 1024, 966, 991, 992, 993, 994, 0, 0, 987,
-	988, 0, 989, 990, 0, 651, 660, 0, 660, 0,
-	0, 659, 0, 0, 514, 1009, 0, 0, 225, 212,
-	0, 0, 0, 0, 805, 0, 44, 0, 319, 0,
-	329, 49, 0, 0, 537, 0, 535, 579, 0, 0,
-	1030, 1030, 0, 0, 0, 0, 1030, 1030, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 674, 676, 677, 680, 681, 682, 720,
-	721, 722, 683, 717, 718, 719, 684, 0, 0, 949,
-	950, 713, 962, 1026, 0, 1007, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 645, 507, 0, 360, 0,
-	222, 1015, 1014, 1017, 0, 1020, 0, 797, 42, 46,
-	51, 52, 0, 0, 0, 0, 0, 0, 455, 573,
-	578, 580, 581, 0, 0, 584, 585, 586, 587, 0,
-	0, 590, 591, 592, 593, 594, 595, 596, 597, 598,
-	599, 615, 616, 617, 618, 619, 620, 600, 601, 602,
-	603, 604, 605, 612, 0, 0, 609, 0, 0, 0,
-	708, 0, 1004, 0, 1005, 1012, 0, 652, 654, 655,
-	656, 657, 658, 653, 0, 0, 0, 0, 644, 646,
-	688, 0, 506, 515, 516, 360, 0, 0, 31, 0,
-	48, 0, 53, 0, 0, 0, 0, 0, 332, 322,
-	562, 0, 0, 568, 0, 574, 582, 583, 588, 589,
-	606, 0, 0, 608, 0, 0, 716, 0, 695, 709,
-	0, 0, 1008, 507, 507, 507, 507, 0, 689, 508,
-	1030, 0, 0, 512, 513, 517, 1018, 1021, 22, 0,
-	0, 45, 0, 54, 0, 56, 57, 331, 0, 542,
-	0, 0, 0, 0, 0, 571, 0, 613, 614, 607,
-	610, 611, 686, 694, 696, 697, 698, 0, 710, 711,
-	712, 714, 639, 640, 641, 642, 0, 0, 510, 0,
-	21, 0, 32, 0, 34, 36, 37, 662, 43, 47,
-	55, 333, 544, 0, 563, 0, 0, 0, 0, 0,
-	0, 0, 687, 699, 0, 700, 0, 0, 0, 643,
-	509, 511, 23, 24, 0, 33, 0, 0, 541, 0,
-	573, 564, 0, 566, 0, 0, 0, 0, 701, 703,
-	704, 0, 0, 702, 0, 0, 35, 663, 0, 546,
-	0, 560, 565, 567, 0, 572, 570, 705, 707, 706,
-	25, 26, 27, 0, 545, 0, 558, 543, 0, 569,
-	664, 547, -2, 0, 561, 548, -2, 0, 556, 0,
-	549, 557, 0, 552, 0, 0, 551, 0, -2, 0,
-	553, -2, 0, 559,
+	20, 458, 459, 460, 0, 0, 281, 0, 235, 236,
+	237, 238, 239, 240, 241, 242, 243, 244, 217, 0,
+	0, 188, 164, 165, 166, 122, 123, 124, 125, 0,
+	0, 0, 184, 345, -2, 461, 462, 463, 464, -2,
+	282, 283, 284, 285, 286, 206, 207, 208, -2, 0,
+	177, 0, 169, 169, 0, 365, 0, 0, 0, 376,
+	0, 389, 21, 319, 0, 324, 640, 677, 678, 679,
+	1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380,
+	1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390,
+	1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400,
+	1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 0,
+	197, 0, 0, 201, 0, 0, 0, 278, 193, 194,
+	195, 196, 0, 0, 411, 412, 435, 438, 441, 0,
+	187, 0, 0, 83, 506, 85, 508, 0, 89, 91,
+	92, -2, 96, 97, 98, 99, 100, 101, 102, 0,
+	104, 1250, 106, 1311, 109, 110, 111, 0, 120, 121,
+	-2, -2, 503, 0, 0, 1300, 65, 0, 185, 186,
+	-2, 0, 0, 0, 381, 467, 537, 537, 0, 537,
+	550, 514, 515, 516, 535, 536, 0, 0, 254, 255,
+	0, 271, 262, 271, 0, 246, 247, 248, 252, 253,
+	272, 220, 178, 179, 168, 0, 173, 0, 167, 0,
+	0, 136, 0, 141, 0, 0, 1249, 1315, 1265, 0,
+	1283, 0, 162, 155, 156, 1042, 1211, 0, 360, 0,
+	366, 365, 365, 0, 365, 220, 220, 220, 220, 220,
+	353, 0, 355, 358, 0, 0, 390, 391, 386, 387,
+	388, 392, 393, 3, 0, 0, 323, 0, 398, 198,
+	680, 0, 0, 202, 203, 0, 0, 209, 0, 212,
+	1409, 1410, 1411, 0, 0, 0, 0, 0, 0, 0,
+	426, 0, 0, 425, 0, 0, 0, 0, 439, 440,
+	442, 0, 444, 445, 453, 454, 455, 456, 457, 0,
+	365, 79, 0, 0, 0, 0, 0, 510, 90, 119,
+	93, 94, 0, 114, 116, 118, 117, 103, 115, 105,
+	107, 108, 113, 79, 0, 0, 0, 66, 183, 348,
+	0, 325, 0, 378, 380, 0, 382, 383, 0, 537,
+	468, 0, 0, 0, 0, 0, 0, 267, 268, 262,
+	262, 256, 264, 0, 269, 270, 0, 398, 0, 0,
+	0, 537, 0, 0, 0, 0, 171, 0, 176, 126,
+	131, 129, 130, 132, 0, 0, 0, 0, 0, 160,
+	161, 0, 0, 0, 0, 0, 149, 152, 632, 633,
+	634, 153, 154, 0, 1043, 1044, 325, 361, 377, 379,
+	360, -2, 0, 374, 375, 0, 0, 0, 0, 0,
+	0, 354, 0, 0, 406, 400, 402, 446, 31, 0,
+	933, 677, 938, -2, 1372, 1373, 1374, 1375, 1376, 1377,
+	1378, -2, 1380, -2, -2, 1383, 1384, 1385, 1387, -2,
+	1389, -2, -2, -2, -2, 1394, -2, -2, -2, 1398,
+	1399, -2, 1401, 1402, -2, 1404, 1405, 1406, 1407, 1408,
+	-2, -2, -2, -2, 949, 748, 749, 750, 751, 0,
+	0, 0, 0, 0, 758, 759, 0, 771, 0, 765,
+	766, 767, 768, 41, 42, 969, 970, 971, 972, 973,
+	974, 975, 976, 894, 735, 0, 0, 0, 879, 869,
+	0, 889, 907, 0, 0, 0, 0, 908, 0, 43,
+	44, 885, 886, 887, 888, 890, 891, 892, 893, 895,
+	896, 897, 898, 901, 902, 903, 904, 905, 906, 909,
+	911, 881, 882, 883, 884, 873, 874, 875, 876, 877,
+	878, 406, 293, 311, 295, 0, 300, 0, 641, 642,
+	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
+	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
+	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
+	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
+	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
+	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
+	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
+	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
+	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 365, 0,
+	0, 199, 0, 204, 0, 0, 211, 213, 214, 215,
+	1412, 1413, 279, 0, 398, 190, 0, 429, 423, 0,
+	416, 427, 428, 419, 0, 421, 0, 417, 418, 358,
+	443, 437, 0, 80, 81, 82, 84, 95, 0, 0,
+	73, 491, 497, 494, 504, 507, 0, 87, 509, 112,
+	0, 68, 0, 0, 0, 349, 362, 31, 367, 368,
+	371, 0, 0, 478, 0, 505, 529, -2, 398, 398,
+	398, 262, 0, 264, 0, 264, 259, 263, 0, 273,
+	275, 0, 478, 1342, 221, 180, 181, 0, 0, 175,
+	0, 0, 133, 134, 135, 142, 137, 139, 0, 0,
+	143, 157, 158, 159, 317, 318, 144, 0, 0, 0,
+	148, 0, 163, 347, 325, 351, 325, 287, 288, 0,
+	290, 638, 291, 451, 452, 356, 0, 0, 433, 398,
+	0, 407, 0, 403, 0, 0, 0, 447, 0, 449,
+	0, 928, 0, 0, 957, 958, 959, 960, 961, 962,
+	963, 921, 917, 917, 917, 0, 917, 0, 0, 855,
+	0, 0, 917, 917, 917, 0, 917, 917, 856, 0,
+	0, 0, 917, 917, 917, 917, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, -2, 923, 0, 754, 755, 756, 757,
+	760, 0, 772, 0, 913, 0, 0, 0, 921, 921,
+	921, 858, 0, 859, 870, 0, 862, 863, 921, 0,
+	921, 867, 868, 917, 398, 294, 308, 0, 312, 0,
+	0, 304, 306, 299, 301, 0, 0, 321, 360, 399,
+	681, 0, 1049, -2, 1051, -2, -2, 0, 205, 210,
+	0, 0, 365, 0, 0, 413, 430, 0, 0, 414,
+	0, 415, 420, 422, 436, 0, 74, 78, 0, 493,
+	0, 0, 496, 86, 0, 0, 0, 23, 23, 0,
+	0, 0, 0, 370, 372, 373, 358, 0, 0, 470,
+	479, 0, 538, 0, 0, 534, -2, 541, 0, 547,
+	245, 249, 250, 398, 265, 262, 266, 262, 264, 0,
+	274, 277, 470, 0, 182, 170, 172, 0, 128, 0,
+	0, 0, 145, 146, 147, 150, 151, 350, 352, 0,
+	0, 21, 359, 0, 396, 401, 408, 409, 925, 926,
+	927, 448, 450, 32, 404, 929, 0, 931, 934, 939,
+	940, 936, 0, 954, -2, -2, 0, 922, 923, 0,
+	918, 919, 0, 0, 0, 0, 0, 0, 0, 871,
+	0, 968, 0, 826, 827, 828, 829, 830, 831, 832,
+	833, 834, 835, 836, 837, 838, 839, 840, 841, 842,
+	843, 844, 845, 846, 847, 848, 849, 850, 851, 852,
+	853, 854, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 821, 822, 823, 0, 0, 0, 0, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 941, 952, 953, 0, 0, 0, 0, 0, 950,
+	945, 0, 752, 0, 769, 773, 0, 0, 914, 915,
+	0, 0, 923, 0, 0, 880, 0, 0, 0, 0,
+	0, 396, 311, 313, 0, 0, 311, 0, 0, 0,
+	320, 0, 292, 0, 0, 280, 216, 360, 191, 192,
+	431, 0, 424, 0, 0, 0, 492, 0, 0, 495,
+	88, 0, 70, 62, 0, 327, 363, 364, 32, 369,
+	0, 0, 643, 469, 0, 480, 481, 482, 483, 484,
+	0, 0, 0, 0, 0, 530, 531, 532, 533, 542,
+	1045, 1045, 1045, 0, 257, 398, 398, 262, 276, 222,
+	0, 174, 127, 0, 234, 138, 289, 639, 0, 434,
+	394, 0, 0, 0, 930, 932, 935, 937, 806, 0,
+	0, 0, 0, 0, 0, 795, 789, 790, 872, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 817, 0,
+	0, 0, 0, 0, 0, 0, 0, 942, 950, 946,
+	0, 943, 0, 0, 924, 0, 774, 0, 0, 0,
+	0, 916, 807, 0, 813, 857, 860, 0, 864, 0,
+	866, 0, 394, 309, 0, 314, 315, 311, 298, 305,
+	297, 307, 302, 303, 322, 682, 1050, 1047, 1048, 200,
+	189, 0, 72, 75, 76, 77, 498, 0, 499, 478,
+	69, 0, 0, 63, 64, 24, 331, 0, 0, 0,
+	0, 0, 644, 645, 647, 648, 0, 0, 650, 704,
+	0, 659, 537, 659, 0, 0, 661, 662, 471, 472,
+	0, 0, 0, 0, 0, 486, 487, 488, 489, 490,
+	0, 0, 1046, 0, 0, 260, 258, 398, 218, 223,
+	224, 0, 228, 0, 0, 140, 357, 384, 0, 0,
+	410, 33, 405, 924, 791, 792, 793, 0, 776, 1024,
+	1028, 779, 1024, 1024, 1024, 785, 785, 1031, 1031, 1034,
+	1031, 794, 0, 815, 796, 797, 0, 800, 798, 0,
+	0, 0, 0, 0, 814, 801, 802, 788, 920, 944,
+	0, 951, 947, 753, 761, 770, 0, 0, 0, 0,
+	0, 0, 799, 385, 310, 0, 296, 432, 502, 0,
+	0, 70, 0, 0, 333, 51, 0, 0, 465, 358,
+	-2, 0, -2, 1037, 978, 979, 980, 1024, 982, 1028,
+	1028, 1024, 1024, 1010, 1011, 1012, 1013, 1014, 1015, 1016,
+	1017, 1018, 0, 0, 1001, 1024, 1026, 1026, 1024, 1021,
+	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
+	993, 994, 995, 996, 649, 705, 671, 671, 660, 671,
+	671, 537, 0, 473, 474, 475, 476, 477, 485, 0,
+	543, 544, 635, 636, 637, 545, 261, 225, 226, 227,
+	0, 230, 231, 233, 0, 395, 397, 762, 777, 1025,
+	0, 778, 0, 780, 781, 782, 783, 786, 787, 784,
+	997, 0, 998, 999, 0, 1000, 810, 816, 0, 0,
+	818, 819, 0, 948, 775, 763, 764, 808, 0, 861,
+	865, 316, 500, 501, 67, 71, 0, 335, 0, 332,
+	0, 0, 0, 0, 551, -2, 588, 1045, 1045, 0,
+	1045, 1045, 1045, 1045, 0, 0, 1045, 1045, 1045, 1045,
+	1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045,
+	0, 646, 673, -2, 685, 687, 0, 0, 690, 691,
+	0, 0, 0, 0, 727, 697, 0, 0, 966, 967,
+	0, 703, 1040, 1038, 1039, 981, 1006, 1007, 1008, 1009,
+	0, 0, 1002, 1003, 0, 1004, 1005, 0, 663, 672,
+	0, 672, 0, 0, 671, 0, 0, 525, 1024, 0,
+	0, 232, 219, 0, 0, 0, 0, 0, 0, 820,
+	0, 53, 341, 0, 334, 0, 328, 330, 61, 0,
+	548, 0, 546, 590, 0, 0, 1045, 1045, 0, 0,
+	0, 0, 1045, 1045, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 686,
+	688, 689, 692, 693, 694, 732, 733, 734, 695, 729,
+	730, 731, 696, 0, 0, 964, 965, 725, 977, 1041,
+	0, 1022, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 657, 518, 0, 371, 0, 229, 1030, 1029, 1032,
+	0, 1035, 0, 811, 812, 809, 47, 0, 337, 0,
+	336, 52, 0, 466, 584, 589, 591, 592, 0, 0,
+	595, 596, 597, 598, 0, 0, 601, 602, 603, 604,
+	605, 606, 607, 608, 609, 610, 626, 627, 628, 629,
+	630, 631, 611, 612, 613, 614, 615, 616, 623, 0,
+	0, 620, 0, 0, 0, 720, 0, 1019, 0, 1020,
+	1027, 0, 664, 666, 667, 668, 669, 670, 665, 0,
+	0, 0, 0, 656, 658, 700, 0, 517, 526, 527,
+	371, 0, 0, 45, 49, 54, 55, 0, 0, 0,
+	0, 339, 0, 0, 0, 573, 0, 0, 579, 0,
+	585, 593, 594, 599, 600, 617, 0, 0, 619, 0,
+	0, 728, 0, 707, 721, 0, 0, 1023, 518, 518,
+	518, 518, 0, 701, 519, 1045, 0, 0, 523, 524,
+	528, 1033, 1036, 34, 0, 51, 0, 56, 0, 0,
+	0, 0, 326, 0, 338, 0, 343, 329, 553, 0,
+	0, 0, 0, 0, 582, 0, 624, 625, 618, 621,
+	622, 698, 706, 708, 709, 710, 0, 722, 723, 724,
+	726, 651, 652, 653, 654, 0, 0, 521, 0, 25,
+	0, 0, 48, 0, 57, 0, 59, 60, 340, 342,
+	0, 555, 0, 574, 0, 0, 0, 0, 0, 0,
+	0, 699, 711, 0, 712, 0, 0, 0, 655, 520,
+	522, 22, 0, 35, 0, 37, 39, 40, 674, 46,
+	50, 58, 344, 552, 0, 584, 575, 0, 577, 0,
+	0, 0, 0, 713, 715, 716, 0, 0, 714, 26,
+	27, 0, 36, 0, 0, 0, 557, 0, 571, 576,
+	578, 0, 583, 581, 717, 719, 718, 0, 0, 38,
+	675, 556, 0, 569, 554, 0, 580, 28, 29, 30,
+	0, 558, -2, 0, 572, 676, 559, -2, 0, 567,
+	0, 560, 568, 0, 563, 0, 0, 562, 0, -2,
+	0, 564, -2, 0, 570,
 }
 
 var yyTok1 = [...]int{
@@ -4674,8 +4747,8 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
 	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 452,
-	90, 89, 91, 3, 453, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 456,
+	90, 89, 91, 3, 457, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
@@ -4749,7 +4822,8 @@ var yyTok3 = [...]int{
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
 	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
-	57775, 450, 57776, 451, 0,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5099,7 +5173,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:505
+//line mysql_sql.y:511
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -5107,82 +5181,95 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:511
+//line mysql_sql.y:517
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:534
+//line mysql_sql.y:541
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:538
+//line mysql_sql.y:545
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
-		yyDollar = yyS[yypt-14 : yypt+1]
+	case 22:
+		yyDollar = yyS[yypt-15 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:544
+//line mysql_sql.y:551
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
 				File:              yyDollar[5].str,
-				DuplicateHandling: yyDollar[6].duplicateKeyUnion(),
-				Table:             yyDollar[9].tableNameUnion(),
-				Fields:            yyDollar[10].fieldsUnion(),
-				Lines:             yyDollar[11].linesUnion(),
-				IgnoredLines:      uint64(yyDollar[12].int64ValUnion()),
-				ColumnList:        yyDollar[13].loadColumnsUnion(),
-				Assignments:       yyDollar[14].updateExprsUnion(),
+				FileFormat:        yyDollar[6].str,
+				DuplicateHandling: yyDollar[7].duplicateKeyUnion(),
+				Table:             yyDollar[10].tableNameUnion(),
+				Fields:            yyDollar[11].fieldsUnion(),
+				Lines:             yyDollar[12].linesUnion(),
+				IgnoredLines:      uint64(yyDollar[13].int64ValUnion()),
+				ColumnList:        yyDollar[14].loadColumnsUnion(),
+				Assignments:       yyDollar[15].updateExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 23:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:567
+		{
+			yyVAL.str = ""
+		}
+	case 24:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:571
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:559
+//line mysql_sql.y:576
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:563
+//line mysql_sql.y:580
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:569
+//line mysql_sql.y:586
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:573
+//line mysql_sql.y:590
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:579
+//line mysql_sql.y:596
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5190,10 +5277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:586
+//line mysql_sql.y:603
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5201,58 +5288,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:595
+//line mysql_sql.y:612
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:599
+//line mysql_sql.y:616
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:603
+//line mysql_sql.y:620
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:608
+//line mysql_sql.y:625
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 35:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:612
+//line mysql_sql.y:629
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:616
+//line mysql_sql.y:633
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:622
+//line mysql_sql.y:639
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5262,10 +5349,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:631
+//line mysql_sql.y:648
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5275,42 +5362,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:642
+//line mysql_sql.y:659
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:646
+//line mysql_sql.y:663
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:652
+//line mysql_sql.y:669
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:656
+//line mysql_sql.y:673
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:662
+//line mysql_sql.y:679
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5333,10 +5420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:686
+//line mysql_sql.y:703
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5355,34 +5442,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:705
+//line mysql_sql.y:722
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:709
+//line mysql_sql.y:726
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:714
+//line mysql_sql.y:731
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:718
+//line mysql_sql.y:735
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5390,42 +5477,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:726
+//line mysql_sql.y:743
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:730
+//line mysql_sql.y:747
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:735
+//line mysql_sql.y:752
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:739
+//line mysql_sql.y:756
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:744
+//line mysql_sql.y:761
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:748
+//line mysql_sql.y:765
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5448,36 +5535,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:772
+//line mysql_sql.y:789
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:776
+//line mysql_sql.y:793
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:782
+//line mysql_sql.y:799
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:788
+//line mysql_sql.y:805
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5496,10 +5583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:806
+//line mysql_sql.y:823
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5517,10 +5604,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:823
+//line mysql_sql.y:840
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5538,50 +5625,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:846
+//line mysql_sql.y:863
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:850
+//line mysql_sql.y:867
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:854
+//line mysql_sql.y:871
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:859
+//line mysql_sql.y:876
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:863
+//line mysql_sql.y:880
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:869
+//line mysql_sql.y:886
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5592,10 +5679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:879
+//line mysql_sql.y:896
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5604,10 +5691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:887
+//line mysql_sql.y:904
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5617,26 +5704,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:897
+//line mysql_sql.y:914
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:901
+//line mysql_sql.y:918
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:911
+//line mysql_sql.y:928
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5647,10 +5734,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:921
+//line mysql_sql.y:938
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5659,30 +5746,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:931
+//line mysql_sql.y:948
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:937
+//line mysql_sql.y:954
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:943
+//line mysql_sql.y:960
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5690,10 +5777,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:950
+//line mysql_sql.y:967
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5702,10 +5789,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:958
+//line mysql_sql.y:975
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5713,58 +5800,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:966
+//line mysql_sql.y:983
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:970
+//line mysql_sql.y:987
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:974
+//line mysql_sql.y:991
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:978
+//line mysql_sql.y:995
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:984
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:988
+//line mysql_sql.y:1005
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:994
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5772,10 +5859,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1001
+//line mysql_sql.y:1018
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5783,418 +5870,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1010
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1014
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1020
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1024
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1028
+//line mysql_sql.y:1045
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1032
+//line mysql_sql.y:1049
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1036
+//line mysql_sql.y:1053
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1040
+//line mysql_sql.y:1057
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1044
+//line mysql_sql.y:1061
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1048
+//line mysql_sql.y:1065
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1052
+//line mysql_sql.y:1069
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1056
+//line mysql_sql.y:1073
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1060
+//line mysql_sql.y:1077
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1064
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1068
+//line mysql_sql.y:1085
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1072
+//line mysql_sql.y:1089
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1076
+//line mysql_sql.y:1093
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1080
+//line mysql_sql.y:1097
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1084
+//line mysql_sql.y:1101
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1088
+//line mysql_sql.y:1105
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1092
+//line mysql_sql.y:1109
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1096
+//line mysql_sql.y:1113
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1100
+//line mysql_sql.y:1117
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1104
+//line mysql_sql.y:1121
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1108
+//line mysql_sql.y:1125
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1112
+//line mysql_sql.y:1129
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1116
+//line mysql_sql.y:1133
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1120
+//line mysql_sql.y:1137
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1124
+//line mysql_sql.y:1141
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1128
+//line mysql_sql.y:1145
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1132
+//line mysql_sql.y:1149
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1136
+//line mysql_sql.y:1153
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1140
+//line mysql_sql.y:1157
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1144
+//line mysql_sql.y:1161
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1148
+//line mysql_sql.y:1165
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1160
+//line mysql_sql.y:1177
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 127:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1166
+//line mysql_sql.y:1183
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1174
+//line mysql_sql.y:1191
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1178
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1182
+//line mysql_sql.y:1199
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1186
+//line mysql_sql.y:1203
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1190
+//line mysql_sql.y:1207
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1196
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1200
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1204
+//line mysql_sql.y:1221
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1210
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1216
+//line mysql_sql.y:1233
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1220
+//line mysql_sql.y:1237
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 140:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1227
+//line mysql_sql.y:1244
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1233
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1254
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1243
+//line mysql_sql.y:1260
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6203,10 +6290,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1251
+//line mysql_sql.y:1268
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6215,10 +6302,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1259
+//line mysql_sql.y:1276
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6228,10 +6315,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1268
+//line mysql_sql.y:1285
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6240,10 +6327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1276
+//line mysql_sql.y:1293
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6252,10 +6339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1284
+//line mysql_sql.y:1301
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6279,10 +6366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1307
+//line mysql_sql.y:1324
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6290,10 +6377,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1314
+//line mysql_sql.y:1331
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6301,10 +6388,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1338
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -6313,10 +6400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1329
+//line mysql_sql.y:1346
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6324,10 +6411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1336
+//line mysql_sql.y:1353
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6335,10 +6422,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1343
+//line mysql_sql.y:1360
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6346,198 +6433,230 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1356
+//line mysql_sql.y:1373
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1360
+//line mysql_sql.y:1377
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1364
+//line mysql_sql.y:1381
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1387
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 158:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1374
+//line mysql_sql.y:1391
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 160:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1381
+//line mysql_sql.y:1398
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 164:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1392
+//line mysql_sql.y:1409
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1398
+//line mysql_sql.y:1415
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1403
+//line mysql_sql.y:1420
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1407
+//line mysql_sql.y:1424
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1411
+//line mysql_sql.y:1428
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1415
+//line mysql_sql.y:1432
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1419
+//line mysql_sql.y:1436
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 174:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1423
+//line mysql_sql.y:1440
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1427
+//line mysql_sql.y:1444
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1431
+//line mysql_sql.y:1448
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1437
+//line mysql_sql.y:1454
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1441
+//line mysql_sql.y:1458
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1462
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1449
+//line mysql_sql.y:1466
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1471
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 182:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1459
+//line mysql_sql.y:1476
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 183:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1482
+		{
+			yyLOCAL = tree.NewKill(yyDollar[2].killOptionUnion(), uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 184:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1487
+		{
+			yyLOCAL = tree.KillConnection
+		}
+		yyVAL.union = yyLOCAL
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1491
+		{
+			yyLOCAL = tree.KillConnection
+		}
+		yyVAL.union = yyLOCAL
+	case 186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1495
+		{
+			yyLOCAL = tree.KillQuery
+		}
+		yyVAL.union = yyLOCAL
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1465
+//line mysql_sql.y:1501
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1469
+//line mysql_sql.y:1505
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1475
+//line mysql_sql.y:1511
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6548,84 +6667,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1488
+//line mysql_sql.y:1524
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1492
+//line mysql_sql.y:1528
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1498
+//line mysql_sql.y:1534
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1543
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1513
+//line mysql_sql.y:1549
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1518
+//line mysql_sql.y:1554
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1523
+//line mysql_sql.y:1559
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1527
+//line mysql_sql.y:1563
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1531
+//line mysql_sql.y:1567
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1535
+//line mysql_sql.y:1571
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6635,10 +6754,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1544
+//line mysql_sql.y:1580
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6647,10 +6766,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1552
+//line mysql_sql.y:1588
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6661,76 +6780,76 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 205:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1562
+//line mysql_sql.y:1598
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1575
+//line mysql_sql.y:1611
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1579
+//line mysql_sql.y:1615
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1585
+//line mysql_sql.y:1621
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1591
+//line mysql_sql.y:1627
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 206:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1596
+//line mysql_sql.y:1632
 		{
 			yyVAL.str = "true"
 		}
-	case 207:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1597
+//line mysql_sql.y:1633
 		{
 			yyVAL.str = "false"
 		}
-	case 208:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1598
+//line mysql_sql.y:1634
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 209:
+	case 216:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1603
+//line mysql_sql.y:1639
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 218:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1649
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6742,10 +6861,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 219:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1624
+//line mysql_sql.y:1660
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6758,118 +6877,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1637
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1641
+//line mysql_sql.y:1677
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1646
+//line mysql_sql.y:1682
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1650
+//line mysql_sql.y:1686
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1656
+//line mysql_sql.y:1692
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1660
+//line mysql_sql.y:1696
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1666
+//line mysql_sql.y:1702
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1670
+//line mysql_sql.y:1706
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1674
+//line mysql_sql.y:1710
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 229:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1678
+//line mysql_sql.y:1714
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1682
+//line mysql_sql.y:1718
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1686
+//line mysql_sql.y:1722
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 232:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1692
+//line mysql_sql.y:1728
 		{
 			yyVAL.item = nil
 		}
-	case 226:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1697
+//line mysql_sql.y:1733
 		{
 			yyVAL.item = nil
 		}
-	case 238:
+	case 245:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1718
+//line mysql_sql.y:1754
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6877,10 +6996,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 249:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1732
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6889,10 +7008,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 250:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1742
+//line mysql_sql.y:1778
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6901,58 +7020,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1751
+//line mysql_sql.y:1787
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1755
+//line mysql_sql.y:1791
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1759
+//line mysql_sql.y:1795
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1765
+//line mysql_sql.y:1801
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1771
+//line mysql_sql.y:1807
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1777
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 257:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1783
+//line mysql_sql.y:1819
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6963,10 +7082,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 258:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1793
+//line mysql_sql.y:1829
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6977,18 +7096,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 259:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1841
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1811
+//line mysql_sql.y:1847
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -7001,10 +7120,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 261:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1823
+//line mysql_sql.y:1859
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -7017,118 +7136,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1836
+//line mysql_sql.y:1872
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1840
+//line mysql_sql.y:1876
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1845
+//line mysql_sql.y:1881
 		{
 			yyVAL.str = ""
 		}
-	case 258:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1849
+//line mysql_sql.y:1885
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 259:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1855
+//line mysql_sql.y:1891
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 271:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1868
+//line mysql_sql.y:1904
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1872
+//line mysql_sql.y:1908
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1914
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1888
+//line mysql_sql.y:1924
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1892
+//line mysql_sql.y:1928
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1901
+//line mysql_sql.y:1937
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1905
+//line mysql_sql.y:1941
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 280:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1909
+//line mysql_sql.y:1945
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1925
+//line mysql_sql.y:1961
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7136,10 +7255,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1934
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7147,10 +7266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 289:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1943
+//line mysql_sql.y:1979
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -7159,26 +7278,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1953
+//line mysql_sql.y:1989
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1959
+//line mysql_sql.y:1995
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 292:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1965
+//line mysql_sql.y:2001
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -7188,10 +7307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1980
+//line mysql_sql.y:2016
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -7199,10 +7318,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1989
+//line mysql_sql.y:2025
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7210,20 +7329,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1996
+//line mysql_sql.y:2032
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 296:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2002
+//line mysql_sql.y:2038
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7232,10 +7351,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2010
+//line mysql_sql.y:2046
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7243,10 +7362,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2017
+//line mysql_sql.y:2053
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -7254,10 +7373,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2024
+//line mysql_sql.y:2060
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -7276,34 +7395,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 300:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2043
+//line mysql_sql.y:2079
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2047
+//line mysql_sql.y:2083
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2051
+//line mysql_sql.y:2087
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2057
+//line mysql_sql.y:2093
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -7311,171 +7430,174 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2066
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2070
+//line mysql_sql.y:2106
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2076
+//line mysql_sql.y:2112
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 300:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2080
+//line mysql_sql.y:2116
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 301:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2086
+//line mysql_sql.y:2122
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2090
+//line mysql_sql.y:2126
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2096
+//line mysql_sql.y:2132
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 311:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2101
+//line mysql_sql.y:2137
 		{
 		}
-	case 306:
+	case 313:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2112
+//line mysql_sql.y:2148
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2116
+//line mysql_sql.y:2152
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2123
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2128
+//line mysql_sql.y:2164
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2132
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2138
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2142
+//line mysql_sql.y:2178
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2148
+//line mysql_sql.y:2184
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2152
+//line mysql_sql.y:2188
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2157
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 326:
+		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2161
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = &tree.ExportParam{
-				Outfile:     true,
-				FilePath:    yyDollar[3].str,
-				Fields:      yyDollar[4].fieldsUnion(),
-				Lines:       yyDollar[5].linesUnion(),
-				Header:      yyDollar[6].unsignedOptUnion(),
-				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
-				ForceQuote:  yyDollar[8].strsUnion(),
+				Outfile:      true,
+				FilePath:     yyDollar[3].str,
+				FileFormat:   yyDollar[4].str,
+				Fields:       yyDollar[5].fieldsUnion(),
+				Lines:        yyDollar[6].linesUnion(),
+				Header:       yyDollar[7].unsignedOptUnion(),
+				MaxFileSize:  uint64(yyDollar[8].int64ValUnion()) * 1024,
+				ForceQuote:   yyDollar[9].strsUnion(),
+				RowGroupSize: uint64(yyDollar[10].int64ValUnion()),
+				Compression:  yyDollar[11].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2174
+//line mysql_sql.y:2213
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7483,10 +7605,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2181
+//line mysql_sql.y:2220
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7494,10 +7616,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 329:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2188
+//line mysql_sql.y:2227
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7516,10 +7638,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2206
+//line mysql_sql.y:2245
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7538,38 +7660,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2225
+//line mysql_sql.y:2264
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2231
+//line mysql_sql.y:2270
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2238
+//line mysql_sql.y:2277
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2242
+//line mysql_sql.y:2281
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7582,115 +7704,143 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2255
+//line mysql_sql.y:2294
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2259
+//line mysql_sql.y:2298
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 337:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:2303
+		{
+			yyLOCAL = 0
+		}
+		yyVAL.union = yyLOCAL
+	case 338:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:2307
+		{
+			yyLOCAL = yyDollar[2].item.(int64)
+		}
+		yyVAL.union = yyLOCAL
+	case 339:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2312
+		{
+			yyVAL.str = ""
+		}
+	case 340:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2316
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2321
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2268
+//line mysql_sql.y:2325
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2275
+//line mysql_sql.y:2332
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2280
+//line mysql_sql.y:2337
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2287
+//line mysql_sql.y:2344
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2293
+//line mysql_sql.y:2350
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2297
+//line mysql_sql.y:2354
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2301
+//line mysql_sql.y:2358
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2305
+//line mysql_sql.y:2362
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 351:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2309
+//line mysql_sql.y:2366
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 352:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2313
+//line mysql_sql.y:2370
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2319
+//line mysql_sql.y:2376
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7698,10 +7848,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2326
+//line mysql_sql.y:2383
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7709,26 +7859,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2335
+//line mysql_sql.y:2392
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2339
+//line mysql_sql.y:2396
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2345
+//line mysql_sql.y:2402
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7736,162 +7886,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2353
+//line mysql_sql.y:2410
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2357
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2362
+//line mysql_sql.y:2419
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2366
+//line mysql_sql.y:2423
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2372
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2376
+//line mysql_sql.y:2433
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2380
+//line mysql_sql.y:2437
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2385
+//line mysql_sql.y:2442
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2389
+//line mysql_sql.y:2446
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2395
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2401
+//line mysql_sql.y:2458
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2405
+//line mysql_sql.y:2462
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2411
+//line mysql_sql.y:2468
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2416
+//line mysql_sql.y:2473
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2420
+//line mysql_sql.y:2477
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2424
+//line mysql_sql.y:2481
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2431
+//line mysql_sql.y:2488
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2435
+//line mysql_sql.y:2492
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2441
+//line mysql_sql.y:2498
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2445
+//line mysql_sql.y:2502
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7902,10 +8052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2455
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7916,10 +8066,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2465
+//line mysql_sql.y:2522
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7930,10 +8080,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2475
+//line mysql_sql.y:2532
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7944,10 +8094,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2487
+//line mysql_sql.y:2544
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7956,10 +8106,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2495
+//line mysql_sql.y:2552
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7968,10 +8118,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2503
+//line mysql_sql.y:2560
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7980,10 +8130,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 384:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2513
+//line mysql_sql.y:2570
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7995,10 +8145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2524
+//line mysql_sql.y:2581
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -8011,130 +8161,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2542
+//line mysql_sql.y:2599
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2546
+//line mysql_sql.y:2603
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2550
+//line mysql_sql.y:2607
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2559
+//line mysql_sql.y:2616
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2563
+//line mysql_sql.y:2620
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2568
+//line mysql_sql.y:2625
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2572
+//line mysql_sql.y:2629
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2577
+//line mysql_sql.y:2634
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2581
+//line mysql_sql.y:2638
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2587
+//line mysql_sql.y:2644
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2591
+//line mysql_sql.y:2648
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2597
+//line mysql_sql.y:2654
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2601
+//line mysql_sql.y:2658
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2605
+//line mysql_sql.y:2662
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 405:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2609
+//line mysql_sql.y:2666
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2615
+//line mysql_sql.y:2672
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -8143,44 +8293,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2623
+//line mysql_sql.y:2680
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2629
+//line mysql_sql.y:2686
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2637
+//line mysql_sql.y:2694
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2641
+//line mysql_sql.y:2698
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2708
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8190,10 +8340,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 414:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2660
+//line mysql_sql.y:2717
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8203,10 +8353,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2670
+//line mysql_sql.y:2727
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8216,10 +8366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2679
+//line mysql_sql.y:2736
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8228,15 +8378,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2689
+//line mysql_sql.y:2746
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 407:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2693
+//line mysql_sql.y:2750
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -8244,130 +8394,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 408:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2760
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 409:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2707
+//line mysql_sql.y:2764
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 410:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2711
+//line mysql_sql.y:2768
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 411:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2715
+//line mysql_sql.y:2772
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 412:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2721
+//line mysql_sql.y:2778
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2725
+//line mysql_sql.y:2782
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2731
+//line mysql_sql.y:2788
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 415:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2737
+//line mysql_sql.y:2794
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 416:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2741
+//line mysql_sql.y:2798
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 417:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2745
+//line mysql_sql.y:2802
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 418:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2751
+//line mysql_sql.y:2808
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2755
+//line mysql_sql.y:2812
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2761
+//line mysql_sql.y:2818
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 432:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2765
+//line mysql_sql.y:2822
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2771
+//line mysql_sql.y:2828
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2775
+//line mysql_sql.y:2832
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2781
+//line mysql_sql.y:2838
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2785
+//line mysql_sql.y:2842
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -8378,28 +8528,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2798
+//line mysql_sql.y:2855
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2803
+//line mysql_sql.y:2860
 		{
 		}
-	case 428:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2804
+//line mysql_sql.y:2861
 		{
 		}
-	case 429:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2808
+//line mysql_sql.y:2865
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -8409,58 +8559,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2820
+//line mysql_sql.y:2877
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2824
+//line mysql_sql.y:2881
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2828
+//line mysql_sql.y:2885
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 435:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2837
+//line mysql_sql.y:2894
 		{
 			yyVAL.str = ""
 		}
-	case 436:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2841
+//line mysql_sql.y:2898
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 437:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2845
+//line mysql_sql.y:2902
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 438:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2849
+//line mysql_sql.y:2906
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 439:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2853
+//line mysql_sql.y:2910
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 454:
+	case 465:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2883
+//line mysql_sql.y:2940
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8471,10 +8621,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 466:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2893
+//line mysql_sql.y:2950
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8485,15 +8635,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2904
+//line mysql_sql.y:2961
 		{
 		}
-	case 458:
+	case 469:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2909
+//line mysql_sql.y:2966
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8503,189 +8653,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2919
+//line mysql_sql.y:2976
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2923
+//line mysql_sql.y:2980
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2929
+//line mysql_sql.y:2986
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:2990
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2939
+//line mysql_sql.y:2996
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3000
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2947
+//line mysql_sql.y:3004
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2951
+//line mysql_sql.y:3008
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3014
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2964
+//line mysql_sql.y:3021
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2969
+//line mysql_sql.y:3026
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2974
+//line mysql_sql.y:3031
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2979
+//line mysql_sql.y:3036
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2985
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2989
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2993
+//line mysql_sql.y:3050
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2999
+//line mysql_sql.y:3056
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3003
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3007
+//line mysql_sql.y:3064
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3011
+//line mysql_sql.y:3068
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3017
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3021
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3027
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8697,42 +8847,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3040
+//line mysql_sql.y:3097
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3044
+//line mysql_sql.y:3101
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3048
+//line mysql_sql.y:3105
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3053
+//line mysql_sql.y:3110
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3057
+//line mysql_sql.y:3114
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8740,20 +8890,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3064
+//line mysql_sql.y:3121
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 500:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3070
+//line mysql_sql.y:3127
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8762,10 +8912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 501:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3078
+//line mysql_sql.y:3135
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8773,20 +8923,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3085
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3097
+//line mysql_sql.y:3154
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8794,82 +8944,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3106
+//line mysql_sql.y:3163
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3110
+//line mysql_sql.y:3167
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3116
+//line mysql_sql.y:3173
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3120
+//line mysql_sql.y:3177
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3124
+//line mysql_sql.y:3181
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3133
+//line mysql_sql.y:3190
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3137
+//line mysql_sql.y:3194
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3141
+//line mysql_sql.y:3198
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3145
+//line mysql_sql.y:3202
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 517:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3151
+//line mysql_sql.y:3208
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8888,18 +9038,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3170
+//line mysql_sql.y:3227
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3174
+//line mysql_sql.y:3231
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8920,123 +9070,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3196
+//line mysql_sql.y:3253
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3200
+//line mysql_sql.y:3257
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3204
+//line mysql_sql.y:3261
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3208
+//line mysql_sql.y:3265
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3212
+//line mysql_sql.y:3269
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3218
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3222
+//line mysql_sql.y:3279
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3228
+//line mysql_sql.y:3285
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3233
+//line mysql_sql.y:3290
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3238
+//line mysql_sql.y:3295
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3242
+//line mysql_sql.y:3299
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3246
+//line mysql_sql.y:3303
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3250
+//line mysql_sql.y:3307
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3254
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 534:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3260
+//line mysql_sql.y:3317
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -9045,98 +9195,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3274
+//line mysql_sql.y:3331
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3278
+//line mysql_sql.y:3335
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3283
+//line mysql_sql.y:3340
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3287
+//line mysql_sql.y:3344
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3293
+//line mysql_sql.y:3350
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3297
+//line mysql_sql.y:3354
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 543:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3303
+//line mysql_sql.y:3360
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3307
+//line mysql_sql.y:3364
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3316
+//line mysql_sql.y:3373
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3320
+//line mysql_sql.y:3377
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 548:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3326
+//line mysql_sql.y:3383
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -9148,34 +9298,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3338
+//line mysql_sql.y:3395
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3342
+//line mysql_sql.y:3399
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3347
+//line mysql_sql.y:3404
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 552:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3351
+//line mysql_sql.y:3408
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -9185,18 +9335,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3361
+//line mysql_sql.y:3418
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3365
+//line mysql_sql.y:3422
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -9205,42 +9355,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3374
+//line mysql_sql.y:3431
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3378
+//line mysql_sql.y:3435
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3384
+//line mysql_sql.y:3441
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3388
+//line mysql_sql.y:3445
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3394
+//line mysql_sql.y:3451
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9250,10 +9400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 560:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3403
+//line mysql_sql.y:3460
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9263,42 +9413,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3413
+//line mysql_sql.y:3470
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 562:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3417
+//line mysql_sql.y:3474
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3423
+//line mysql_sql.y:3480
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3427
+//line mysql_sql.y:3484
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3433
+//line mysql_sql.y:3490
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9306,10 +9456,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3440
+//line mysql_sql.y:3497
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9317,50 +9467,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3449
+//line mysql_sql.y:3506
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3453
+//line mysql_sql.y:3510
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3458
+//line mysql_sql.y:3515
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 570:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3462
+//line mysql_sql.y:3519
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 571:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3467
+//line mysql_sql.y:3524
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3471
+//line mysql_sql.y:3528
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9370,18 +9520,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3481
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3485
+//line mysql_sql.y:3542
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9391,10 +9541,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 575:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3496
+//line mysql_sql.y:3553
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9403,10 +9553,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 576:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3504
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9415,10 +9565,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 577:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3512
+//line mysql_sql.y:3569
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9427,10 +9577,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 578:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3520
+//line mysql_sql.y:3577
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9439,10 +9589,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 580:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3531
+//line mysql_sql.y:3588
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9453,10 +9603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3541
+//line mysql_sql.y:3598
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9466,478 +9616,487 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 582:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3551
+//line mysql_sql.y:3608
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3555
+//line mysql_sql.y:3612
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3560
+//line mysql_sql.y:3617
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3564
+//line mysql_sql.y:3621
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3626
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3630
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3579
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3583
+//line mysql_sql.y:3640
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3587
+//line mysql_sql.y:3644
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3650
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3654
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3658
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3662
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3666
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3670
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3674
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3678
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3682
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3686
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3694
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3698
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3702
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3706
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3726
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3673
+//line mysql_sql.y:3730
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3677
+//line mysql_sql.y:3734
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3681
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3685
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3689
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3693
+//line mysql_sql.y:3750
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 617:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3697
+//line mysql_sql.y:3754
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 618:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3701
+//line mysql_sql.y:3758
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 619:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3705
+//line mysql_sql.y:3762
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3712
+//line mysql_sql.y:3769
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3716
+//line mysql_sql.y:3773
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3722
+//line mysql_sql.y:3779
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3727
+//line mysql_sql.y:3784
 		{
 			yyVAL.str = ""
 		}
-	case 613:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3731
+//line mysql_sql.y:3788
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 614:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3735
+//line mysql_sql.y:3792
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 615:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3741
+//line mysql_sql.y:3798
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3745
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3749
+//line mysql_sql.y:3806
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3753
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3757
+//line mysql_sql.y:3814
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3761
+//line mysql_sql.y:3818
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3777
+//line mysql_sql.y:3834
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3781
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3790
+//line mysql_sql.y:3847
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3795
+//line mysql_sql.y:3852
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 642:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line mysql_sql.y:3857
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 643:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3801
+//line mysql_sql.y:3863
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3808
+//line mysql_sql.y:3870
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3812
+//line mysql_sql.y:3874
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3818
+//line mysql_sql.y:3880
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3822
+//line mysql_sql.y:3884
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3828
+//line mysql_sql.y:3890
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9948,18 +10107,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3838
+//line mysql_sql.y:3900
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 651:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3844
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9969,10 +10128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 652:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3853
+//line mysql_sql.y:3915
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9982,10 +10141,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 653:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3862
+//line mysql_sql.y:3924
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -10009,10 +10168,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 654:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3885
+//line mysql_sql.y:3947
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10022,10 +10181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 655:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3894
+//line mysql_sql.y:3956
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -10036,10 +10195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 656:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3904
+//line mysql_sql.y:3966
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -10047,298 +10206,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3912
+//line mysql_sql.y:3974
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3918
+//line mysql_sql.y:3980
 		{
 			yyVAL.str = ""
 		}
-	case 648:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:3984
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 651:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3932
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3938
+//line mysql_sql.y:4000
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3944
+//line mysql_sql.y:4006
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3958
+//line mysql_sql.y:4020
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3965
+//line mysql_sql.y:4027
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3971
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3975
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 676:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3979
+//line mysql_sql.y:4041
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3990
+//line mysql_sql.y:4052
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3994
+//line mysql_sql.y:4056
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 682:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3998
+//line mysql_sql.y:4060
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4065
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4069
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4013
+//line mysql_sql.y:4075
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4017
+//line mysql_sql.y:4079
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4085
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4089
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4093
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4097
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4039
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4043
+//line mysql_sql.y:4105
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4047
+//line mysql_sql.y:4109
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4051
+//line mysql_sql.y:4113
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4055
+//line mysql_sql.y:4117
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4059
+//line mysql_sql.y:4121
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4063
+//line mysql_sql.y:4125
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 698:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4067
+//line mysql_sql.y:4129
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 699:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4071
+//line mysql_sql.y:4133
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4081
+//line mysql_sql.y:4143
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4085
+//line mysql_sql.y:4147
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4090
+//line mysql_sql.y:4152
 		{
 			yyVAL.str = ""
 		}
-	case 691:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4094
+//line mysql_sql.y:4156
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 692:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4100
+//line mysql_sql.y:4162
 		{
 			yyVAL.str = ""
 		}
-	case 693:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4104
+//line mysql_sql.y:4166
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 694:
+	case 706:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4110
+//line mysql_sql.y:4172
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -10349,10 +10508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 707:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4121
+//line mysql_sql.y:4183
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -10360,10 +10519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4131
+//line mysql_sql.y:4193
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10371,10 +10530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4138
+//line mysql_sql.y:4200
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -10382,10 +10541,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4145
+//line mysql_sql.y:4207
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10393,10 +10552,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4152
+//line mysql_sql.y:4214
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10404,339 +10563,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4161
+//line mysql_sql.y:4223
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4167
+//line mysql_sql.y:4229
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4235
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4177
+//line mysql_sql.y:4239
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4181
+//line mysql_sql.y:4243
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4185
+//line mysql_sql.y:4247
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4189
+//line mysql_sql.y:4251
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 720:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4194
+//line mysql_sql.y:4256
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4201
+//line mysql_sql.y:4263
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4205
+//line mysql_sql.y:4267
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4209
+//line mysql_sql.y:4271
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4214
+//line mysql_sql.y:4276
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4218
+//line mysql_sql.y:4280
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 727:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4223
+//line mysql_sql.y:4285
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4227
+//line mysql_sql.y:4289
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4243
+//line mysql_sql.y:4305
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4311
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4323
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4265
+//line mysql_sql.y:4327
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4269
+//line mysql_sql.y:4331
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4273
+//line mysql_sql.y:4335
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4277
+//line mysql_sql.y:4339
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4281
+//line mysql_sql.y:4343
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4285
+//line mysql_sql.y:4347
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4289
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4293
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4359
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4365
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4369
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4373
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4315
+//line mysql_sql.y:4377
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 753:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4319
+//line mysql_sql.y:4381
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4323
+//line mysql_sql.y:4385
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4327
+//line mysql_sql.y:4389
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4331
+//line mysql_sql.y:4393
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4335
+//line mysql_sql.y:4397
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4339
+//line mysql_sql.y:4401
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 759:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4343
+//line mysql_sql.y:4405
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4347
+//line mysql_sql.y:4409
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 761:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4352
+//line mysql_sql.y:4414
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10745,26 +10904,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 762:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4360
+//line mysql_sql.y:4422
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 763:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4364
+//line mysql_sql.y:4426
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 764:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4368
+//line mysql_sql.y:4430
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -10774,90 +10933,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4377
+//line mysql_sql.y:4439
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4381
+//line mysql_sql.y:4443
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4385
+//line mysql_sql.y:4447
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4389
+//line mysql_sql.y:4451
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 769:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4394
+//line mysql_sql.y:4456
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4398
+//line mysql_sql.y:4460
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 771:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4403
+//line mysql_sql.y:4465
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4407
+//line mysql_sql.y:4469
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4413
+//line mysql_sql.y:4475
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4417
+//line mysql_sql.y:4479
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4423
+//line mysql_sql.y:4485
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10865,10 +11024,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4433
+//line mysql_sql.y:4495
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10882,10 +11041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4446
+//line mysql_sql.y:4508
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10899,10 +11058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4459
+//line mysql_sql.y:4521
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10915,10 +11074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4471
+//line mysql_sql.y:4533
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10933,10 +11092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4485
+//line mysql_sql.y:4547
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10952,10 +11111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4500
+//line mysql_sql.y:4562
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10971,10 +11130,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4515
+//line mysql_sql.y:4577
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10988,10 +11147,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4528
+//line mysql_sql.y:4590
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11006,15 +11165,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 785:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4543
+//line mysql_sql.y:4605
 		{
 		}
-	case 776:
+	case 788:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4549
+//line mysql_sql.y:4611
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11024,10 +11183,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 789:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4558
+//line mysql_sql.y:4620
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11036,10 +11195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 790:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4566
+//line mysql_sql.y:4628
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11048,10 +11207,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 791:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4636
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11061,10 +11220,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 792:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4645
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11074,10 +11233,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 793:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4654
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11087,10 +11246,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 794:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4663
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11100,10 +11259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 795:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4672
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -11113,10 +11272,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 796:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4681
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11126,10 +11285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 797:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4690
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11139,10 +11298,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 798:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4699
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11152,10 +11311,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 799:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4646
+//line mysql_sql.y:4708
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11165,10 +11324,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 800:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4655
+//line mysql_sql.y:4717
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11178,10 +11337,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 801:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4664
+//line mysql_sql.y:4726
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11191,10 +11350,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4673
+//line mysql_sql.y:4735
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11204,10 +11363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 806:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4689
+//line mysql_sql.y:4751
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11216,10 +11375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 807:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4697
+//line mysql_sql.y:4759
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11228,10 +11387,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 808:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4705
+//line mysql_sql.y:4767
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11240,10 +11399,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 809:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4713
+//line mysql_sql.y:4775
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11252,10 +11411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 810:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4721
+//line mysql_sql.y:4783
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -11265,10 +11424,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 811:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4792
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			unit := strings.TrimPrefix(strings.ToLower(yyDollar[3].str), "sql_tsi_")
+			arg1 := tree.NewNumValWithType(constant.MakeString(unit), unit, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{arg1, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4802
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			unit := strings.TrimPrefix(strings.ToLower(yyDollar[3].str), "sql_tsi_")
+			arg1 := tree.NewNumValWithType(constant.MakeString(unit), unit, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{arg1, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4730
+//line mysql_sql.y:4812
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11277,10 +11464,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 814:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4738
+//line mysql_sql.y:4820
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11290,10 +11477,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 815:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4747
+//line mysql_sql.y:4829
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11303,10 +11490,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 816:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4838
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4756
+//line mysql_sql.y:4846
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11315,22 +11514,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 818:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4764
+//line mysql_sql.y:4854
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{arg1, yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 819:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4772
+//line mysql_sql.y:4863
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -11340,10 +11540,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 820:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4781
+//line mysql_sql.y:4872
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -11353,16 +11553,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4801
+//line mysql_sql.y:4892
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 840:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4837
+//line mysql_sql.y:4928
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11375,10 +11575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4849
+//line mysql_sql.y:4940
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11391,10 +11591,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 857:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4863
+//line mysql_sql.y:4954
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11403,10 +11603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4871
+//line mysql_sql.y:4962
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11414,10 +11614,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 859:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4878
+//line mysql_sql.y:4969
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -11430,10 +11630,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 860:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4890
+//line mysql_sql.y:4981
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -11442,10 +11642,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 861:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4898
+//line mysql_sql.y:4989
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -11457,10 +11657,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4909
+//line mysql_sql.y:5000
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -11470,10 +11670,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4918
+//line mysql_sql.y:5009
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -11483,10 +11683,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 864:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4927
+//line mysql_sql.y:5018
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11495,10 +11695,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 865:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4935
+//line mysql_sql.y:5026
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11509,10 +11709,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 866:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4945
+//line mysql_sql.y:5036
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11521,10 +11721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4953
+//line mysql_sql.y:5044
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11533,10 +11733,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4961
+//line mysql_sql.y:5052
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11546,52 +11746,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4971
+//line mysql_sql.y:5062
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4975
+//line mysql_sql.y:5066
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4981
+//line mysql_sql.y:5072
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4985
+//line mysql_sql.y:5076
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4998
+//line mysql_sql.y:5089
 		{
 		}
-	case 865:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5000
+//line mysql_sql.y:5091
 		{
 		}
-	case 898:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5040
+//line mysql_sql.y:5131
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
@@ -11601,10 +11801,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5049
+//line mysql_sql.y:5140
 		{
 			str := strconv.FormatInt(yyDollar[2].item.(int64), 10)
 			str += " " + yyDollar[3].str
@@ -11616,10 +11816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5060
+//line mysql_sql.y:5151
 		{
 			str := yyDollar[2].str
 			str += " " + yyDollar[3].str
@@ -11631,10 +11831,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 916:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5071
+//line mysql_sql.y:5162
 		{
 			str := strconv.FormatInt(-yyDollar[3].item.(int64), 10)
 			str += " " + yyDollar[4].str
@@ -11646,404 +11846,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 917:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5083
+//line mysql_sql.y:5174
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5087
+//line mysql_sql.y:5178
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5091
+//line mysql_sql.y:5182
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:5097
+//line mysql_sql.y:5188
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 921:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5102
+//line mysql_sql.y:5193
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5106
+//line mysql_sql.y:5197
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5112
+//line mysql_sql.y:5203
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5116
+//line mysql_sql.y:5207
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5123
+//line mysql_sql.y:5214
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5127
+//line mysql_sql.y:5218
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5131
+//line mysql_sql.y:5222
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5135
+//line mysql_sql.y:5226
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5139
+//line mysql_sql.y:5230
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 930:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5143
+//line mysql_sql.y:5234
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5147
+//line mysql_sql.y:5238
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 932:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5152
+//line mysql_sql.y:5243
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5157
+//line mysql_sql.y:5248
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5163
+//line mysql_sql.y:5254
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 935:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5167
+//line mysql_sql.y:5258
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5171
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 937:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5175
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5182
+//line mysql_sql.y:5273
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5186
+//line mysql_sql.y:5277
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5192
+//line mysql_sql.y:5283
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 942:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5196
+//line mysql_sql.y:5287
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 943:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5200
+//line mysql_sql.y:5291
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 944:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5204
+//line mysql_sql.y:5295
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5208
+//line mysql_sql.y:5299
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 946:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5212
+//line mysql_sql.y:5303
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 947:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5216
+//line mysql_sql.y:5307
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 948:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5220
+//line mysql_sql.y:5311
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5226
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5230
+//line mysql_sql.y:5321
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5236
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5240
+//line mysql_sql.y:5331
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5247
+//line mysql_sql.y:5338
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5251
+//line mysql_sql.y:5342
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5255
+//line mysql_sql.y:5346
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5261
+//line mysql_sql.y:5352
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5265
+//line mysql_sql.y:5356
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5269
+//line mysql_sql.y:5360
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5273
+//line mysql_sql.y:5364
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5277
+//line mysql_sql.y:5368
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5281
+//line mysql_sql.y:5372
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5285
+//line mysql_sql.y:5376
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 964:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5291
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5295
+//line mysql_sql.y:5386
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5299
+//line mysql_sql.y:5390
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5303
+//line mysql_sql.y:5394
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5309
+//line mysql_sql.y:5400
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -12059,104 +12259,104 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5326
+//line mysql_sql.y:5417
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5330
+//line mysql_sql.y:5421
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5335
+//line mysql_sql.y:5426
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5340
+//line mysql_sql.y:5431
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5344
+//line mysql_sql.y:5435
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5348
+//line mysql_sql.y:5439
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5352
+//line mysql_sql.y:5443
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5357
+//line mysql_sql.y:5448
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal128)
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 977:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5366
+//line mysql_sql.y:5457
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5377
+//line mysql_sql.y:5468
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5382
+//line mysql_sql.y:5473
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5388
+//line mysql_sql.y:5479
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12169,10 +12369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5400
+//line mysql_sql.y:5491
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12185,10 +12385,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5412
+//line mysql_sql.y:5503
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12201,10 +12401,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5424
+//line mysql_sql.y:5515
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12218,10 +12418,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5437
+//line mysql_sql.y:5528
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12235,10 +12435,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5450
+//line mysql_sql.y:5541
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12252,10 +12452,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5463
+//line mysql_sql.y:5554
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12269,10 +12469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5476
+//line mysql_sql.y:5567
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12286,10 +12486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5489
+//line mysql_sql.y:5580
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12303,10 +12503,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5502
+//line mysql_sql.y:5593
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12320,10 +12520,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5515
+//line mysql_sql.y:5606
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12337,10 +12537,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5528
+//line mysql_sql.y:5619
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12354,10 +12554,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5541
+//line mysql_sql.y:5632
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12371,10 +12571,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5554
+//line mysql_sql.y:5645
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12388,10 +12588,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5569
+//line mysql_sql.y:5660
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -12415,10 +12615,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5592
+//line mysql_sql.y:5683
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -12455,10 +12655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5629
+//line mysql_sql.y:5720
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -12495,10 +12695,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5677
+//line mysql_sql.y:5768
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12514,10 +12714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5694
+//line mysql_sql.y:5785
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12530,10 +12730,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1002:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5706
+//line mysql_sql.y:5797
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12549,10 +12749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1003:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5721
+//line mysql_sql.y:5812
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -12573,10 +12773,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5741
+//line mysql_sql.y:5832
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -12597,10 +12797,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5761
+//line mysql_sql.y:5852
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12615,10 +12815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1006:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5777
+//line mysql_sql.y:5868
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12632,10 +12832,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5790
+//line mysql_sql.y:5881
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12649,10 +12849,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5803
+//line mysql_sql.y:5894
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12666,10 +12866,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1009:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5816
+//line mysql_sql.y:5907
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12683,10 +12883,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5829
+//line mysql_sql.y:5920
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12699,10 +12899,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5841
+//line mysql_sql.y:5932
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12715,10 +12915,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5853
+//line mysql_sql.y:5944
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12731,10 +12931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5865
+//line mysql_sql.y:5956
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12747,10 +12947,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5877
+//line mysql_sql.y:5968
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12763,10 +12963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1015:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5889
+//line mysql_sql.y:5980
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12779,10 +12979,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5901
+//line mysql_sql.y:5992
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12795,10 +12995,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5913
+//line mysql_sql.y:6004
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12811,10 +13011,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5925
+//line mysql_sql.y:6016
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12827,10 +13027,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1019:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5937
+//line mysql_sql.y:6028
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12844,10 +13044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1020:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5950
+//line mysql_sql.y:6041
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12861,10 +13061,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5965
+//line mysql_sql.y:6056
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12877,75 +13077,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5988
+//line mysql_sql.y:6079
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5993
+//line mysql_sql.y:6084
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1024:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5999
+//line mysql_sql.y:6090
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1026:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6006
+//line mysql_sql.y:6097
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1027:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6010
+//line mysql_sql.y:6101
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6015
+//line mysql_sql.y:6106
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1029:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6019
+//line mysql_sql.y:6110
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6025
+//line mysql_sql.y:6116
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1031:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6031
+//line mysql_sql.y:6122
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12953,10 +13153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1032:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6038
+//line mysql_sql.y:6129
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12964,10 +13164,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1033:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6045
+//line mysql_sql.y:6136
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12975,10 +13175,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6054
+//line mysql_sql.y:6145
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12986,10 +13186,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6061
+//line mysql_sql.y:6152
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12997,10 +13197,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1036:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6068
+//line mysql_sql.y:6159
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13008,52 +13208,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1037:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6077
+//line mysql_sql.y:6168
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6081
+//line mysql_sql.y:6172
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6085
+//line mysql_sql.y:6176
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1040:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6091
+//line mysql_sql.y:6182
 		{
 		}
-	case 1026:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6093
+//line mysql_sql.y:6184
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1045:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6103
+//line mysql_sql.y:6194
 		{
 			yyVAL.str = ""
 		}
-	case 1031:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6107
+//line mysql_sql.y:6198
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
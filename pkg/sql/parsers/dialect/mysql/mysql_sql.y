@@ -117,6 +117,7 @@ import (
     numVal *tree.NumVal
     subPartition *tree.SubPartition
     subPartitions []*tree.SubPartition
+    killOption tree.KillOption
 
     subquery *tree.Subquery
     funcExpr *tree.FuncExpr
@@ -268,6 +269,9 @@ import (
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
 %token <str> LOCAL EXCEPT
 
+// KILL tokens
+%token <str> KILL
+
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
 %token <str> CURRENT_TIME LOCALTIME LOCALTIMESTAMP
@@ -291,12 +295,13 @@ import (
 // Built-in function
 %token <str> ADDDATE BIT_AND BIT_OR BIT_XOR CAST COUNT APPROX_COUNT_DISTINCT
 %token <str> APPROX_PERCENTILE CURDATE CURTIME DATE_ADD DATE_SUB EXTRACT
+%token <str> TIMESTAMPADD TIMESTAMPDIFF
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
 
 // Insert
-%token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
+%token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE ROW_GROUP_SIZE
 
 %token <str> UNUSED
 
@@ -310,7 +315,7 @@ import (
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> alter_user_stmt update_stmt use_stmt kill_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
@@ -340,13 +345,13 @@ import (
 %type <columnTableDef> column_def
 %type <columnType> cast_type
 %type <columnType> column_type char_type spatial_type time_type numeric_type decimal_type int_type
-%type <str> integer_opt
+%type <str> integer_opt data_format_opt export_compression_opt
 %type <columnAttribute> column_attribute_elem keys
 %type <columnAttributes> column_attribute_list column_attribute_list_opt
 %type <tableOptions> table_option_list_opt table_option_list
 %type <str> charset_name storage_opt collate_name column_format storage_media
 %type <rowFormatType> row_format_options
-%type <int64Val> field_length_opt max_file_size_opt
+%type <int64Val> field_length_opt max_file_size_opt row_group_size_opt
 %type <matchType> match match_opt
 %type <referenceOptionType> ref_opt on_delete on_update
 %type <referenceOnRecord> on_delete_update_opt on_delete_update
@@ -442,6 +447,7 @@ import (
 %type <tableOptions> partition_option_list
 %type <subPartition> sub_partition
 %type <subPartitions> sub_partition_list sub_partition_list_opt
+%type <killOption> kill_opt
 %type <subquery> subquery
 %type <numVal> int_num_val
 
@@ -530,6 +536,7 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   kill_stmt
 |   select_stmt
     {
         $$ = $1
@@ -540,21 +547,31 @@ stmt:
     }
 
 load_data_stmt:
-    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
+    LOAD DATA local_opt INFILE STRING data_format_opt duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
         $$ = &tree.Load{
             Local: $3,
             File: $5,
-            DuplicateHandling: $6,
-            Table: $9,
-            Fields: $10,
-            Lines: $11,
-            IgnoredLines: uint64($12),
-            ColumnList: $13,
-            Assignments: $14,
+            FileFormat: $6,
+            DuplicateHandling: $7,
+            Table: $10,
+            Fields: $11,
+            Lines: $12,
+            IgnoredLines: uint64($13),
+            ColumnList: $14,
+            Assignments: $15,
         }
     }
 
+data_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
 load_set_spec_opt:
     {
         $$ = nil
@@ -1460,6 +1477,25 @@ begin_stmt:
         $$ = &tree.BeginTransaction{}
     }
 
+kill_stmt:
+    KILL kill_opt INTEGRAL
+    {
+        $$ = tree.NewKill($2, uint64($3.(int64)))
+    }
+
+kill_opt:
+    {
+        $$ = tree.KillConnection
+    }
+|   CONNECTION
+    {
+        $$ = tree.KillConnection
+    }
+|   QUERY
+    {
+        $$ = tree.KillQuery
+    }
+
 use_stmt:
     USE ident
     {
@@ -2157,16 +2193,19 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING data_format_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt row_group_size_opt export_compression_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
             FilePath :  $3,
-            Fields:     $4,
-            Lines:      $5,
-            Header:     $6,
-            MaxFileSize:uint64($7)*1024,
-            ForceQuote: $8,
+            FileFormat: $4,
+            Fields:     $5,
+            Lines:      $6,
+            Header:     $7,
+            MaxFileSize:uint64($8)*1024,
+            ForceQuote: $9,
+            RowGroupSize: uint64($10),
+            Compression: $11,
         }
     }
 
@@ -2260,6 +2299,24 @@ max_file_size_opt:
         $$ = $2.(int64)
     }
 
+row_group_size_opt:
+    {
+        $$ = 0
+    }
+|   ROW_GROUP_SIZE INTEGRAL
+    {
+        $$ = $2.(int64)
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
 force_quote_opt:
     {
         $$ = []string{}
@@ -3796,6 +3853,11 @@ table_name:
         prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
         $$ = tree.NewTableName(tree.Identifier($3), prefix)
     }
+|   ident '.' reserved_keyword
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
+        $$ = tree.NewTableName(tree.Identifier($3), prefix)
+    }
 
 table_elem_list_opt:
     {
@@ -4726,6 +4788,26 @@ function_call_generic:
              Exprs: tree.Exprs{timeUinit, $5},
        }
     }
+|   TIMESTAMPADD '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        unit := strings.TrimPrefix(strings.ToLower($3), "sql_tsi_")
+        arg1 := tree.NewNumValWithType(constant.MakeString(unit), unit, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{arg1, $5, $7},
+        }
+    }
+|   TIMESTAMPDIFF '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        unit := strings.TrimPrefix(strings.ToLower($3), "sql_tsi_")
+        arg1 := tree.NewNumValWithType(constant.MakeString(unit), unit, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{arg1, $5, $7},
+        }
+    }
 |	func_not_keyword '(' expression_list_opt ')'
 	{
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -4752,6 +4834,14 @@ function_call_generic:
             Type: $3,
         }
 	}
+|	POSITION '(' bit_expr IN expression ')'
+	{
+		name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{$3, $5},
+        }
+	}
 |	TRIM '(' expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -4763,9 +4853,10 @@ function_call_generic:
 |	TRIM '(' expression FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
+		arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{$3},
+             Exprs: tree.Exprs{arg1, $3, $5},
         }
 	}
 |	TRIM '(' trim_direction FROM expression ')'
@@ -6427,6 +6518,7 @@ non_reserved_keyword:
 |   HEADER
 |   MAX_FILE_SIZE
 |   FORCE_QUOTE
+|   ROW_GROUP_SIZE
 |   QUARTER
 |	UNKNOWN
 |	ANY
@@ -6438,7 +6530,6 @@ func_not_keyword:
 |   NOW
 |	ADDDATE
 |   CURDATE
-|   POSITION
 |   SESSION_USER
 |   SUBDATE
 |   SYSTEM_USER
@@ -6475,6 +6566,8 @@ not_keyword:
 |   SUM
 |   SYSDATE
 |   SYSTEM_USER
+|   TIMESTAMPADD
+|   TIMESTAMPDIFF
 |   TRANSLATE
 |   TRIM
 |   VARIANCE
@@ -54,12 +54,18 @@ var (
 	}{{
 		input:  "SET timestamp=DEFAULT;",
 		output: "set timestamp = default",
+	}, {
+		input:  "select table_name from information_schema.TABLES where table_schema = 'db'",
+		output: "select table_name from information_schema.tables where table_schema = db",
 	}, {
 		input:  "SET timestamp=UNIX_TIMESTAMP('2011-07-31 10:00:00')",
 		output: "set timestamp = unix_timestamp(2011-07-31 10:00:00)",
 	}, {
 		input:  "select ltrim(\"a\"),rtrim(\"a\"),trim(BOTH \"\" from \"a\"),trim(BOTH \" \" from \"a\");",
 		output: "select ltrim(a), rtrim(a), trim(both, , a), trim(both,  , a) from dual",
+	}, {
+		input:  "select trim(\"x\" from \"xax\"), trim(leading from a), position('a' in 'bab')",
+		output: "select trim(both, x, xax), trim(leading, a), position(a, bab) from dual",
 	}, {
 		input:  "select rpad('hello', -18446744073709551616, '1');",
 		output: "select rpad(hello, -18446744073709551616, 1) from dual",
@@ -235,6 +241,9 @@ var (
 	}, {
 		input:  "select extract(year from l_shipdate) as l_year from t",
 		output: "select extract(year, l_shipdate) as l_year from t",
+	}, {
+		input:  "select timestampdiff(sql_tsi_day, a, b), timestampadd(Month, -1, a) from t",
+		output: "select timestampdiff(day, a, b), timestampadd(month, -1, a) from t",
 	}, {
 		input:  "select * from R join S on R.uid = S.uid where l_shipdate <= date '1998-12-01' - interval '112 day'",
 		output: "select * from r inner join s on r.uid = s.uid where l_shipdate <= date(1998-12-01) - interval(112 day)",
@@ -350,7 +359,7 @@ var (
 		input:  "load data local infile 'data' replace into table db.a (a, b, @vc, @vd) set a = @vc != 0, d = @vd != 1",
 		output: "load data local infile data replace into table db.a (a, b, @vc, @vd) set a = @vc != 0, d = @vd != 1",
 	}, {
-		input: "load data local infile 'data' replace into table db.a lines starting by '#' terminated by '\t' ignore 2 lines",
+		input:  "load data local infile 'data' replace into table db.a lines starting by '#' terminated by '\t' ignore 2 lines",
 		output: "load data local infile data replace into table db.a lines starting by # terminated by 	 ignore 2 lines",
 	}, {
 		input:  "load data infile 'data.txt' into table db.a fields terminated by '\t' escaped by '\t'",
@@ -361,6 +370,12 @@ var (
 	}, {
 		input:  "load data infile 'data.txt' into table db.a",
 		output: "load data infile data.txt into table db.a",
+	}, {
+		input:  "load data local infile 'data.arrow' format 'ARROW' into table db.a",
+		output: "load data local infile data.arrow format arrow into table db.a",
+	}, {
+		input:  "load data infile 's3://bucket/data.parquet' format 'Parquet' into table db.a (a, b)",
+		output: "load data infile s3://bucket/data.parquet format parquet into table db.a (a, b)",
 	}, {
 		input:  "show tables from test01 where tables_in_test01 like '%t2%'",
 		output: "show tables from test01 where tables_in_test01 like %t2%",
@@ -674,6 +689,13 @@ var (
 		input: "show full processlist",
 	}, {
 		input: "show processlist",
+	}, {
+		input:  "kill 12",
+		output: "kill connection 12",
+	}, {
+		input: "kill connection 12",
+	}, {
+		input: "kill query 12",
 	}, {
 		input:  "show full tables from db1 like 'a%' where a != 0",
 		output: "show full tables from db1 like a% where a != 0",
@@ -859,6 +881,12 @@ var (
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test'",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
+	}, {
+		input:  "select a from t into outfile '/Users/tmp/test.arrow' format 'arrow'",
+		output: "select a from t into outfile /Users/tmp/test.arrow format arrow fields terminated by , enclosed by \" lines terminated by \n header true",
+	}, {
+		input:  "select a from t into outfile '/Users/tmp/test.parquet' format 'PARQUET' row_group_size 10000 compression 'ZSTD'",
+		output: "select a from t into outfile /Users/tmp/test.parquet format parquet fields terminated by , enclosed by \" lines terminated by \n header true row_group_size 10000 compression zstd",
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ','",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
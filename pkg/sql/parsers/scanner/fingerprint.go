@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
+// Fingerprint normalizes the sql into the shape of the statement, statements
+// which differ only in literals have the same fingerprint. Literals are replaced
+// by '?', lists of literals are folded into one, keywords and identifiers are
+// lower cased, comments are dropped and blanks are collapsed.
+// e.g. "SELECT a FROM t WHERE b IN (1, 2, 3)" -> "select a from t where b in (?)"
+func Fingerprint(dialectType dialect.DialectType, sql string) string {
+	s := NewScanner(dialectType, sql)
+	var words []string
+	for {
+		begin := s.Pos
+		id, val := s.Scan()
+		if id == 0 || id == LEX_ERROR {
+			break
+		}
+		var word string
+		switch id {
+		case COMMENT, ';':
+			continue
+		case STRING, INTEGRAL, FLOAT, HEX, HEXNUM, BIT_LITERAL, VALUE_ARG:
+			word = "?"
+			// fold "?, ?" into "?"
+			if n := len(words); n >= 2 && words[n-1] == "," && words[n-2] == "?" {
+				words = words[:n-1]
+				continue
+			}
+		case AT_ID:
+			word = "@" + val
+		case AT_AT_ID:
+			word = "@@" + val
+		default:
+			if val != "" {
+				word = strings.ToLower(val)
+			} else {
+				word = strings.TrimSpace(s.buf[begin:s.Pos])
+				// block comments are skipped by Scan
+				if i := strings.LastIndex(word, "*/"); i >= 0 && strings.HasPrefix(word, "/*") {
+					word = strings.TrimSpace(word[i+2:])
+				}
+			}
+		}
+		words = append(words, word)
+	}
+
+	var buf strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			switch {
+			case word == "," || word == ")" || word == ".":
+			case words[i-1] == "(" || words[i-1] == ".":
+			default:
+				buf.WriteByte(' ')
+			}
+		}
+		buf.WriteString(word)
+	}
+	return buf.String()
+}
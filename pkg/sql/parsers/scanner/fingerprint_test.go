@@ -0,0 +1,40 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
+func TestFingerprint(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{"select 1", "select ?"},
+		{"SELECT a, b FROM t WHERE c = 'x' AND d > 10.5", "select a, b from t where c = ? and d > ?"},
+		{"select * from db1.t1 where a in (1, 2, 3)", "select * from db1.t1 where a in (?)"},
+		{"insert into t values (1, 'a'), (2, 'b');", "insert into t values (?), (?)"},
+		{"select a from t /* comment */ where b >= 1 # tail", "select a from t where b >= ?"},
+		{"select @a, @@version from t where x = 0x1F", "select @a, @@version from t where x = ?"},
+	}
+	for _, tcase := range testcases {
+		if out := Fingerprint(dialect.MYSQL, tcase.in); out != tcase.out {
+			t.Errorf("Fingerprint(%s): %s, want %s", tcase.in, out, tcase.out)
+		}
+	}
+}
@@ -79,6 +79,7 @@ func initTokens(dialectType dialect.DialectType) {
 		HEADER = MYSQL_HEADER
 		MAX_FILE_SIZE = MYSQL_MAX_FILE_SIZE
 		FORCE_QUOTE = MYSQL_FORCE_QUOTE
+		ROW_GROUP_SIZE = MYSQL_ROW_GROUP_SIZE
 		AVG = MYSQL_AVG
 		ADDDATE = MYSQL_ADDDATE
 		COUNT = MYSQL_COUNT
@@ -87,6 +88,8 @@ func initTokens(dialectType dialect.DialectType) {
 		DATE_ADD = MYSQL_DATE_ADD
 		DATE_SUB = MYSQL_DATE_SUB
 		EXTRACT = MYSQL_EXTRACT
+		TIMESTAMPADD = MYSQL_TIMESTAMPADD
+		TIMESTAMPDIFF = MYSQL_TIMESTAMPDIFF
 		MAX = MYSQL_MAX
 		MID = MYSQL_MID
 		NOW = MYSQL_NOW
@@ -182,6 +185,7 @@ func initTokens(dialectType dialect.DialectType) {
 		REVOKE = MYSQL_REVOKE
 		EXCEPT = MYSQL_EXCEPT
 		LOCAL = MYSQL_LOCAL
+		KILL = MYSQL_KILL
 		ASSIGNMENT = MYSQL_ASSIGNMENT
 		NO = MYSQL_NO
 		CHAIN = MYSQL_CHAIN
@@ -645,7 +649,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
-		"kill":                     UNUSED,
+		"kill":                     KILL,
 		"language":                 LANGUAGE,
 		"last_insert_id":           LAST_INSERT_ID,
 		"leading":                  LEADING,
@@ -719,6 +723,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"header":                   HEADER,
 		"max_file_size":            MAX_FILE_SIZE,
 		"force_quote":              FORCE_QUOTE,
+		"row_group_size":           ROW_GROUP_SIZE,
 		"parser":                   PARSER,
 		"partition":                PARTITION,
 		"partitions":               PARTITIONS,
@@ -873,6 +878,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"date_add":                 DATE_ADD,
 		"date_sub":                 DATE_SUB,
 		"extract":                  EXTRACT,
+		"timestampadd":             TIMESTAMPADD,
+		"timestampdiff":            TIMESTAMPDIFF,
 		"max":                      MAX,
 		"mid":                      MID,
 		"now":                      NOW,
@@ -965,6 +972,8 @@ var (
 	DATE_ADD                 int
 	DATE_SUB                 int
 	EXTRACT                  int
+	TIMESTAMPADD             int
+	TIMESTAMPDIFF            int
 	MAX                      int
 	MID                      int
 	MIN                      int
@@ -1057,6 +1066,7 @@ var (
 	REVOKE                   int
 	EXCEPT                   int
 	LOCAL                    int
+	KILL                     int
 	ASSIGNMENT               int
 	RELEASE                  int
 	NO                       int
@@ -1333,6 +1343,7 @@ var (
 	HEADER                   int
 	MAX_FILE_SIZE            int
 	FORCE_QUOTE              int
+	ROW_GROUP_SIZE           int
 	STARTING                 int
 	LINES                    int
 	UNUSED                   int
@@ -349,86 +349,90 @@ const MYSQL_UNCOMMITTED = 57690
 const MYSQL_SERIALIZABLE = 57691
 const MYSQL_LOCAL = 57692
 const MYSQL_EXCEPT = 57693
-const MYSQL_CURRENT_TIMESTAMP = 57694
-const MYSQL_DATABASE = 57695
-const MYSQL_CURRENT_TIME = 57696
-const MYSQL_LOCALTIME = 57697
-const MYSQL_LOCALTIMESTAMP = 57698
-const MYSQL_UTC_DATE = 57699
-const MYSQL_UTC_TIME = 57700
-const MYSQL_UTC_TIMESTAMP = 57701
-const MYSQL_REPLACE = 57702
-const MYSQL_CONVERT = 57703
-const MYSQL_SEPARATOR = 57704
-const MYSQL_CURRENT_DATE = 57705
-const MYSQL_CURRENT_USER = 57706
-const MYSQL_CURRENT_ROLE = 57707
-const MYSQL_SECOND_MICROSECOND = 57708
-const MYSQL_MINUTE_MICROSECOND = 57709
-const MYSQL_MINUTE_SECOND = 57710
-const MYSQL_HOUR_MICROSECOND = 57711
-const MYSQL_HOUR_SECOND = 57712
-const MYSQL_HOUR_MINUTE = 57713
-const MYSQL_DAY_MICROSECOND = 57714
-const MYSQL_DAY_SECOND = 57715
-const MYSQL_DAY_MINUTE = 57716
-const MYSQL_DAY_HOUR = 57717
-const MYSQL_YEAR_MONTH = 57718
-const MYSQL_SQL_TSI_HOUR = 57719
-const MYSQL_SQL_TSI_DAY = 57720
-const MYSQL_SQL_TSI_WEEK = 57721
-const MYSQL_SQL_TSI_MONTH = 57722
-const MYSQL_SQL_TSI_QUARTER = 57723
-const MYSQL_SQL_TSI_YEAR = 57724
-const MYSQL_SQL_TSI_SECOND = 57725
-const MYSQL_SQL_TSI_MINUTE = 57726
-const MYSQL_RECURSIVE = 57727
-const MYSQL_MATCH = 57728
-const MYSQL_AGAINST = 57729
-const MYSQL_BOOLEAN = 57730
-const MYSQL_LANGUAGE = 57731
-const MYSQL_WITH = 57732
-const MYSQL_QUERY = 57733
-const MYSQL_EXPANSION = 57734
-const MYSQL_ADDDATE = 57735
-const MYSQL_BIT_AND = 57736
-const MYSQL_BIT_OR = 57737
-const MYSQL_BIT_XOR = 57738
-const MYSQL_CAST = 57739
-const MYSQL_COUNT = 57740
-const MYSQL_APPROX_COUNT_DISTINCT = 57741
-const MYSQL_APPROX_PERCENTILE = 57742
-const MYSQL_CURDATE = 57743
-const MYSQL_CURTIME = 57744
-const MYSQL_DATE_ADD = 57745
-const MYSQL_DATE_SUB = 57746
-const MYSQL_EXTRACT = 57747
-const MYSQL_GROUP_CONCAT = 57748
-const MYSQL_MAX = 57749
-const MYSQL_MID = 57750
-const MYSQL_MIN = 57751
-const MYSQL_NOW = 57752
-const MYSQL_POSITION = 57753
-const MYSQL_SESSION_USER = 57754
-const MYSQL_STD = 57755
-const MYSQL_STDDEV = 57756
-const MYSQL_STDDEV_POP = 57757
-const MYSQL_STDDEV_SAMP = 57758
-const MYSQL_SUBDATE = 57759
-const MYSQL_SUBSTR = 57760
-const MYSQL_SUBSTRING = 57761
-const MYSQL_SUM = 57762
-const MYSQL_SYSDATE = 57763
-const MYSQL_SYSTEM_USER = 57764
-const MYSQL_TRANSLATE = 57765
-const MYSQL_TRIM = 57766
-const MYSQL_VARIANCE = 57767
-const MYSQL_VAR_POP = 57768
-const MYSQL_VAR_SAMP = 57769
-const MYSQL_AVG = 57770
-const MYSQL_ROW = 57771
-const MYSQL_OUTFILE = 57772
-const MYSQL_HEADER = 57773
-const MYSQL_MAX_FILE_SIZE = 57774
-const MYSQL_FORCE_QUOTE = 57775
-const MYSQL_UNUSED = 57776
+const MYSQL_KILL = 57694
+const MYSQL_CURRENT_TIMESTAMP = 57695
+const MYSQL_DATABASE = 57696
+const MYSQL_CURRENT_TIME = 57697
+const MYSQL_LOCALTIME = 57698
+const MYSQL_LOCALTIMESTAMP = 57699
+const MYSQL_UTC_DATE = 57700
+const MYSQL_UTC_TIME = 57701
+const MYSQL_UTC_TIMESTAMP = 57702
+const MYSQL_REPLACE = 57703
+const MYSQL_CONVERT = 57704
+const MYSQL_SEPARATOR = 57705
+const MYSQL_CURRENT_DATE = 57706
+const MYSQL_CURRENT_USER = 57707
+const MYSQL_CURRENT_ROLE = 57708
+const MYSQL_SECOND_MICROSECOND = 57709
+const MYSQL_MINUTE_MICROSECOND = 57710
+const MYSQL_MINUTE_SECOND = 57711
+const MYSQL_HOUR_MICROSECOND = 57712
+const MYSQL_HOUR_SECOND = 57713
+const MYSQL_HOUR_MINUTE = 57714
+const MYSQL_DAY_MICROSECOND = 57715
+const MYSQL_DAY_SECOND = 57716
+const MYSQL_DAY_MINUTE = 57717
+const MYSQL_DAY_HOUR = 57718
+const MYSQL_YEAR_MONTH = 57719
+const MYSQL_SQL_TSI_HOUR = 57720
+const MYSQL_SQL_TSI_DAY = 57721
+const MYSQL_SQL_TSI_WEEK = 57722
+const MYSQL_SQL_TSI_MONTH = 57723
+const MYSQL_SQL_TSI_QUARTER = 57724
+const MYSQL_SQL_TSI_YEAR = 57725
+const MYSQL_SQL_TSI_SECOND = 57726
+const MYSQL_SQL_TSI_MINUTE = 57727
+const MYSQL_RECURSIVE = 57728
+const MYSQL_MATCH = 57729
+const MYSQL_AGAINST = 57730
+const MYSQL_BOOLEAN = 57731
+const MYSQL_LANGUAGE = 57732
+const MYSQL_WITH = 57733
+const MYSQL_QUERY = 57734
+const MYSQL_EXPANSION = 57735
+const MYSQL_ADDDATE = 57736
+const MYSQL_BIT_AND = 57737
+const MYSQL_BIT_OR = 57738
+const MYSQL_BIT_XOR = 57739
+const MYSQL_CAST = 57740
+const MYSQL_COUNT = 57741
+const MYSQL_APPROX_COUNT_DISTINCT = 57742
+const MYSQL_APPROX_PERCENTILE = 57743
+const MYSQL_CURDATE = 57744
+const MYSQL_CURTIME = 57745
+const MYSQL_DATE_ADD = 57746
+const MYSQL_DATE_SUB = 57747
+const MYSQL_EXTRACT = 57748
+const MYSQL_TIMESTAMPADD = 57749
+const MYSQL_TIMESTAMPDIFF = 57750
+const MYSQL_GROUP_CONCAT = 57751
+const MYSQL_MAX = 57752
+const MYSQL_MID = 57753
+const MYSQL_MIN = 57754
+const MYSQL_NOW = 57755
+const MYSQL_POSITION = 57756
+const MYSQL_SESSION_USER = 57757
+const MYSQL_STD = 57758
+const MYSQL_STDDEV = 57759
+const MYSQL_STDDEV_POP = 57760
+const MYSQL_STDDEV_SAMP = 57761
+const MYSQL_SUBDATE = 57762
+const MYSQL_SUBSTR = 57763
+const MYSQL_SUBSTRING = 57764
+const MYSQL_SUM = 57765
+const MYSQL_SYSDATE = 57766
+const MYSQL_SYSTEM_USER = 57767
+const MYSQL_TRANSLATE = 57768
+const MYSQL_TRIM = 57769
+const MYSQL_VARIANCE = 57770
+const MYSQL_VAR_POP = 57771
+const MYSQL_VAR_SAMP = 57772
+const MYSQL_AVG = 57773
+const MYSQL_ROW = 57774
+const MYSQL_OUTFILE = 57775
+const MYSQL_HEADER = 57776
+const MYSQL_MAX_FILE_SIZE = 57777
+const MYSQL_FORCE_QUOTE = 57778
+const MYSQL_ROW_GROUP_SIZE = 57779
+const MYSQL_UNUSED = 57780
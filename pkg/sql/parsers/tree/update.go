@@ -20,7 +20,7 @@ import (
 	"strconv"
 )
 
-//update statement
+// update statement
 type Update struct {
 	statementImpl
 	Table   TableExpr
@@ -78,7 +78,7 @@ func (node *UpdateExprs) Format(ctx *FmtCtx) {
 	}
 }
 
-//the update expression.
+// the update expression.
 type UpdateExpr struct {
 	NodeFormatter
 	Tuple bool
@@ -105,11 +105,13 @@ func NewUpdateExpr(t bool, n []*UnresolvedName, e Expr) *UpdateExpr {
 	}
 }
 
-//Load data statement
+// Load data statement
 type Load struct {
 	statementImpl
-	Local             bool
-	File              string
+	Local bool
+	File  string
+	// FileFormat, the format of the file, csv by default
+	FileFormat        string
 	DuplicateHandling DuplicateKey
 	Table             *TableName
 	//Partition
@@ -132,6 +134,10 @@ func (node *Load) Format(ctx *FmtCtx) {
 	}
 	ctx.WriteString(" infile ")
 	ctx.WriteString(node.File)
+	if node.FileFormat != "" {
+		ctx.WriteString(" format ")
+		ctx.WriteString(node.FileFormat)
+	}
 
 	switch node.DuplicateHandling.(type) {
 	case *DuplicateKeyError:
@@ -284,7 +290,7 @@ func NewLines(s string, t string) *Lines {
 	}
 }
 
-//column element in load data column list
+// column element in load data column list
 type LoadColumn interface {
 	NodeFormatter
 }
@@ -298,6 +304,8 @@ type ExportParam struct {
 	Outfile bool
 	// filename path
 	FilePath string
+	// FileFormat, the format of the file, csv by default
+	FileFormat string
 	// Fields
 	Fields *Fields
 	// Lines
@@ -313,6 +321,10 @@ type ExportParam struct {
 	ForceQuote []string
 	ColumnFlag []bool
 	Symbol     [][]byte
+	// RowGroupSize, the max rows of a row group of the parquet file
+	RowGroupSize uint64
+	// Compression, the compression of the parquet file
+	Compression string
 
 	// default flush size
 	DefaultBufSize int64
@@ -325,6 +337,9 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 		return
 	}
 	ctx.WriteString("into outfile " + ep.FilePath)
+	if ep.FileFormat != "" {
+		ctx.WriteString(" format " + ep.FileFormat)
+	}
 	if ep.Fields != nil {
 		ctx.WriteByte(' ')
 		ep.Fields.Format(ctx)
@@ -352,6 +367,13 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 			prefix = ", "
 		}
 	}
+	if ep.RowGroupSize != 0 {
+		ctx.WriteString(" row_group_size ")
+		ctx.WriteString(strconv.FormatUint(ep.RowGroupSize, 10))
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression " + ep.Compression)
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
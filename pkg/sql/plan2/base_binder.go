@@ -689,26 +689,6 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr, depth int32) (*plan.Expr, error) {
 	// rewrite some ast Exprs before binding
 	switch name {
-	case "nullif":
-		// rewrite 'nullif(expr1, expr2)' to 'case when expr1=expr2 then null else expr1'
-		if len(astArgs) != 2 {
-			return nil, errors.New("", "nullif function need two args")
-		}
-		elseExpr := astArgs[0]
-		thenExpr := tree.NewNumVal(constant.MakeUnknown(), "", false)
-		whenExpr := tree.NewComparisonExpr(tree.EQUAL, astArgs[0], astArgs[1])
-		astArgs = []tree.Expr{whenExpr, thenExpr, elseExpr}
-		name = "case"
-	case "ifnull":
-		// rewrite 'ifnull(expr1, expr2)' to 'case when isnull(expr1) then expr2 else null'
-		if len(astArgs) != 2 {
-			return nil, errors.New("", "ifnull function need two args")
-		}
-		elseExpr := tree.NewNumVal(constant.MakeUnknown(), "", false)
-		thenExpr := astArgs[1]
-		whenExpr := tree.NewIsNullExpr(astArgs[0])
-		astArgs = []tree.Expr{whenExpr, thenExpr, elseExpr}
-		name = "case"
 	case "extract":
 		// ”extract(year from col_name)"  parser return year as UnresolvedName.
 		// we must rewrite it to string。 because binder bind UnresolvedName as column name
@@ -794,6 +774,13 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
+	case "timestampadd", "timestampdiff":
+		// rewrite the unit of timestampadd(unit, num, date) and timestampdiff(unit, date1, date2),
+		// which is a string built by parser, to int64
+		args, err = resetTimestampFunctionArgs(name, args)
+		if err != nil {
+			return nil, err
+		}
 	case "+":
 		// rewrite "date '2001' + interval '1 day'" to date_add(date '2001', 1, day(unit))
 		if len(args) != 2 {
@@ -1064,6 +1051,56 @@ func resetDateFunctionArgs(dateExpr *Expr, intervalExpr *Expr) ([]*Expr, error)
 	}, nil
 }
 
+func resetTimestampFunctionArgs(name string, args []*Expr) ([]*Expr, error) {
+	if len(args) != 3 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, name+" function need three args")
+	}
+	unitExpr, ok := args[0].Expr.(*plan.Expr_C)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "the unit of "+name+" must be constant")
+	}
+	unitStr, ok := unitExpr.C.Value.(*plan.Const_Sval)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "the unit of "+name+" must be constant")
+	}
+	intervalType, err := types.IntervalTypeOf(unitStr.Sval)
+	if err != nil {
+		return nil, err
+	}
+	switch intervalType {
+	case types.MicroSecond, types.Second, types.Minute, types.Hour, types.Day,
+		types.Week, types.Month, types.Quarter, types.Year:
+	default:
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid unit '%s' of %s", unitStr.Sval, name))
+	}
+
+	// "timestampadd(hour, 1, date '2020-10-10')" will return datetime, the same as date_add
+	if name == "timestampadd" && args[2].Typ.Id == plan.Type_DATE && !types.UnitIsDayOrLarger(intervalType) {
+		args[2], err = appendCastBeforeExpr(args[2], &plan.Type{
+			Id:   plan.Type_DATETIME,
+			Size: 8,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	args[0] = &Expr{
+		Expr: &plan.Expr_C{
+			C: &Const{
+				Value: &plan.Const_Ival{
+					Ival: int64(intervalType),
+				},
+			},
+		},
+		Typ: &plan.Type{
+			Id:   plan.Type_INT64,
+			Size: 8,
+		},
+	}
+	return args, nil
+}
+
 //splitConjunctiveCondition split a expression to a list of AND conditions.
 func splitConjunctiveCondition(astExpr tree.Expr) []tree.Expr {
 	var astExprs []tree.Expr
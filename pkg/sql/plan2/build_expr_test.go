@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/rule"
 	"github.com/smartystreets/goconvey/convey"
 )
 
@@ -394,3 +395,48 @@ func runOneExprStmt(opt Optimizer, t *testing.T, sql string) (*plan.Plan, error)
 	}
 	return pl, nil
 }
+
+func TestExpr_C(t *testing.T) {
+	convey.Convey("coalesce constant fold succ", t, func() {
+		mock := NewMockOptimizer()
+		input := []string{
+			"select coalesce(null, 2.5, 1.5) from dual;",
+			"select ifnull(null, null) from dual;",
+			"select coalesce(null, n_regionkey, 1) from nation;",
+			"select ifnull(null, n_regionkey) from nation;",
+		}
+		for i := 0; i < len(input); i++ {
+			pl, err := runOneExprStmt(mock, t, input[i])
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			query, ok := pl.Plan.(*plan.Plan_Query)
+			if !ok {
+				t.Fatalf("%+v", errors.New("return type is not right"))
+			}
+			r := rule.NewConstantFlod()
+			for _, node := range query.Query.Nodes {
+				r.Apply(node, query.Query)
+			}
+			expr := query.Query.Nodes[len(query.Query.Nodes)-1].ProjectList[0]
+			switch i {
+			case 0:
+				c, ok := expr.Expr.(*plan.Expr_C)
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(c.C.GetDval(), convey.ShouldEqual, 2.5)
+			case 1:
+				c, ok := expr.Expr.(*plan.Expr_C)
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(c.C.Isnull, convey.ShouldBeTrue)
+			case 2:
+				f, ok := expr.Expr.(*plan.Expr_F)
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(f.F.Func.ObjName, convey.ShouldEqual, "coalesce")
+				convey.So(len(f.F.Args), convey.ShouldEqual, 2)
+			case 3:
+				_, ok := expr.Expr.(*plan.Expr_Col)
+				convey.So(ok, convey.ShouldBeTrue)
+			}
+		}
+	})
+}
@@ -418,6 +418,16 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"select n_name from nation where n_name != @str_var and n_regionkey > @int_var",
 		"select n_name from nation where n_name != @@global.str_var and n_regionkey > @@session.int_var",
 		"select distinct(n_name), ((abs(n_regionkey))) from nation",
+		"select upper(n_name), lower(n_comment), concat(n_name, '-', n_comment), replace(n_name, 'A', 'a') from nation",
+		"select trim(n_name), trim(leading 'x' from n_name), left(n_name, 2), right(n_name, n_regionkey) from nation",
+		"select locate('A', n_name), position('A' in n_name), repeat(n_name, 2), ascii(n_name), chr(65), translate(n_name, 'AB', 'ab') from nation",
+		"select coalesce(n_comment, n_name, 'none'), greatest(n_regionkey, n_nationkey, 1.5), least(n_name, n_comment) from nation",
+		"select nullif(n_regionkey, 2), ifnull(n_regionkey, 0.5), coalesce(null, n_regionkey, null) from nation",
+		"select md5(n_name), sha1(n_name), sha2(n_comment, 256), crc32(n_name), hex(n_name), hex(n_regionkey), unhex(hex(n_name)) from nation",
+		"select to_base64(n_comment), from_base64(to_base64(n_comment)), sha(n_name) from nation",
+		"select datediff(l_receiptdate, l_shipdate), date_format(l_shipdate, '%Y-%m-%d %W'), last_day(l_commitdate) from lineitem",
+		"select timestampdiff(day, l_shipdate, l_receiptdate), timestampadd(month, 1, l_shipdate), timestampadd(hour, l_linenumber, l_shipdate) from lineitem",
+		"select str_to_date('May 1, 2013', '%M %d,%Y'), timestampdiff(sql_tsi_minute, str_to_date(n_comment, '%Y%m%d'), l_shipdate) from nation, lineitem",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
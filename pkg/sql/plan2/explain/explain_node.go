@@ -16,6 +16,7 @@ package explain
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -403,6 +404,7 @@ var _ NodeElemDescribe = &OrderByDescribeImpl{}
 var _ NodeElemDescribe = &WinSpecDescribeImpl{}
 var _ NodeElemDescribe = &RowsetDataDescribeImpl{}
 var _ NodeElemDescribe = &UpdateListDescribeImpl{}
+var _ NodeElemDescribe = &AnalyzeInfoDescribeImpl{}
 
 type CostDescribeImpl struct {
 	Cost *plan.Cost
@@ -537,3 +539,25 @@ func (u *UpdateListDescribeImpl) GetDescription(options *ExplainOptions) (string
 	//}
 	return result, nil
 }
+
+type AnalyzeInfoDescribeImpl struct {
+	AnalyzeInfo *AnalyzeInfo
+}
+
+func (a *AnalyzeInfoDescribeImpl) GetDescription(options *ExplainOptions) (string, error) {
+	info := a.AnalyzeInfo
+	if info == nil {
+		info = &AnalyzeInfo{}
+	}
+	result := "Analyze: timeConsumed=" + time.Duration(info.TimeConsumed).String() +
+		" inputRows=" + strconv.FormatInt(info.InputRows, 10) +
+		" outputRows=" + strconv.FormatInt(info.OutputRows, 10) +
+		" inputBatches=" + strconv.FormatInt(info.InputBatches, 10) +
+		" outputBatches=" + strconv.FormatInt(info.OutputBatches, 10) +
+		" memorySize=" + strconv.FormatInt(info.MemorySize, 10) + "bytes"
+	if info.ReadBlocks != 0 || info.PrunedBlocks != 0 {
+		result += " readBlocks=" + strconv.FormatInt(info.ReadBlocks, 10) +
+			" prunedBlocks=" + strconv.FormatInt(info.PrunedBlocks, 10)
+	}
+	return result, nil
+}
@@ -15,6 +15,9 @@
 package explain
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -25,6 +28,9 @@ var _ ExplainQuery = &ExplainQueryImpl{}
 
 type ExplainQueryImpl struct {
 	QueryPlan *plan.Query
+	// Analysis, runtime statistics of the plan nodes keyed by node id, which are
+	// printed by ExplainAnalyze
+	Analysis map[int32]*AnalyzeInfo
 }
 
 func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
@@ -34,14 +40,33 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	return e.explain(buffer, options, nil)
+}
+
+func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	analysis := e.Analysis
+	if analysis == nil {
+		analysis = make(map[int32]*AnalyzeInfo)
+	}
+	return e.explain(buffer, options, analysis)
+}
+
+func (e *ExplainQueryImpl) explain(buffer *ExplainDataBuffer, options *ExplainOptions, analysis map[int32]*AnalyzeInfo) error {
 	var Nodes []*plan.Node = e.QueryPlan.Nodes
 	for index, rootNodeId := range e.QueryPlan.Steps {
 		logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
+		if options.Format == EXPLAIN_FORMAT_JSON {
+			if err := explainJson(Nodes[rootNodeId], Nodes, buffer, options, analysis); err != nil {
+				return err
+			}
+			continue
+		}
 		settings := FormatSettings{
-			buffer: buffer,
-			offset: 0,
-			indent: 2,
-			level:  0,
+			buffer:   buffer,
+			offset:   0,
+			indent:   2,
+			level:    0,
+			analysis: analysis,
 		}
 		err := traversalPlan(Nodes[rootNodeId], Nodes, &settings, options)
 		if err != nil {
@@ -51,11 +76,6 @@ func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *Expla
 	return nil
 }
 
-func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
-	// TODO implement me
-	panic("implement me")
-}
-
 func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOptions) error {
 	nodedescImpl := NewNodeDescriptionImpl(step)
 
@@ -97,8 +117,18 @@ func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOpti
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+
+		// Get runtime statistics of the node, "Analyze:"
+		if settings.analysis != nil {
+			analyzeDescImpl := &AnalyzeInfoDescribeImpl{
+				AnalyzeInfo: settings.analysis[step.NodeId],
+			}
+			analyzeInfo, err := analyzeDescImpl.GetDescription(options)
+			if err != nil {
+				return err
+			}
+			settings.buffer.PushNewLine(analyzeInfo, false, settings.level)
+		}
 	} else if options.Format == EXPLAIN_FORMAT_DOT {
 		return errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
 	}
@@ -140,3 +170,70 @@ func serachNodeIndex(nodeId int32, Nodes []*plan.Node) (int32, error) {
 	}
 	return -1, errors.New(errno.InternalError, "Invalid Plan nodeId")
 }
+
+// JsonNode is a plan node in the json format explain output
+type JsonNode struct {
+	NodeId   int32        `json:"node_id"`
+	Title    string       `json:"title"`
+	Output   string       `json:"output,omitempty"`
+	Extra    []string     `json:"extra,omitempty"`
+	Analyze  *AnalyzeInfo `json:"analyze,omitempty"`
+	Children []*JsonNode  `json:"children,omitempty"`
+}
+
+// explainJson pushes the plan tree rooted at node into buffer as an indented json document
+func explainJson(node *plan.Node, Nodes []*plan.Node, buffer *ExplainDataBuffer, options *ExplainOptions, analysis map[int32]*AnalyzeInfo) error {
+	root, err := buildJsonNode(node, Nodes, options, analysis)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		buffer.PushNewLine(line, true, 0)
+	}
+	return nil
+}
+
+// buildJsonNode builds the json tree of the plan rooted at node, the node
+// descriptions in the tree are in text format
+func buildJsonNode(node *plan.Node, Nodes []*plan.Node, options *ExplainOptions, analysis map[int32]*AnalyzeInfo) (*JsonNode, error) {
+	var err error
+
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+	options = &textOptions
+	nodedescImpl := NewNodeDescriptionImpl(node)
+	jnode := &JsonNode{NodeId: node.NodeId}
+	if jnode.Title, err = nodedescImpl.GetNodeBasicInfo(options); err != nil {
+		return nil, err
+	}
+	if options.Verbose && node.GetProjectList() != nil {
+		if jnode.Output, err = nodedescImpl.GetProjectListInfo(options); err != nil {
+			return nil, err
+		}
+	}
+	if jnode.Extra, err = nodedescImpl.GetExtraInfo(options); err != nil {
+		return nil, err
+	}
+	if analysis != nil {
+		jnode.Analyze = analysis[node.NodeId]
+		if jnode.Analyze == nil {
+			jnode.Analyze = &AnalyzeInfo{}
+		}
+	}
+	for _, childNodeId := range node.Children {
+		index, err := serachNodeIndex(childNodeId, Nodes)
+		if err != nil {
+			return nil, err
+		}
+		child, err := buildJsonNode(Nodes[index], Nodes, options, analysis)
+		if err != nil {
+			return nil, err
+		}
+		jnode.Children = append(jnode.Children, child)
+	}
+	return jnode, nil
+}
@@ -15,11 +15,13 @@
 package explain
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -231,6 +233,90 @@ func TestDMLDelete(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainAnalyze(t *testing.T) {
+	mock := plan2.NewMockOptimizer()
+	sql := "SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_REGIONKEY > 0 ORDER BY N_NAME LIMIT 10"
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	logicPlan, err := plan2.BuildPlan(mock.CurrentContext(), stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	explainQuery := NewExplainQueryImpl(qry)
+	explainQuery.Analysis = make(map[int32]*AnalyzeInfo)
+	for i, n := range qry.Nodes {
+		explainQuery.Analysis[n.NodeId] = &AnalyzeInfo{
+			InputRows:     100,
+			OutputRows:    int64(10 * (i + 1)),
+			InputBatches:  1,
+			OutputBatches: 1,
+			TimeConsumed:  1000,
+		}
+		if n.NodeType == plan.Node_TABLE_SCAN {
+			explainQuery.Analysis[n.NodeId].ReadBlocks = 4
+			explainQuery.Analysis[n.NodeId].PrunedBlocks = 6
+		}
+	}
+
+	es := NewExplainDefaultOptions()
+	es.Anzlyze = true
+	buffer := NewExplainDataBuffer()
+	if err = explainQuery.ExplainAnalyze(buffer, es); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	lines, scans := 0, 0
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "Analyze: timeConsumed=1µs inputRows=100") {
+			lines++
+		}
+		if strings.Contains(line, "readBlocks=4 prunedBlocks=6") {
+			scans++
+		}
+	}
+	if scans != 1 {
+		t.Fatalf("expect the blocks of 1 table scan, got %d: %v", scans, buffer.Lines)
+	}
+
+	es.Format = EXPLAIN_FORMAT_JSON
+	buffer = NewExplainDataBuffer()
+	if err = explainQuery.ExplainAnalyze(buffer, es); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var root JsonNode
+	if err = json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &root); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var check func(n *JsonNode) int
+	check = func(n *JsonNode) int {
+		if n.Analyze == nil || *n.Analyze != *explainQuery.Analysis[n.NodeId] {
+			t.Fatalf("unexpected analyze info of node %d: %v", n.NodeId, n.Analyze)
+		}
+		cnt := 1
+		for _, c := range n.Children {
+			cnt += check(c)
+		}
+		return cnt
+	}
+	if cnt := check(&root); cnt != lines {
+		t.Fatalf("expect %d analyze lines, got %d: %v", cnt, lines, buffer.Lines)
+	}
+
+	buffer = NewExplainDataBuffer()
+	if err = explainQuery.ExplainPlan(buffer, es); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	root = JsonNode{}
+	if err = json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &root); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if root.Analyze != nil {
+		t.Fatalf("unexpected analyze info in explain plan")
+	}
+}
+
 func runTestShouldPass(opt plan2.Optimizer, t *testing.T, sqls []string) {
 	for _, sql := range sqls {
 		err := runOneStmt(opt, t, sql)
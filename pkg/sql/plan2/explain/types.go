@@ -45,6 +45,22 @@ type FormatSettings struct {
 	offset int
 	indent int
 	level  int
+	// analysis, runtime statistics of the plan nodes, nil if not analyzed
+	analysis map[int32]*AnalyzeInfo
+}
+
+// AnalyzeInfo contains the runtime statistics of a plan node gathered by
+// running the query, TimeConsumed is in nanoseconds and MemorySize in bytes.
+// ReadBlocks and PrunedBlocks are only set for table scans.
+type AnalyzeInfo struct {
+	InputRows     int64 `json:"input_rows"`
+	OutputRows    int64 `json:"output_rows"`
+	InputBatches  int64 `json:"input_batches"`
+	OutputBatches int64 `json:"output_batches"`
+	TimeConsumed  int64 `json:"time_consumed"`
+	MemorySize    int64 `json:"memory_size"`
+	ReadBlocks    int64 `json:"read_blocks"`
+	PrunedBlocks  int64 `json:"pruned_blocks"`
 }
 
 type ExplainDataBuffer struct {
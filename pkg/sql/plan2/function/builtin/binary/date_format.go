@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/date_format"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// DateFormat formats a date, datetime or timestamp by the format string of mysql's DATE_FORMAT
+func DateFormat(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	dateVector, formatVector := vectors[0], vectors[1]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if dateVector.IsScalarNull() || formatVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	dateValues, formatValues := datetimesOf(dateVector), formatVector.Col.(*types.Bytes)
+	if dateVector.IsScalar() && formatVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := &types.Bytes{
+			Offsets: make([]uint32, 0, 1),
+			Lengths: make([]uint32, 0, 1),
+		}
+		vector.SetCol(resultVector, date_format.DateFormat(dateValues, formatValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(dateVector)
+	if dateVector.IsScalar() {
+		length = vector.Length(formatVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, 0)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, length),
+		Lengths: make([]uint32, 0, length),
+	}
+	nulls.Or(dateVector.Nsp, formatVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, date_format.DateFormat(dateValues, formatValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestDateDiff(t *testing.T) {
+	convey.Convey("date", t, func() {
+		left := testutil.MakeDateVector([]string{"2007-12-31", "2010-11-30", ""}, []uint64{2})
+		right := testutil.MakeScalarDate("2007-12-30", 3)
+		proc := testutil.NewProc()
+		ovec, err := DateDiff([]*vector.Vector{left, right}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Col.([]int64)[:2], convey.ShouldResemble, []int64{1, 1066})
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("datetime", t, func() {
+		left := testutil.MakeScalarDateTime("2007-12-31 23:59:59", 1)
+		right := testutil.MakeScalarDateTime("2007-12-30 00:00:01", 1)
+		proc := testutil.NewProc()
+		ovec, err := DateDiff([]*vector.Vector{left, right}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]int64), convey.ShouldResemble, []int64{1})
+
+		ovec, err = DateDiff([]*vector.Vector{left, testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestDateFormat(t *testing.T) {
+	convey.Convey("datetime", t, func() {
+		ivec := testutil.MakeDateTimeVector([]string{"2009-10-04 22:23:00", "", "1997-10-04 22:23:00"}, []uint64{1})
+		fvec := testutil.MakeScalarVarchar("%W %M %Y %r", 3)
+		proc := testutil.NewProc()
+		ovec, err := DateFormat([]*vector.Vector{ivec, fvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_varchar)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "Sunday October 2009 10:23:00 PM")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "Saturday October 1997 10:23:00 PM")
+	})
+
+	convey.Convey("date", t, func() {
+		ivec := testutil.MakeScalarDate("1999-01-01", 1)
+		fvec := testutil.MakeScalarVarchar("%X %V %j", 1)
+		proc := testutil.NewProc()
+		ovec, err := DateFormat([]*vector.Vector{ivec, fvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "1998 52 001")
+	})
+}
+
+func TestStrToDate(t *testing.T) {
+	convey.Convey("column", t, func() {
+		ivec := testutil.MakeVarcharVector([]string{"01,5,2013", "", "32,5,2013", "15,12,1999"}, []uint64{1})
+		fvec := testutil.MakeScalarVarchar("%d,%m,%Y", 4)
+		proc := testutil.NewProc()
+		ovec, err := StrToDate([]*vector.Vector{ivec, fvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_datetime)
+		col := ovec.Col.([]types.Datetime)
+		convey.So(col[0].String(), convey.ShouldEqual, "2013-05-01 00:00:00")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+		convey.So(col[3].String(), convey.ShouldEqual, "1999-12-15 00:00:00")
+	})
+
+	convey.Convey("scalar", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := StrToDate([]*vector.Vector{testutil.MakeScalarVarchar("May 1, 2013 9:30 pm", 1), testutil.MakeScalarVarchar("%M %d,%Y %h:%i %p", 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]types.Datetime)[0].String(), convey.ShouldEqual, "2013-05-01 21:30:00")
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datediff"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// DateDiff returns the number of days between two dates, only the date parts
+// of the arguments are used if they are datetime or timestamp.
+func DateDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	leftVector, rightVector := vectors[0], vectors[1]
+	resultType := types.Type{Oid: types.T_int64, Size: 8}
+	resultElementSize := int(resultType.Size)
+	if leftVector.IsScalarNull() || rightVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	leftValues, rightValues := datesOf(leftVector), datesOf(rightVector)
+	if leftVector.IsScalar() && rightVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]int64, 1)
+		vector.SetCol(resultVector, datediff.DateDiff(leftValues, rightValues, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(leftVector)
+	if leftVector.IsScalar() {
+		length = vector.Length(rightVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeInt64Slice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Or(leftVector.Nsp, rightVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, datediff.DateDiff(leftValues, rightValues, length, resultValues))
+	return resultVector, nil
+}
+
+// datesOf returns the values of a date, datetime or timestamp vector as dates.
+func datesOf(v *vector.Vector) []types.Date {
+	if v.Typ.Oid == types.T_date {
+		return v.Col.([]types.Date)
+	}
+	xs := datetimesOf(v)
+	rs := make([]types.Date, len(xs))
+	for i, x := range xs {
+		rs[i] = x.ToDate()
+	}
+	return rs
+}
+
+// datetimesOf returns the values of a date, datetime or timestamp vector as datetimes,
+// timestamps are converted to the local time zone.
+func datetimesOf(v *vector.Vector) []types.Datetime {
+	switch v.Typ.Oid {
+	case types.T_date:
+		xs := v.Col.([]types.Date)
+		rs, _ := types.DateToDatetime(xs, make([]types.Datetime, len(xs)))
+		return rs
+	case types.T_timestamp:
+		xs := v.Col.([]types.Timestamp)
+		rs, _ := types.TimestampToDatetime(xs, make([]types.Datetime, len(xs)))
+		return rs
+	}
+	return v.Col.([]types.Datetime)
+}
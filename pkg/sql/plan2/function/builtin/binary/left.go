@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/left"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Left(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringWithCount(vectors, proc, left.Left)
+}
+
+// stringWithCount evaluates a function whose arguments are a string and an int64
+// count, such as left(str, len), and whose result has the same type as the string.
+func stringWithCount(vectors []*vector.Vector, proc *process.Process,
+	fn func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if vectors[0].Typ.Oid == types.T_char {
+		resultType.Oid = types.T_char
+	}
+	return stringWithCountAs(vectors, proc, resultType, fn)
+}
+
+// stringWithCountAs is the same as stringWithCount, except that the type of result is given.
+func stringWithCountAs(vectors []*vector.Vector, proc *process.Process, resultType types.Type,
+	fn func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	strVector, countVector := vectors[0], vectors[1]
+	if strVector.IsScalarNull() || countVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	strValues, countValues := strVector.Col.(*types.Bytes), countVector.Col.([]int64)
+	if strVector.IsScalar() && countVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := &types.Bytes{
+			Offsets: make([]uint32, 0, 1),
+			Lengths: make([]uint32, 0, 1),
+		}
+		vector.SetCol(resultVector, fn(strValues, countValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(strVector)
+	if strVector.IsScalar() {
+		length = vector.Length(countVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(len(strValues.Data)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, length),
+		Lengths: make([]uint32, 0, length),
+	}
+	nulls.Or(strVector.Nsp, countVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, fn(strValues, countValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
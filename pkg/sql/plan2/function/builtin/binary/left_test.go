@@ -0,0 +1,69 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestLeft(t *testing.T) {
+	convey.Convey("column and scalar", t, func() {
+		svec := testutil.MakeVarcharVector([]string{"hello", "你好世界", ""}, []uint64{2})
+		nvec := testutil.MakeScalarInt64(2, 3)
+		proc := testutil.NewProc()
+		ovec, err := Left([]*vector.Vector{svec, nvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(len(col.Offsets), convey.ShouldEqual, 3)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "he")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "你好")
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("scalar and column", t, func() {
+		svec := testutil.MakeScalarVarchar("hello", 2)
+		nvec := testutil.MakeInt64Vector([]int64{1, 3}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Right([]*vector.Vector{svec, nvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "o")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "llo")
+	})
+
+	convey.Convey("scalar", t, func() {
+		svec := testutil.MakeScalarVarchar("ab", 2)
+		nvec := testutil.MakeScalarInt64(3, 2)
+		proc := testutil.NewProc()
+		ovec, err := Repeat([]*vector.Vector{svec, nvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "ababab")
+	})
+
+	convey.Convey("null", t, func() {
+		svec := testutil.MakeVarcharVector([]string{"a"}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Left([]*vector.Vector{svec, testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestSha2(t *testing.T) {
+	convey.Convey("column and scalar", t, func() {
+		svec := testutil.MakeCharVector([]string{"abc", ""}, []uint64{1})
+		nvec := testutil.MakeScalarInt64(256, 2)
+		proc := testutil.NewProc()
+		ovec, err := Sha2([]*vector.Vector{svec, nvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_varchar)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("invalid length", t, func() {
+		svec := testutil.MakeScalarVarchar("abc", 1)
+		nvec := testutil.MakeScalarInt64(100, 1)
+		proc := testutil.NewProc()
+		ovec, err := Sha2([]*vector.Vector{svec, nvec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
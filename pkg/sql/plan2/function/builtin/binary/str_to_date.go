@@ -0,0 +1,55 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/str_to_date"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// StrToDate parses a string by the format string of mysql's STR_TO_DATE,
+// the result is null if the string doesn't match the format.
+func StrToDate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	strVector, formatVector := vectors[0], vectors[1]
+	resultType := types.Type{Oid: types.T_datetime, Size: 8}
+	resultElementSize := int(resultType.Size)
+	if strVector.IsScalarNull() || formatVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	strValues, formatValues := strVector.Col.(*types.Bytes), formatVector.Col.(*types.Bytes)
+	if strVector.IsScalar() && formatVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]types.Datetime, 1)
+		vector.SetCol(resultVector, str_to_date.StrToDate(strValues, formatValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(strVector)
+	if strVector.IsScalar() {
+		length = vector.Length(formatVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeDatetimeSlice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Or(strVector.Nsp, formatVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, str_to_date.StrToDate(strValues, formatValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
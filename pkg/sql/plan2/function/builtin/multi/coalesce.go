@@ -0,0 +1,345 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+// CoalesceRet is the set of fixed-length types supported by
+// coalesce, ifnull, nullif, greatest and least.
+type CoalesceRet interface {
+	constraints.Integer | constraints.Float | bool |
+		types.Date | types.Datetime | types.Timestamp |
+		types.Decimal64 | types.Decimal128
+}
+
+var (
+	CoalesceUint8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[uint8](vs, proc, types.Type{Oid: types.T_uint8})
+	}
+
+	CoalesceUint16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[uint16](vs, proc, types.Type{Oid: types.T_uint16})
+	}
+
+	CoalesceUint32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[uint32](vs, proc, types.Type{Oid: types.T_uint32})
+	}
+
+	CoalesceUint64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[uint64](vs, proc, types.Type{Oid: types.T_uint64})
+	}
+
+	CoalesceInt8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[int8](vs, proc, types.Type{Oid: types.T_int8})
+	}
+
+	CoalesceInt16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[int16](vs, proc, types.Type{Oid: types.T_int16})
+	}
+
+	CoalesceInt32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[int32](vs, proc, types.Type{Oid: types.T_int32})
+	}
+
+	CoalesceInt64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[int64](vs, proc, types.Type{Oid: types.T_int64})
+	}
+
+	CoalesceFloat32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[float32](vs, proc, types.Type{Oid: types.T_float32})
+	}
+
+	CoalesceFloat64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[float64](vs, proc, types.Type{Oid: types.T_float64})
+	}
+
+	CoalesceBool = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[bool](vs, proc, types.Type{Oid: types.T_bool})
+	}
+
+	CoalesceDate = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Date](vs, proc, types.Type{Oid: types.T_date})
+	}
+
+	CoalesceDatetime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
+	}
+
+	CoalesceTimestamp = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Timestamp](vs, proc, types.Type{Oid: types.T_timestamp})
+	}
+
+	CoalesceDecimal64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Decimal64](vs, proc, types.T_decimal64.ToType())
+	}
+
+	CoalesceDecimal128 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Decimal128](vs, proc, types.T_decimal128.ToType())
+	}
+
+	CoalesceVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceString(vs, proc, types.Type{Oid: types.T_varchar, Size: 24})
+	}
+
+	CoalesceChar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceString(vs, proc, types.Type{Oid: types.T_char, Size: 24})
+	}
+)
+
+// CoalesceTypeCheckFn is type check function for coalesce,
+// it accepts one or more arguments of the return type.
+func CoalesceTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
+	if len(inputTypes) == 0 {
+		return false
+	}
+	return sameTypeCheck(inputTypes, ret)
+}
+
+// IfnullTypeCheckFn is type check function for ifnull,
+// it accepts two arguments of the return type.
+func IfnullTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
+	if len(inputTypes) != 2 {
+		return false
+	}
+	return sameTypeCheck(inputTypes, ret)
+}
+
+func sameTypeCheck(inputTypes []types.T, ret types.T) bool {
+	for _, t := range inputTypes {
+		if t != ret && t != types.T_any {
+			return false
+		}
+	}
+	return true
+}
+
+// coalesceGeneral returns the first non-null argument of each row,
+// and NULL if all the arguments are NULL.
+func coalesceGeneral[T CoalesceRet](vs []*vector.Vector, proc *process.Process, typ types.Type) (*vector.Vector, error) {
+	cols, typ := fixedColumns[T](vs, typ)
+	length, ok := rowCount(vs)
+	if !ok {
+		for i, v := range vs {
+			if !v.IsScalarNull() {
+				return newFixedScalar(proc, typ, cols[i][0]), nil
+			}
+		}
+		return proc.AllocScalarNullVector(typ), nil
+	}
+
+	rs, rscols, err := allocFixedResult[T](proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		i := firstNotNull(vs, j)
+		if i < 0 {
+			nulls.Add(rs.Nsp, uint64(j))
+			continue
+		}
+		rscols[j] = valueAt(cols[i], vs[i], j)
+	}
+	return rs, nil
+}
+
+// coalesceString is the string version of coalesceGeneral.
+func coalesceString(vs []*vector.Vector, proc *process.Process, typ types.Type) (*vector.Vector, error) {
+	length, ok := rowCount(vs)
+	if !ok {
+		for _, v := range vs {
+			if !v.IsScalarNull() {
+				return newStringScalar(proc, typ, v.Col.(*types.Bytes).Get(0)), nil
+			}
+		}
+		return proc.AllocScalarNullVector(typ), nil
+	}
+
+	rs, rscols, err := allocStringColumn(vs, proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		i := firstNotNull(vs, j)
+		if i < 0 {
+			nulls.Add(rs.Nsp, uint64(j))
+			rscols.AppendOnce(nil)
+			continue
+		}
+		rscols.AppendOnce(bytesAt(vs[i], j))
+	}
+	vector.SetCol(rs, rscols)
+	return rs, nil
+}
+
+// rowCount returns the number of rows of the first non-constant vector,
+// and false if all the vectors are constants.
+func rowCount(vs []*vector.Vector) (int, bool) {
+	for _, v := range vs {
+		if !v.IsScalar() {
+			return vector.Length(v), true
+		}
+	}
+	return 1, false
+}
+
+// isNullAt returns true if the j-th row of v is NULL.
+func isNullAt(v *vector.Vector, j int) bool {
+	if v.IsScalar() {
+		return v.IsScalarNull()
+	}
+	return nulls.Contains(v.Nsp, uint64(j))
+}
+
+// anyNullAt returns true if the j-th row of any vector is NULL.
+func anyNullAt(vs []*vector.Vector, j int) bool {
+	for _, v := range vs {
+		if isNullAt(v, j) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstNotNull returns the index of the first vector whose j-th row is not NULL,
+// and -1 if there is no such vector.
+func firstNotNull(vs []*vector.Vector, j int) int {
+	for i, v := range vs {
+		if !isNullAt(v, j) {
+			return i
+		}
+	}
+	return -1
+}
+
+func valueAt[T any](col []T, v *vector.Vector, j int) T {
+	if v.IsScalar() {
+		return col[0]
+	}
+	return col[j]
+}
+
+func bytesAt(v *vector.Vector, j int) []byte {
+	if v.IsScalar() {
+		return v.Col.(*types.Bytes).Get(0)
+	}
+	return v.Col.(*types.Bytes).Get(int64(j))
+}
+
+// fixedColumns returns the columns of the vectors, the column of a constant NULL is nil.
+// Decimal columns are aligned to the biggest scale of the vectors,
+// and the returned type carries the width and scale of the result.
+func fixedColumns[T CoalesceRet](vs []*vector.Vector, typ types.Type) ([][]T, types.Type) {
+	cols := make([][]T, len(vs))
+	if typ.Oid != types.T_decimal64 && typ.Oid != types.T_decimal128 {
+		for i, v := range vs {
+			if !v.IsScalarNull() {
+				cols[i] = vector.MustTCols[T](v)
+			}
+		}
+		return cols, typ
+	}
+
+	first := true
+	for _, v := range vs {
+		if v.IsScalarNull() {
+			continue
+		}
+		if first {
+			// the result has the type of the arguments, including the
+			// size and precision not unified below
+			typ, first = v.Typ, false
+			continue
+		}
+		if v.Typ.Scale > typ.Scale {
+			typ.Scale = v.Typ.Scale
+		}
+		if v.Typ.Width > typ.Width {
+			typ.Width = v.Typ.Width
+		}
+	}
+	for i, v := range vs {
+		if v.IsScalarNull() {
+			continue
+		}
+		cols[i] = vector.MustTCols[T](v)
+		diff := typ.Scale - v.Typ.Scale
+		if diff == 0 || len(cols[i]) == 0 {
+			continue
+		}
+		switch col := any(cols[i]).(type) {
+		case []types.Decimal64:
+			cols[i] = any(types.AlignDecimal64UsingScaleDiffBatch(col, make([]types.Decimal64, len(col)), diff)).([]T)
+		case []types.Decimal128:
+			aligned := make([]types.Decimal128, len(col))
+			types.AlignDecimal128UsingScaleDiffBatch(col, aligned, diff)
+			cols[i] = any(aligned).([]T)
+		}
+	}
+	return cols, typ
+}
+
+func allocFixedResult[T CoalesceRet](proc *process.Process, typ types.Type, length int) (*vector.Vector, []T, error) {
+	rs, err := proc.AllocVector(typ, int64(length*typ.Oid.TypeLen()))
+	if err != nil {
+		return nil, nil, err
+	}
+	rs.Col = vector.DecodeFixedCol[T](rs, typ.Oid.TypeLen())
+	rs.Col = rs.Col.([]T)[:length]
+	return rs, rs.Col.([]T), nil
+}
+
+func newFixedScalar[T CoalesceRet](proc *process.Process, typ types.Type, value T) *vector.Vector {
+	rs := proc.AllocScalarVector(typ)
+	rs.Col = []T{value}
+	return rs
+}
+
+// allocStringColumn allocates a string result vector whose data is big enough for all the arguments.
+func allocStringColumn(vs []*vector.Vector, proc *process.Process, typ types.Type, length int) (*vector.Vector, *types.Bytes, error) {
+	size := 0
+	for _, v := range vs {
+		if v.IsScalarNull() {
+			continue
+		}
+		if v.IsScalar() {
+			size += len(v.Col.(*types.Bytes).Data) * length
+		} else {
+			size += len(v.Col.(*types.Bytes).Data)
+		}
+	}
+	rs, err := proc.AllocVector(typ, int64(size))
+	if err != nil {
+		return nil, nil, err
+	}
+	return rs, newStringResult(rs, length), nil
+}
+
+func newStringScalar(proc *process.Process, typ types.Type, value []byte) *vector.Vector {
+	rs := proc.AllocScalarVector(typ)
+	data := make([]byte, len(value))
+	copy(data, value)
+	rs.Col = &types.Bytes{
+		Data:    data,
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(value))},
+	}
+	return rs
+}
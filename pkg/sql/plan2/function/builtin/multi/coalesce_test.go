@@ -0,0 +1,162 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCoalesce(t *testing.T) {
+	convey.Convey("int64", t, func() {
+		v1 := testutil.MakeInt64Vector([]int64{1, 0, 0}, []uint64{1, 2})
+		v2 := testutil.MakeInt64Vector([]int64{10, 20, 0}, []uint64{2})
+		v3 := testutil.MakeScalarNull(3)
+		proc := testutil.NewProc()
+		ovec, err := CoalesceInt64([]*vector.Vector{v1, v2, v3}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.([]int64)
+		convey.So(col[0], convey.ShouldEqual, 1)
+		convey.So(col[1], convey.ShouldEqual, 20)
+		convey.So(nulls.Contains(ovec.Nsp, 0), convey.ShouldBeFalse)
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+
+		ovec, err = CoalesceInt64([]*vector.Vector{v1, testutil.MakeScalarInt64(7, 3)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Col.([]int64), convey.ShouldResemble, []int64{1, 7, 7})
+		convey.So(nulls.Any(ovec.Nsp), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("scalar", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := CoalesceVarchar([]*vector.Vector{testutil.MakeScalarNull(1), testutil.MakeScalarVarchar("a", 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "a")
+
+		ovec, err = CoalesceDate([]*vector.Vector{testutil.MakeScalarNull(1), testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("varchar", t, func() {
+		v1 := testutil.MakeVarcharVector([]string{"a", "", ""}, []uint64{1, 2})
+		v2 := testutil.MakeScalarVarchar("xyz", 3)
+		proc := testutil.NewProc()
+		ovec, err := CoalesceVarchar([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "a")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "xyz")
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "xyz")
+		convey.So(nulls.Any(ovec.Nsp), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("decimal", t, func() {
+		v1 := vector.New(types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 1})
+		v1.Col = []types.Decimal64{15, 0}
+		nulls.Add(v1.Nsp, 1)
+		v2 := vector.New(types.Type{Oid: types.T_decimal64, Size: 8, Width: 12, Scale: 2})
+		v2.Col = []types.Decimal64{100, 250}
+		proc := testutil.NewProc()
+		ovec, err := CoalesceDecimal64([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ, convey.ShouldResemble, types.Type{Oid: types.T_decimal64, Size: 8, Width: 12, Scale: 2})
+		convey.So(ovec.Col.([]types.Decimal64), convey.ShouldResemble, []types.Decimal64{150, 250})
+	})
+}
+
+func TestGreatestAndLeast(t *testing.T) {
+	convey.Convey("int64", t, func() {
+		v1 := testutil.MakeInt64Vector([]int64{1, 5, 3}, []uint64{2})
+		v2 := testutil.MakeInt64Vector([]int64{4, 2, 6}, nil)
+		v3 := testutil.MakeScalarInt64(3, 3)
+		proc := testutil.NewProc()
+		ovec, err := GreatestInt64([]*vector.Vector{v1, v2, v3}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.([]int64)
+		convey.So(col[:2], convey.ShouldResemble, []int64{4, 5})
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+
+		ovec, err = LeastInt64([]*vector.Vector{v1, v2, v3}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col = ovec.Col.([]int64)
+		convey.So(col[:2], convey.ShouldResemble, []int64{1, 2})
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("varchar", t, func() {
+		v1 := testutil.MakeVarcharVector([]string{"apple", "pear"}, nil)
+		v2 := testutil.MakeScalarVarchar("banana", 2)
+		proc := testutil.NewProc()
+		ovec, err := GreatestVarchar([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "banana")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "pear")
+
+		ovec, err = LeastVarchar([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col = ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "apple")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "banana")
+	})
+
+	convey.Convey("scalar", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := LeastFloat64([]*vector.Vector{testutil.MakeScalarFloat64(1.5, 1), testutil.MakeScalarFloat64(-2, 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]float64)[0], convey.ShouldEqual, -2)
+
+		ovec, err = GreatestFloat64([]*vector.Vector{testutil.MakeScalarFloat64(1.5, 1), testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestNullif(t *testing.T) {
+	convey.Convey("int64", t, func() {
+		v1 := testutil.MakeInt64Vector([]int64{1, 2, 0}, []uint64{2})
+		v2 := testutil.MakeInt64Vector([]int64{1, 3, 0}, nil)
+		proc := testutil.NewProc()
+		ovec, err := NullifInt64([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(nulls.Contains(ovec.Nsp, 0), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeFalse)
+		convey.So(ovec.Col.([]int64)[1], convey.ShouldEqual, 2)
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("varchar", t, func() {
+		v1 := testutil.MakeVarcharVector([]string{"a", "b"}, nil)
+		v2 := testutil.MakeScalarVarchar("a", 2)
+		proc := testutil.NewProc()
+		ovec, err := NullifVarchar([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(nulls.Contains(ovec.Nsp, 0), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(1)), convey.ShouldEqual, "b")
+
+		ovec, err = NullifVarchar([]*vector.Vector{testutil.MakeScalarVarchar("a", 1), testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "a")
+	})
+}
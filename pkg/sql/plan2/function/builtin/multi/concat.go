@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/concat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Concat returns the concatenation of all its arguments, and NULL if any argument is NULL.
+func Concat(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	inputValues := make([]*types.Bytes, len(vectors))
+	for i, v := range vectors {
+		if v.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues[i] = v.Col.(*types.Bytes)
+	}
+	resultVector, length, err := allocStringResult(vectors, resultType, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := newStringResult(resultVector, length)
+	vector.SetCol(resultVector, concat.Concat(inputValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
+
+// allocStringResult allocates the result vector of a string function whose arguments
+// are vectors. It returns a constant vector if all the arguments are constants,
+// otherwise the result contains the nulls of every argument.
+// The number of rows of the result is returned too.
+func allocStringResult(vectors []*vector.Vector, resultType types.Type, proc *process.Process) (*vector.Vector, int, error) {
+	length := -1
+	size := 0
+	for _, v := range vectors {
+		if v.IsScalar() {
+			continue
+		}
+		length = vector.Length(v)
+		if vs, ok := v.Col.(*types.Bytes); ok {
+			size += len(vs.Data)
+		}
+	}
+	if length < 0 {
+		return vector.NewConst(resultType), 1, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(size))
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, v := range vectors {
+		if !v.IsScalar() {
+			nulls.Set(resultVector.Nsp, v.Nsp)
+		}
+	}
+	return resultVector, length, nil
+}
+
+func newStringResult(resultVector *vector.Vector, length int) *types.Bytes {
+	return &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, length),
+		Lengths: make([]uint32, 0, length),
+	}
+}
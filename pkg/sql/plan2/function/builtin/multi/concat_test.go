@@ -0,0 +1,106 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestConcat(t *testing.T) {
+	convey.Convey("column", t, func() {
+		v1 := testutil.MakeVarcharVector([]string{"a", "b", "c"}, []uint64{1})
+		v2 := testutil.MakeScalarVarchar("-", 3)
+		v3 := testutil.MakeCharVector([]string{"x", "y", "你"}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Concat([]*vector.Vector{v1, v2, v3}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(len(col.Offsets), convey.ShouldEqual, 3)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "a-x")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "c-你")
+	})
+
+	convey.Convey("scalar", t, func() {
+		v1 := testutil.MakeScalarVarchar("My", 1)
+		v2 := testutil.MakeScalarVarchar("SQL", 1)
+		proc := testutil.NewProc()
+		ovec, err := Concat([]*vector.Vector{v1, v2}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "MySQL")
+
+		ovec, err = Concat([]*vector.Vector{v1, testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestTrim(t *testing.T) {
+	convey.Convey("default", t, func() {
+		v := testutil.MakeVarcharVector([]string{"  a  ", "b "}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Trim([]*vector.Vector{v}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "a")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "b")
+	})
+
+	convey.Convey("direction and remstr", t, func() {
+		d := testutil.MakeScalarVarchar("leading", 2)
+		r := testutil.MakeScalarVarchar("xy", 2)
+		v := testutil.MakeVarcharVector([]string{"xyxyaxy", "bxy"}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Trim([]*vector.Vector{d, r, v}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "axy")
+		convey.So(string(col.Get(1)), convey.ShouldEqual, "bxy")
+	})
+
+	convey.Convey("bad direction", t, func() {
+		d := testutil.MakeScalarVarchar("middle", 1)
+		v := testutil.MakeVarcharVector([]string{"a"}, nil)
+		proc := testutil.NewProc()
+		_, err := Trim([]*vector.Vector{d, v}, proc)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestLocate(t *testing.T) {
+	convey.Convey("locate", t, func() {
+		sub := testutil.MakeScalarVarchar("bar", 3)
+		str := testutil.MakeVarcharVector([]string{"foobarbar", "xyz", "bar"}, []uint64{2})
+		proc := testutil.NewProc()
+		ovec, err := Locate([]*vector.Vector{sub, str}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.([]int64)
+		convey.So(col[0], convey.ShouldEqual, 4)
+		convey.So(col[1], convey.ShouldEqual, 0)
+		convey.So(nulls.Contains(ovec.Nsp, 2), convey.ShouldBeTrue)
+
+		pos := testutil.MakeScalarInt64(5, 3)
+		ovec, err = Locate([]*vector.Vector{sub, str, pos}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Col.([]int64)[0], convey.ShouldEqual, 7)
+	})
+}
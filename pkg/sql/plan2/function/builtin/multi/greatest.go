@@ -0,0 +1,274 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+// greatest returns the largest argument of each row, and least returns the smallest one.
+// Both of them return NULL if any argument is NULL.
+var (
+	GreatestUint8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint8](vs, proc, types.Type{Oid: types.T_uint8}, greater[uint8])
+	}
+
+	GreatestUint16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint16](vs, proc, types.Type{Oid: types.T_uint16}, greater[uint16])
+	}
+
+	GreatestUint32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint32](vs, proc, types.Type{Oid: types.T_uint32}, greater[uint32])
+	}
+
+	GreatestUint64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint64](vs, proc, types.Type{Oid: types.T_uint64}, greater[uint64])
+	}
+
+	GreatestInt8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int8](vs, proc, types.Type{Oid: types.T_int8}, greater[int8])
+	}
+
+	GreatestInt16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int16](vs, proc, types.Type{Oid: types.T_int16}, greater[int16])
+	}
+
+	GreatestInt32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int32](vs, proc, types.Type{Oid: types.T_int32}, greater[int32])
+	}
+
+	GreatestInt64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int64](vs, proc, types.Type{Oid: types.T_int64}, greater[int64])
+	}
+
+	GreatestFloat32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[float32](vs, proc, types.Type{Oid: types.T_float32}, greater[float32])
+	}
+
+	GreatestFloat64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[float64](vs, proc, types.Type{Oid: types.T_float64}, greater[float64])
+	}
+
+	GreatestDate = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Date](vs, proc, types.Type{Oid: types.T_date}, greater[types.Date])
+	}
+
+	GreatestDatetime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime}, greater[types.Datetime])
+	}
+
+	GreatestTimestamp = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Timestamp](vs, proc, types.Type{Oid: types.T_timestamp}, greater[types.Timestamp])
+	}
+
+	GreatestDecimal64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Decimal64](vs, proc, types.T_decimal64.ToType(), greater[types.Decimal64])
+	}
+
+	GreatestDecimal128 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Decimal128](vs, proc, types.T_decimal128.ToType(), greaterDecimal128)
+	}
+
+	GreatestVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectString(vs, proc, types.Type{Oid: types.T_varchar, Size: 24}, greaterString)
+	}
+
+	GreatestChar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectString(vs, proc, types.Type{Oid: types.T_char, Size: 24}, greaterString)
+	}
+
+	LeastUint8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint8](vs, proc, types.Type{Oid: types.T_uint8}, less[uint8])
+	}
+
+	LeastUint16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint16](vs, proc, types.Type{Oid: types.T_uint16}, less[uint16])
+	}
+
+	LeastUint32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint32](vs, proc, types.Type{Oid: types.T_uint32}, less[uint32])
+	}
+
+	LeastUint64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[uint64](vs, proc, types.Type{Oid: types.T_uint64}, less[uint64])
+	}
+
+	LeastInt8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int8](vs, proc, types.Type{Oid: types.T_int8}, less[int8])
+	}
+
+	LeastInt16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int16](vs, proc, types.Type{Oid: types.T_int16}, less[int16])
+	}
+
+	LeastInt32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int32](vs, proc, types.Type{Oid: types.T_int32}, less[int32])
+	}
+
+	LeastInt64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[int64](vs, proc, types.Type{Oid: types.T_int64}, less[int64])
+	}
+
+	LeastFloat32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[float32](vs, proc, types.Type{Oid: types.T_float32}, less[float32])
+	}
+
+	LeastFloat64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[float64](vs, proc, types.Type{Oid: types.T_float64}, less[float64])
+	}
+
+	LeastDate = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Date](vs, proc, types.Type{Oid: types.T_date}, less[types.Date])
+	}
+
+	LeastDatetime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime}, less[types.Datetime])
+	}
+
+	LeastTimestamp = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Timestamp](vs, proc, types.Type{Oid: types.T_timestamp}, less[types.Timestamp])
+	}
+
+	LeastDecimal64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Decimal64](vs, proc, types.T_decimal64.ToType(), less[types.Decimal64])
+	}
+
+	LeastDecimal128 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectGeneral[types.Decimal128](vs, proc, types.T_decimal128.ToType(), lessDecimal128)
+	}
+
+	LeastVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectString(vs, proc, types.Type{Oid: types.T_varchar, Size: 24}, lessString)
+	}
+
+	LeastChar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return selectString(vs, proc, types.Type{Oid: types.T_char, Size: 24}, lessString)
+	}
+)
+
+// GreatestTypeCheckFn is type check function for greatest and least,
+// it accepts two or more arguments of the return type.
+func GreatestTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
+	if len(inputTypes) < 2 {
+		return false
+	}
+	return sameTypeCheck(inputTypes, ret)
+}
+
+func greater[T constraints.Ordered](a, b T) bool {
+	return a > b
+}
+
+func less[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
+
+func greaterDecimal128(a, b types.Decimal128) bool {
+	return types.CompareDecimal128Decimal128Aligned(a, b) > 0
+}
+
+func lessDecimal128(a, b types.Decimal128) bool {
+	return types.CompareDecimal128Decimal128Aligned(a, b) < 0
+}
+
+func greaterString(a, b []byte) bool {
+	return bytes.Compare(a, b) > 0
+}
+
+func lessString(a, b []byte) bool {
+	return bytes.Compare(a, b) < 0
+}
+
+// selectGeneral returns the argument of each row which is preferred by better,
+// and NULL if any argument is NULL.
+func selectGeneral[T CoalesceRet](vs []*vector.Vector, proc *process.Process, typ types.Type, better func(a, b T) bool) (*vector.Vector, error) {
+	cols, typ := fixedColumns[T](vs, typ)
+	length, ok := rowCount(vs)
+	if !ok {
+		if anyNullAt(vs, 0) {
+			return proc.AllocScalarNullVector(typ), nil
+		}
+		r := cols[0][0]
+		for i := 1; i < len(cols); i++ {
+			if better(cols[i][0], r) {
+				r = cols[i][0]
+			}
+		}
+		return newFixedScalar(proc, typ, r), nil
+	}
+
+	rs, rscols, err := allocFixedResult[T](proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		if anyNullAt(vs, j) {
+			nulls.Add(rs.Nsp, uint64(j))
+			continue
+		}
+		r := valueAt(cols[0], vs[0], j)
+		for i := 1; i < len(cols); i++ {
+			if v := valueAt(cols[i], vs[i], j); better(v, r) {
+				r = v
+			}
+		}
+		rscols[j] = r
+	}
+	return rs, nil
+}
+
+// selectString is the string version of selectGeneral.
+func selectString(vs []*vector.Vector, proc *process.Process, typ types.Type, better func(a, b []byte) bool) (*vector.Vector, error) {
+	length, ok := rowCount(vs)
+	if !ok {
+		if anyNullAt(vs, 0) {
+			return proc.AllocScalarNullVector(typ), nil
+		}
+		r := bytesAt(vs[0], 0)
+		for i := 1; i < len(vs); i++ {
+			if v := bytesAt(vs[i], 0); better(v, r) {
+				r = v
+			}
+		}
+		return newStringScalar(proc, typ, r), nil
+	}
+
+	rs, rscols, err := allocStringColumn(vs, proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		if anyNullAt(vs, j) {
+			nulls.Add(rs.Nsp, uint64(j))
+			rscols.AppendOnce(nil)
+			continue
+		}
+		r := bytesAt(vs[0], j)
+		for i := 1; i < len(vs); i++ {
+			if v := bytesAt(vs[i], j); better(v, r) {
+				r = v
+			}
+		}
+		rscols.AppendOnce(r)
+	}
+	vector.SetCol(rs, rscols)
+	return rs, nil
+}
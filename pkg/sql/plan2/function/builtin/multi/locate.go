@@ -0,0 +1,67 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/locate"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Locate implements locate(substr, str) and locate(substr, str, pos),
+// position(substr in str) is the same as the first one.
+func Locate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_int64, Size: 8}
+	resultElementSize := int(resultType.Size)
+	for _, v := range vectors {
+		if v.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+	}
+	substrValues, strValues := vectors[0].Col.(*types.Bytes), vectors[1].Col.(*types.Bytes)
+	var posValues []int64
+	if len(vectors) > 2 {
+		posValues = vectors[2].Col.([]int64)
+	}
+
+	length := -1
+	for _, v := range vectors {
+		if !v.IsScalar() {
+			length = vector.Length(v)
+		}
+	}
+	if length < 0 {
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]int64, 1)
+		vector.SetCol(resultVector, locate.Locate(substrValues, strValues, posValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeInt64Slice(resultVector.Data)
+	resultValues = resultValues[:length]
+	for _, v := range vectors {
+		if !v.IsScalar() {
+			nulls.Set(resultVector.Nsp, v.Nsp)
+		}
+	}
+	vector.SetCol(resultVector, locate.Locate(substrValues, strValues, posValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
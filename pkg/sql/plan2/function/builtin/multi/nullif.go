@@ -0,0 +1,170 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// nullif(a, b) returns NULL if a = b, otherwise it returns a.
+var (
+	NullifUint8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[uint8](vs, proc, types.Type{Oid: types.T_uint8})
+	}
+
+	NullifUint16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[uint16](vs, proc, types.Type{Oid: types.T_uint16})
+	}
+
+	NullifUint32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[uint32](vs, proc, types.Type{Oid: types.T_uint32})
+	}
+
+	NullifUint64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[uint64](vs, proc, types.Type{Oid: types.T_uint64})
+	}
+
+	NullifInt8 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[int8](vs, proc, types.Type{Oid: types.T_int8})
+	}
+
+	NullifInt16 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[int16](vs, proc, types.Type{Oid: types.T_int16})
+	}
+
+	NullifInt32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[int32](vs, proc, types.Type{Oid: types.T_int32})
+	}
+
+	NullifInt64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[int64](vs, proc, types.Type{Oid: types.T_int64})
+	}
+
+	NullifFloat32 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[float32](vs, proc, types.Type{Oid: types.T_float32})
+	}
+
+	NullifFloat64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[float64](vs, proc, types.Type{Oid: types.T_float64})
+	}
+
+	NullifDate = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[types.Date](vs, proc, types.Type{Oid: types.T_date})
+	}
+
+	NullifDatetime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
+	}
+
+	NullifTimestamp = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[types.Timestamp](vs, proc, types.Type{Oid: types.T_timestamp})
+	}
+
+	NullifDecimal64 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[types.Decimal64](vs, proc, types.T_decimal64.ToType())
+	}
+
+	NullifDecimal128 = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[types.Decimal128](vs, proc, types.T_decimal128.ToType())
+	}
+
+	NullifBool = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifGeneral[bool](vs, proc, types.Type{Oid: types.T_bool})
+	}
+
+	NullifVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifString(vs, proc, types.Type{Oid: types.T_varchar, Size: 24})
+	}
+
+	NullifChar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return nullifString(vs, proc, types.Type{Oid: types.T_char, Size: 24})
+	}
+)
+
+// NullifTypeCheckFn is type check function for nullif,
+// it accepts two arguments of the return type.
+func NullifTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
+	if len(inputTypes) != 2 {
+		return false
+	}
+	return sameTypeCheck(inputTypes, ret)
+}
+
+func nullifGeneral[T CoalesceRet](vs []*vector.Vector, proc *process.Process, typ types.Type) (*vector.Vector, error) {
+	cols, typ := fixedColumns[T](vs, typ)
+	length, ok := rowCount(vs)
+	if !ok {
+		a, b := vs[0], vs[1]
+		if a.IsScalarNull() || (!b.IsScalarNull() && cols[0][0] == cols[1][0]) {
+			return proc.AllocScalarNullVector(typ), nil
+		}
+		return newFixedScalar(proc, typ, cols[0][0]), nil
+	}
+
+	rs, rscols, err := allocFixedResult[T](proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		if isNullAt(vs[0], j) {
+			nulls.Add(rs.Nsp, uint64(j))
+			continue
+		}
+		a := valueAt(cols[0], vs[0], j)
+		if !isNullAt(vs[1], j) && a == valueAt(cols[1], vs[1], j) {
+			nulls.Add(rs.Nsp, uint64(j))
+			continue
+		}
+		rscols[j] = a
+	}
+	return rs, nil
+}
+
+func nullifString(vs []*vector.Vector, proc *process.Process, typ types.Type) (*vector.Vector, error) {
+	length, ok := rowCount(vs)
+	if !ok {
+		a, b := vs[0], vs[1]
+		if a.IsScalarNull() || (!b.IsScalarNull() && bytes.Equal(bytesAt(a, 0), bytesAt(b, 0))) {
+			return proc.AllocScalarNullVector(typ), nil
+		}
+		return newStringScalar(proc, typ, bytesAt(a, 0)), nil
+	}
+
+	rs, rscols, err := allocStringColumn(vs[:1], proc, typ, length)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < length; j++ {
+		if isNullAt(vs[0], j) {
+			nulls.Add(rs.Nsp, uint64(j))
+			rscols.AppendOnce(nil)
+			continue
+		}
+		a := bytesAt(vs[0], j)
+		if !isNullAt(vs[1], j) && bytes.Equal(a, bytesAt(vs[1], j)) {
+			nulls.Add(rs.Nsp, uint64(j))
+			rscols.AppendOnce(nil)
+			continue
+		}
+		rscols.AppendOnce(a)
+	}
+	vector.SetCol(rs, rscols)
+	return rs, nil
+}
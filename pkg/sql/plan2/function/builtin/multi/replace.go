@@ -0,0 +1,41 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/replace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Replace(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	strVector, fromVector, toVector := vectors[0], vectors[1], vectors[2]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if strVector.Typ.Oid == types.T_char {
+		resultType.Oid = types.T_char
+	}
+	if strVector.IsScalarNull() || fromVector.IsScalarNull() || toVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	resultVector, length, err := allocStringResult(vectors, resultType, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := newStringResult(resultVector, length)
+	vector.SetCol(resultVector, replace.Replace(strVector.Col.(*types.Bytes), fromVector.Col.(*types.Bytes),
+		toVector.Col.(*types.Bytes), resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
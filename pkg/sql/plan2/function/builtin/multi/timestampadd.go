@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/timestampadd"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// DateTimestampAdd adds an interval to a date, the unit has been rewritten to an int64
+// constant of types.IntervalType by the binder, and it is always day or larger
+// for date, the binder casts the date to datetime for the smaller units.
+func DateTimestampAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	unitVector, numVector, dateVector := vectors[0], vectors[1], vectors[2]
+	resultType := types.Type{Oid: types.T_date, Size: 4}
+	resultElementSize := int(resultType.Size)
+	if unitVector.IsScalarNull() || numVector.IsScalarNull() || dateVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	unit := types.IntervalType(unitVector.Col.([]int64)[0])
+	numValues, dateValues := numVector.Col.([]int64), dateVector.Col.([]types.Date)
+	if numVector.IsScalar() && dateVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]types.Date, 1)
+		vector.SetCol(resultVector, timestampadd.DateAdd(unit, numValues, dateValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(numVector)
+	if numVector.IsScalar() {
+		length = vector.Length(dateVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeDateSlice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Or(numVector.Nsp, dateVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, timestampadd.DateAdd(unit, numValues, dateValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
+
+// DatetimeTimestampAdd adds an interval to a datetime or a timestamp, the result is a datetime.
+func DatetimeTimestampAdd(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	unitVector, numVector, datetimeVector := vectors[0], vectors[1], vectors[2]
+	resultType := types.Type{Oid: types.T_datetime, Size: 8}
+	resultElementSize := int(resultType.Size)
+	if unitVector.IsScalarNull() || numVector.IsScalarNull() || datetimeVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	unit := types.IntervalType(unitVector.Col.([]int64)[0])
+	numValues, datetimeValues := numVector.Col.([]int64), datetimesOf(datetimeVector)
+	if numVector.IsScalar() && datetimeVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]types.Datetime, 1)
+		vector.SetCol(resultVector, timestampadd.DatetimeAdd(unit, numValues, datetimeValues, resultVector.Nsp, 1, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(numVector)
+	if numVector.IsScalar() {
+		length = vector.Length(datetimeVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeDatetimeSlice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Or(numVector.Nsp, datetimeVector.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, timestampadd.DatetimeAdd(unit, numValues, datetimeValues, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
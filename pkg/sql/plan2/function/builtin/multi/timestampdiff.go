@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/timestampdiff"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// TimestampDiff returns the difference between two date, datetime or timestamp values.
+// The first argument is the unit, which has been rewritten to an int64 constant
+// of types.IntervalType by the binder.
+func TimestampDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	unitVector, leftVector, rightVector := vectors[0], vectors[1], vectors[2]
+	resultType := types.Type{Oid: types.T_int64, Size: 8}
+	resultElementSize := int(resultType.Size)
+	if unitVector.IsScalarNull() || leftVector.IsScalarNull() || rightVector.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	unit := types.IntervalType(unitVector.Col.([]int64)[0])
+	leftValues, rightValues := datetimesOf(leftVector), datetimesOf(rightVector)
+	if leftVector.IsScalar() && rightVector.IsScalar() {
+		resultVector := vector.NewConst(resultType)
+		resultValues, err := timestampdiff.TimestampDiff(unit, leftValues, rightValues, resultVector.Nsp, 1, make([]int64, 1))
+		if err != nil {
+			return nil, err
+		}
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+	length := vector.Length(leftVector)
+	if leftVector.IsScalar() {
+		length = vector.Length(rightVector)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeInt64Slice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Or(leftVector.Nsp, rightVector.Nsp, resultVector.Nsp)
+	if _, err = timestampdiff.TimestampDiff(unit, leftValues, rightValues, resultVector.Nsp, length, resultValues); err != nil {
+		return nil, err
+	}
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
+
+// datetimesOf returns the values of a date, datetime or timestamp vector as datetimes,
+// timestamps are converted to the local time zone.
+func datetimesOf(v *vector.Vector) []types.Datetime {
+	switch v.Typ.Oid {
+	case types.T_date:
+		xs := v.Col.([]types.Date)
+		rs, _ := types.DateToDatetime(xs, make([]types.Datetime, len(xs)))
+		return rs
+	case types.T_timestamp:
+		xs := v.Col.([]types.Timestamp)
+		rs, _ := types.TimestampToDatetime(xs, make([]types.Datetime, len(xs)))
+		return rs
+	}
+	return v.Col.([]types.Datetime)
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTimestampDiff(t *testing.T) {
+	convey.Convey("datetime", t, func() {
+		unit := testutil.MakeScalarInt64(int64(types.Minute), 3)
+		left := testutil.MakeScalarDateTime("2003-02-01 00:00:00", 3)
+		right := testutil.MakeDateTimeVector([]string{"2003-05-01 12:05:55", "", "2003-01-31 23:59:01"}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := TimestampDiff([]*vector.Vector{unit, left, right}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.([]int64)
+		convey.So(col[0], convey.ShouldEqual, 128885)
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(col[2], convey.ShouldEqual, 0)
+	})
+
+	convey.Convey("date", t, func() {
+		unit := testutil.MakeScalarInt64(int64(types.Month), 1)
+		left := testutil.MakeScalarDate("2003-02-01", 1)
+		right := testutil.MakeScalarDate("2003-05-01", 1)
+		proc := testutil.NewProc()
+		ovec, err := TimestampDiff([]*vector.Vector{unit, left, right}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]int64), convey.ShouldResemble, []int64{3})
+	})
+}
+
+func TestTimestampAdd(t *testing.T) {
+	convey.Convey("date", t, func() {
+		unit := testutil.MakeScalarInt64(int64(types.Week), 2)
+		nums := testutil.MakeInt64Vector([]int64{1, 0}, []uint64{1})
+		dates := testutil.MakeScalarDate("2003-01-02", 2)
+		proc := testutil.NewProc()
+		ovec, err := DateTimestampAdd([]*vector.Vector{unit, nums, dates}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_date)
+		convey.So(ovec.Col.([]types.Date)[0].String(), convey.ShouldEqual, "2003-01-09")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("datetime", t, func() {
+		unit := testutil.MakeScalarInt64(int64(types.Minute), 1)
+		nums := testutil.MakeScalarInt64(1, 1)
+		datetimes := testutil.MakeScalarDateTime("2003-01-02 23:59:00", 1)
+		proc := testutil.NewProc()
+		ovec, err := DatetimeTimestampAdd([]*vector.Vector{unit, nums, datetimes}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]types.Datetime)[0].String(), convey.ShouldEqual, "2003-01-03 00:00:00")
+	})
+}
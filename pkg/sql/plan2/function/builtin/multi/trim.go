@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	errorTrimDirection = errors.New("the direction of trim function must be a constant of both, leading or trailing")
+	spaceTrimString    = &types.Bytes{Data: []byte(" "), Offsets: []uint32{0}, Lengths: []uint32{1}}
+)
+
+// Trim implements the three forms that the parser produces for trim:
+//
+//	trim(str)
+//	trim(direction, str)
+//	trim(direction, remstr, str)
+//
+// where the direction is one of both, leading and trailing, and remstr is a space by default.
+func Trim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	strVector := vectors[len(vectors)-1]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if strVector.Typ.Oid == types.T_char {
+		resultType.Oid = types.T_char
+	}
+	for _, v := range vectors {
+		if v.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+	}
+
+	mode := trim.Both
+	remstrs := spaceTrimString
+	if len(vectors) > 1 {
+		if !vectors[0].IsScalar() {
+			return nil, errorTrimDirection
+		}
+		var ok bool
+		if mode, ok = trim.ParseMode(vectors[0].Col.(*types.Bytes).Get(0)); !ok {
+			return nil, errorTrimDirection
+		}
+	}
+	if len(vectors) > 2 {
+		remstrs = vectors[1].Col.(*types.Bytes)
+	}
+
+	resultVector, length, err := allocStringResult(vectors, resultType, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultValues := newStringResult(resultVector, length)
+	vector.SetCol(resultVector, trim.Trim(mode, strVector.Col.(*types.Bytes), remstrs, resultVector.Nsp, length, resultValues))
+	return resultVector, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/base64"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func ToBase64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return encodeString(vectors, proc, func(xs *types.Bytes) int {
+		return (len(xs.Data)+2)/3*4 + 4*len(xs.Offsets)
+	}, func(xs *types.Bytes, _ *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+		return base64.ToBase64(xs, rs)
+	})
+}
+
+func FromBase64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return encodeString(vectors, proc, func(xs *types.Bytes) int {
+		return len(xs.Data) / 4 * 3
+	}, base64.FromBase64)
+}
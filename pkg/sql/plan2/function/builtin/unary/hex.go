@@ -0,0 +1,103 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/hex"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func HexString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return encodeString(vectors, proc, func(xs *types.Bytes) int {
+		return len(xs.Data) * 2
+	}, func(xs *types.Bytes, _ *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+		return hex.HexString(xs, rs)
+	})
+}
+
+func HexInt64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return hexNumber(vectors, proc, hex.HexInt64)
+}
+
+func HexUint64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return hexNumber(vectors, proc, hex.HexUint64)
+}
+
+func Unhex(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return encodeString(vectors, proc, func(xs *types.Bytes) int {
+		return len(xs.Data)/2 + len(xs.Offsets)
+	}, hex.Unhex)
+}
+
+func hexNumber[T int64 | uint64](vectors []*vector.Vector, proc *process.Process, fn func([]T, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.([]T)
+		resultVector := vector.NewConst(resultType)
+		vector.SetCol(resultVector, fn(inputValues, &types.Bytes{}))
+		return resultVector, nil
+	}
+	inputValues := inputVector.Col.([]T)
+	resultVector, err := proc.AllocVector(resultType, int64(16*len(inputValues)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, len(inputValues)),
+		Lengths: make([]uint32, 0, len(inputValues)),
+	}
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, fn(inputValues, resultValues))
+	return resultVector, nil
+}
+
+// encodeString evaluates a function which converts each string of the input vector to a varchar.
+// size returns the expected data size of the result, and fn adds the rows
+// whose input is invalid to the nulls of result.
+func encodeString(vectors []*vector.Vector, proc *process.Process, size func(*types.Bytes) int,
+	fn func(*types.Bytes, *nulls.Nulls, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.(*types.Bytes)
+		resultVector := vector.NewConst(resultType)
+		vector.SetCol(resultVector, fn(inputValues, resultVector.Nsp, &types.Bytes{}))
+		return resultVector, nil
+	}
+	inputValues := inputVector.Col.(*types.Bytes)
+	resultVector, err := proc.AllocVector(resultType, int64(size(inputValues)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, len(inputValues.Offsets)),
+		Lengths: make([]uint32, 0, len(inputValues.Lengths)),
+	}
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, fn(inputValues, resultVector.Nsp, resultValues))
+	return resultVector, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestHexAndUnhex(t *testing.T) {
+	convey.Convey("column", t, func() {
+		ivec := testutil.MakeVarcharVector([]string{"abc", "", "MySQL"}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := HexString([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "616263")
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "4D7953514C")
+
+		ovec, err = Unhex([]*vector.Vector{ovec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col = ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "abc")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "MySQL")
+	})
+
+	convey.Convey("scalar", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := HexInt64([]*vector.Vector{testutil.MakeScalarInt64(255, 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "FF")
+
+		ovec, err = Unhex([]*vector.Vector{testutil.MakeScalarVarchar("xyz", 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
+
+func TestDigest(t *testing.T) {
+	convey.Convey("md5 and sha1", t, func() {
+		ivec := testutil.MakeCharVector([]string{"abc", ""}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := Md5([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_varchar)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "900150983cd24fb0d6963f7d28e17f72")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+
+		ovec, err = Sha1([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "a9993e364706816aba3e25717850c26c9cd0d89d")
+	})
+
+	convey.Convey("crc32", t, func() {
+		ivec := testutil.MakeVarcharVector([]string{"MySQL", "mysql"}, nil)
+		proc := testutil.NewProc()
+		ovec, err := Crc32([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Col.([]uint32), convey.ShouldResemble, []uint32{3259397556, 2501908538})
+	})
+}
+
+func TestBase64(t *testing.T) {
+	convey.Convey("column", t, func() {
+		ivec := testutil.MakeVarcharVector([]string{"abc", "", "MySQL"}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := ToBase64([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "YWJj")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "TXlTUUw=")
+
+		ovec, err = FromBase64([]*vector.Vector{ovec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col = ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "abc")
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "MySQL")
+	})
+
+	convey.Convey("invalid", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := FromBase64([]*vector.Vector{testutil.MakeScalarVarchar("!!", 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
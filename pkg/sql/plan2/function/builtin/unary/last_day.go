@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/last_day"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func DateLastDay(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return lastDay(vectors, proc, func(v *vector.Vector, rs []types.Date) []types.Date {
+		return last_day.DateLastDay(v.Col.([]types.Date), rs)
+	})
+}
+
+func DatetimeLastDay(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return lastDay(vectors, proc, func(v *vector.Vector, rs []types.Date) []types.Date {
+		return last_day.DatetimeLastDay(v.Col.([]types.Datetime), rs)
+	})
+}
+
+// TimestampLastDay returns the last day of the month in the local time zone
+func TimestampLastDay(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return lastDay(vectors, proc, func(v *vector.Vector, rs []types.Date) []types.Date {
+		xs := v.Col.([]types.Timestamp)
+		dts, _ := types.TimestampToDatetime(xs, make([]types.Datetime, len(xs)))
+		return last_day.DatetimeLastDay(dts, rs)
+	})
+}
+
+func lastDay(vectors []*vector.Vector, proc *process.Process, fn func(*vector.Vector, []types.Date) []types.Date) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_date, Size: 4}
+	resultElementSize := int(resultType.Size)
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := vector.NewConst(resultType)
+		resultValues := make([]types.Date, 1)
+		vector.SetCol(resultVector, fn(inputVector, resultValues))
+		return resultVector, nil
+	}
+	length := vector.Length(inputVector)
+	resultVector, err := proc.AllocVector(resultType, int64(resultElementSize*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := encoding.DecodeDateSlice(resultVector.Data)
+	resultValues = resultValues[:length]
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, fn(inputVector, resultValues))
+	return resultVector, nil
+}
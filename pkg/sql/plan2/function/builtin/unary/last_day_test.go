@@ -0,0 +1,50 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestLastDay(t *testing.T) {
+	convey.Convey("date", t, func() {
+		ivec := testutil.MakeDateVector([]string{"2003-02-05", "", "2004-02-05"}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := DateLastDay([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		col := ovec.Col.([]types.Date)
+		convey.So(col[0].String(), convey.ShouldEqual, "2003-02-28")
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		convey.So(col[2].String(), convey.ShouldEqual, "2004-02-29")
+	})
+
+	convey.Convey("datetime", t, func() {
+		proc := testutil.NewProc()
+		ovec, err := DatetimeLastDay([]*vector.Vector{testutil.MakeScalarDateTime("2003-12-05 01:02:03", 1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Col.([]types.Date)[0].String(), convey.ShouldEqual, "2003-12-31")
+
+		ovec, err = DatetimeLastDay([]*vector.Vector{testutil.MakeScalarNull(1)}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lower"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Lower(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.Type{Oid: types.T_varchar, Size: 24}
+	if inputVector.Typ.Oid == types.T_char {
+		resultType.Oid = types.T_char
+	}
+	if inputVector.IsScalar() {
+		if inputVector.ConstVectorIsNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		inputValues := inputVector.Col.(*types.Bytes)
+		resultVector := vector.NewConst(resultType)
+		resultValues := &types.Bytes{
+			Data:    make([]byte, 0, len(inputValues.Data)),
+			Offsets: make([]uint32, 0, 1),
+			Lengths: make([]uint32, 0, 1),
+		}
+		vector.SetCol(resultVector, lower.LowerVarChar(inputValues, resultValues))
+		return resultVector, nil
+	}
+	inputValues := inputVector.Col.(*types.Bytes)
+	resultVector, err := proc.AllocVector(resultType, int64(len(inputValues.Data)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := &types.Bytes{
+		Data:    resultVector.Data[:0],
+		Offsets: make([]uint32, 0, len(inputValues.Offsets)),
+		Lengths: make([]uint32, 0, len(inputValues.Lengths)),
+	}
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, lower.LowerVarChar(inputValues, resultValues))
+	return resultVector, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestUpper(t *testing.T) {
+	convey.Convey("column", t, func() {
+		ivec := testutil.MakeVarcharVector([]string{"abc", "", "año", "你好a"}, []uint64{1})
+		proc := testutil.NewProc()
+		ovec, err := Upper([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_varchar)
+		convey.So(nulls.Contains(ovec.Nsp, 1), convey.ShouldBeTrue)
+		col := ovec.Col.(*types.Bytes)
+		convey.So(string(col.Get(0)), convey.ShouldEqual, "ABC")
+		convey.So(string(col.Get(2)), convey.ShouldEqual, "AÑO")
+		convey.So(string(col.Get(3)), convey.ShouldEqual, "你好A")
+	})
+
+	convey.Convey("scalar", t, func() {
+		ivec := testutil.MakeScalarChar("xyz", 10)
+		proc := testutil.NewProc()
+		ovec, err := Upper([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalar(), convey.ShouldBeTrue)
+		convey.So(ovec.Typ.Oid, convey.ShouldEqual, types.T_char)
+		convey.So(string(ovec.Col.(*types.Bytes).Get(0)), convey.ShouldEqual, "XYZ")
+	})
+
+	convey.Convey("null", t, func() {
+		ivec := testutil.MakeScalarNull(10)
+		proc := testutil.NewProc()
+		ovec, err := Lower([]*vector.Vector{ivec}, proc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ovec.IsScalarNull(), convey.ShouldBeTrue)
+	})
+}
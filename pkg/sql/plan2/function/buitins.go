@@ -2300,4 +2300,1389 @@ var builtins = map[int][]Function{
 			Fn:          unary.Cot[float64],
 		},
 	},
+	// string functions
+	ASCII: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Ascii,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Ascii,
+		},
+	},
+	CHR: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Chr,
+		},
+	},
+	CONCAT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: concatTypeCheck,
+			Fn:          multi.Concat,
+		},
+	},
+	LEFT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Left,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Left,
+		},
+	},
+	LOWER: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Lower,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Lower,
+		},
+	},
+	POSITION: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Locate,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Locate,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Locate,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_int64},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Locate,
+		},
+	},
+	REPEAT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Repeat,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Repeat,
+		},
+	},
+	REPLACE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Replace,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Replace,
+		},
+	},
+	RIGHT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Right,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Right,
+		},
+	},
+	TRANSLATE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Translate,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Translate,
+		},
+	},
+	TRIM: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char, types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.Trim,
+		},
+	},
+	UPPER: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Upper,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Upper,
+		},
+	},
+
+	// functions to deal with NULL and to compare values
+	COALESCE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceUint8,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceUint16,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceUint32,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceUint64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceInt8,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceInt16,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceInt32,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceInt64,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceFloat32,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceFloat64,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceDecimal64,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceDecimal128,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceDate,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceDatetime,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceTimestamp,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceChar,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceVarchar,
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: multi.CoalesceTypeCheckFn,
+			Fn:          multi.CoalesceBool,
+		},
+	},
+	GREATEST: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestUint8,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestUint16,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestUint32,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestUint64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestInt8,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestInt16,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestInt32,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestInt64,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestFloat32,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestFloat64,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestDecimal64,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestDecimal128,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestDate,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestDatetime,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestTimestamp,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestChar,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.GreatestVarchar,
+		},
+	},
+	IFNULL: {
+		{
+			Index:       0,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceUint8,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceUint16,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceUint32,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceUint64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceInt8,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceInt16,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceInt32,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceInt64,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceFloat32,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceFloat64,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceDecimal64,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceDecimal128,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceDate,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceDatetime,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceTimestamp,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceChar,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceVarchar,
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: multi.IfnullTypeCheckFn,
+			Fn:          multi.CoalesceBool,
+		},
+	},
+	LEAST: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastUint8,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastUint16,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastUint32,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastUint64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastInt8,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastInt16,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastInt32,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastInt64,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastFloat32,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastFloat64,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastDecimal64,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastDecimal128,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastDate,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastDatetime,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastTimestamp,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastChar,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: multi.GreatestTypeCheckFn,
+			Fn:          multi.LeastVarchar,
+		},
+	},
+	NULLIF: {
+		{
+			Index:       0,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint8,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifUint8,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint16,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifUint16,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifUint32,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_uint64,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifUint64,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int8,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifInt8,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int16,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifInt16,
+		},
+		{
+			Index:       6,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int32,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifInt32,
+		},
+		{
+			Index:       7,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifInt64,
+		},
+		{
+			Index:       8,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float32,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifFloat32,
+		},
+		{
+			Index:       9,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_float64,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifFloat64,
+		},
+		{
+			Index:       10,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal64,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifDecimal64,
+		},
+		{
+			Index:       11,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_decimal128,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifDecimal128,
+		},
+		{
+			Index:       12,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifDate,
+		},
+		{
+			Index:       13,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifDatetime,
+		},
+		{
+			Index:       14,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_timestamp,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifTimestamp,
+		},
+		{
+			Index:       15,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_char,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifChar,
+		},
+		{
+			Index:       16,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifVarchar,
+		},
+		{
+			Index:       17,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: multi.NullifTypeCheckFn,
+			Fn:          multi.NullifBool,
+		},
+	},
+
+	// hash and encoding functions
+	BASE64_DECODE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.FromBase64,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.FromBase64,
+		},
+	},
+	BASE64_ENCODE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.ToBase64,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.ToBase64,
+		},
+	},
+	CRC32: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Crc32,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_uint32,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Crc32,
+		},
+	},
+	HEX_DECODE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Unhex,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Unhex,
+		},
+	},
+	HEX_ENCODE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.HexString,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.HexString,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.HexInt64,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_uint64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.HexUint64,
+		},
+	},
+	MD5: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Md5,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Md5,
+		},
+	},
+	SHA1: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Sha1,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.Sha1,
+		},
+	},
+	SHA2: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Sha2,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_int64},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.Sha2,
+		},
+	},
+
+	// date and time functions
+	DATEDIFF: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_date, types.T_date},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateDiff,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime, types.T_datetime},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateDiff,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_timestamp, types.T_timestamp},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateDiff,
+		},
+	},
+	DATE_FORMAT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_date, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_date, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+		{
+			Index:       4,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_timestamp, types.T_char},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+		{
+			Index:       5,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_timestamp, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.DateFormat,
+		},
+	},
+	LAST_DAY: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_date},
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DateLastDay,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_datetime},
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.DatetimeLastDay,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_timestamp},
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          unary.TimestampLastDay,
+		},
+	},
+	STR_TO_DATE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_char},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.StrToDate,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_char, types.T_varchar},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.StrToDate,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_char},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.StrToDate,
+		},
+		{
+			Index:       3,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_varchar, types.T_varchar},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          binary.StrToDate,
+		},
+	},
+	TIMESTAMPADD: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_int64, types.T_date},
+			ReturnTyp:   types.T_date,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.DateTimestampAdd,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_int64, types.T_datetime},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.DatetimeTimestampAdd,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_int64, types.T_timestamp},
+			ReturnTyp:   types.T_datetime,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.DatetimeTimestampAdd,
+		},
+	},
+	TIMESTAMPDIFF: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_date, types.T_date},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.TimestampDiff,
+		},
+		{
+			Index:       1,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_datetime, types.T_datetime},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.TimestampDiff,
+		},
+		{
+			Index:       2,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        []types.T{types.T_int64, types.T_timestamp, types.T_timestamp},
+			ReturnTyp:   types.T_int64,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          multi.TimestampDiff,
+		},
+	},
 }
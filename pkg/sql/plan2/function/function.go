@@ -19,6 +19,7 @@ import (
 	"math"
 	"reflect"
 
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/builtin/multi"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function/operator"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -78,6 +79,7 @@ var (
 		},
 		types.T_int64:     {types.T_float64, types.T_decimal64, types.T_decimal128},
 		types.T_float32:   {types.T_float64},
+		types.T_date:      {types.T_datetime},
 		types.T_decimal64: {types.T_decimal128},
 		types.T_char:      {types.T_varchar},
 		types.T_varchar:   {types.T_char},
@@ -316,6 +318,20 @@ func concatWsTypeCheck(args []types.T, require []types.T, _ types.T) bool {
 	return true
 }
 
+// concatTypeCheck requires at least one argument, and all the arguments should be char or varchar.
+// ScalarNull is allowed, and the result will be NULL.
+func concatTypeCheck(args []types.T, _ []types.T, _ types.T) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for _, arg := range args {
+		if arg != types.T_varchar && arg != types.T_char && isNotScalarNull(arg) {
+			return false
+		}
+	}
+	return true
+}
+
 // returns the cost if t1 can level up to t2
 func up(t1, t2 types.T) int {
 	return levelUp[t1][t2]
@@ -325,6 +341,10 @@ var (
 	strictTypeCheckPointer   = reflect.ValueOf(strictTypeCheck).Pointer()
 	caseWhenTypeCheckPointer = reflect.ValueOf(operator.CwTypeCheckFn).Pointer()
 	ifTypeCheckPointer       = reflect.ValueOf(operator.IfTypeCheckFn).Pointer()
+	coalesceTypeCheckPointer = reflect.ValueOf(multi.CoalesceTypeCheckFn).Pointer()
+	ifnullTypeCheckPointer   = reflect.ValueOf(multi.IfnullTypeCheckFn).Pointer()
+	nullifTypeCheckPointer   = reflect.ValueOf(multi.NullifTypeCheckFn).Pointer()
+	greatestTypeCheckPointer = reflect.ValueOf(multi.GreatestTypeCheckFn).Pointer()
 )
 
 // typeCheckWithLevelUp check if the input parameters meet the function requirements.
@@ -418,6 +438,26 @@ func (f *Function) typeCheckWithLevelUp(sources []types.T) (int, []types.T) {
 			}
 			return cost, finalTypes
 		}
+	case coalesceTypeCheckPointer, ifnullTypeCheckPointer, nullifTypeCheckPointer, greatestTypeCheckPointer:
+		// all the arguments should level up to the return type
+		rt, _ := f.ReturnType()
+		cost := 0
+		finalTypes := make([]types.T, len(sources))
+		for i := range sources {
+			if sources[i] == ScalarNull {
+				finalTypes[i] = ScalarNull
+				continue
+			}
+			c := up(sources[i], rt)
+			if c == upFailed {
+				return matchFailed, nil
+			}
+			cost += c
+			finalTypes[i] = rt
+		}
+		if f.TypeCheck(finalTypes) {
+			return cost, finalTypes
+		}
 	}
 	return matchFailed, nil
 }
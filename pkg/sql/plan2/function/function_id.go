@@ -204,6 +204,17 @@ const (
 	DATE_SUB              // DATE_SUB
 	APPROX_COUNT_DISTINCT // APPROX_COUNT_DISTINCT, special aggregate
 
+	CRC32 // CRC32
+	MD5   // MD5
+	SHA1  // SHA1
+	SHA2  // SHA2
+
+	DATE_FORMAT   // DATE_FORMAT
+	LAST_DAY      // LAST_DAY
+	STR_TO_DATE   // STR_TO_DATE
+	TIMESTAMPADD  // TIMESTAMPADD
+	TIMESTAMPDIFF // TIMESTAMPDIFF
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -243,7 +254,6 @@ var functionIdRegister = map[string]int32{
 	"isnot":       ISNOT,
 	"is_null":     ISNULL,
 	"isnull":      ISNULL,
-	"ifnull":      ISNULL,
 	"is_not_null": ISNOTNULL,
 	"isnotnull":   ISNOTNULL,
 	// aggregate
@@ -264,25 +274,45 @@ var functionIdRegister = map[string]int32{
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
+	"date_format": DATE_FORMAT,
+	"datediff":    DATEDIFF,
 	"endswith":    ENDSWITH,
 	"findinset":   FINDINSET,
 	"find_in_set": FINDINSET,
+	"left":        LEFT,
 	"power":       POW,
+	"repeat":      REPEAT,
+	"right":       RIGHT,
+	"sha2":        SHA2,
 	"startswith":  STARTSWITH,
+	"str_to_date": STR_TO_DATE,
 	"to_date":     TO_DATE,
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// variadic functions
 	"ceil":              CEIL,
 	"ceiling":           CEIL,
+	"coalesce":          COALESCE,
+	"concat":            CONCAT,
 	"concat_ws":         CONCAT_WS,
 	"current_timestamp": CURRENT_TIMESTAMP,
 	"floor":             FLOOR,
+	"greatest":          GREATEST,
+	"ifnull":            IFNULL,
+	"least":             LEAST,
+	"locate":            POSITION,
 	"lpad":              LPAD,
+	"nullif":            NULLIF,
 	"pi":                PI,
+	"position":          POSITION,
+	"replace":           REPLACE,
 	"round":             ROUND,
 	"rpad":              RPAD,
 	"substr":            SUBSTRING,
 	"substring":         SUBSTRING,
+	"timestampadd":      TIMESTAMPADD,
+	"timestampdiff":     TIMESTAMPDIFF,
+	"translate":         TRANSLATE,
+	"trim":              TRIM,
 	"utc_timestamp":     UTC_TIMESTAMP,
 	"unix_timestamp":    UNIX_TIMESTAMP,
 	"from_unixtime":     FROM_UNIXTIME,
@@ -290,26 +320,41 @@ var functionIdRegister = map[string]int32{
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	"abs":         ABS,
 	"acos":        ACOS,
+	"ascii":       ASCII,
 	"bit_length":  BIT_LENGTH,
+	"chr":         CHR,
+	"crc32":       CRC32,
 	"date":        DATE,
 	"day":         DAY,
 	"dayofyear":   DAYOFYEAR,
 	"exp":         EXP,
+	"from_base64": BASE64_DECODE,
+	"hex":         HEX_ENCODE,
 	"empty":       EMPTY,
 	"length":      LENGTH,
 	"lengthutf8":  LENGTH_UTF8,
 	"char_length": LENGTH_UTF8,
+	"last_day":    LAST_DAY,
+	"lcase":       LOWER,
 	"ln":          LN,
 	"log":         LOG,
+	"lower":       LOWER,
 	"ltrim":       LTRIM,
+	"md5":         MD5,
 	"month":       MONTH,
 	"oct":         OCT,
 	"reverse":     REVERSE,
 	"rtrim":       RTRIM,
+	"sha":         SHA1,
+	"sha1":        SHA1,
 	"sin":         SIN,
 	"sinh":        SINH,
 	"space":       SPACE,
 	"tan":         TAN,
+	"to_base64":   BASE64_ENCODE,
+	"ucase":       UPPER,
+	"unhex":       HEX_DECODE,
+	"upper":       UPPER,
 	"week":        WEEK,
 	"weekday":     WEEKDAY,
 	"year":        YEAR,
@@ -81,6 +81,11 @@ func (r *ConstantFold) constantFold(e *plan.Expr) *plan.Expr {
 	for i := range ef.F.Args {
 		ef.F.Args[i] = r.constantFold(ef.F.Args[i])
 	}
+	if fid, _ := function.DecodeOverloadID(overloadId); fid == function.COALESCE || fid == function.IFNULL {
+		if e = foldCoalesce(e, ef); e.Expr != ef {
+			return e
+		}
+	}
 	if !isConstant(e) {
 		return e
 	}
@@ -99,6 +104,35 @@ func (r *ConstantFold) constantFold(e *plan.Expr) *plan.Expr {
 	return e
 }
 
+// foldCoalesce removes the leading NULL constants from the arguments of coalesce or ifnull.
+// The first argument is returned if it is a non-null constant or the only one left,
+// and a NULL constant is returned if all the arguments are NULL. So ifnull, which takes
+// exactly two arguments, is never left with one argument.
+func foldCoalesce(e *plan.Expr, ef *plan.Expr_F) *plan.Expr {
+	args := ef.F.Args
+	for len(args) > 0 {
+		c, ok := args[0].Expr.(*plan.Expr_C)
+		if !ok {
+			break
+		}
+		if !c.C.Isnull {
+			return args[0]
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		e.Expr = &plan.Expr_C{
+			C: &plan.Const{Isnull: true},
+		}
+		return e
+	}
+	if len(args) == 1 {
+		return args[0]
+	}
+	ef.F.Args = args
+	return e
+}
+
 func getConstantValue(vec *vector.Vector) *plan.Const {
 	if nulls.Any(vec.Nsp) {
 		return &plan.Const{Isnull: true}
@@ -122,6 +156,24 @@ func getConstantValue(vec *vector.Vector) *plan.Const {
 				Dval: vec.Col.([]float64)[0],
 			},
 		}
+	case types.T_date:
+		return &plan.Const{
+			Value: &plan.Const_Dateval{
+				Dateval: int32(vec.Col.([]types.Date)[0]),
+			},
+		}
+	case types.T_datetime:
+		return &plan.Const{
+			Value: &plan.Const_Datetimeval{
+				Datetimeval: int64(vec.Col.([]types.Datetime)[0]),
+			},
+		}
+	case types.T_timestamp:
+		return &plan.Const{
+			Value: &plan.Const_Timestampval{
+				Timestampval: int64(vec.Col.([]types.Timestamp)[0]),
+			},
+		}
 	case types.T_varchar:
 		return &plan.Const{
 			Value: &plan.Const_Sval{
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
@@ -432,6 +433,10 @@ func (tc *txnOperator) handleError(responses []txn.TxnResponse, err error) ([]tx
 	for _, resp := range responses {
 		switch resp.Txn.GetStatus() {
 		case txn.TxnStatus_Aborted, txn.TxnStatus_Aborting:
+			// the txn is aborted by the rollback request
+			if resp.Method == txn.TxnMethod_Rollback {
+				break
+			}
 			// read after txn aborted
 			return nil, errTxnAborted
 		case txn.TxnStatus_Committed, txn.TxnStatus_Committing, txn.TxnStatus_Prepared:
@@ -445,7 +450,9 @@ func (tc *txnOperator) handleError(responses []txn.TxnResponse, err error) ([]tx
 			}
 		}
 
-		// TODO: handle explicit txn error to error,  resp.TxnError
+		if resp.TxnError != nil {
+			return nil, moerr.NewError(resp.TxnError.Code, resp.TxnError.Message)
+		}
 	}
 	return responses, nil
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
@@ -315,6 +316,21 @@ func TestWriteOnCommittingTxn(t *testing.T) {
 	})
 }
 
+func TestWriteWithTxnError(t *testing.T) {
+	runOperatorTests(func(ctx context.Context, tc *txnOperator, ts *testTxnSender) {
+		ts.setManual(func(responses []txn.TxnResponse, err error) ([]txn.TxnResponse, error) {
+			for idx := range responses {
+				responses[idx].TxnError = &txn.TxnError{Code: moerr.ErrTxnWriteConflict, Message: "conflict"}
+			}
+			return responses, err
+		})
+		responses, err := tc.Write(ctx, []txn.TxnRequest{txn.NewTxnRequest(&txn.CNOpRequest{OpCode: 1})})
+		assert.Error(t, err)
+		assert.Equal(t, moerr.NewError(moerr.ErrTxnWriteConflict, "conflict"), err)
+		assert.Empty(t, responses)
+	})
+}
+
 func runOperatorTests(tc func(context.Context, *txnOperator, *testTxnSender), options ...TxnOption) {
 	ts := newTestTxnSender()
 	c := NewTxnClient(ts, WithLogger(logutil.GetPanicLoggerWithLevel(zap.DebugLevel)))
@@ -59,8 +59,11 @@ type TxnOperator interface {
 // TxnSender is used to send transaction requests to the DN nodes.
 type TxnSender interface {
 	// Send send request to the specified DN node, and wait for response synchronously.
-	// For any reason, if no response is received, the internal will keep retrying until
-	// the Context times out.
+	// The Context must have a deadline, and the DN stops handling the request after it.
+	// The requests never written to the DN, or idempotent (Read, GetStatus), are retried
+	// until the Context times out. Other requests may have been handled by the DN if no
+	// response is received, so Send returns the error instead of resending them, and the
+	// caller cannot tell whether they took effect.
 	Send(context.Context, []txn.TxnRequest) ([]txn.TxnResponse, error)
 }
 
@@ -0,0 +1,103 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// HLCClockType the HLCClock based on the synchronized physical clocks
+	HLCClockType = "hlc"
+	// TSOClockType the TSOClock based on the timestamps allocated by the TSO leader
+	TSOClockType = "tso"
+
+	defaultMaxOffset         = time.Millisecond * 500
+	defaultTSOBatchSize      = 1
+	defaultTSORequestTimeout = time.Second * 3
+)
+
+var (
+	ErrInvalidConfig = moerr.NewError(moerr.BAD_CONFIGURATION, "invalid txn clock configuration")
+)
+
+// Config defines the configurations of the txn clock.
+type Config struct {
+	// Type the clock type, HLCClockType or TSOClockType, default is HLCClockType.
+	Type string
+	// MaxOffset the max clock offset between the nodes, used by the HLCClock.
+	MaxOffset time.Duration
+	// TSOBatchSize the number of timestamps allocated from the TSO leader at a time
+	// and cached by the TSOClock.
+	TSOBatchSize uint32
+	// TSORequestTimeout the timeout of the allocation from the TSO leader.
+	TSORequestTimeout time.Duration
+	// TSOServiceAddresses the service addresses of the Log stores, the TSOClock
+	// allocates timestamps from the TSO running on the HAKeeper leader among them.
+	TSOServiceAddresses []string
+}
+
+// Validate validates the configuration.
+func (c *Config) Validate() error {
+	switch c.Type {
+	case HLCClockType:
+		if c.MaxOffset <= 0 {
+			return errors.Wrapf(ErrInvalidConfig, "MaxOffset not set")
+		}
+	case TSOClockType:
+		if c.TSOBatchSize == 0 {
+			return errors.Wrapf(ErrInvalidConfig, "TSOBatchSize not set")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidConfig, "unknown clock type %q", c.Type)
+	}
+	return nil
+}
+
+func (c *Config) Fill() {
+	if len(c.Type) == 0 {
+		c.Type = HLCClockType
+	}
+	if c.MaxOffset == 0 {
+		c.MaxOffset = defaultMaxOffset
+	}
+	if c.TSOBatchSize == 0 {
+		c.TSOBatchSize = defaultTSOBatchSize
+	}
+	if c.TSORequestTimeout == 0 {
+		c.TSORequestTimeout = defaultTSORequestTimeout
+	}
+}
+
+// NewClock returns the Clock specified by the configuration. The allocator is used by
+// the TSOClock to allocate timestamps from the TSO leader.
+func NewClock(ctx context.Context, cfg Config, allocator TSOAllocator) (Clock, error) {
+	cfg.Fill()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Type == TSOClockType {
+		if allocator == nil {
+			return nil, errors.Wrapf(ErrInvalidConfig, "TSOAllocator not set")
+		}
+		return NewTSOClock(allocator, cfg.TSOBatchSize, cfg.TSORequestTimeout), nil
+	}
+	return NewUnixNanoHLCClock(ctx, cfg.MaxOffset), nil
+}
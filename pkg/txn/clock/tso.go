@@ -0,0 +1,317 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+var (
+	// defaultTSORetryInterval the initial interval to retry the failed allocation, it
+	// is doubled after each failure up to defaultTSOMaxRetryInterval
+	defaultTSORetryInterval = time.Millisecond * 10
+	// defaultTSOMaxRetryInterval the max interval to retry the failed allocation
+	defaultTSOMaxRetryInterval = time.Second
+	// defaultTSOLogInterval the interval to log the failed allocations
+	defaultTSOLogInterval = time.Second * 10
+
+	errTSONotStarted    = moerr.NewError(moerr.INVALID_STATE, "tso server not started")
+	errTSOLeaderChanged = moerr.NewError(moerr.INVALID_STATE, "tso upper bound changed by another leader")
+)
+
+// TSOAllocator allocates the timestamps for the TSOClock. It is implemented by the
+// TSOServer running on the TSO leader, and by the clients sending the requests to
+// the TSO leader.
+type TSOAllocator interface {
+	// Allocate allocates count consecutive timestamps and returns the first one, the
+	// others have the same PhysicalTime and increasing LogicalTime.
+	Allocate(ctx context.Context, count uint32) (timestamp.Timestamp, error)
+}
+
+// TSOStore persists the upper bound of the PhysicalTime of the timestamps allocated by
+// the TSO leader.
+type TSOStore interface {
+	// Load returns the persisted upper bound, 0 is returned if nothing persisted. The
+	// read must be linearizable, the TSOServer reads the upper bound before handing out
+	// any timestamp to confirm that no other leader has started.
+	Load(ctx context.Context) (int64, error)
+	// Save persists the upper bound.
+	Save(ctx context.Context, limit int64) error
+}
+
+// TSOServer is the timestamp oracle running on the TSO leader, it hands out monotonic
+// timestamps to the TSOClocks. The timestamps are allocated in pre-allocated windows,
+// the upper bound of the window is persisted before any timestamp in the window is
+// handed out, so a new leader never hands out the timestamps handed out by the
+// previous leaders.
+//
+// A new leader always persists a greater upper bound before handing out any timestamp,
+// and each allocation reads the persisted upper bound first and fails if it is no
+// longer the one persisted by this server. So once the new leader starts handing out
+// timestamps, the deposed leader hands out nothing, and the timestamps are linearizable
+// across the leader changes.
+type TSOServer struct {
+	store         TSOStore
+	physicalClock func() int64
+	window        time.Duration
+
+	mu struct {
+		sync.Mutex
+		started bool
+		// last the last allocated timestamp
+		last timestamp.Timestamp
+		// limit the persisted upper bound of the PhysicalTime
+		limit int64
+	}
+}
+
+var _ TSOAllocator = (*TSOServer)(nil)
+
+// NewTSOServer returns a TSOServer. The window is the length of the physical time
+// persisted at a time, a bigger window means fewer writes to the TSOStore and a bigger
+// forward jump after the leader changed.
+func NewTSOServer(store TSOStore, clock func() int64, window time.Duration) *TSOServer {
+	if clock == nil {
+		panic("physical clock source not specified")
+	}
+	return &TSOServer{
+		store:         store,
+		physicalClock: clock,
+		window:        window,
+	}
+}
+
+// Start loads the persisted upper bound, it must be called when the node becomes
+// the TSO leader.
+func (s *TSOServer) Start(ctx context.Context) error {
+	limit, err := s.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// all the timestamps allocated later are greater than the persisted upper bound
+	s.mu.last = timestamp.Timestamp{PhysicalTime: limit + 1}
+	s.mu.limit = limit
+	s.mu.started = true
+	return nil
+}
+
+// Stop stops handing out timestamps, it must be called when the node is no longer the
+// TSO leader.
+func (s *TSOServer) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.started = false
+}
+
+// Started returns true if the TSOServer is handing out timestamps.
+func (s *TSOServer) Started() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mu.started
+}
+
+// Allocate allocates count consecutive timestamps, a new window is persisted if the
+// allocated timestamps exceed the current window. The TSOServer is stopped if the
+// persisted upper bound has been changed by another leader.
+func (s *TSOServer) Allocate(ctx context.Context, count uint32) (timestamp.Timestamp, error) {
+	if count == 0 {
+		count = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.mu.started {
+		return timestamp.Timestamp{}, errTSONotStarted
+	}
+	// the lock is held during the read, so no timestamp is handed out by this server
+	// between the read and the return
+	limit, err := s.store.Load(ctx)
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	if limit != s.mu.limit {
+		s.mu.started = false
+		return timestamp.Timestamp{}, errTSOLeaderChanged
+	}
+
+	first := s.mu.last
+	if now := s.physicalClock(); now > first.PhysicalTime {
+		first = timestamp.Timestamp{PhysicalTime: now}
+	} else if uint64(first.LogicalTime)+uint64(count) > math.MaxUint32 {
+		first = timestamp.Timestamp{PhysicalTime: first.PhysicalTime + 1}
+	} else {
+		first.LogicalTime++
+	}
+	last := first
+	last.LogicalTime += count - 1
+
+	if last.PhysicalTime > s.mu.limit {
+		limit := last.PhysicalTime + int64(s.window)
+		if err := s.store.Save(ctx, limit); err != nil {
+			return timestamp.Timestamp{}, err
+		}
+		s.mu.limit = limit
+	}
+	s.mu.last = last
+	return first, nil
+}
+
+// TSOClock is a Clock based on the timestamps allocated by the TSO leader, it does not
+// depend on the synchronization of the physical clocks. The clock allocates a batch of
+// timestamps at a time and hands them out locally.
+//
+// The cached timestamps are dropped once a greater or equal timestamp is received by
+// Update, so the causal order is kept. The timestamps may be reordered between the
+// transactions started on different nodes without any message exchanged, a batch size
+// of 1 is used to avoid that.
+type TSOClock struct {
+	logger    *zap.Logger
+	allocator TSOAllocator
+	batchSize uint32
+	timeout   time.Duration
+	// retryInterval the initial interval to retry the failed allocation
+	retryInterval time.Duration
+
+	mu struct {
+		sync.Mutex
+		// next the next cached timestamp, and remaining is the number of cached timestamps
+		next      timestamp.Timestamp
+		remaining uint32
+		// floor the greatest timestamp handed out or received, the allocated batches
+		// not greater than it are dropped
+		floor timestamp.Timestamp
+	}
+}
+
+var _ Clock = (*TSOClock)(nil)
+
+// NewTSOClock returns a TSOClock. The batchSize is the number of timestamps allocated
+// at a time, and the timeout is the timeout of each allocation.
+func NewTSOClock(allocator TSOAllocator, batchSize uint32, timeout time.Duration) *TSOClock {
+	if batchSize == 0 {
+		batchSize = 1
+	}
+	if timeout <= 0 {
+		timeout = defaultTSORequestTimeout
+	}
+	return &TSOClock{
+		logger:        logutil.Adjust(nil).Named("tso-clock"),
+		allocator:     allocator,
+		batchSize:     batchSize,
+		timeout:       timeout,
+		retryInterval: defaultTSORetryInterval,
+	}
+}
+
+// HasNetworkLatency returns true, the timestamps are allocated by the TSO leader.
+func (c *TSOClock) HasNetworkLatency() bool {
+	return true
+}
+
+// MaxOffset returns 0, the TSOClock has no clock offset.
+func (c *TSOClock) MaxOffset() time.Duration {
+	return 0
+}
+
+// Now returns the next timestamp, and the upper bound is the same as the timestamp. If
+// no timestamp cached, it blocks until the timestamps allocated by the TSO leader. The
+// lock is not held during the allocation, so Update is never blocked by the TSO leader.
+// As the Clock can't return errors, the failed allocations are retried with backoff
+// until the TSO leader is available again, e.g. after the leader changed.
+func (c *TSOClock) Now() (timestamp.Timestamp, timestamp.Timestamp) {
+	interval := c.retryInterval
+	var failedAt, loggedAt time.Time
+	for {
+		if now, ok := c.next(); ok {
+			return now, now
+		}
+		ts, err := c.allocate()
+		if err == nil {
+			c.refill(ts)
+			continue
+		}
+
+		if failedAt.IsZero() {
+			failedAt = time.Now()
+		}
+		if time.Since(loggedAt) >= defaultTSOLogInterval {
+			loggedAt = time.Now()
+			c.logger.Warn("failed to allocate timestamps, retry later",
+				zap.Duration("unavailable", time.Since(failedAt)),
+				zap.Error(err))
+		}
+		time.Sleep(interval)
+		if interval *= 2; interval > defaultTSOMaxRetryInterval {
+			interval = defaultTSOMaxRetryInterval
+		}
+	}
+}
+
+// Update drops the cached timestamps which are not greater than the received timestamp.
+func (c *TSOClock) Update(ts timestamp.Timestamp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.floor.Less(ts) {
+		c.mu.floor = ts
+	}
+	if c.mu.remaining > 0 && !ts.Less(c.mu.next) {
+		c.mu.remaining = 0
+	}
+}
+
+// next hands out a cached timestamp
+func (c *TSOClock) next() (timestamp.Timestamp, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.remaining == 0 {
+		return timestamp.Timestamp{}, false
+	}
+	now := c.mu.next
+	c.mu.next.LogicalTime++
+	c.mu.remaining--
+	c.mu.floor = now
+	return now, true
+}
+
+// refill caches the allocated batch starting from ts. The batch is dropped if the
+// cache is refilled by others concurrently, or a greater timestamp is received or
+// handed out during the allocation.
+func (c *TSOClock) refill(ts timestamp.Timestamp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.remaining > 0 || !c.mu.floor.Less(ts) {
+		return
+	}
+	c.mu.next = ts
+	c.mu.remaining = c.batchSize
+}
+
+func (c *TSOClock) allocate() (timestamp.Timestamp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return c.allocator.Allocate(ctx, c.batchSize)
+}
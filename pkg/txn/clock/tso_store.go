@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"encoding/binary"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// defaultTSOStoreReadSize the max size of the records read at a time
+const defaultTSOStoreReadSize = 1024 * 1024
+
+// LogClient is the part of logservice.Client used by the TSOStore to persist the
+// upper bound of the TSO windows.
+type LogClient interface {
+	Append(ctx context.Context, rec pb.LogRecord) (uint64, error)
+	Read(ctx context.Context, firstIndex uint64, maxSize uint64) ([]pb.LogRecord, uint64, error)
+	Truncate(ctx context.Context, index uint64) error
+	GetTruncatedIndex(ctx context.Context) (uint64, error)
+}
+
+type logTSOStore struct {
+	client LogClient
+}
+
+// NewLogTSOStore returns a TSOStore which persists the upper bound to the LogService.
+// Each Save appends a record and truncates the previous records.
+func NewLogTSOStore(client LogClient) TSOStore {
+	return &logTSOStore{client: client}
+}
+
+func (s *logTSOStore) Load(ctx context.Context) (int64, error) {
+	index, err := s.client.GetTruncatedIndex(ctx)
+	if err != nil {
+		return 0, err
+	}
+	index++
+
+	limit := int64(0)
+	for {
+		records, next, err := s.client.Read(ctx, index, defaultTSOStoreReadSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, rec := range records {
+			if len(rec.Data) != 8 {
+				continue
+			}
+			if v := int64(binary.BigEndian.Uint64(rec.Data)); v > limit {
+				limit = v
+			}
+		}
+		if next == index {
+			return limit, nil
+		}
+		index = next
+	}
+}
+
+func (s *logTSOStore) Save(ctx context.Context, limit int64) error {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(limit))
+	index, err := s.client.Append(ctx, pb.LogRecord{Data: data})
+	if err != nil {
+		return err
+	}
+	if index > 1 {
+		return s.client.Truncate(ctx, index-1)
+	}
+	return nil
+}
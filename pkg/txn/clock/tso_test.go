@@ -0,0 +1,261 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSOServerAllocate(t *testing.T) {
+	ctx := context.Background()
+	store := &testTSOStore{}
+	physical := int64(10)
+	s := NewTSOServer(store, func() int64 { return physical }, 100)
+
+	_, err := s.Allocate(ctx, 1)
+	assert.Equal(t, errTSONotStarted, err)
+	require.NoError(t, s.Start(ctx))
+
+	ts, err := s.Allocate(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, timestamp.Timestamp{PhysicalTime: 10}, ts)
+	ts, err = s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, timestamp.Timestamp{PhysicalTime: 10, LogicalTime: 3}, ts)
+	assert.Equal(t, []int64{110}, store.saved)
+
+	// the physical clock moves backward
+	physical = 5
+	ts, err = s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, timestamp.Timestamp{PhysicalTime: 10, LogicalTime: 4}, ts)
+
+	// no persistence in the window
+	physical = 110
+	_, err = s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{110}, store.saved)
+
+	physical = 111
+	_, err = s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{110, 211}, store.saved)
+}
+
+func TestTSOServerAllocateFailed(t *testing.T) {
+	ctx := context.Background()
+	store := &testTSOStore{}
+	s := NewTSOServer(store, func() int64 { return 10 }, 100)
+	require.NoError(t, s.Start(ctx))
+
+	store.err = errors.New("save failed")
+	_, err := s.Allocate(ctx, 1)
+	assert.Error(t, err)
+
+	store.err = nil
+	ts, err := s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, timestamp.Timestamp{PhysicalTime: 10}, ts)
+}
+
+func TestTSOServerLeaderChanged(t *testing.T) {
+	ctx := context.Background()
+	store := NewLogTSOStore(mem.NewMemLog())
+	s1 := NewTSOServer(store, func() int64 { return 1000 }, 100)
+	require.NoError(t, s1.Start(ctx))
+	ts1, err := s1.Allocate(ctx, 10)
+	require.NoError(t, err)
+
+	// the physical clock of the new leader is behind
+	s2 := NewTSOServer(store, func() int64 { return 10 }, 100)
+	require.NoError(t, s2.Start(ctx))
+	ts2, err := s2.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, ts1.PhysicalTime < ts2.PhysicalTime)
+	assert.Equal(t, int64(1101), ts2.PhysicalTime)
+
+	limit, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1201), limit)
+}
+
+func TestTSOClock(t *testing.T) {
+	ctx := context.Background()
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	require.NoError(t, s.Start(ctx))
+	allocator := &testTSOAllocator{allocator: s}
+	c := NewTSOClock(allocator, 3, time.Second)
+	assert.True(t, c.HasNetworkLatency())
+	assert.Equal(t, time.Duration(0), c.MaxOffset())
+
+	var last timestamp.Timestamp
+	for i := 0; i < 6; i++ {
+		now, upper := c.Now()
+		assert.Equal(t, now, upper)
+		assert.True(t, last.Less(now))
+		last = now
+	}
+	assert.Equal(t, 2, allocator.count)
+
+	// the cached timestamps are dropped after a greater timestamp received
+	c.Now()
+	ts, err := s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	c.Update(ts)
+	now, _ := c.Now()
+	assert.True(t, ts.Less(now))
+	assert.Equal(t, 4, allocator.count)
+
+	// a smaller timestamp keeps the cache
+	c.Update(timestamp.Timestamp{PhysicalTime: 1})
+	c.Now()
+	assert.Equal(t, 4, allocator.count)
+}
+
+func TestTSOClockRetry(t *testing.T) {
+	ctx := context.Background()
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	c := NewTSOClock(s, 1, time.Second)
+	time.AfterFunc(time.Millisecond*50, func() {
+		assert.NoError(t, s.Start(ctx))
+	})
+	now, _ := c.Now()
+	assert.Equal(t, int64(10), now.PhysicalTime)
+}
+
+func TestTSOClockRetryUntilAvailable(t *testing.T) {
+	ctx := context.Background()
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	c := NewTSOClock(s, 1, time.Second)
+	c.retryInterval = time.Millisecond
+	// the allocation keeps failing far longer than the initial retry interval
+	time.AfterFunc(time.Millisecond*1500, func() {
+		assert.NoError(t, s.Start(ctx))
+	})
+	now, _ := c.Now()
+	assert.Equal(t, int64(10), now.PhysicalTime)
+}
+
+func TestTSOServerStopsAfterLeaderChanged(t *testing.T) {
+	ctx := context.Background()
+	store := &testTSOStore{}
+	old := NewTSOServer(store, func() int64 { return 10 }, 100)
+	require.NoError(t, old.Start(ctx))
+	ts, err := old.Allocate(ctx, 1)
+	require.NoError(t, err)
+
+	// the new leader persists a greater upper bound before handing out timestamps
+	s := NewTSOServer(store, func() int64 { return 10 }, 100)
+	require.NoError(t, s.Start(ctx))
+	next, err := s.Allocate(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, ts.Less(next))
+
+	// the deposed leader hands out nothing within its old window
+	_, err = old.Allocate(ctx, 1)
+	assert.Error(t, err)
+	assert.False(t, old.Started())
+}
+
+func TestTSOClockDropOutdatedBatch(t *testing.T) {
+	ctx := context.Background()
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	require.NoError(t, s.Start(ctx))
+	allocator := &testTSOAllocator{allocator: s}
+	c := NewTSOClock(allocator, 1, time.Second)
+	// a greater timestamp is received during the first allocation
+	received := timestamp.Timestamp{PhysicalTime: 10}
+	allocator.onAllocate = func() {
+		c.Update(received)
+		allocator.onAllocate = nil
+	}
+	now, _ := c.Now()
+	assert.True(t, received.Less(now))
+	assert.Equal(t, 2, allocator.count)
+}
+
+func TestTSOServerStop(t *testing.T) {
+	ctx := context.Background()
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	require.NoError(t, s.Start(ctx))
+	assert.True(t, s.Started())
+	s.Stop()
+	assert.False(t, s.Started())
+	_, err := s.Allocate(ctx, 1)
+	assert.Error(t, err)
+}
+
+func TestNewClock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := NewClock(ctx, Config{}, nil)
+	require.NoError(t, err)
+	assert.False(t, c.HasNetworkLatency())
+
+	_, err = NewClock(ctx, Config{Type: TSOClockType}, nil)
+	assert.Error(t, err)
+
+	s := NewTSOServer(&testTSOStore{}, func() int64 { return 10 }, 100)
+	c, err = NewClock(ctx, Config{Type: TSOClockType}, s)
+	require.NoError(t, err)
+	assert.True(t, c.HasNetworkLatency())
+
+	_, err = NewClock(ctx, Config{Type: "unknown"}, nil)
+	assert.Error(t, err)
+}
+
+type testTSOStore struct {
+	saved []int64
+	err   error
+}
+
+func (s *testTSOStore) Load(ctx context.Context) (int64, error) {
+	if len(s.saved) == 0 {
+		return 0, nil
+	}
+	return s.saved[len(s.saved)-1], nil
+}
+
+func (s *testTSOStore) Save(ctx context.Context, limit int64) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.saved = append(s.saved, limit)
+	return nil
+}
+
+type testTSOAllocator struct {
+	allocator  TSOAllocator
+	count      int
+	onAllocate func()
+}
+
+func (a *testTSOAllocator) Allocate(ctx context.Context, count uint32) (timestamp.Timestamp, error) {
+	a.count++
+	ts, err := a.allocator.Allocate(ctx, count)
+	if a.onAllocate != nil {
+		a.onAllocate()
+	}
+	return ts, err
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// frameHeaderSize every frame starts with a 4 bytes big-endian payload length
+	frameHeaderSize = 4
+	// defaultMaxMessageSize the max size of one frame payload
+	defaultMaxMessageSize = 64 * 1024 * 1024
+)
+
+// message is implemented by txn.TxnRequestBatch and txn.TxnResponseBatch
+type message interface {
+	Size() int
+	MarshalTo([]byte) (int, error)
+	Unmarshal([]byte) error
+}
+
+// writeFrame encodes the message into buf and writes it to w as one frame. The
+// buffer is grown if necessary, and returned for reuse.
+func writeFrame(w io.Writer, m message, buf []byte) ([]byte, error) {
+	size := m.Size()
+	if cap(buf) < frameHeaderSize+size {
+		buf = make([]byte, frameHeaderSize+size)
+	}
+	buf = buf[:frameHeaderSize+size]
+	binary.BigEndian.PutUint32(buf, uint32(size))
+	if _, err := m.MarshalTo(buf[frameHeaderSize:]); err != nil {
+		return buf, err
+	}
+	_, err := w.Write(buf)
+	return buf, err
+}
+
+// readFrame reads one frame from r and decodes it into m. The buffer is grown if
+// necessary, and returned for reuse.
+func readFrame(r io.Reader, m message, buf []byte, maxSize int) ([]byte, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return buf, err
+	}
+	size := int(binary.BigEndian.Uint32(header[:]))
+	if size > maxSize {
+		return buf, fmt.Errorf("frame size %d exceeds the limit %d", size, maxSize)
+	}
+	if cap(buf) < size {
+		buf = make([]byte, size)
+	}
+	buf = buf[:size]
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return buf, err
+	}
+	return buf, m.Unmarshal(buf)
+}
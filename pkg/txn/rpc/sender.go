@@ -0,0 +1,554 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"go.uber.org/zap"
+)
+
+var (
+	// defaultConnections the number of connections to each DN
+	defaultConnections = 4
+	// defaultQueueSize the number of requests waiting to be sent on each connection
+	defaultQueueSize = 1024
+	// defaultMaxBatchSize the max number of requests merged into one frame
+	defaultMaxBatchSize = 256
+	// defaultDialTimeout timeout to connect to the DN
+	defaultDialTimeout = time.Second * 3
+	// defaultRetryInterval the interval to resend the requests which failed
+	defaultRetryInterval = time.Millisecond * 100
+)
+
+var (
+	errSenderClosed     = errors.New("txn sender closed")
+	errDeadlineNotSet   = errors.New("context deadline not set")
+	errConnectionClosed = errors.New("connection closed")
+	errMissingAddress   = errors.New("missing DNShard address")
+)
+
+// WithSenderLogger set logger for the TxnSender
+func WithSenderLogger(logger *zap.Logger) SenderOption {
+	return func(s *sender) {
+		s.logger = logger
+	}
+}
+
+// WithSenderConnections set the number of connections to each DN
+func WithSenderConnections(n int) SenderOption {
+	return func(s *sender) {
+		s.options.connections = n
+	}
+}
+
+// WithSenderMaxBatchSize set the max number of requests merged into one frame
+func WithSenderMaxBatchSize(n int) SenderOption {
+	return func(s *sender) {
+		s.options.maxBatchSize = n
+	}
+}
+
+// WithSenderDialTimeout set the timeout to connect to the DN
+func WithSenderDialTimeout(timeout time.Duration) SenderOption {
+	return func(s *sender) {
+		s.options.dialTimeout = timeout
+	}
+}
+
+// WithSenderRetryInterval set the interval to resend the failed requests
+func WithSenderRetryInterval(interval time.Duration) SenderOption {
+	return func(s *sender) {
+		s.options.retryInterval = interval
+	}
+}
+
+// WithSenderMaxMessageSize set the max size of the response frame
+func WithSenderMaxMessageSize(size int) SenderOption {
+	return func(s *sender) {
+		s.options.maxMessageSize = size
+	}
+}
+
+type sender struct {
+	logger  *zap.Logger
+	options struct {
+		connections    int
+		maxBatchSize   int
+		dialTimeout    time.Duration
+		retryInterval  time.Duration
+		maxMessageSize int
+	}
+
+	requestID uint64
+	// ctx is cancelled when the sender is closed
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopper sync.WaitGroup
+
+	mu struct {
+		sync.Mutex
+		closed bool
+		// backends DN address -> backend
+		backends map[string]*backend
+	}
+}
+
+// NewSender create a TxnSender which sends the requests to the DNs via RPC.
+func NewSender(options ...SenderOption) TxnSender {
+	s := &sender{}
+	for _, opt := range options {
+		opt(s)
+	}
+	s.adjust()
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mu.backends = make(map[string]*backend)
+	return s
+}
+
+func (s *sender) adjust() {
+	s.logger = logutil.Adjust(s.logger).Named("txn-sender")
+	if s.options.connections <= 0 {
+		s.options.connections = defaultConnections
+	}
+	if s.options.maxBatchSize <= 0 {
+		s.options.maxBatchSize = defaultMaxBatchSize
+	}
+	if s.options.dialTimeout <= 0 {
+		s.options.dialTimeout = defaultDialTimeout
+	}
+	if s.options.retryInterval <= 0 {
+		s.options.retryInterval = defaultRetryInterval
+	}
+	if s.options.maxMessageSize <= 0 {
+		s.options.maxMessageSize = defaultMaxMessageSize
+	}
+}
+
+func (s *sender) Close() error {
+	s.mu.Lock()
+	if s.mu.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.mu.closed = true
+	s.mu.Unlock()
+
+	s.cancel()
+	s.stopper.Wait()
+	return nil
+}
+
+func (s *sender) Send(ctx context.Context, requests []txn.TxnRequest) ([]txn.TxnResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errDeadlineNotSet
+	}
+
+	responses := make([]txn.TxnResponse, len(requests))
+	futures := make([]*future, len(requests))
+	pending := make([]int, 0, len(requests))
+	for i := range requests {
+		pending = append(pending, i)
+	}
+	for {
+		for _, i := range pending {
+			f, err := s.send(ctx, requests[i])
+			if err != nil {
+				cancelFutures(futures)
+				return nil, err
+			}
+			futures[i] = f
+		}
+
+		var lastErr error
+		failed := pending[:0]
+		for _, i := range pending {
+			f := futures[i]
+			resp, err := f.get()
+			futures[i] = nil
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					cancelFutures(futures)
+					return nil, ctxErr
+				}
+				if err == errSenderClosed {
+					cancelFutures(futures)
+					return nil, err
+				}
+				// the request maybe handled by the DN even if no response received,
+				// only the requests never written or idempotent can be resent.
+				if f.isSent() && !isIdempotent(requests[i].Method) {
+					cancelFutures(futures)
+					return nil, err
+				}
+				lastErr = err
+				failed = append(failed, i)
+				continue
+			}
+			responses[i] = resp
+		}
+		if len(failed) == 0 {
+			return responses, nil
+		}
+
+		s.logger.Debug("failed to send txn requests, retry later",
+			zap.Int("failed", len(failed)),
+			zap.Error(lastErr))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.ctx.Done():
+			return nil, errSenderClosed
+		case <-time.After(s.options.retryInterval):
+		}
+		pending = failed
+	}
+}
+
+// send assigns a new request id to the request, and adds it to the queue of a
+// connection to the target DN.
+func (s *sender) send(ctx context.Context, request txn.TxnRequest) (*future, error) {
+	b, err := s.getBackend(request.GetTargetDN().Address)
+	if err != nil {
+		return nil, err
+	}
+
+	request.RequestID = atomic.AddUint64(&s.requestID, 1)
+	c := b.next()
+	f := newFuture(ctx, request, c)
+	select {
+	case c.queue <- f:
+		return f, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.ctx.Done():
+		return nil, errSenderClosed
+	}
+}
+
+func (s *sender) getBackend(address string) (*backend, error) {
+	if address == "" {
+		return nil, errMissingAddress
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mu.closed {
+		return nil, errSenderClosed
+	}
+	if b, ok := s.mu.backends[address]; ok {
+		return b, nil
+	}
+
+	b := &backend{conns: make([]*connection, 0, s.options.connections)}
+	for i := 0; i < s.options.connections; i++ {
+		c := newConnection(s, address)
+		b.conns = append(b.conns, c)
+		s.stopper.Add(1)
+		go c.writeLoop()
+	}
+	s.mu.backends[address] = b
+	return b, nil
+}
+
+// backend the connections to one DN, the requests are sent by the connections in
+// turn.
+type backend struct {
+	seq   uint64
+	conns []*connection
+}
+
+func (b *backend) next() *connection {
+	return b.conns[atomic.AddUint64(&b.seq, 1)%uint64(len(b.conns))]
+}
+
+// connection is a long-lived connection to the DN. The connection is established
+// lazily, and re-established on the next request after it broken. The writeLoop
+// merges the queued requests into one frame, and the readLoop dispatches the
+// responses to the waiting futures by request id.
+type connection struct {
+	s       *sender
+	address string
+	queue   chan *future
+
+	mu struct {
+		sync.Mutex
+		conn net.Conn
+		// pending the requests were sent but no response received, request id -> future
+		pending map[uint64]*future
+	}
+}
+
+func newConnection(s *sender, address string) *connection {
+	c := &connection{
+		s:       s,
+		address: address,
+		queue:   make(chan *future, defaultQueueSize),
+	}
+	c.mu.pending = make(map[uint64]*future)
+	return c
+}
+
+func (c *connection) writeLoop() {
+	defer c.s.stopper.Done()
+
+	var buf []byte
+	batch := &txn.TxnRequestBatch{}
+	futures := make([]*future, 0, c.s.options.maxBatchSize)
+	for {
+		select {
+		case <-c.s.ctx.Done():
+			c.close(errSenderClosed)
+			return
+		case f := <-c.queue:
+			futures = c.collect(f, futures[:0])
+			if len(futures) == 0 {
+				continue
+			}
+
+			conn, err := c.getConn()
+			if err != nil {
+				c.s.logger.Debug("failed to connect to DN",
+					zap.String("address", c.address),
+					zap.Error(err))
+				for _, f := range futures {
+					f.done(txn.TxnResponse{}, err)
+				}
+				continue
+			}
+
+			batch.Requests = batch.Requests[:0]
+			var deadline time.Time
+			c.mu.Lock()
+			for _, f := range futures {
+				// the future maybe cancelled by the caller after added to the
+				// queue, skip it. Send ensures the deadline of the ctx is set.
+				d, _ := f.ctx.Deadline()
+				timeout := time.Until(d)
+				if f.ctx.Err() != nil || timeout <= 0 {
+					continue
+				}
+				f.sent = true
+				c.mu.pending[f.request.RequestID] = f
+				request := f.request
+				request.Timeout = int64(timeout)
+				batch.Requests = append(batch.Requests, request)
+				if deadline.IsZero() || d.Before(deadline) {
+					deadline = d
+				}
+			}
+			c.mu.Unlock()
+			if len(batch.Requests) == 0 {
+				continue
+			}
+
+			// the write is bounded by the earliest deadline of the requests
+			if err = conn.SetWriteDeadline(deadline); err == nil {
+				buf, err = writeFrame(conn, batch, buf)
+			}
+			if err != nil {
+				c.s.logger.Debug("failed to write txn requests",
+					zap.String("address", c.address),
+					zap.Error(err))
+				c.closeConn(conn, err)
+			}
+		}
+	}
+}
+
+// collect takes the queued requests without blocking until maxBatchSize reached.
+func (c *connection) collect(f *future, futures []*future) []*future {
+	if f.ctx.Err() == nil {
+		futures = append(futures, f)
+	}
+	for len(futures) < c.s.options.maxBatchSize {
+		select {
+		case f := <-c.queue:
+			if f.ctx.Err() == nil {
+				futures = append(futures, f)
+			}
+		default:
+			return futures
+		}
+	}
+	return futures
+}
+
+func (c *connection) getConn() (net.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.conn != nil {
+		return c.mu.conn, nil
+	}
+
+	conn, err := net.DialTimeout("tcp", c.address, c.s.options.dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.conn = conn
+	c.s.stopper.Add(1)
+	go c.readLoop(conn)
+	return conn, nil
+}
+
+func (c *connection) readLoop(conn net.Conn) {
+	defer c.s.stopper.Done()
+
+	var buf []byte
+	var err error
+	for {
+		batch := &txn.TxnResponseBatch{}
+		if buf, err = readFrame(conn, batch, buf, c.s.options.maxMessageSize); err != nil {
+			c.closeConn(conn, errConnectionClosed)
+			return
+		}
+
+		c.mu.Lock()
+		for _, resp := range batch.Responses {
+			if f, ok := c.mu.pending[resp.RequestID]; ok {
+				delete(c.mu.pending, resp.RequestID)
+				f.done(resp, nil)
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+// closeConn closes the broken conn, and all the pending requests sent on it failed.
+func (c *connection) closeConn(conn net.Conn, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.conn != conn {
+		return
+	}
+	c.closeLocked(err)
+}
+
+// close closes the connection when the sender closed, all the pending and queued
+// requests failed.
+func (c *connection) close(err error) {
+	c.mu.Lock()
+	c.closeLocked(err)
+	c.mu.Unlock()
+
+	for {
+		select {
+		case f := <-c.queue:
+			f.done(txn.TxnResponse{}, err)
+		default:
+			return
+		}
+	}
+}
+
+func (c *connection) closeLocked(err error) {
+	if c.mu.conn != nil {
+		if e := c.mu.conn.Close(); e != nil {
+			c.s.logger.Debug("failed to close connection",
+				zap.String("address", c.address),
+				zap.Error(e))
+		}
+		c.mu.conn = nil
+	}
+	for id, f := range c.mu.pending {
+		delete(c.mu.pending, id)
+		f.done(txn.TxnResponse{}, err)
+	}
+}
+
+func (c *connection) removePending(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.mu.pending, id)
+}
+
+type result struct {
+	response txn.TxnResponse
+	err      error
+}
+
+// future waits for the response of one request.
+type future struct {
+	ctx     context.Context
+	request txn.TxnRequest
+	conn    *connection
+	c       chan result
+	// sent is set when the request is about to be written to the connection,
+	// guarded by the mutex of the connection.
+	sent bool
+}
+
+func newFuture(ctx context.Context, request txn.TxnRequest, conn *connection) *future {
+	return &future{
+		ctx:     ctx,
+		request: request,
+		conn:    conn,
+		c:       make(chan result, 1),
+	}
+}
+
+func (f *future) get() (txn.TxnResponse, error) {
+	select {
+	case r := <-f.c:
+		return r.response, r.err
+	case <-f.ctx.Done():
+		f.cancel()
+		return txn.TxnResponse{}, f.ctx.Err()
+	}
+}
+
+func (f *future) done(response txn.TxnResponse, err error) {
+	select {
+	case f.c <- result{response: response, err: err}:
+	default:
+	}
+}
+
+// isSent returns true if the request maybe written to the connection.
+func (f *future) isSent() bool {
+	f.conn.mu.Lock()
+	defer f.conn.mu.Unlock()
+	return f.sent
+}
+
+func (f *future) cancel() {
+	f.conn.removePending(f.request.RequestID)
+}
+
+func cancelFutures(futures []*future) {
+	for _, f := range futures {
+		if f != nil {
+			f.cancel()
+		}
+	}
+}
+
+// isIdempotent returns true if the request can be handled by the DN more than
+// once without changing the result.
+func isIdempotent(method txn.TxnMethod) bool {
+	switch method {
+	case txn.TxnMethod_Read, txn.TxnMethod_GetStatus:
+		return true
+	default:
+		return false
+	}
+}
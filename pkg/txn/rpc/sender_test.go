@@ -0,0 +1,366 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWithSingleRequest(t *testing.T) {
+	address := newTestAddress(t)
+	s := newTestServer(t, address, echoHandler)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender()
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	responses, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(responses))
+	assert.Equal(t, txn.TxnMethod_Write, responses[0].Method)
+	assert.Equal(t, []byte("t1"), responses[0].Txn.ID)
+}
+
+func TestSendToMultipleDNs(t *testing.T) {
+	address1 := newTestAddress(t)
+	address2 := newTestAddress(t)
+	s1 := newTestServer(t, address1, echoHandler)
+	defer func() {
+		assert.NoError(t, s1.Close())
+	}()
+	s2 := newTestServer(t, address2, echoHandler)
+	defer func() {
+		assert.NoError(t, s2.Close())
+	}()
+
+	sd := NewSender(WithSenderConnections(2))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	var requests []txn.TxnRequest
+	for i := 0; i < 100; i++ {
+		address := address1
+		if i%2 == 1 {
+			address = address2
+		}
+		requests = append(requests, newTestRequest(address, fmt.Sprintf("t%d", i)))
+	}
+	responses, err := sd.Send(ctx, requests)
+	require.NoError(t, err)
+	require.Equal(t, len(requests), len(responses))
+	for i, resp := range responses {
+		// the responses are in the same order as the requests
+		assert.Equal(t, []byte(fmt.Sprintf("t%d", i)), resp.Txn.ID)
+	}
+}
+
+func TestConcurrentSend(t *testing.T) {
+	address := newTestAddress(t)
+	s := newTestServer(t, address, echoHandler)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender(WithSenderConnections(1))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				id := fmt.Sprintf("t%d-%d", i, j)
+				responses, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, id)})
+				assert.NoError(t, err)
+				assert.Equal(t, []byte(id), responses[0].Txn.ID)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestQueuedRequestsAreBatched(t *testing.T) {
+	sd := NewSender(WithSenderMaxBatchSize(3)).(*sender)
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newConnection(sd, "")
+	for i := 0; i < 4; i++ {
+		c.queue <- newFuture(ctx, newTestRequest("", "t"), c)
+	}
+
+	futures := c.collect(<-c.queue, nil)
+	assert.Equal(t, 3, len(futures))
+	assert.Equal(t, 1, len(c.queue))
+}
+
+func TestSendRetryUntilDNStarted(t *testing.T) {
+	address := newTestAddress(t)
+	sd := NewSender(WithSenderRetryInterval(time.Millisecond * 10))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	var s TxnServer
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+	s = NewTxnServer(address, echoHandler)
+	time.AfterFunc(time.Millisecond*100, func() {
+		assert.NoError(t, s.Start())
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	responses, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("t1"), responses[0].Txn.ID)
+}
+
+func TestSendAfterDNRestarted(t *testing.T) {
+	address := newTestAddress(t)
+	sd := NewSender(WithSenderConnections(1), WithSenderRetryInterval(time.Millisecond*10))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	s := newTestServer(t, address, echoHandler)
+	_, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+	// the write request is not resent if the broken connection is not detected
+	// before it was written, wait for the connection closed.
+	b, err := sd.(*sender).getBackend(address)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		c := b.conns[0]
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.mu.conn == nil
+	}, time.Second*10, time.Millisecond)
+
+	s = newTestServer(t, address, echoHandler)
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+	responses, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t2")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("t2"), responses[0].Txn.ID)
+}
+
+func TestSendTimeout(t *testing.T) {
+	address := newTestAddress(t)
+	var handled uint64
+	s := newTestServer(t, address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		atomic.AddUint64(&handled, 1)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender(WithSenderConnections(1))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	_, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&handled))
+
+	// the pending request is removed after timeout
+	b, err := sd.(*sender).getBackend(address)
+	require.NoError(t, err)
+	c := b.conns[0]
+	c.mu.Lock()
+	assert.Empty(t, c.mu.pending)
+	c.mu.Unlock()
+}
+
+func TestSendNotRetryWrittenWriteRequest(t *testing.T) {
+	address := newTestAddress(t)
+	var handled uint64
+	s := newTestServer(t, address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		atomic.AddUint64(&handled, 1)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender(WithSenderConnections(1), WithSenderRetryInterval(time.Millisecond*10))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	go func() {
+		for atomic.LoadUint64(&handled) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		assert.NoError(t, s.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	_, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	assert.Equal(t, errConnectionClosed, err)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&handled))
+}
+
+func TestSendRetryWrittenReadRequest(t *testing.T) {
+	address := newTestAddress(t)
+	var handled uint64
+	s1 := newTestServer(t, address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		atomic.AddUint64(&handled, 1)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	defer func() {
+		assert.NoError(t, s1.Close())
+	}()
+
+	sd := NewSender(WithSenderConnections(1), WithSenderRetryInterval(time.Millisecond*10))
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	s2 := NewTxnServer(address, echoHandler)
+	defer func() {
+		assert.NoError(t, s2.Close())
+	}()
+	go func() {
+		for atomic.LoadUint64(&handled) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		assert.NoError(t, s1.Close())
+		assert.NoError(t, s2.Start())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	req := newTestRequest(address, "t1")
+	req.Method = txn.TxnMethod_Read
+	responses, err := sd.Send(ctx, []txn.TxnRequest{req})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("t1"), responses[0].Txn.ID)
+	assert.Equal(t, uint64(1), atomic.LoadUint64(&handled))
+}
+
+func TestSendWithHandlerError(t *testing.T) {
+	address := newTestAddress(t)
+	s := newTestServer(t, address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		return fmt.Errorf("handler error")
+	})
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender()
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	responses, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	require.NoError(t, err)
+	require.NotNil(t, responses[0].TxnError)
+	assert.Equal(t, "handler error", responses[0].TxnError.Message)
+}
+
+func TestSendWithoutDeadline(t *testing.T) {
+	sd := NewSender()
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	_, err := sd.Send(context.Background(), []txn.TxnRequest{newTestRequest("", "t1")})
+	assert.Equal(t, errDeadlineNotSet, err)
+}
+
+func TestSendAfterClosed(t *testing.T) {
+	sd := NewSender()
+	require.NoError(t, sd.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	_, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest("127.0.0.1:1", "t1")})
+	assert.Equal(t, errSenderClosed, err)
+}
+
+func echoHandler(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	response.Method = request.Method
+	response.Txn = &request.Txn
+	return nil
+}
+
+func newTestServer(t *testing.T, address string, handler TxnRequestHandler) TxnServer {
+	s := NewTxnServer(address, handler)
+	require.NoError(t, s.Start())
+	return s
+}
+
+func newTestAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, l.Close())
+	}()
+	return l.Addr().String()
+}
+
+func newTestRequest(address string, id string) txn.TxnRequest {
+	return txn.TxnRequest{
+		Txn:    txn.TxnMeta{ID: []byte(id)},
+		Method: txn.TxnMethod_Write,
+		CNRequest: &txn.CNOpRequest{
+			Target: metadata.DNShard{Address: address},
+		},
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"go.uber.org/zap"
+)
+
+var (
+	errServerStarted = errors.New("txn server already started")
+	errServerClosed  = errors.New("txn server closed")
+	errTimeoutNotSet = moerr.NewError(moerr.INVALID_INPUT, "txn request timeout not set")
+)
+
+// WithServerLogger set logger for the TxnServer
+func WithServerLogger(logger *zap.Logger) ServerOption {
+	return func(s *server) {
+		s.logger = logger
+	}
+}
+
+// WithServerMaxMessageSize set the max size of the request frame
+func WithServerMaxMessageSize(size int) ServerOption {
+	return func(s *server) {
+		s.options.maxMessageSize = size
+	}
+}
+
+// WithServerMaxBatchSize set the max number of responses merged into one frame
+func WithServerMaxBatchSize(n int) ServerOption {
+	return func(s *server) {
+		s.options.maxBatchSize = n
+	}
+}
+
+type server struct {
+	logger  *zap.Logger
+	address string
+	handler TxnRequestHandler
+	options struct {
+		maxMessageSize int
+		maxBatchSize   int
+	}
+
+	// ctx is cancelled when the server is closed, the handler is called with a
+	// child of it bounded by the timeout of the request
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopper sync.WaitGroup
+
+	mu struct {
+		sync.Mutex
+		started  bool
+		closed   bool
+		listener net.Listener
+		conns    map[net.Conn]struct{}
+	}
+}
+
+// NewTxnServer create a TxnServer listening on the address, the received requests
+// are handled by the handler concurrently.
+func NewTxnServer(address string, handler TxnRequestHandler, options ...ServerOption) TxnServer {
+	s := &server{address: address, handler: handler}
+	for _, opt := range options {
+		opt(s)
+	}
+	s.logger = logutil.Adjust(s.logger).Named("txn-server").With(zap.String("address", address))
+	if s.options.maxMessageSize <= 0 {
+		s.options.maxMessageSize = defaultMaxMessageSize
+	}
+	if s.options.maxBatchSize <= 0 {
+		s.options.maxBatchSize = defaultMaxBatchSize
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mu.conns = make(map[net.Conn]struct{})
+	return s
+}
+
+func (s *server) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mu.closed {
+		return errServerClosed
+	}
+	if s.mu.started {
+		return errServerStarted
+	}
+
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.mu.started = true
+	s.mu.listener = listener
+	s.stopper.Add(1)
+	go s.acceptLoop(listener)
+	return nil
+}
+
+func (s *server) Close() error {
+	s.mu.Lock()
+	if s.mu.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.mu.closed = true
+	var err error
+	if s.mu.listener != nil {
+		err = s.mu.listener.Close()
+	}
+	for conn := range s.mu.conns {
+		s.closeConnLocked(conn)
+	}
+	s.mu.Unlock()
+
+	s.cancel()
+	s.stopper.Wait()
+	return err
+}
+
+func (s *server) acceptLoop(listener net.Listener) {
+	defer s.stopper.Done()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if s.ctx.Err() == nil && !s.isClosed() {
+				s.logger.Error("failed to accept connection", zap.Error(err))
+			}
+			return
+		}
+		if !s.addConn(conn) {
+			return
+		}
+		s.stopper.Add(1)
+		go s.serve(conn)
+	}
+}
+
+// serve reads the request batches from the conn, the requests are handled
+// concurrently, and the responses are merged into batches by the writeLoop.
+func (s *server) serve(conn net.Conn) {
+	defer s.stopper.Done()
+
+	responses := make(chan txn.TxnResponse, defaultQueueSize)
+	closed := make(chan struct{})
+	var handlers sync.WaitGroup
+	defer func() {
+		s.closeConn(conn)
+		handlers.Wait()
+		close(closed)
+	}()
+
+	s.stopper.Add(1)
+	go s.writeLoop(conn, responses, closed)
+
+	var buf []byte
+	var err error
+	for {
+		batch := &txn.TxnRequestBatch{}
+		if buf, err = readFrame(conn, batch, buf, s.options.maxMessageSize); err != nil {
+			s.logger.Debug("connection closed",
+				zap.String("remote", conn.RemoteAddr().String()),
+				zap.Error(err))
+			return
+		}
+		for i := range batch.Requests {
+			request := &batch.Requests[i]
+			handlers.Add(1)
+			go func() {
+				defer handlers.Done()
+				response := s.handle(request)
+				select {
+				case responses <- response:
+				case <-s.ctx.Done():
+				}
+			}()
+		}
+	}
+}
+
+func (s *server) handle(request *txn.TxnRequest) txn.TxnResponse {
+	var response txn.TxnResponse
+	if err := s.handleWithTimeout(request, &response); err != nil {
+		s.logger.Error("failed to handle txn request",
+			zap.String("request", request.DebugString()),
+			zap.Error(err))
+		response.Method = request.Method
+		response.Flag = request.Flag
+		response.TxnError = newTxnError(err)
+	}
+	response.RequestID = request.RequestID
+	return response
+}
+
+// handleWithTimeout calls the handler with a ctx bounded by the timeout of the
+// request, the sender gives up waiting for the response after that.
+func (s *server) handleWithTimeout(request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if request.Timeout <= 0 {
+		return errTimeoutNotSet
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, time.Duration(request.Timeout))
+	defer cancel()
+	return s.handler(ctx, request, response)
+}
+
+func (s *server) writeLoop(conn net.Conn, responses chan txn.TxnResponse, closed chan struct{}) {
+	defer s.stopper.Done()
+
+	var buf []byte
+	var err error
+	batch := &txn.TxnResponseBatch{}
+	for {
+		select {
+		case <-closed:
+			return
+		case resp := <-responses:
+			batch.Responses = append(batch.Responses[:0], resp)
+			s.collect(responses, batch)
+			if buf, err = writeFrame(conn, batch, buf); err != nil {
+				s.logger.Debug("failed to write txn responses",
+					zap.String("remote", conn.RemoteAddr().String()),
+					zap.Error(err))
+				s.closeConn(conn)
+			}
+		}
+	}
+}
+
+// collect takes the ready responses without blocking until maxBatchSize reached.
+func (s *server) collect(responses chan txn.TxnResponse, batch *txn.TxnResponseBatch) {
+	for len(batch.Responses) < s.options.maxBatchSize {
+		select {
+		case resp := <-responses:
+			batch.Responses = append(batch.Responses, resp)
+		default:
+			return
+		}
+	}
+}
+
+func (s *server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mu.closed
+}
+
+func (s *server) addConn(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mu.closed {
+		if err := conn.Close(); err != nil {
+			s.logger.Debug("failed to close connection", zap.Error(err))
+		}
+		return false
+	}
+	s.mu.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *server) closeConn(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeConnLocked(conn)
+}
+
+func (s *server) closeConnLocked(conn net.Conn) {
+	if _, ok := s.mu.conns[conn]; !ok {
+		return
+	}
+	delete(s.mu.conns, conn)
+	if err := conn.Close(); err != nil {
+		s.logger.Debug("failed to close connection", zap.Error(err))
+	}
+}
+
+func newTxnError(err error) *txn.TxnError {
+	var me *moerr.Error
+	if errors.As(err, &me) {
+		return &txn.TxnError{Code: me.Code, Message: me.Message}
+	}
+	return &txn.TxnError{Code: moerr.INTERNAL_ERROR, Message: err.Error()}
+}
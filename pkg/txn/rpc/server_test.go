@@ -0,0 +1,142 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/service"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerStartTwice(t *testing.T) {
+	s := newTestServer(t, newTestAddress(t), echoHandler)
+	assert.Equal(t, errServerStarted, s.Start())
+	assert.NoError(t, s.Close())
+	assert.Equal(t, errServerClosed, s.Start())
+}
+
+func TestServerHandleWithRequestTimeout(t *testing.T) {
+	address := newTestAddress(t)
+	deadlines := make(chan time.Time, 1)
+	s := newTestServer(t, address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		d, ok := ctx.Deadline()
+		assert.True(t, ok)
+		deadlines <- d
+		return echoHandler(ctx, request, response)
+	})
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	sd := NewSender()
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	_, err := sd.Send(ctx, []txn.TxnRequest{newTestRequest(address, "t1")})
+	require.NoError(t, err)
+	d, _ := ctx.Deadline()
+	assert.WithinDuration(t, d, <-deadlines, time.Second)
+}
+
+func TestServerRejectRequestWithoutTimeout(t *testing.T) {
+	handled := false
+	s := NewTxnServer(newTestAddress(t), func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+		handled = true
+		return nil
+	})
+	request := newTestRequest("", "t1")
+	request.RequestID = 1
+	response := s.(*server).handle(&request)
+	assert.False(t, handled)
+	assert.Equal(t, uint64(1), response.RequestID)
+	require.NotNil(t, response.TxnError)
+	assert.Equal(t, int32(moerr.INVALID_INPUT), response.TxnError.Code)
+}
+
+func TestCommitTwoPCWithTxnService(t *testing.T) {
+	sd := NewSender()
+	defer func() {
+		assert.NoError(t, sd.Close())
+	}()
+
+	var shards []metadata.DNShard
+	for i := uint64(1); i <= 2; i++ {
+		dn := metadata.DNShard{
+			DNShardRecord: metadata.DNShardRecord{ShardID: i},
+			ReplicaID:     i,
+			Address:       newTestAddress(t),
+		}
+		shards = append(shards, dn)
+
+		ts := service.NewTxnService(nil, dn, mem.NewKVTxnStorage(mem.NewMemLog()), sd, newTestClock())
+		require.NoError(t, ts.Start())
+		s := newTestServer(t, dn.Address, func(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+			return service.HandleRequest(ctx, ts, request, response)
+		})
+		defer func() {
+			assert.NoError(t, s.Close())
+			assert.NoError(t, ts.Close())
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	c := client.NewTxnClient(sd, client.WithClock(newTestClock()))
+	op := c.New(client.WithDisable1PCOpt())
+	for i, dn := range shards {
+		_, err := op.Write(ctx, []txn.TxnRequest{{
+			CNRequest: &txn.CNOpRequest{
+				OpCode:  mem.OpSet,
+				Payload: mem.NewSetPayload([][]byte{[]byte("k")}, [][]byte{{byte(i)}}),
+				Target:  dn,
+			},
+		}})
+		require.NoError(t, err)
+	}
+	require.NoError(t, op.Commit(ctx))
+
+	for i, dn := range shards {
+		responses, err := c.New().Read(ctx, []txn.TxnRequest{{
+			CNRequest: &txn.CNOpRequest{
+				OpCode:  mem.OpGet,
+				Payload: mem.NewGetPayload([]byte("k")),
+				Target:  dn,
+			},
+		}})
+		require.NoError(t, err)
+		values, err := mem.DecodeGetResponse(responses[0].CNOpResponse.Payload)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, values[0])
+	}
+}
+
+func newTestClock() clock.Clock {
+	return clock.NewHLCClock(func() int64 {
+		return time.Now().UnixNano()
+	}, time.Hour)
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+)
+
+// SenderOption options for create TxnSender
+type SenderOption func(*sender)
+
+// ServerOption options for create TxnServer
+type ServerOption func(*server)
+
+// TxnSender is a client.TxnSender based on RPC. The requests are routed to the DN
+// by DNShard.Address, and the concurrent requests sent to the same DN are merged
+// into one RPC frame. The requests failed before written to the connection are
+// retried until the Context times out, but a non-idempotent request, e.g. Write
+// or Commit, fails if the connection is broken after it was written.
+type TxnSender interface {
+	client.TxnSender
+	// Close the sender, close all connections to the DNs.
+	Close() error
+}
+
+// TxnRequestHandler handles the TxnRequest received by the TxnServer, and fills the
+// TxnResponse. The TxnService can be used as handler via service.HandleRequest.
+type TxnRequestHandler func(context.Context, *txn.TxnRequest, *txn.TxnResponse) error
+
+// TxnServer receives the TxnRequests sent by the TxnSender on the DN.
+type TxnServer interface {
+	// Start start listening and serving the requests.
+	Start() error
+	// Close stop the server, close all the accepted connections.
+	Close() error
+}
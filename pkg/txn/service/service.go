@@ -0,0 +1,210 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"go.uber.org/zap"
+)
+
+var (
+	// defaultRetryInterval the interval to retry the asynchronous commit or cleanup
+	// phase of the 2pc transaction.
+	defaultRetryInterval = time.Millisecond * 100
+	// defaultRequestTimeout the timeout of the requests sent to other DNs
+	defaultRequestTimeout = time.Second * 10
+)
+
+type service struct {
+	logger  *zap.Logger
+	shard   metadata.DNShard
+	storage storage.TxnStorage
+	sender  client.TxnSender
+	clock   clock.Clock
+
+	// ctx is cancelled when the service is closed, and the asynchronous tasks exit.
+	ctx    context.Context
+	cancel context.CancelFunc
+	tasks  sync.WaitGroup
+
+	mu struct {
+		sync.RWMutex
+		// txns the transactions which are not completed on the DNShard, txn id -> txnContext
+		txns map[string]*txnContext
+	}
+}
+
+// NewTxnService create TxnService. The sender is used to send the requests of the 2pc
+// transaction to other DNs.
+func NewTxnService(logger *zap.Logger,
+	shard metadata.DNShard,
+	storage storage.TxnStorage,
+	sender client.TxnSender,
+	clock clock.Clock) TxnService {
+	logger = logutil.Adjust(logger).With(zap.Uint64("dn-shard", shard.ShardID))
+	s := &service{
+		logger:  logger,
+		shard:   shard,
+		storage: storage,
+		sender:  sender,
+		clock:   clock,
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mu.txns = make(map[string]*txnContext)
+	return s
+}
+
+func (s *service) Shard() metadata.DNShard {
+	return s.shard
+}
+
+func (s *service) Close() error {
+	s.cancel()
+	s.tasks.Wait()
+	return s.storage.Close(context.Background())
+}
+
+// txnContext the state of a transaction on the DNShard
+type txnContext struct {
+	sync.Mutex
+	meta txn.TxnMeta
+}
+
+func (tc *txnContext) getTxn() txn.TxnMeta {
+	tc.Lock()
+	defer tc.Unlock()
+	return tc.meta
+}
+
+func (s *service) getTxnContext(id []byte) *txnContext {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mu.txns[string(id)]
+}
+
+func (s *service) getOrCreateTxnContext(meta txn.TxnMeta) *txnContext {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tc, ok := s.mu.txns[string(meta.ID)]
+	if !ok {
+		meta.Status = txn.TxnStatus_Active
+		tc = &txnContext{meta: meta}
+		s.mu.txns[string(meta.ID)] = tc
+	}
+	return tc
+}
+
+// removeTxnContext removes the completed transaction
+func (s *service) removeTxnContext(id []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.mu.txns, string(id))
+}
+
+// validShard checks the target DNShard of the request
+func (s *service) validShard(request *txn.TxnRequest, response *txn.TxnResponse) bool {
+	if request.GetTargetDN().ShardID != s.shard.ShardID {
+		response.TxnError = newTxnError(moerr.NewError(moerr.ErrDNShardNotFound,
+			"the DNShard of the request is not found"))
+		return false
+	}
+	return true
+}
+
+// runTask runs the asynchronous task until the service is closed
+func (s *service) runTask(fn func(ctx context.Context)) {
+	s.tasks.Add(1)
+	go func() {
+		defer s.tasks.Done()
+		fn(s.ctx)
+	}()
+}
+
+// sendUntilSucceed sends the requests to other DNs until all the requests are handled
+// successfully or the service is closed. It is used in the asynchronous commit and
+// cleanup phases of the 2pc transaction.
+func (s *service) sendUntilSucceed(ctx context.Context, requests []txn.TxnRequest) bool {
+	for {
+		responses, err := s.send(ctx, requests)
+		if err == nil {
+			failed := requests[:0]
+			for idx, resp := range responses {
+				if resp.TxnError != nil {
+					s.logger.Error("handle request failed",
+						util.TxnIDField(requests[idx].Txn),
+						zap.String("request", requests[idx].DebugString()),
+						zap.String("error", resp.TxnError.DebugString()))
+					failed = append(failed, requests[idx])
+				}
+			}
+			if len(failed) == 0 {
+				return true
+			}
+			requests = failed
+		}
+
+		if !waitRetry(ctx) {
+			return false
+		}
+	}
+}
+
+// waitRetry waits for the retry interval, false is returned if the ctx is done
+func waitRetry(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(defaultRetryInterval):
+		return true
+	}
+}
+
+func (s *service) send(ctx context.Context, requests []txn.TxnRequest) ([]txn.TxnResponse, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	responses, err := s.sender.Send(ctx, requests)
+	if err != nil {
+		s.logger.Error("send requests failed",
+			util.TxnIDField(requests[0].Txn),
+			zap.Int("count", len(requests)),
+			zap.Error(err))
+		return nil, err
+	}
+	return responses, nil
+}
+
+// newTxnError converts the error to TxnError
+func newTxnError(err error) *txn.TxnError {
+	var me *moerr.Error
+	if errors.As(err, &me) {
+		return &txn.TxnError{Code: me.Code, Message: me.Message}
+	}
+	return &txn.TxnError{Code: moerr.INTERNAL_ERROR, Message: err.Error()}
+}
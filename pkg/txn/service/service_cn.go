@@ -0,0 +1,293 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"go.uber.org/zap"
+)
+
+func (s *service) Read(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+	s.clock.Update(request.Txn.SnapshotTS)
+
+	// read only transactions have no txnContext
+	if tc := s.getTxnContext(request.Txn.ID); tc != nil {
+		meta := tc.getTxn()
+		if meta.Status != txn.TxnStatus_Active {
+			response.Txn = &meta
+			return nil
+		}
+	}
+
+	payload, err := s.storage.Read(ctx, request.Txn, request.CNRequest.OpCode, request.CNRequest.Payload)
+	if err != nil {
+		response.TxnError = newTxnError(err)
+		return nil
+	}
+	response.CNOpResponse = &txn.CNOpResponse{Payload: payload}
+	return nil
+}
+
+func (s *service) Write(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+	s.clock.Update(request.Txn.SnapshotTS)
+
+	tc := s.getOrCreateTxnContext(request.Txn)
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.meta.Status != txn.TxnStatus_Active {
+		meta := tc.meta
+		response.Txn = &meta
+		return nil
+	}
+
+	payload, err := s.storage.Write(ctx, request.Txn, request.CNRequest.OpCode, request.CNRequest.Payload)
+	if err != nil {
+		response.TxnError = newTxnError(err)
+		return nil
+	}
+	response.CNOpResponse = &txn.CNOpResponse{Payload: payload}
+	return nil
+}
+
+func (s *service) Commit(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+	s.clock.Update(request.Txn.SnapshotTS)
+
+	tc := s.getOrCreateTxnContext(request.Txn)
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.meta.Status != txn.TxnStatus_Active {
+		meta := tc.meta
+		response.Txn = &meta
+		return nil
+	}
+
+	dnShards := request.CommitRequest.DNShards
+	if len(dnShards) <= 1 {
+		s.commitOnePC(ctx, tc, response)
+	} else {
+		s.commitTwoPC(ctx, tc, dnShards, request.CommitRequest.Disable1PCOpt, response)
+	}
+	meta := tc.meta
+	response.Txn = &meta
+	return nil
+}
+
+// commitOnePC commits the transaction which only writes the current DNShard
+func (s *service) commitOnePC(ctx context.Context, tc *txnContext, response *txn.TxnResponse) {
+	now, _ := s.clock.Now()
+	tc.meta.CommitTS = &now
+	if err := s.storage.Commit(ctx, tc.meta); err != nil {
+		s.logger.Error("commit txn failed",
+			util.TxnIDField(tc.meta),
+			zap.Error(err))
+		response.TxnError = newTxnError(err)
+		s.rollbackLocked(ctx, tc)
+		return
+	}
+	tc.meta.Status = txn.TxnStatus_Committed
+	s.removeTxnContext(tc.meta.ID)
+}
+
+// commitTwoPC the first phase of the 2pc transaction, prepares all the DNShards. If all the
+// DNShards are prepared, the transaction is committed, and the second phase is performed
+// asynchronously unless the 1pc optimization is disabled.
+func (s *service) commitTwoPC(ctx context.Context,
+	tc *txnContext,
+	dnShards []metadata.DNShard,
+	disable1PCOpt bool,
+	response *txn.TxnResponse) {
+	// prepare the coordinator, the DNShards are persisted with the Prepared record
+	now, _ := s.clock.Now()
+	tc.meta.PreparedTS = &now
+	tc.meta.DNShards = dnShards
+	if err := s.storage.Prepare(ctx, tc.meta); err != nil {
+		s.logger.Error("prepare txn failed",
+			util.TxnIDField(tc.meta),
+			zap.Error(err))
+		response.TxnError = newTxnError(err)
+		s.abortTwoPC(ctx, tc, dnShards)
+		return
+	}
+	tc.meta.Status = txn.TxnStatus_Prepared
+
+	// prepare all the participants
+	requests := s.newDNShardRequests(tc.meta, dnShards, txn.TxnMethod_Prepare)
+	responses, err := s.send(ctx, requests)
+	if err != nil {
+		response.TxnError = newTxnError(err)
+		s.abortTwoPC(ctx, tc, dnShards)
+		return
+	}
+	commitTS := now
+	for _, resp := range responses {
+		if resp.TxnError != nil || resp.Txn == nil || resp.Txn.Status != txn.TxnStatus_Prepared {
+			if resp.TxnError != nil {
+				response.TxnError = resp.TxnError
+			} else {
+				response.TxnError = newTxnError(moerr.NewError(moerr.ErrTxnAborted,
+					"the transaction is aborted by a participant"))
+			}
+			s.abortTwoPC(ctx, tc, dnShards)
+			return
+		}
+		if resp.Txn.PreparedTS != nil && resp.Txn.PreparedTS.Greater(commitTS) {
+			commitTS = *resp.Txn.PreparedTS
+		}
+	}
+
+	// all DNShards are prepared, the transaction is committed
+	s.clock.Update(commitTS)
+	tc.meta.CommitTS = &commitTS
+	tc.meta.Status = txn.TxnStatus_Committing
+	if disable1PCOpt {
+		if s.commitTwoPCLocked(ctx, tc, dnShards) {
+			return
+		}
+	}
+	meta := tc.meta
+	s.runTask(func(ctx context.Context) {
+		tc.Lock()
+		defer tc.Unlock()
+		if tc.meta.Status == txn.TxnStatus_Committing {
+			s.commitTwoPCLocked(ctx, tc, dnShards)
+		}
+	})
+	s.logger.Debug("txn committing",
+		util.TxnIDField(meta),
+		zap.String("commit-ts", commitTS.String()))
+}
+
+// commitTwoPCLocked the second phase of the 2pc transaction, commits the data of all the
+// participants, and then commits the coordinator.
+func (s *service) commitTwoPCLocked(ctx context.Context, tc *txnContext, dnShards []metadata.DNShard) bool {
+	requests := s.newDNShardRequests(tc.meta, dnShards, txn.TxnMethod_CommitDNShard)
+	if !s.sendUntilSucceed(ctx, requests) {
+		return false
+	}
+	for {
+		err := s.storage.Commit(ctx, tc.meta)
+		if err == nil {
+			break
+		}
+		s.logger.Error("commit txn failed",
+			util.TxnIDField(tc.meta),
+			zap.Error(err))
+		if !waitRetry(ctx) {
+			return false
+		}
+	}
+	tc.meta.Status = txn.TxnStatus_Committed
+	s.removeTxnContext(tc.meta.ID)
+	return true
+}
+
+// abortTwoPC rollbacks the 2pc transaction on all the DNShards
+func (s *service) abortTwoPC(ctx context.Context, tc *txnContext, dnShards []metadata.DNShard) {
+	tc.meta.Status = txn.TxnStatus_Aborting
+	requests := s.newDNShardRequests(tc.meta, dnShards, txn.TxnMethod_RollbackDNShard)
+	if _, err := s.send(ctx, requests); err != nil {
+		// cleanup asynchronously, the participants will find the transaction is aborted
+		// by the coordinator.
+		s.runTask(func(ctx context.Context) {
+			s.sendUntilSucceed(ctx, requests)
+		})
+	}
+	s.rollbackLocked(ctx, tc)
+}
+
+func (s *service) Rollback(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+
+	tc := s.getOrCreateTxnContext(request.Txn)
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.meta.Status != txn.TxnStatus_Active {
+		meta := tc.meta
+		response.Txn = &meta
+		return nil
+	}
+
+	dnShards := request.RollbackRequest.DNShards
+	tc.meta.Status = txn.TxnStatus_Aborting
+	requests := s.newDNShardRequests(tc.meta, dnShards, txn.TxnMethod_RollbackDNShard)
+	responses, err := s.send(ctx, requests)
+	if err != nil {
+		response.TxnError = newTxnError(err)
+	}
+	for _, resp := range responses {
+		if resp.TxnError != nil {
+			response.TxnError = resp.TxnError
+		}
+	}
+	s.rollbackLocked(ctx, tc)
+	meta := tc.meta
+	response.Txn = &meta
+	return nil
+}
+
+// rollbackLocked rollbacks the transaction on the current DNShard
+func (s *service) rollbackLocked(ctx context.Context, tc *txnContext) {
+	if err := s.storage.Rollback(ctx, tc.meta); err != nil {
+		s.logger.Error("rollback txn failed",
+			util.TxnIDField(tc.meta),
+			zap.Error(err))
+	}
+	tc.meta.Status = txn.TxnStatus_Aborted
+	s.removeTxnContext(tc.meta.ID)
+}
+
+// newDNShardRequests creates the requests of the method to the DNShards except the
+// current DNShard.
+func (s *service) newDNShardRequests(meta txn.TxnMeta, dnShards []metadata.DNShard, method txn.TxnMethod) []txn.TxnRequest {
+	requests := make([]txn.TxnRequest, 0, len(dnShards))
+	for idx := range dnShards {
+		dn := dnShards[idx]
+		if dn.ShardID == s.shard.ShardID {
+			continue
+		}
+		req := txn.TxnRequest{Txn: meta, Method: method}
+		switch method {
+		case txn.TxnMethod_Prepare:
+			req.PrepareRequest = &txn.TxnPrepareRequest{DNShard: &dn}
+		case txn.TxnMethod_CommitDNShard:
+			req.CommitDNShardRequest = &txn.TxnCommitDNShardRequest{DNShard: &dn}
+		case txn.TxnMethod_RollbackDNShard:
+			req.RollbackDNShardRequest = &txn.TxnRollbackDNShardRequest{DNShard: &dn}
+		case txn.TxnMethod_GetStatus:
+			req.GetStatusRequest = &txn.TxnGetStatusRequest{DNShard: &dn}
+		}
+		requests = append(requests, req)
+	}
+	return requests
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"go.uber.org/zap"
+)
+
+func (s *service) Prepare(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+	s.clock.Update(request.Txn.SnapshotTS)
+
+	tc := s.getTxnContext(request.Txn.ID)
+	if tc == nil {
+		// the writes of the transaction are lost or never received
+		meta := request.Txn
+		meta.Status = txn.TxnStatus_Aborted
+		response.Txn = &meta
+		response.TxnError = newTxnError(moerr.NewError(moerr.ErrTxnAborted,
+			"the transaction is not found on the DN"))
+		return nil
+	}
+
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.meta.Status == txn.TxnStatus_Active {
+		now, _ := s.clock.Now()
+		tc.meta.PreparedTS = &now
+		// the participants persist the DNShards to find the coordinator during the recovery
+		tc.meta.DNShards = request.Txn.DNShards
+		if err := s.storage.Prepare(ctx, tc.meta); err != nil {
+			s.logger.Error("prepare txn failed",
+				util.TxnIDField(tc.meta),
+				zap.Error(err))
+			response.TxnError = newTxnError(err)
+			s.rollbackLocked(ctx, tc)
+		} else {
+			tc.meta.Status = txn.TxnStatus_Prepared
+		}
+	}
+	meta := tc.meta
+	response.Txn = &meta
+	return nil
+}
+
+func (s *service) GetStatus(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+
+	if tc := s.getTxnContext(request.Txn.ID); tc != nil {
+		meta := tc.getTxn()
+		response.Txn = &meta
+		return nil
+	}
+
+	// the 2pc transaction is completed, or not found
+	meta, err := s.storage.GetStatus(ctx, request.Txn.ID)
+	if err != nil {
+		response.TxnError = newTxnError(err)
+		return nil
+	}
+	response.Txn = meta
+	return nil
+}
+
+func (s *service) CommitDNShard(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+	if request.Txn.CommitTS == nil {
+		response.TxnError = newTxnError(moerr.NewError(moerr.INVALID_INPUT, "missing commit timestamp"))
+		return nil
+	}
+	s.clock.Update(*request.Txn.CommitTS)
+
+	tc := s.getTxnContext(request.Txn.ID)
+	if tc == nil {
+		// already committed
+		meta := request.Txn
+		meta.Status = txn.TxnStatus_Committed
+		response.Txn = &meta
+		return nil
+	}
+
+	tc.Lock()
+	defer tc.Unlock()
+
+	switch tc.meta.Status {
+	case txn.TxnStatus_Prepared, txn.TxnStatus_Committing:
+		tc.meta.CommitTS = request.Txn.CommitTS
+		if err := s.storage.Commit(ctx, tc.meta); err != nil {
+			s.logger.Error("commit txn failed",
+				util.TxnIDField(tc.meta),
+				zap.Error(err))
+			response.TxnError = newTxnError(err)
+		} else {
+			tc.meta.Status = txn.TxnStatus_Committed
+			s.removeTxnContext(tc.meta.ID)
+		}
+	case txn.TxnStatus_Committed:
+	default:
+		response.TxnError = newTxnError(moerr.NewError(moerr.INVALID_STATE,
+			"commit a transaction which is not prepared, status: "+tc.meta.Status.String()))
+	}
+	meta := tc.meta
+	response.Txn = &meta
+	return nil
+}
+
+func (s *service) RollbackDNShard(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	if !s.validShard(request, response) {
+		return nil
+	}
+
+	tc := s.getTxnContext(request.Txn.ID)
+	if tc == nil {
+		// already aborted, or no data written on the DN
+		meta := request.Txn
+		meta.Status = txn.TxnStatus_Aborted
+		response.Txn = &meta
+		return nil
+	}
+
+	tc.Lock()
+	defer tc.Unlock()
+
+	switch tc.meta.Status {
+	case txn.TxnStatus_Committing, txn.TxnStatus_Committed:
+		response.TxnError = newTxnError(moerr.NewError(moerr.INVALID_STATE,
+			"rollback a committed transaction"))
+	case txn.TxnStatus_Aborted:
+	default:
+		s.rollbackLocked(ctx, tc)
+	}
+	meta := tc.meta
+	response.Txn = &meta
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"go.uber.org/zap"
+)
+
+func (s *service) Start() error {
+	txns, err := s.storage.Recover(s.ctx)
+	if err != nil {
+		return err
+	}
+	for _, meta := range txns {
+		tc := s.addInDoubtTxn(meta)
+		s.runTask(func(ctx context.Context) {
+			s.resolveInDoubtTxn(ctx, tc)
+		})
+	}
+	return nil
+}
+
+// addInDoubtTxn adds the prepared transaction found in the recovery
+func (s *service) addInDoubtTxn(meta txn.TxnMeta) *txnContext {
+	if meta.PreparedTS != nil {
+		s.clock.Update(*meta.PreparedTS)
+	}
+	s.logger.Info("in-doubt txn found",
+		util.TxnIDField(meta),
+		zap.Bool("coordinator", s.isCoordinator(meta)))
+
+	tc := &txnContext{meta: meta}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.txns[string(meta.ID)] = tc
+	return tc
+}
+
+// isCoordinator returns true if the current DNShard is the coordinator of the 2pc transaction
+func (s *service) isCoordinator(meta txn.TxnMeta) bool {
+	return len(meta.DNShards) > 0 && meta.DNShards[0].ShardID == s.shard.ShardID
+}
+
+// resolveInDoubtTxn drives the in-doubt transaction to Committed or Aborted. The coordinator
+// queries the status of all the participants to make the decision, and the participant queries
+// the status of the coordinator until the decision is made.
+func (s *service) resolveInDoubtTxn(ctx context.Context, tc *txnContext) {
+	meta := tc.getTxn()
+	if len(meta.DNShards) == 0 {
+		// waiting for the CommitDNShard or RollbackDNShard from the coordinator
+		s.logger.Error("missing DNShards of in-doubt txn",
+			util.TxnIDField(meta))
+		return
+	}
+
+	if s.isCoordinator(meta) {
+		s.resolveAsCoordinator(ctx, tc)
+	} else {
+		s.resolveAsParticipant(ctx, tc)
+	}
+}
+
+// resolveAsCoordinator commits the transaction if any participant has committed or all the
+// participants are prepared, otherwise the transaction is aborted.
+func (s *service) resolveAsCoordinator(ctx context.Context, tc *txnContext) {
+	meta := tc.getTxn()
+	requests := s.newDNShardRequests(meta, meta.DNShards, txn.TxnMethod_GetStatus)
+	responses, ok := s.getStatusUntilSucceed(ctx, requests)
+	if !ok {
+		return
+	}
+	commit, commitTS := decideInDoubtTxn(meta, responses)
+
+	tc.Lock()
+	defer tc.Unlock()
+	if tc.meta.Status != txn.TxnStatus_Prepared {
+		return
+	}
+
+	s.logger.Info("in-doubt txn resolved",
+		util.TxnIDField(tc.meta),
+		zap.Bool("commit", commit))
+	if commit {
+		tc.meta.CommitTS = &commitTS
+		tc.meta.Status = txn.TxnStatus_Committing
+		s.commitTwoPCLocked(ctx, tc, tc.meta.DNShards)
+		return
+	}
+	s.abortTwoPC(ctx, tc, tc.meta.DNShards)
+}
+
+// decideInDoubtTxn the in-doubt transaction is committed if any participant has committed, or
+// all the participants are prepared and the commitTS is max(PreparedTS).
+func decideInDoubtTxn(meta txn.TxnMeta, responses []txn.TxnResponse) (bool, timestamp.Timestamp) {
+	commitTS := *meta.PreparedTS
+	prepared := true
+	for _, resp := range responses {
+		if resp.Txn == nil {
+			// no writes or the writes are lost before prepared
+			prepared = false
+			continue
+		}
+		switch resp.Txn.Status {
+		case txn.TxnStatus_Committed:
+			return true, *resp.Txn.CommitTS
+		case txn.TxnStatus_Prepared:
+			if resp.Txn.PreparedTS != nil && resp.Txn.PreparedTS.Greater(commitTS) {
+				commitTS = *resp.Txn.PreparedTS
+			}
+		default:
+			prepared = false
+		}
+	}
+	return prepared, commitTS
+}
+
+// resolveAsParticipant queries the status of the coordinator until the transaction is committed
+// or aborted by the coordinator.
+func (s *service) resolveAsParticipant(ctx context.Context, tc *txnContext) {
+	meta := tc.getTxn()
+	requests := s.newDNShardRequests(meta, meta.DNShards[:1], txn.TxnMethod_GetStatus)
+	for {
+		responses, ok := s.getStatusUntilSucceed(ctx, requests)
+		if !ok {
+			return
+		}
+
+		if request, ok := s.newResolveRequest(meta, responses[0].Txn); ok {
+			s.logger.Info("in-doubt txn resolved",
+				util.TxnIDField(meta),
+				zap.String("coordinator-status", responses[0].Txn.Status.String()))
+			response := txn.TxnResponse{}
+			if err := HandleRequest(ctx, s, &request, &response); err == nil && response.TxnError == nil {
+				return
+			}
+		}
+
+		// the coordinator has not made the decision
+		if !waitRetry(ctx) {
+			return
+		}
+	}
+}
+
+// newResolveRequest returns the CommitDNShard or RollbackDNShard request to the current DNShard
+// by the status of the coordinator, false is returned if the coordinator has not made the decision.
+func (s *service) newResolveRequest(meta txn.TxnMeta, coordinator *txn.TxnMeta) (txn.TxnRequest, bool) {
+	request := txn.TxnRequest{Txn: meta}
+	if coordinator == nil {
+		return request, false
+	}
+	switch coordinator.Status {
+	case txn.TxnStatus_Committing, txn.TxnStatus_Committed:
+		request.Txn.CommitTS = coordinator.CommitTS
+		request.Method = txn.TxnMethod_CommitDNShard
+		request.CommitDNShardRequest = &txn.TxnCommitDNShardRequest{DNShard: &s.shard}
+		return request, true
+	case txn.TxnStatus_Aborting, txn.TxnStatus_Aborted:
+		request.Method = txn.TxnMethod_RollbackDNShard
+		request.RollbackDNShardRequest = &txn.TxnRollbackDNShardRequest{DNShard: &s.shard}
+		return request, true
+	}
+	return request, false
+}
+
+// getStatusUntilSucceed sends the GetStatus requests until all the statuses are returned, or
+// the service is closed.
+func (s *service) getStatusUntilSucceed(ctx context.Context, requests []txn.TxnRequest) ([]txn.TxnResponse, bool) {
+	for {
+		responses, err := s.send(ctx, requests)
+		if err == nil {
+			failed := false
+			for idx, resp := range responses {
+				if resp.TxnError != nil {
+					s.logger.Error("get txn status failed",
+						util.TxnIDField(requests[idx].Txn),
+						zap.String("request", requests[idx].DebugString()),
+						zap.String("error", resp.TxnError.DebugString()))
+					failed = true
+				}
+			}
+			if !failed {
+				return responses, true
+			}
+		}
+
+		if !waitRetry(ctx) {
+			return nil, false
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverCoordinatorCrashedBeforePrepareParticipants(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		env.setHooks(func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_Prepare {
+				env.crash(1)
+			}
+		}, nil)
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.Error(t, op.Commit(ctx))
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared}, env.logs(t, 1))
+
+		// the participant is not prepared, the transaction is aborted
+		env.setHooks(nil, nil)
+		env.restart(t, 1)
+		env.waitCompleted(t, ctx, 1)
+		env.waitCompleted(t, ctx, 2)
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Aborted}, env.logs(t, 1))
+		assert.Empty(t, env.logs(t, 2))
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+	})
+}
+
+func TestRecoverParticipantCrashedAfterPrepared(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		env.setHooks(nil, func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_Prepare {
+				env.crash(2)
+			}
+		})
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.Error(t, op.Commit(ctx))
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Aborted}, env.logs(t, 1))
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared}, env.logs(t, 2))
+
+		// the coordinator is aborted
+		env.setHooks(nil, nil)
+		env.restart(t, 2)
+		env.waitCompleted(t, ctx, 2)
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Aborted}, env.logs(t, 2))
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+	})
+}
+
+func TestRecoverCoordinatorCrashedBeforeCommitParticipants(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		committed := make(chan struct{})
+		env.setHooks(func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_CommitDNShard {
+				<-committed
+				env.crash(1)
+			}
+		}, nil)
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Commit(ctx))
+		close(committed)
+		for !env.isCrashed(1) {
+			time.Sleep(time.Millisecond * 10)
+		}
+
+		// the reads on the keys of the in-doubt transaction are blocked
+		readCtx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+		defer cancel()
+		_, err := c.New().Read(readCtx, []txn.TxnRequest{newGetRequest(env.shard(2), "k2")})
+		require.Error(t, err)
+
+		// all the DNs are prepared, the transaction is committed
+		env.setHooks(nil, nil)
+		env.restart(t, 1)
+		env.waitCompleted(t, ctx, 1)
+		env.waitCompleted(t, ctx, 2)
+		assertTwoPCCommitted(t, ctx, c, env)
+	})
+}
+
+func TestRecoverCoordinatorCrashedAfterParticipantsCommitted(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		committed := make(chan struct{})
+		env.setHooks(nil, func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_CommitDNShard {
+				<-committed
+				env.crash(1)
+			}
+		})
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Commit(ctx))
+		close(committed)
+		for !env.isCrashed(1) {
+			time.Sleep(time.Millisecond * 10)
+		}
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared}, env.logs(t, 1))
+
+		// the participant is committed, the transaction is committed
+		env.setHooks(nil, nil)
+		env.restart(t, 1)
+		env.waitCompleted(t, ctx, 1)
+		assertTwoPCCommitted(t, ctx, c, env)
+	})
+}
+
+func TestRecoverParticipantCrashedBeforeCommitted(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		committed := make(chan struct{})
+		env.setHooks(func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_CommitDNShard {
+				<-committed
+				env.crash(2)
+			}
+		}, nil)
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Commit(ctx))
+		close(committed)
+		for !env.isCrashed(2) {
+			time.Sleep(time.Millisecond * 10)
+		}
+
+		// the coordinator is committing, the transaction is committed
+		env.setHooks(nil, nil)
+		env.restart(t, 2)
+		env.waitCompleted(t, ctx, 1)
+		env.waitCompleted(t, ctx, 2)
+		assertTwoPCCommitted(t, ctx, c, env)
+	})
+}
+
+func TestRecoverAllCrashedAfterPrepared(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		committed := make(chan struct{})
+		env.setHooks(func(request txn.TxnRequest) {
+			if request.Method == txn.TxnMethod_CommitDNShard {
+				<-committed
+				env.crash(1)
+				env.crash(2)
+			}
+		}, nil)
+
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Commit(ctx))
+		close(committed)
+		for !env.isCrashed(2) {
+			time.Sleep(time.Millisecond * 10)
+		}
+
+		// the participant waits for the coordinator
+		env.setHooks(nil, nil)
+		env.restart(t, 2)
+		env.restart(t, 1)
+		env.waitCompleted(t, ctx, 1)
+		env.waitCompleted(t, ctx, 2)
+		assertTwoPCCommitted(t, ctx, c, env)
+	})
+}
+
+func TestGetStatusOfCompletedTwoPCTxn(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New(client.WithDisable1PCOpt())
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		meta := env.getTxn(2)
+		require.NoError(t, op.Commit(ctx))
+
+		// the status is recovered from the log after restarted
+		env.crash(2)
+		env.restart(t, 2)
+		resp := env.mustSend(t, ctx, txn.TxnRequest{
+			Txn:              meta,
+			Method:           txn.TxnMethod_GetStatus,
+			GetStatusRequest: &txn.TxnGetStatusRequest{DNShard: &env.shards[1]},
+		})
+		require.NotNil(t, resp.Txn)
+		assert.Equal(t, txn.TxnStatus_Committed, resp.Txn.Status)
+		assert.NotNil(t, resp.Txn.CommitTS)
+		assert.Equal(t, "v2", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+	})
+}
+
+func assertTwoPCCommitted(t *testing.T, ctx context.Context, c client.TxnClient, env *testEnv) {
+	assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Committed}, env.logs(t, 1))
+	assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Committed}, env.logs(t, 2))
+	assert.Equal(t, "v1", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+	assert.Equal(t, "v2", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+}
@@ -0,0 +1,483 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadAndWrite(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		assert.Equal(t, "v1", mustRead(t, ctx, op, env.shard(1), "k1"))
+
+		// uncommitted data is invisible to other transactions
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		require.NoError(t, op.Rollback(ctx))
+	})
+}
+
+func TestCommitOnePC(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		require.NoError(t, op.Commit(ctx))
+
+		assert.Equal(t, "v1", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Committed}, env.logs(t, 1))
+		assert.Equal(t, 0, env.txnCount(1))
+	})
+}
+
+func TestCommitTwoPC(t *testing.T) {
+	runServiceTests(t, 3, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New(client.WithDisable1PCOpt())
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		mustWrite(t, ctx, op, env.shard(3), "k3", "v3")
+		require.NoError(t, op.Commit(ctx))
+
+		for i := 1; i <= 3; i++ {
+			assert.Equal(t, fmt.Sprintf("v%d", i), mustRead(t, ctx, c.New(), env.shard(uint64(i)), fmt.Sprintf("k%d", i)))
+			assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Committed}, env.logs(t, uint64(i)))
+			assert.Equal(t, 0, env.txnCount(uint64(i)))
+		}
+		// the DNShards are persisted on all DNs, and the first one is the coordinator
+		assert.Equal(t, 3, len(env.prepared(t, 1).DNShards))
+		assert.Equal(t, env.shard(1), env.prepared(t, 2).DNShards[0])
+	})
+}
+
+func TestCommitTwoPCAsync(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Commit(ctx))
+
+		// the data is committed by the asynchronous commit phase
+		for env.txnCount(1) > 0 || env.txnCount(2) > 0 {
+			time.Sleep(time.Millisecond * 10)
+		}
+		assert.Equal(t, "v1", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		assert.Equal(t, "v2", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+	})
+}
+
+func TestCommitTwoPCWithPrepareFailed(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+
+		// the writes on DN2 are lost
+		env.mustSend(t, ctx, txn.TxnRequest{
+			Txn:                    env.getTxn(1),
+			Method:                 txn.TxnMethod_RollbackDNShard,
+			RollbackDNShardRequest: &txn.TxnRollbackDNShardRequest{DNShard: &env.shards[1]},
+		})
+
+		err := op.Commit(ctx)
+		require.Error(t, err)
+		assert.Equal(t, []txn.TxnStatus{txn.TxnStatus_Prepared, txn.TxnStatus_Aborted}, env.logs(t, 1))
+		assert.Empty(t, env.logs(t, 2))
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		assert.Equal(t, 0, env.txnCount(1))
+	})
+}
+
+func TestRollback(t *testing.T) {
+	runServiceTests(t, 2, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		mustWrite(t, ctx, op, env.shard(2), "k2", "v2")
+		require.NoError(t, op.Rollback(ctx))
+
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(1), "k1"))
+		assert.Equal(t, "", mustRead(t, ctx, c.New(), env.shard(2), "k2"))
+		assert.Equal(t, 0, env.txnCount(1))
+		assert.Equal(t, 0, env.txnCount(2))
+		assert.Empty(t, env.logs(t, 1))
+	})
+}
+
+func TestWriteConflict(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op1 := c.New()
+		op2 := c.New()
+		mustWrite(t, ctx, op1, env.shard(1), "k1", "v1")
+		_, err := op2.Write(ctx, []txn.TxnRequest{newSetRequest(env.shard(1), "k1", "v2")})
+		assertErrorCode(t, moerr.ErrTxnWriteConflict, err)
+
+		// committed after the snapshot of op2
+		require.NoError(t, op1.Commit(ctx))
+		_, err = op2.Write(ctx, []txn.TxnRequest{newSetRequest(env.shard(1), "k1", "v2")})
+		assertErrorCode(t, moerr.ErrTxnWriteConflict, err)
+	})
+}
+
+func TestGetStatus(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		op := c.New()
+		mustWrite(t, ctx, op, env.shard(1), "k1", "v1")
+		meta := env.getTxn(1)
+
+		resp := env.mustSend(t, ctx, txn.TxnRequest{
+			Txn:              meta,
+			Method:           txn.TxnMethod_GetStatus,
+			GetStatusRequest: &txn.TxnGetStatusRequest{DNShard: &env.shards[0]},
+		})
+		require.NotNil(t, resp.Txn)
+		assert.Equal(t, txn.TxnStatus_Active, resp.Txn.Status)
+
+		require.NoError(t, op.Commit(ctx))
+		resp = env.mustSend(t, ctx, txn.TxnRequest{
+			Txn:              meta,
+			Method:           txn.TxnMethod_GetStatus,
+			GetStatusRequest: &txn.TxnGetStatusRequest{DNShard: &env.shards[0]},
+		})
+		assert.Nil(t, resp.Txn)
+	})
+}
+
+func TestRequestWithWrongDNShard(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		dn := env.shard(1)
+		dn.ShardID = 2
+		resp := txn.TxnResponse{}
+		req := newSetRequest(dn, "k1", "v1")
+		req.Method = txn.TxnMethod_Write
+		require.NoError(t, HandleRequest(ctx, env.service(1), &req, &resp))
+		require.NotNil(t, resp.TxnError)
+		assert.Equal(t, int32(moerr.ErrDNShardNotFound), resp.TxnError.Code)
+	})
+}
+
+func TestRequestWithUnknownMethod(t *testing.T) {
+	runServiceTests(t, 1, func(ctx context.Context, c client.TxnClient, env *testEnv) {
+		resp := txn.TxnResponse{}
+		req := newSetRequest(env.shard(1), "k1", "v1")
+		req.Method = txn.TxnMethod(-1)
+		require.NoError(t, HandleRequest(ctx, env.service(1), &req, &resp))
+		require.NotNil(t, resp.TxnError)
+		assert.Equal(t, int32(moerr.INVALID_INPUT), resp.TxnError.Code)
+	})
+}
+
+type testEnv struct {
+	shards []metadata.DNShard
+	logs_  []mem.LogClient
+
+	mu struct {
+		sync.RWMutex
+		services []TxnService
+		// storageLogs the logs used by the current TxnStorages, which reject the appends
+		// after the DN crashed.
+		storageLogs []*crashableLog
+		crashed     []bool
+		// before and after are called before and after the request is handled by the DN
+		before func(request txn.TxnRequest)
+		after  func(request txn.TxnRequest)
+	}
+}
+
+func (env *testEnv) shard(id uint64) metadata.DNShard {
+	return env.shards[id-1]
+}
+
+func (env *testEnv) service(id uint64) TxnService {
+	env.mu.RLock()
+	defer env.mu.RUnlock()
+	return env.mu.services[id-1]
+}
+
+func (env *testEnv) txnCount(id uint64) int {
+	s := env.service(id).(*service)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.mu.txns)
+}
+
+// getTxn returns the only active transaction on the DNShard
+func (env *testEnv) getTxn(id uint64) txn.TxnMeta {
+	s := env.service(id).(*service)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, tc := range s.mu.txns {
+		return tc.getTxn()
+	}
+	panic("no txn")
+}
+
+func (env *testEnv) newService(id uint64) TxnService {
+	log := &crashableLog{LogClient: env.logs_[id-1]}
+	env.mu.storageLogs[id-1] = log
+	return NewTxnService(nil, env.shard(id), mem.NewKVTxnStorage(log), &testSender{env: env, from: id}, newTestClock())
+}
+
+// crash simulates the DN crash, the requests sent from or to the DN fail, and nothing is
+// persisted by the DN until restarted.
+func (env *testEnv) crash(id uint64) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	env.mu.crashed[id-1] = true
+	env.mu.storageLogs[id-1].crash()
+}
+
+// restart closes the crashed TxnService, and starts a new TxnService on the same log
+func (env *testEnv) restart(t *testing.T, id uint64) {
+	require.NoError(t, env.service(id).Close())
+
+	env.mu.Lock()
+	s := env.newService(id)
+	env.mu.services[id-1] = s
+	env.mu.Unlock()
+	require.NoError(t, s.Start())
+
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	env.mu.crashed[id-1] = false
+}
+
+func (env *testEnv) isCrashed(id uint64) bool {
+	env.mu.RLock()
+	defer env.mu.RUnlock()
+	return id > 0 && env.mu.crashed[id-1]
+}
+
+func (env *testEnv) setHooks(before, after func(request txn.TxnRequest)) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	env.mu.before, env.mu.after = before, after
+}
+
+func (env *testEnv) getHooks() (func(request txn.TxnRequest), func(request txn.TxnRequest)) {
+	env.mu.RLock()
+	defer env.mu.RUnlock()
+	return env.mu.before, env.mu.after
+}
+
+// waitCompleted waits until all the transactions on the DNShard are completed
+func (env *testEnv) waitCompleted(t *testing.T, ctx context.Context, id uint64) {
+	for env.txnCount(id) > 0 {
+		select {
+		case <-ctx.Done():
+			require.Fail(t, "wait txns completed timeout")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
+
+type testLog struct {
+	Txn txn.TxnMeta `json:"txn"`
+}
+
+func (env *testEnv) readLogs(t *testing.T, id uint64) []txn.TxnMeta {
+	records, _, err := env.logs_[id-1].Read(context.Background(), 1, 1024*1024)
+	require.NoError(t, err)
+	var values []txn.TxnMeta
+	for _, rec := range records {
+		l := testLog{}
+		require.NoError(t, json.Unmarshal(rec.Data, &l))
+		values = append(values, l.Txn)
+	}
+	return values
+}
+
+func (env *testEnv) logs(t *testing.T, id uint64) []txn.TxnStatus {
+	var values []txn.TxnStatus
+	for _, meta := range env.readLogs(t, id) {
+		values = append(values, meta.Status)
+	}
+	return values
+}
+
+func (env *testEnv) prepared(t *testing.T, id uint64) txn.TxnMeta {
+	for _, meta := range env.readLogs(t, id) {
+		if meta.Status == txn.TxnStatus_Prepared {
+			return meta
+		}
+	}
+	panic("no prepared record")
+}
+
+func (env *testEnv) mustSend(t *testing.T, ctx context.Context, request txn.TxnRequest) txn.TxnResponse {
+	s := &testSender{env: env}
+	responses, err := s.Send(ctx, []txn.TxnRequest{request})
+	require.NoError(t, err)
+	return responses[0]
+}
+
+// crashableLog rejects the appends after crashed
+type crashableLog struct {
+	mem.LogClient
+	crashed atomic.Value
+}
+
+func (l *crashableLog) crash() {
+	l.crashed.Store(true)
+}
+
+func (l *crashableLog) Append(ctx context.Context, rec pb.LogRecord) (uint64, error) {
+	if crashed, _ := l.crashed.Load().(bool); crashed {
+		return 0, errCrashed
+	}
+	return l.LogClient.Append(ctx, rec)
+}
+
+var errCrashed = errors.New("crashed")
+
+// testSender sends the requests to the in-process TxnServices by the target DNShard. The
+// from is the DNShard sending the requests, 0 for CN.
+type testSender struct {
+	env  *testEnv
+	from uint64
+}
+
+func (s *testSender) Send(ctx context.Context, requests []txn.TxnRequest) ([]txn.TxnResponse, error) {
+	responses := make([]txn.TxnResponse, len(requests))
+	errs := make([]error, len(requests))
+	var wg sync.WaitGroup
+	for idx := range requests {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			errs[idx] = s.handle(ctx, &requests[idx], &responses[idx])
+		}(idx)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+func (s *testSender) handle(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	to := request.GetTargetDN().ShardID
+	before, after := s.env.getHooks()
+	if before != nil {
+		before(*request)
+	}
+	if s.env.isCrashed(s.from) || s.env.isCrashed(to) {
+		return errCrashed
+	}
+	if err := HandleRequest(ctx, s.env.service(to), request, response); err != nil {
+		panic(err)
+	}
+	if after != nil {
+		after(*request)
+	}
+	// the response is lost
+	if s.env.isCrashed(s.from) || s.env.isCrashed(to) {
+		return errCrashed
+	}
+	return nil
+}
+
+func runServiceTests(t *testing.T, shards int, fn func(ctx context.Context, c client.TxnClient, env *testEnv)) {
+	env := &testEnv{}
+	for i := 1; i <= shards; i++ {
+		dn := metadata.DNShard{
+			DNShardRecord: metadata.DNShardRecord{ShardID: uint64(i)},
+			ReplicaID:     uint64(i),
+			Address:       fmt.Sprintf("dn-%d", i),
+		}
+		env.shards = append(env.shards, dn)
+		env.logs_ = append(env.logs_, mem.NewMemLog())
+	}
+	env.mu.storageLogs = make([]*crashableLog, shards)
+	env.mu.crashed = make([]bool, shards)
+	for i := 1; i <= shards; i++ {
+		s := env.newService(uint64(i))
+		require.NoError(t, s.Start())
+		env.mu.services = append(env.mu.services, s)
+	}
+	defer func() {
+		for i := 1; i <= shards; i++ {
+			assert.NoError(t, env.service(uint64(i)).Close())
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	fn(ctx, client.NewTxnClient(&testSender{env: env}, client.WithClock(newTestClock())), env)
+}
+
+func newTestClock() clock.Clock {
+	return clock.NewHLCClock(func() int64 {
+		return time.Now().UnixNano()
+	}, time.Hour)
+}
+
+func newSetRequest(dn metadata.DNShard, key, value string) txn.TxnRequest {
+	return txn.TxnRequest{
+		CNRequest: &txn.CNOpRequest{
+			OpCode:  mem.OpSet,
+			Payload: mem.NewSetPayload([][]byte{[]byte(key)}, [][]byte{[]byte(value)}),
+			Target:  dn,
+		},
+	}
+}
+
+func mustWrite(t *testing.T, ctx context.Context, op client.TxnOperator, dn metadata.DNShard, key, value string) {
+	_, err := op.Write(ctx, []txn.TxnRequest{newSetRequest(dn, key, value)})
+	require.NoError(t, err)
+}
+
+func newGetRequest(dn metadata.DNShard, key string) txn.TxnRequest {
+	return txn.TxnRequest{
+		CNRequest: &txn.CNOpRequest{
+			OpCode:  mem.OpGet,
+			Payload: mem.NewGetPayload([]byte(key)),
+			Target:  dn,
+		},
+	}
+}
+
+func mustRead(t *testing.T, ctx context.Context, op client.TxnOperator, dn metadata.DNShard, key string) string {
+	responses, err := op.Read(ctx, []txn.TxnRequest{newGetRequest(dn, key)})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(responses))
+	values, err := mem.DecodeGetResponse(responses[0].CNOpResponse.Payload)
+	require.NoError(t, err)
+	return string(values[0])
+}
+
+func assertErrorCode(t *testing.T, code int32, err error) {
+	require.Error(t, err)
+	me, ok := err.(*moerr.Error)
+	require.True(t, ok, "%T", err)
+	assert.Equal(t, code, me.Code)
+}
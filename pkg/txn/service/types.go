@@ -0,0 +1,93 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+)
+
+// TxnService is a transaction service that runs on the DNStore and is used to receive transaction
+// requests from the CN. In the case of a 2pc distributed transaction, it acts as a transaction
+// coordinator to handle distributed transactions, and as a participant to handle the Prepare,
+// CommitDNShard and RollbackDNShard requests from the coordinator.
+//
+// The TxnService is managed by the Replica of the DNShard, each DNShard has a TxnService.
+//
+// All the explicit errors of the transaction are returned by response.TxnError, and the status of
+// the transaction is returned by response.Txn. The returned error means the request is not handled.
+type TxnService interface {
+	// Shard returns the metadata of DNShard
+	Shard() metadata.DNShard
+	// Start start the txn service. The transactions are recovered from the TxnStorage, and
+	// the in-doubt transactions are resolved asynchronously.
+	Start() error
+	// Close close the txn service, and wait for the asynchronous tasks to exit.
+	Close() error
+
+	// Read handle txn read request from CN.
+	Read(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// Write handle txn write request from CN.
+	Write(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// Commit handle txn commit request from CN. For a 2pc transaction, the DN is the coordinator,
+	// it sends the Prepare requests to all the DNs, and commits the transaction asynchronously if
+	// all the DNs are prepared.
+	Commit(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// Rollback handle txn rollback request from CN.
+	Rollback(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+
+	// Prepare handle txn prepare request from coordinator DN.
+	Prepare(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// GetStatus handle get txn status in current DNShard request from DN. The status of the
+	// completed 2pc transaction is returned by the TxnStorage, and the response.Txn is nil if
+	// the transaction is not found.
+	GetStatus(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// CommitDNShard handle commit txn data in current DNShard request from coordinator DN.
+	CommitDNShard(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+	// RollbackDNShard handle rollback txn data in current DNShard request from coordinator DN.
+	RollbackDNShard(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) error
+}
+
+// HandleRequest dispatches the request to the TxnService method by the request.Method. The
+// request with an unknown method is rejected by the TxnError of the response.
+func HandleRequest(ctx context.Context, s TxnService, request *txn.TxnRequest, response *txn.TxnResponse) error {
+	response.Method = request.Method
+	response.Flag = request.Flag
+	switch request.Method {
+	case txn.TxnMethod_Read:
+		return s.Read(ctx, request, response)
+	case txn.TxnMethod_Write:
+		return s.Write(ctx, request, response)
+	case txn.TxnMethod_Commit:
+		return s.Commit(ctx, request, response)
+	case txn.TxnMethod_Rollback:
+		return s.Rollback(ctx, request, response)
+	case txn.TxnMethod_Prepare:
+		return s.Prepare(ctx, request, response)
+	case txn.TxnMethod_GetStatus:
+		return s.GetStatus(ctx, request, response)
+	case txn.TxnMethod_CommitDNShard:
+		return s.CommitDNShard(ctx, request, response)
+	case txn.TxnMethod_RollbackDNShard:
+		return s.RollbackDNShard(ctx, request, response)
+	default:
+		response.TxnError = newTxnError(moerr.NewError(moerr.INVALID_INPUT,
+			"unknown txn request method: "+request.Method.String()))
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"encoding/json"
+)
+
+const (
+	// OpGet read the values of the keys, the payload is created by NewGetPayload
+	OpGet = uint32(1)
+	// OpSet write the values of the keys, the payload is created by NewSetPayload
+	OpSet = uint32(2)
+)
+
+// kvRequest is the payload of the read and write requests of KVTxnStorage
+type kvRequest struct {
+	Keys   [][]byte `json:"keys"`
+	Values [][]byte `json:"values,omitempty"`
+}
+
+// kvResponse is the payload of the read response of KVTxnStorage
+type kvResponse struct {
+	Values [][]byte `json:"values"`
+}
+
+// NewGetPayload returns the payload of OpGet
+func NewGetPayload(keys ...[]byte) []byte {
+	return mustMarshal(kvRequest{Keys: keys})
+}
+
+// NewSetPayload returns the payload of OpSet, the keys and values are paired by index
+func NewSetPayload(keys, values [][]byte) []byte {
+	if len(keys) != len(values) {
+		panic("keys and values not match")
+	}
+	return mustMarshal(kvRequest{Keys: keys, Values: values})
+}
+
+// DecodeGetResponse decode the values of the keys from the response payload of OpGet,
+// nil is returned for the key not found.
+func DecodeGetResponse(payload []byte) ([][]byte, error) {
+	resp := kvResponse{}
+	if err := json.Unmarshal(payload, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Values, nil
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// LogClient is the part of logservice.Client used by the KVTxnStorage to persist
+// and replay the transaction records.
+type LogClient interface {
+	Close() error
+	Append(ctx context.Context, rec pb.LogRecord) (uint64, error)
+	Read(ctx context.Context, firstIndex uint64, maxSize uint64) ([]pb.LogRecord, uint64, error)
+	Truncate(ctx context.Context, index uint64) error
+	GetTruncatedIndex(ctx context.Context) (uint64, error)
+}
+
+// NewMemLog returns a LogClient which keeps the log records in memory, it is used to
+// test the txn storage without the LogService.
+func NewMemLog() LogClient {
+	return &memLogClient{}
+}
+
+type memLogClient struct {
+	sync.RWMutex
+	records   []pb.LogRecord
+	truncated uint64
+}
+
+func (l *memLogClient) Close() error {
+	return nil
+}
+
+func (l *memLogClient) Append(ctx context.Context, rec pb.LogRecord) (uint64, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	rec.Index = uint64(len(l.records) + 1)
+	rec.Data = append([]byte(nil), rec.Data...)
+	l.records = append(l.records, rec)
+	return rec.Index, nil
+}
+
+// Read returns the records start from the firstIndex, the next index to read is returned if
+// the maxSize is reached, otherwise the firstIndex is returned, same as the LogService.
+func (l *memLogClient) Read(ctx context.Context, firstIndex uint64, maxSize uint64) ([]pb.LogRecord, uint64, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	if firstIndex <= l.truncated {
+		firstIndex = l.truncated + 1
+	}
+	var values []pb.LogRecord
+	size := uint64(0)
+	for idx := firstIndex; idx <= uint64(len(l.records)); idx++ {
+		rec := l.records[idx-1]
+		values = append(values, rec)
+		size += uint64(len(rec.Data))
+		if size >= maxSize && idx < uint64(len(l.records)) {
+			return values, idx + 1, nil
+		}
+	}
+	return values, firstIndex, nil
+}
+
+func (l *memLogClient) Truncate(ctx context.Context, index uint64) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if index > l.truncated {
+		l.truncated = index
+	}
+	return nil
+}
+
+func (l *memLogClient) GetTruncatedIndex(ctx context.Context) (uint64, error) {
+	l.RLock()
+	defer l.RUnlock()
+	return l.truncated, nil
+}
@@ -0,0 +1,426 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+)
+
+// recoverReadSize the max size of the records read from the log at a time during the recovery
+const recoverReadSize = 4 * 1024 * 1024
+
+// kvLog is the transaction record persisted in the LogService. The writes are
+// persisted in the Prepared record of a 2pc transaction, or in the Committed
+// record of a 1pc transaction.
+type kvLog struct {
+	Txn    txn.TxnMeta `json:"txn"`
+	Keys   [][]byte    `json:"keys,omitempty"`
+	Values [][]byte    `json:"values,omitempty"`
+}
+
+// version is a committed version of a key
+type version struct {
+	ts    timestamp.Timestamp
+	value []byte
+}
+
+// kvTxn is an uncommitted transaction
+type kvTxn struct {
+	meta     txn.TxnMeta
+	prepared bool
+	// keys the written keys in order, and writes is the latest value of each key
+	keys   []string
+	writes map[string][]byte
+	// done is closed when the transaction is committed or aborted
+	done chan struct{}
+}
+
+func newKVTxn(meta txn.TxnMeta) *kvTxn {
+	return &kvTxn{
+		meta:   meta,
+		writes: make(map[string][]byte),
+		done:   make(chan struct{}),
+	}
+}
+
+func (t *kvTxn) write(key string, value []byte) {
+	if _, ok := t.writes[key]; !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.writes[key] = value
+}
+
+func (t *kvTxn) log(meta txn.TxnMeta, withWrites bool) kvLog {
+	l := kvLog{Txn: meta}
+	if withWrites {
+		for _, key := range t.keys {
+			l.Keys = append(l.Keys, []byte(key))
+			l.Values = append(l.Values, t.writes[key])
+		}
+	}
+	return l
+}
+
+// KVTxnStorage is an in-memory multi-version KV txn storage, it is used to test the
+// TxnService. The transaction records are persisted to the LogService by the LogClient.
+type KVTxnStorage struct {
+	logClient LogClient
+
+	mu struct {
+		sync.RWMutex
+		// committed key -> versions sorted by commit timestamp
+		committed map[string][]version
+		// uncommitted txn id -> uncommitted txn
+		uncommitted map[string]*kvTxn
+		// locked key -> the id of the uncommitted txn which has written the key
+		locked map[string]string
+		// completed txn id -> the final status of the prepared txn, it is used to
+		// answer the GetStatus of the in-doubt transactions during the recovery.
+		completed map[string]txn.TxnMeta
+	}
+}
+
+var _ storage.TxnStorage = (*KVTxnStorage)(nil)
+
+// NewKVTxnStorage create KVTxnStorage
+func NewKVTxnStorage(logClient LogClient) *KVTxnStorage {
+	s := &KVTxnStorage{logClient: logClient}
+	s.mu.committed = make(map[string][]version)
+	s.mu.uncommitted = make(map[string]*kvTxn)
+	s.mu.locked = make(map[string]string)
+	s.mu.completed = make(map[string]txn.TxnMeta)
+	return s
+}
+
+func (s *KVTxnStorage) Close(ctx context.Context) error {
+	return s.logClient.Close()
+}
+
+func (s *KVTxnStorage) Read(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error) {
+	if op != OpGet {
+		return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unknown read op %d", op))
+	}
+	req := kvRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// the prepared transactions may be committed before the snapshot, the read
+	// must wait until they are committed or aborted.
+	for {
+		blocking := s.getBlockingTxnLocked(txnMeta, req.Keys)
+		if blocking == nil {
+			break
+		}
+		s.mu.RUnlock()
+		select {
+		case <-blocking.done:
+			s.mu.RLock()
+		case <-ctx.Done():
+			s.mu.RLock()
+			return nil, ctx.Err()
+		}
+	}
+
+	resp := kvResponse{Values: make([][]byte, len(req.Keys))}
+	t := s.mu.uncommitted[string(txnMeta.ID)]
+	for i, key := range req.Keys {
+		if t != nil {
+			if value, ok := t.writes[string(key)]; ok {
+				resp.Values[i] = value
+				continue
+			}
+		}
+		resp.Values[i] = s.getCommittedLocked(string(key), txnMeta.SnapshotTS)
+	}
+	return mustMarshal(resp), nil
+}
+
+// getBlockingTxnLocked returns a prepared transaction of other txn which has written
+// the keys before the snapshot of the txn.
+func (s *KVTxnStorage) getBlockingTxnLocked(txnMeta txn.TxnMeta, keys [][]byte) *kvTxn {
+	for _, key := range keys {
+		id, ok := s.mu.locked[string(key)]
+		if !ok || id == string(txnMeta.ID) {
+			continue
+		}
+		t := s.mu.uncommitted[id]
+		if t.prepared && (t.meta.PreparedTS == nil || t.meta.PreparedTS.Less(txnMeta.SnapshotTS)) {
+			return t
+		}
+	}
+	return nil
+}
+
+// getCommittedLocked returns the latest version committed before ts
+func (s *KVTxnStorage) getCommittedLocked(key string, ts timestamp.Timestamp) []byte {
+	versions := s.mu.committed[key]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].ts.Less(ts) {
+			return versions[i].value
+		}
+	}
+	return nil
+}
+
+func (s *KVTxnStorage) Write(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error) {
+	if op != OpSet {
+		return nil, moerr.NewError(moerr.INVALID_INPUT, fmt.Sprintf("unknown write op %d", op))
+	}
+	req := kvRequest{}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Keys) != len(req.Values) {
+		return nil, moerr.NewError(moerr.INVALID_INPUT, "keys and values not match")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := string(txnMeta.ID)
+	t, ok := s.mu.uncommitted[id]
+	if !ok {
+		t = newKVTxn(txnMeta)
+	} else if t.prepared {
+		return nil, moerr.NewError(moerr.ErrTxnClosed, "the transaction has been prepared")
+	}
+	for _, key := range req.Keys {
+		if err := s.checkConflictLocked(txnMeta, string(key)); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.uncommitted[id] = t
+	for i, key := range req.Keys {
+		t.write(string(key), req.Values[i])
+		s.mu.locked[string(key)] = id
+	}
+	return nil, nil
+}
+
+// checkConflictLocked the key can not be written if it is written by another uncommitted
+// txn, or it is committed after the snapshot of the txn.
+func (s *KVTxnStorage) checkConflictLocked(txnMeta txn.TxnMeta, key string) error {
+	if id, ok := s.mu.locked[key]; ok && id != string(txnMeta.ID) {
+		return moerr.NewError(moerr.ErrTxnWriteConflict,
+			fmt.Sprintf("key %q is written by another uncommitted transaction", key))
+	}
+	if versions := s.mu.committed[key]; len(versions) > 0 &&
+		versions[len(versions)-1].ts.GreaterEq(txnMeta.SnapshotTS) {
+		return moerr.NewError(moerr.ErrTxnWriteConflict,
+			fmt.Sprintf("key %q is committed after the snapshot of the transaction", key))
+	}
+	return nil
+}
+
+func (s *KVTxnStorage) Prepare(ctx context.Context, txnMeta txn.TxnMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.mu.uncommitted[string(txnMeta.ID)]
+	if !ok {
+		return moerr.NewError(moerr.ErrTxnAborted, "the transaction has no writes on the DN")
+	}
+	if t.prepared {
+		return nil
+	}
+
+	txnMeta.Status = txn.TxnStatus_Prepared
+	if err := s.appendLog(ctx, t.log(txnMeta, true)); err != nil {
+		return err
+	}
+	t.meta = txnMeta
+	t.prepared = true
+	return nil
+}
+
+func (s *KVTxnStorage) Commit(ctx context.Context, txnMeta txn.TxnMeta) error {
+	if txnMeta.CommitTS == nil {
+		return moerr.NewError(moerr.INVALID_INPUT, "missing commit timestamp")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.mu.uncommitted[string(txnMeta.ID)]
+	if !ok {
+		// nothing written
+		return nil
+	}
+	txnMeta.Status = txn.TxnStatus_Committed
+	if err := s.appendLog(ctx, t.log(txnMeta, !t.prepared)); err != nil {
+		return err
+	}
+	s.commitLocked(t, txnMeta)
+	return nil
+}
+
+func (s *KVTxnStorage) commitLocked(t *kvTxn, txnMeta txn.TxnMeta) {
+	for _, key := range t.keys {
+		s.addCommittedLocked(key, *txnMeta.CommitTS, t.writes[key])
+	}
+	if t.prepared {
+		s.mu.completed[string(txnMeta.ID)] = txnMeta
+	}
+	s.removeUncommittedLocked(t)
+}
+
+func (s *KVTxnStorage) addCommittedLocked(key string, ts timestamp.Timestamp, value []byte) {
+	versions := append(s.mu.committed[key], version{ts: ts, value: value})
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].ts.Less(versions[j].ts)
+	})
+	s.mu.committed[key] = versions
+}
+
+func (s *KVTxnStorage) Rollback(ctx context.Context, txnMeta txn.TxnMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.mu.uncommitted[string(txnMeta.ID)]
+	if !ok {
+		return nil
+	}
+	if t.prepared {
+		txnMeta.Status = txn.TxnStatus_Aborted
+		if err := s.appendLog(ctx, t.log(txnMeta, false)); err != nil {
+			return err
+		}
+		s.mu.completed[string(txnMeta.ID)] = txnMeta
+	}
+	s.removeUncommittedLocked(t)
+	return nil
+}
+
+func (s *KVTxnStorage) GetStatus(ctx context.Context, txnID []byte) (*txn.TxnMeta, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	id := string(txnID)
+	if t, ok := s.mu.uncommitted[id]; ok && t.prepared {
+		meta := t.meta
+		return &meta, nil
+	}
+	if meta, ok := s.mu.completed[id]; ok {
+		return &meta, nil
+	}
+	return nil, nil
+}
+
+func (s *KVTxnStorage) Recover(ctx context.Context) ([]txn.TxnMeta, error) {
+	index, err := s.logClient.GetTruncatedIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index++
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var prepared []string
+	for {
+		records, next, err := s.logClient.Read(ctx, index, recoverReadSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			if len(rec.Data) == 0 {
+				continue
+			}
+			l := kvLog{}
+			if err := json.Unmarshal(rec.Data, &l); err != nil {
+				return nil, err
+			}
+			if l.Txn.Status == txn.TxnStatus_Prepared {
+				prepared = append(prepared, string(l.Txn.ID))
+			}
+			s.replayLocked(l)
+		}
+		if next == index {
+			break
+		}
+		index = next
+	}
+
+	var txns []txn.TxnMeta
+	for _, id := range prepared {
+		if t, ok := s.mu.uncommitted[id]; ok {
+			txns = append(txns, t.meta)
+		}
+	}
+	return txns, nil
+}
+
+// replayLocked applies the transaction record to the data
+func (s *KVTxnStorage) replayLocked(l kvLog) {
+	id := string(l.Txn.ID)
+	switch l.Txn.Status {
+	case txn.TxnStatus_Prepared:
+		t := newKVTxn(l.Txn)
+		t.prepared = true
+		for i, key := range l.Keys {
+			t.write(string(key), l.Values[i])
+			s.mu.locked[string(key)] = id
+		}
+		s.mu.uncommitted[id] = t
+	case txn.TxnStatus_Committed:
+		t, ok := s.mu.uncommitted[id]
+		if !ok {
+			// the writes of 1pc transaction are in the Committed record
+			t = newKVTxn(l.Txn)
+			for i, key := range l.Keys {
+				t.write(string(key), l.Values[i])
+			}
+		}
+		s.commitLocked(t, l.Txn)
+	case txn.TxnStatus_Aborted:
+		if t, ok := s.mu.uncommitted[id]; ok {
+			s.removeUncommittedLocked(t)
+		}
+		s.mu.completed[id] = l.Txn
+	}
+}
+
+func (s *KVTxnStorage) removeUncommittedLocked(t *kvTxn) {
+	id := string(t.meta.ID)
+	for _, key := range t.keys {
+		if s.mu.locked[key] == id {
+			delete(s.mu.locked, key)
+		}
+	}
+	if _, ok := s.mu.uncommitted[id]; ok {
+		delete(s.mu.uncommitted, id)
+		close(t.done)
+	}
+}
+
+func (s *KVTxnStorage) appendLog(ctx context.Context, l kvLog) error {
+	_, err := s.logClient.Append(ctx, pb.LogRecord{Data: mustMarshal(l)})
+	return err
+}
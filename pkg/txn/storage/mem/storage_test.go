@@ -0,0 +1,229 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCommittedBeforeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	s := NewKVTxnStorage(NewMemLog())
+
+	wTxn := newTestTxn(1, 1)
+	mustSet(t, s, wTxn, "k1", "v1")
+	assert.Equal(t, "v1", mustGet(t, s, wTxn, "k1"))
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(2, 10), "k1"))
+
+	wTxn.CommitTS = newTestTS(5)
+	require.NoError(t, s.Commit(ctx, wTxn))
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(3, 5), "k1"))
+	assert.Equal(t, "v1", mustGet(t, s, newTestTxn(4, 6), "k1"))
+}
+
+func TestWriteConflict(t *testing.T) {
+	ctx := context.Background()
+	s := NewKVTxnStorage(NewMemLog())
+
+	txn1 := newTestTxn(1, 1)
+	txn2 := newTestTxn(2, 1)
+	mustSet(t, s, txn1, "k1", "v1")
+	_, err := s.Write(ctx, txn2, OpSet, NewSetPayload([][]byte{[]byte("k1")}, [][]byte{[]byte("v2")}))
+	assert.Equal(t, int32(moerr.ErrTxnWriteConflict), err.(*moerr.Error).Code)
+
+	txn1.CommitTS = newTestTS(2)
+	require.NoError(t, s.Commit(ctx, txn1))
+	_, err = s.Write(ctx, txn2, OpSet, NewSetPayload([][]byte{[]byte("k1")}, [][]byte{[]byte("v2")}))
+	assert.Equal(t, int32(moerr.ErrTxnWriteConflict), err.(*moerr.Error).Code)
+
+	mustSet(t, s, newTestTxn(3, 3), "k1", "v3")
+}
+
+func TestTxnRecords(t *testing.T) {
+	ctx := context.Background()
+	log := NewMemLog()
+	s := NewKVTxnStorage(log)
+
+	// 1pc, writes in the Committed record
+	txn1 := newTestTxn(1, 1)
+	mustSet(t, s, txn1, "k1", "v1")
+	txn1.CommitTS = newTestTS(2)
+	require.NoError(t, s.Commit(ctx, txn1))
+
+	// 2pc, writes in the Prepared record
+	txn2 := newTestTxn(2, 3)
+	mustSet(t, s, txn2, "k2", "v2")
+	txn2.PreparedTS = newTestTS(4)
+	require.NoError(t, s.Prepare(ctx, txn2))
+	txn2.CommitTS = newTestTS(5)
+	require.NoError(t, s.Commit(ctx, txn2))
+
+	// aborted after prepared
+	txn3 := newTestTxn(3, 6)
+	mustSet(t, s, txn3, "k3", "v3")
+	txn3.PreparedTS = newTestTS(7)
+	require.NoError(t, s.Prepare(ctx, txn3))
+	require.NoError(t, s.Rollback(ctx, txn3))
+
+	// aborted before prepared, nothing persisted
+	txn4 := newTestTxn(4, 6)
+	mustSet(t, s, txn4, "k4", "v4")
+	require.NoError(t, s.Rollback(ctx, txn4))
+
+	records, _, err := log.Read(ctx, 1, 1024)
+	require.NoError(t, err)
+	var logs []kvLog
+	for _, rec := range records {
+		l := kvLog{}
+		require.NoError(t, json.Unmarshal(rec.Data, &l))
+		logs = append(logs, l)
+	}
+	require.Equal(t, 5, len(logs))
+	assert.Equal(t, txn.TxnStatus_Committed, logs[0].Txn.Status)
+	assert.Equal(t, [][]byte{[]byte("k1")}, logs[0].Keys)
+	assert.Equal(t, txn.TxnStatus_Prepared, logs[1].Txn.Status)
+	assert.Equal(t, [][]byte{[]byte("k2")}, logs[1].Keys)
+	assert.Equal(t, txn.TxnStatus_Committed, logs[2].Txn.Status)
+	assert.Empty(t, logs[2].Keys)
+	assert.Equal(t, txn.TxnStatus_Prepared, logs[3].Txn.Status)
+	assert.Equal(t, txn.TxnStatus_Aborted, logs[4].Txn.Status)
+
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(5, 10), "k3"))
+	assert.Equal(t, "v2", mustGet(t, s, newTestTxn(5, 10), "k2"))
+}
+
+func TestRecover(t *testing.T) {
+	ctx := context.Background()
+	log := NewMemLog()
+	s := NewKVTxnStorage(log)
+
+	// 1pc committed
+	txn1 := newTestTxn(1, 1)
+	mustSet(t, s, txn1, "k1", "v1")
+	txn1.CommitTS = newTestTS(2)
+	require.NoError(t, s.Commit(ctx, txn1))
+
+	// 2pc committed
+	txn2 := newTestTxn(2, 3)
+	mustSet(t, s, txn2, "k2", "v2")
+	txn2.PreparedTS = newTestTS(4)
+	require.NoError(t, s.Prepare(ctx, txn2))
+	txn2.CommitTS = newTestTS(5)
+	require.NoError(t, s.Commit(ctx, txn2))
+
+	// 2pc aborted
+	txn3 := newTestTxn(3, 6)
+	mustSet(t, s, txn3, "k3", "v3")
+	txn3.PreparedTS = newTestTS(7)
+	require.NoError(t, s.Prepare(ctx, txn3))
+	require.NoError(t, s.Rollback(ctx, txn3))
+
+	// in-doubt
+	txn4 := newTestTxn(4, 8)
+	mustSet(t, s, txn4, "k4", "v4")
+	txn4.PreparedTS = newTestTS(9)
+	require.NoError(t, s.Prepare(ctx, txn4))
+
+	// active, lost after restart
+	mustSet(t, s, newTestTxn(5, 8), "k5", "v5")
+
+	s = NewKVTxnStorage(log)
+	txns, err := s.Recover(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(txns))
+	assert.Equal(t, txn4.ID, txns[0].ID)
+	assert.Equal(t, txn.TxnStatus_Prepared, txns[0].Status)
+	assert.Equal(t, txn4.PreparedTS, txns[0].PreparedTS)
+
+	assert.Equal(t, "v1", mustGet(t, s, newTestTxn(6, 10), "k1"))
+	assert.Equal(t, "v2", mustGet(t, s, newTestTxn(6, 10), "k2"))
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(6, 10), "k3"))
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(6, 10), "k5"))
+	// the in-doubt txn is committed
+	txn4.CommitTS = newTestTS(10)
+	require.NoError(t, s.Commit(ctx, txn4))
+	assert.Equal(t, "v4", mustGet(t, s, newTestTxn(6, 11), "k4"))
+
+	for id, status := range map[byte]txn.TxnStatus{
+		2: txn.TxnStatus_Committed,
+		3: txn.TxnStatus_Aborted,
+		4: txn.TxnStatus_Committed,
+	} {
+		meta, err := s.GetStatus(ctx, []byte{id})
+		require.NoError(t, err)
+		require.NotNil(t, meta)
+		assert.Equal(t, status, meta.Status)
+	}
+	// 1pc has no Prepared record
+	meta, err := s.GetStatus(ctx, txn1.ID)
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+}
+
+func TestReadBlockedByPreparedTxn(t *testing.T) {
+	ctx := context.Background()
+	s := NewKVTxnStorage(NewMemLog())
+
+	wTxn := newTestTxn(1, 1)
+	mustSet(t, s, wTxn, "k1", "v1")
+	wTxn.PreparedTS = newTestTS(2)
+	require.NoError(t, s.Prepare(ctx, wTxn))
+
+	// the snapshot is before the PreparedTS, not blocked
+	assert.Equal(t, "", mustGet(t, s, newTestTxn(2, 2), "k1"))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	_, err := s.Read(timeoutCtx, newTestTxn(3, 5), OpGet, NewGetPayload([]byte("k1")))
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	c := make(chan string)
+	go func() {
+		c <- mustGet(t, s, newTestTxn(3, 5), "k1")
+	}()
+	wTxn.CommitTS = newTestTS(3)
+	require.NoError(t, s.Commit(ctx, wTxn))
+	assert.Equal(t, "v1", <-c)
+}
+
+func newTestTS(ts int64) *timestamp.Timestamp {
+	return &timestamp.Timestamp{PhysicalTime: ts}
+}
+
+func newTestTxn(id byte, ts int64) txn.TxnMeta {
+	return txn.TxnMeta{ID: []byte{id}, SnapshotTS: *newTestTS(ts)}
+}
+
+func mustSet(t *testing.T, s *KVTxnStorage, meta txn.TxnMeta, key, value string) {
+	_, err := s.Write(context.Background(), meta, OpSet, NewSetPayload([][]byte{[]byte(key)}, [][]byte{[]byte(value)}))
+	require.NoError(t, err)
+}
+
+func mustGet(t *testing.T, s *KVTxnStorage, meta txn.TxnMeta, key string) string {
+	payload, err := s.Read(context.Background(), meta, OpGet, NewGetPayload([]byte(key)))
+	require.NoError(t, err)
+	values, err := DecodeGetResponse(payload)
+	require.NoError(t, err)
+	return string(values[0])
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+)
+
+// TxnStorage In order for TxnService to implement distributed transactions based on Clock-SI on a
+// stand-alone storage engine, it requires a number of interfaces implemented by the storage engine.
+//
+// The TxnStorage is responsible for persisting the transaction records to the LogService:
+// Prepare writes the Prepared record, Commit writes the Committed record and Rollback writes
+// the Aborted record of a prepared transaction.
+type TxnStorage interface {
+	// Close close the txn storage
+	Close(ctx context.Context) error
+	// Recover replays the transaction records from the LogService to rebuild the data, and
+	// returns the in-doubt transactions, which are prepared but neither committed nor aborted.
+	// The keys written by the in-doubt transactions can not be read by the transactions whose
+	// snapshot is after the PreparedTS until the transactions are committed or aborted. It is
+	// called once before any request is handled.
+	Recover(ctx context.Context) ([]txn.TxnMeta, error)
+	// GetStatus returns the persisted status of a 2pc transaction, which is Prepared, Committed
+	// or Aborted. Nil is returned if the transaction has no Prepared record.
+	GetStatus(ctx context.Context, txnID []byte) (*txn.TxnMeta, error)
+
+	// Read execute read requests sent by CN. The read waits for the prepared transactions
+	// which may be committed before the snapshot of the txn.
+	Read(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error)
+	// Write execute write requests sent by CN.
+	Write(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payload []byte) ([]byte, error)
+	// Prepare prepare data written by a transaction on a DNShard. TxnStorage needs to do conflict
+	// detection locally and persist the Prepared record. The txnMeta.PreparedTS is the prepared
+	// timestamp of the transaction, the txnMeta.DNShards are all the DNShards of the transaction
+	// and the first one is the coordinator.
+	Prepare(ctx context.Context, txnMeta txn.TxnMeta) error
+	// Commit commit the transaction at txnMeta.CommitTS, and persist the Committed record. For a
+	// 2pc transaction, the transaction must be prepared.
+	Commit(ctx context.Context, txnMeta txn.TxnMeta) error
+	// Rollback rollback the transaction, the Aborted record is persisted if the transaction has
+	// been prepared.
+	Rollback(ctx context.Context, txnMeta txn.TxnMeta) error
+}
@@ -55,9 +55,39 @@ func (s *OptsBuilder) Finish() SessionOverrideOptions {
 	return *s.opts
 }
 
+// Column is the metadata of a column of the result set
+type Column struct {
+	Name string
+	// Type, the mysql type of the column, see pkg/defines
+	Type   uint8
+	Signed bool
+}
+
+// ResultSet is the result of a query. The rows are indexed from 0 and a null
+// value is nil.
+type ResultSet interface {
+	// AffectedRows returns the rows affected by the insert, update or delete
+	AffectedRows() uint64
+	ColumnCount() uint64
+	Column(uint64) (Column, error)
+	RowCount() uint64
+	Row(uint64) ([]interface{}, error)
+	Value(row uint64, col uint64) (interface{}, error)
+	ValueByName(row uint64, col string) (interface{}, error)
+	IsNull(row uint64, col uint64) (bool, error)
+	// the typed accessors convert the value into the type
+	Int64(row uint64, col uint64) (int64, error)
+	Uint64(row uint64, col uint64) (uint64, error)
+	Float64(row uint64, col uint64) (float64, error)
+	String(row uint64, col uint64) (string, error)
+}
+
 type InternalExecutor interface {
 	// exec sql without returning results set
 	Exec(string, SessionOverrideOptions) error
+	// Query executes the sql and returns its result set. The args are bound
+	// to the '?' placeholders in the sql in order, see BindParams.
+	Query(string, SessionOverrideOptions, ...interface{}) (ResultSet, error)
 	// override session for the executor scope
 	ApplySessionOverride(SessionOverrideOptions)
 }
@@ -0,0 +1,156 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internalExecutor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BindParams replaces the '?' placeholders in the sql with the args as sql
+// literals. The placeholders in strings, quoted identifiers and comments are
+// not replaced. Strings are quoted and escaped, so the args can not change the
+// structure of the sql.
+func BindParams(sql string, args ...interface{}) (string, error) {
+	var buf strings.Builder
+	next := 0
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := skipQuoted(sql, i)
+			buf.WriteString(sql[i:end])
+			i = end - 1
+		case c == '#' || (c == '-' && strings.HasPrefix(sql[i:], "-- ")):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			buf.WriteString(sql[i : i+end])
+			i += end - 1
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				end = len(sql)
+			} else {
+				end += i + 4
+			}
+			buf.WriteString(sql[i:end])
+			i = end - 1
+		case c == '?':
+			if next >= len(args) {
+				return "", fmt.Errorf("the sql has more placeholders than the %d args", len(args))
+			}
+			if err := writeLiteral(&buf, args[next]); err != nil {
+				return "", err
+			}
+			next++
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	if next != len(args) {
+		return "", fmt.Errorf("the sql has %d placeholders but %d args", next, len(args))
+	}
+	return buf.String(), nil
+}
+
+// skipQuoted returns the position after the quoted string beginning at i
+func skipQuoted(sql string, i int) int {
+	quote := sql[i]
+	for j := i + 1; j < len(sql); j++ {
+		switch sql[j] {
+		case '\\':
+			if quote != '`' {
+				j++
+			}
+		case quote:
+			// a doubled quote is an escaped quote
+			if j+1 < len(sql) && sql[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(sql)
+}
+
+func writeLiteral(buf *strings.Builder, arg interface{}) error {
+	switch v := arg.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case int:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int8:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int16:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int32:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case uint:
+		buf.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint8:
+		buf.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint16:
+		buf.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint32:
+		buf.WriteString(strconv.FormatUint(uint64(v), 10))
+	case uint64:
+		buf.WriteString(strconv.FormatUint(v, 10))
+	case float32:
+		buf.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		writeString(buf, v)
+	case []byte:
+		writeString(buf, string(v))
+	case time.Time:
+		writeString(buf, v.Format("2006-01-02 15:04:05.999999"))
+	default:
+		return fmt.Errorf("unsupported type %T of the arg", arg)
+	}
+	return nil
+}
+
+// writeString writes s as a quoted and escaped sql string
+func writeString(buf *strings.Builder, s string) {
+	buf.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case 0:
+			buf.WriteString("\\0")
+		case '\n':
+			buf.WriteString("\\n")
+		case '\r':
+			buf.WriteString("\\r")
+		case '\x1a':
+			buf.WriteString("\\Z")
+		case '\'', '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('\'')
+}
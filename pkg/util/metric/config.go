@@ -31,6 +31,8 @@ var (
 	configGatherInterval  int64 = envOrDefaultInt[int64]("MO_METRIC_GATHER_INTERVAL", 15000) // 15s
 	configExportToProm    int32 = envOrDefaultBool("MO_METRIC_EXPORT_TO_PROM", 1)
 	configForceReinit     int32 = envOrDefaultBool("MO_METRIC_DROP_AND_INIT", 0) // TODO: find a better way to init metrics and remove this one
+	// the window of statement_summary
+	configStatementSummaryInterval int64 = envOrDefaultInt[int64]("MO_METRIC_STATEMENT_SUMMARY_INTERVAL", 60000) // 60s
 )
 
 func initConfigByParamaterUnit(pu *config.ParameterUnit) {
@@ -81,6 +83,10 @@ func getGatherInterval() time.Duration {
 	return time.Duration(atomic.LoadInt64(&configGatherInterval)) * time.Millisecond
 }
 
+func getStatementSummaryInterval() time.Duration {
+	return time.Duration(atomic.LoadInt64(&configStatementSummaryInterval)) * time.Millisecond
+}
+
 // for tests
 
 func setRawHistBufLimit(new int32) int32 {
@@ -0,0 +1,241 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"go.uber.org/zap"
+)
+
+const (
+	STATEMENT_SUMMARY_TABLE = "statement_summary"
+	// the length of the fingerprint kept in the slow query log and the summary
+	statementSQLLength = 1024
+	// the max number of fingerprints in one summary window, the statements of
+	// other fingerprints are dropped
+	statementSummaryMaxFingerprints = 1024
+)
+
+var sqlCreateStatementSummary = fmt.Sprintf(
+	"create table if not exists %s.%s (`%s` datetime, `%s` int, `%s` varchar(20), "+
+		"`fingerprint` varchar(%d), `exec_count` bigint, "+
+		"`sum_latency` double, `max_latency` double, `sum_rows` bigint, "+
+		"`sum_bytes_read` bigint, `max_memory` bigint)",
+	METRIC_DB, STATEMENT_SUMMARY_TABLE, LBL_TIME, LBL_NODE, LBL_ROLE,
+	statementSQLLength,
+)
+
+// sqlInsertStatementSummary is the values of one summary in the insert sql
+const sqlInsertStatementSummary = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+// StatementInfo is the execution statistics of one statement. Only the
+// fingerprint of the statement is kept, the literals of the sql, such as
+// passwords, never reach the log or the summary.
+type StatementInfo struct {
+	// Fingerprint, the normalized sql, statements which differ only
+	// in literals have the same fingerprint
+	Fingerprint string
+	User        string
+	Database    string
+	StartTime   time.Time
+	Duration    time.Duration
+	// Rows, rows returned or affected
+	Rows uint64
+	// BytesRead, size of the data read from the storage engine
+	BytesRead int64
+	// PeakMemory, peak memory of the mheap
+	PeakMemory int64
+}
+
+// LogSlowStatement writes the statement into the slow query log
+func LogSlowStatement(s *StatementInfo) {
+	logutil.Warn("[SlowQuery]",
+		zap.String("fingerprint", truncateSQL(s.Fingerprint)),
+		zap.String("user", s.User),
+		zap.String("database", s.Database),
+		zap.Time("start", s.StartTime),
+		zap.Duration("duration", s.Duration),
+		zap.Uint64("rows", s.Rows),
+		zap.Int64("bytes_read", s.BytesRead),
+		zap.Int64("peak_memory", s.PeakMemory),
+	)
+}
+
+// RecordStatement adds the statement into the summary of its fingerprint.
+// The summaries are flushed into system_metrics.statement_summary periodically.
+func RecordStatement(s *StatementInfo) {
+	if summarizer := moStatementSummarizer; summarizer != nil {
+		summarizer.record(s)
+	}
+}
+
+func truncateSQL(sql string) string {
+	if len(sql) > statementSQLLength {
+		return sql[:statementSQLLength]
+	}
+	return sql
+}
+
+// statementSummary aggregates the statements of a fingerprint
+type statementSummary struct {
+	count        uint64
+	sumLatency   time.Duration
+	maxLatency   time.Duration
+	sumRows      uint64
+	sumBytesRead int64
+	maxMemory    int64
+}
+
+func (s *statementSummary) add(info *StatementInfo) {
+	s.count++
+	s.sumLatency += info.Duration
+	if info.Duration > s.maxLatency {
+		s.maxLatency = info.Duration
+	}
+	s.sumRows += info.Rows
+	s.sumBytesRead += info.BytesRead
+	if info.PeakMemory > s.maxMemory {
+		s.maxMemory = info.PeakMemory
+	}
+}
+
+type statementSummarizer struct {
+	sync.Mutex
+	summaries map[string]*statementSummary
+
+	ieFactory func() ie.InternalExecutor
+	nodeid    int32
+	role      string
+	isRunning int32
+	cancel    context.CancelFunc
+	stopWg    sync.WaitGroup
+	now       func() int64
+}
+
+var moStatementSummarizer *statementSummarizer
+
+func newStatementSummarizer(factory func() ie.InternalExecutor, node int32, role string) *statementSummarizer {
+	return &statementSummarizer{
+		summaries: make(map[string]*statementSummary),
+		ieFactory: factory,
+		nodeid:    node,
+		role:      role,
+		now:       func() int64 { return int64(types.Now()) },
+	}
+}
+
+func (s *statementSummarizer) record(info *StatementInfo) {
+	s.Lock()
+	defer s.Unlock()
+	summary, ok := s.summaries[info.Fingerprint]
+	if !ok {
+		if len(s.summaries) >= statementSummaryMaxFingerprints {
+			return
+		}
+		summary = &statementSummary{}
+		s.summaries[info.Fingerprint] = summary
+	}
+	summary.add(info)
+}
+
+// swap takes the summaries of the window and starts a new one
+func (s *statementSummarizer) swap() map[string]*statementSummary {
+	s.Lock()
+	defer s.Unlock()
+	summaries := s.summaries
+	s.summaries = make(map[string]*statementSummary)
+	return summaries
+}
+
+func (s *statementSummarizer) Start() {
+	if atomic.SwapInt32(&s.isRunning, 1) == 1 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	exec := s.ieFactory()
+	exec.ApplySessionOverride(ie.NewOptsBuilder().Database(METRIC_DB).Internal(true).Finish())
+	s.stopWg.Add(1)
+	go func() {
+		defer s.stopWg.Done()
+		ticker := time.NewTicker(getStatementSummaryInterval())
+		defer ticker.Stop()
+		buf := new(bytes.Buffer)
+		for {
+			select {
+			case <-ticker.C:
+				sql := s.getSql(s.swap(), buf)
+				if sql == "" {
+					continue
+				}
+				if err := exec.Exec(sql, ie.NewOptsBuilder().Finish()); err != nil {
+					logutil.Errorf("[Metric] insert statement summary error. err: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *statementSummarizer) Stop() (<-chan struct{}, bool) {
+	if atomic.SwapInt32(&s.isRunning, 0) == 0 {
+		return nil, false
+	}
+	s.cancel()
+	stopCh := make(chan struct{})
+	go func() { s.stopWg.Wait(); close(stopCh) }()
+	return stopCh, true
+}
+
+// getSql extracts a insert sql from the summaries, it returns "" if there is no summary.
+// The values are bound by ie.BindParams, so the fingerprints are escaped as sql strings.
+func (s *statementSummarizer) getSql(summaries map[string]*statementSummary, buf *bytes.Buffer) string {
+	if len(summaries) == 0 {
+		return ""
+	}
+	buf.Reset()
+	buf.WriteString(fmt.Sprintf("insert into %s.%s values ", METRIC_DB, STATEMENT_SUMMARY_TABLE))
+	now := types.Datetime(s.now()).String()
+	rows := 0
+	for fingerprint, summary := range summaries {
+		values, err := ie.BindParams(sqlInsertStatementSummary,
+			now, s.nodeid, s.role, truncateSQL(fingerprint), summary.count,
+			summary.sumLatency.Seconds(), summary.maxLatency.Seconds(),
+			summary.sumRows, summary.sumBytesRead, summary.maxMemory)
+		if err != nil {
+			logutil.Errorf("[Metric] bind statement summary error. err: %v", err)
+			continue
+		}
+		if rows > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(values)
+		rows++
+	}
+	if rows == 0 {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementSummarizer(t *testing.T) {
+	sqlch := make(chan string, 100)
+	s := newStatementSummarizer(newExecutorFactory(sqlch), 1, "test")
+	s.now = makeDummyClock(1)
+
+	s.record(&StatementInfo{Fingerprint: "select ?", Duration: time.Second, Rows: 1, BytesRead: 10, PeakMemory: 100})
+	s.record(&StatementInfo{Fingerprint: "select ?", Duration: 3 * time.Second, Rows: 1, BytesRead: 20, PeakMemory: 50})
+	s.record(&StatementInfo{Fingerprint: "insert into t values (?)", Duration: time.Second})
+
+	summaries := s.swap()
+	require.Len(t, summaries, 2)
+	require.Empty(t, s.swap())
+
+	sel := summaries["select ?"]
+	require.Equal(t, uint64(2), sel.count)
+	require.Equal(t, 4*time.Second, sel.sumLatency)
+	require.Equal(t, 3*time.Second, sel.maxLatency)
+	require.Equal(t, uint64(2), sel.sumRows)
+	require.Equal(t, int64(30), sel.sumBytesRead)
+	require.Equal(t, int64(100), sel.maxMemory)
+
+	buf := new(bytes.Buffer)
+	require.Equal(t, "", s.getSql(nil, buf))
+	sql := s.getSql(map[string]*statementSummary{"select ?": sel}, buf)
+	require.True(t, strings.HasPrefix(sql, "insert into system_metrics.statement_summary values "), sql)
+	require.Contains(t, sql, `1, 'test', 'select ?', 2, 4, 3, 2, 30, 100)`)
+
+	// the fingerprints are escaped as sql strings
+	sql = s.getSql(map[string]*statementSummary{`select * from t where a = '\'' or "x"`: sel}, buf)
+	require.Contains(t, sql, `'select * from t where a = \'\\\'\' or \"x\"'`)
+}
@@ -79,6 +79,7 @@ func InitMetric(ieFactory func() ie.InternalExecutor, pu *config.ParameterUnit,
 	registry = prom.NewRegistry()
 	moCollector = newMetricCollector(ieFactory)
 	moExporter = newMetricExporter(registry, moCollector, int32(nodeId), role)
+	moStatementSummarizer = newStatementSummarizer(ieFactory, int32(nodeId), role)
 
 	// register metrics and create tables
 	registerAllMetrics()
@@ -87,6 +88,7 @@ func InitMetric(ieFactory func() ie.InternalExecutor, pu *config.ParameterUnit,
 	// start the data flow
 	moCollector.Start()
 	moExporter.Start()
+	moStatementSummarizer.Start()
 
 	if getExportToProm() {
 		// http.HandleFunc("/query", makeDebugHandleFunc(ieFactory))
@@ -118,6 +120,12 @@ func StopMetricSync() {
 		}
 		moExporter = nil
 	}
+	if moStatementSummarizer != nil {
+		if ch, effect := moStatementSummarizer.Stop(); effect {
+			<-ch
+		}
+		moStatementSummarizer = nil
+	}
 	if statusSvr != nil {
 		_ = statusSvr.Shutdown(context.TODO())
 		statusSvr = nil
@@ -175,6 +183,7 @@ func initTables(ieFactory func() ie.InternalExecutor) {
 		sql := createTableSqlFromMetricFamily(mf, buf)
 		mustExec(sql)
 	}
+	mustExec(sqlCreateStatementSummary)
 	createCost = time.Since(instant)
 }
 
@@ -36,6 +36,10 @@ func (e *dummySqlExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions)
 	e.opts = opts
 }
 
+func (e *dummySqlExecutor) Query(sql string, opts ie.SessionOverrideOptions, args ...interface{}) (ie.ResultSet, error) {
+	return nil, nil
+}
+
 func (e *dummySqlExecutor) Exec(sql string, opts ie.SessionOverrideOptions) error {
 	select {
 	case e.ch <- sql:
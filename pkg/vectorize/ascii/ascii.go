@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ascii
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	AsciiChar    func(*types.Bytes, []uint8) []uint8
+	AsciiVarChar func(*types.Bytes, []uint8) []uint8
+)
+
+func init() {
+	AsciiChar = ascii
+	AsciiVarChar = ascii
+}
+
+// ascii returns the numeric value of the leftmost byte of each string, and 0 for an
+// empty string. Like MySQL, it works on bytes, so for a multibyte character only
+// its first byte is returned.
+func ascii(xs *types.Bytes, rs []uint8) []uint8 {
+	for i, length := range xs.Lengths {
+		if length == 0 {
+			rs[i] = 0
+			continue
+		}
+		rs[i] = xs.Data[xs.Offsets[i]]
+	}
+	return rs
+}
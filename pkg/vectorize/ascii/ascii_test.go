@@ -0,0 +1,31 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ascii
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAscii(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"2", "dx", "", "你"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := ascii(xs, make([]uint8, 4))
+	require.Equal(t, []uint8{50, 100, 0, 0xe4}, rs)
+}
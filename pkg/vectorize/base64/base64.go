@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"encoding/base64"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	ToBase64   func(*types.Bytes, *types.Bytes) *types.Bytes
+	FromBase64 func(*types.Bytes, *nulls.Nulls, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	ToBase64 = toBase64
+	FromBase64 = fromBase64
+}
+
+// lineLength is the max length of a line of encoded output, the same as MySQL.
+const lineLength = 76
+
+// toBase64 appends the base-64 encoded form of each string in xs to rs,
+// a newline is added after each 76 characters of encoded output.
+func toBase64(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	var buf []byte
+	for i := range xs.Offsets {
+		x := xs.Get(int64(i))
+		n := base64.StdEncoding.EncodedLen(len(x))
+		if cap(buf) < n {
+			buf = make([]byte, n)
+		}
+		buf = buf[:n]
+		base64.StdEncoding.Encode(buf, x)
+		o := uint32(len(rs.Data))
+		for len(buf) > lineLength {
+			rs.Data = append(rs.Data, buf[:lineLength]...)
+			rs.Data = append(rs.Data, '\n')
+			buf = buf[lineLength:]
+		}
+		rs.Data = append(rs.Data, buf...)
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
+
+// fromBase64 appends the decoded form of each base-64 encoded string in xs to rs,
+// newlines, carriage returns, tabs and spaces are ignored.
+// The result is NULL if a string is not a valid base-64 encoded string.
+func fromBase64(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	var buf []byte
+	for i := range xs.Offsets {
+		buf = buf[:0]
+		for _, c := range xs.Get(int64(i)) {
+			switch c {
+			case '\n', '\r', '\t', ' ':
+			default:
+				buf = append(buf, c)
+			}
+		}
+		o := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, base64.StdEncoding.DecodedLen(len(buf)))...)
+		n, err := base64.StdEncoding.Decode(rs.Data[o:], buf)
+		if err != nil {
+			n = 0
+			nulls.Add(nsp, uint64(i))
+		}
+		rs.Data = rs.Data[:o+n]
+		rs.Offsets = append(rs.Offsets, uint32(o))
+		rs.Lengths = append(rs.Lengths, uint32(n))
+	}
+	return rs
+}
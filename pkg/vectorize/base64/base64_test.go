@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToBase64(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"abc", "", strings.Repeat("a", 60)} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := toBase64(xs, &types.Bytes{})
+	require.Equal(t, "YWJj", string(rs.Get(0)))
+	require.Equal(t, "", string(rs.Get(1)))
+	lines := strings.Split(string(rs.Get(2)), "\n")
+	require.Equal(t, 2, len(lines))
+	require.Equal(t, 76, len(lines[0]))
+	require.Equal(t, 4, len(lines[1]))
+}
+
+func TestFromBase64(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"YWJj", "YW Jj\nZA==", "!!!", ""} {
+		xs.AppendOnce([]byte(s))
+	}
+	nsp := new(nulls.Nulls)
+	rs := fromBase64(xs, nsp, &types.Bytes{})
+	require.Equal(t, "abc", string(rs.Get(0)))
+	require.Equal(t, "abcd", string(rs.Get(1)))
+	require.True(t, nulls.Contains(nsp, 2))
+	require.Equal(t, "", string(rs.Get(3)))
+	require.False(t, nulls.Contains(nsp, 3))
+}
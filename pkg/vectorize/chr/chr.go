@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chr
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Chr func([]int64, *nulls.Nulls, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Chr = chr
+}
+
+// chr converts each code point of xs to its utf8 encoded character. Values which
+// are not valid unicode code points are added to nsp, and rows already contained
+// in nsp get an empty result.
+func chr(xs []int64, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	var buf [utf8.UTFMax]byte
+
+	for i, x := range xs {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		if x < 0 || x > utf8.MaxRune || !utf8.ValidRune(rune(x)) {
+			nulls.Add(nsp, uint64(i))
+			rs.AppendOnce(nil)
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], rune(x))
+		rs.AppendOnce(buf[:n])
+	}
+	return rs
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Concat func([]*types.Bytes, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Concat = concat
+}
+
+// concat joins the i-th strings of all xs into the i-th result for each of the
+// length rows. Rows contained in nsp are null, i.e. any argument is null, and
+// get an empty result.
+func concat(xs []*types.Bytes, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		offset := uint32(len(rs.Data))
+		if !nulls.Contains(nsp, uint64(i)) {
+			for _, x := range xs {
+				rs.Data = append(rs.Data, broadcast.Bytes(x, i)...)
+			}
+		}
+		rs.Offsets = append(rs.Offsets, offset)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-offset)
+	}
+	return rs
+}
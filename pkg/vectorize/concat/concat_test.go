@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestConcat(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 2)
+	xs := []*types.Bytes{
+		makeBytes("a", "你", "x", ""),
+		makeBytes("-"),
+		makeBytes("b", "好", "y", ""),
+	}
+	rs := concat(xs, nsp, 4, &types.Bytes{})
+	require.Equal(t, 4, len(rs.Offsets))
+	require.Equal(t, "a-b", string(rs.Get(0)))
+	require.Equal(t, "你-好", string(rs.Get(1)))
+	require.Equal(t, "", string(rs.Get(2)))
+	require.Equal(t, "-", string(rs.Get(3)))
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crc32
+
+import (
+	"hash/crc32"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Crc32 func(*types.Bytes, []uint32) []uint32
+)
+
+func init() {
+	Crc32 = crc32Bytes
+}
+
+// crc32Bytes computes the cyclic redundancy check value of each string in xs,
+// using the IEEE polynomial as MySQL does.
+func crc32Bytes(xs *types.Bytes, rs []uint32) []uint32 {
+	for i := range xs.Offsets {
+		rs[i] = crc32.ChecksumIEEE(xs.Get(int64(i)))
+	}
+	return rs
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crc32
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrc32(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"", "MySQL", "mysql"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := crc32Bytes(xs, make([]uint32, 3))
+	require.Equal(t, []uint32{0, 3259397556, 2501908538}, rs)
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_format
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	DateFormat func([]types.Datetime, *types.Bytes, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	DateFormat = dateFormat
+}
+
+// dateFormat appends each datetime of xs formatted by the format of mysql's DATE_FORMAT to rs,
+// the buffer of the formatted value is reused across the rows.
+func dateFormat(xs []types.Datetime, formats *types.Bytes, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	var buf []byte
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, format := broadcast.Value(xs, i), broadcast.Bytes(formats, i)
+		buf = x.AppendFormat(buf[:0], format)
+		rs.AppendOnce(buf)
+	}
+	return rs
+}
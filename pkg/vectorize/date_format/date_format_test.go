@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package date_format
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateFormat(t *testing.T) {
+	dt := func(s string) types.Datetime {
+		v, err := types.ParseDatetime(s, 6)
+		require.NoError(t, err)
+		return v
+	}
+	xs := []types.Datetime{dt("2009-10-04 22:23:00"), dt("2007-10-04 22:23:00"), dt("1900-10-04 22:23:00")}
+	formats := &types.Bytes{}
+	formats.AppendOnce([]byte("%W %M %Y"))
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := dateFormat(xs, formats, nsp, 3, &types.Bytes{})
+	require.Equal(t, "Sunday October 2009", string(rs.Get(0)))
+	require.Equal(t, "", string(rs.Get(1)))
+	require.Equal(t, "Thursday October 1900", string(rs.Get(2)))
+
+	formats = &types.Bytes{}
+	formats.AppendOnce([]byte("%H:%i:%s"))
+	formats.AppendOnce([]byte("%D %y %a %d %m %b %j"))
+	rs = dateFormat(xs[1:], formats, new(nulls.Nulls), 2, &types.Bytes{})
+	require.Equal(t, "22:23:00", string(rs.Get(0)))
+	require.Equal(t, "4th 00 Thu 04 10 Oct 277", string(rs.Get(1)))
+}
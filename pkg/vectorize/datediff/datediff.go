@@ -0,0 +1,38 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datediff
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	DateDiff func([]types.Date, []types.Date, int, []int64) []int64
+)
+
+func init() {
+	DateDiff = dateDiff
+}
+
+// dateDiff returns the number of days from ys to xs for each row, which is
+// negative if xs is earlier than ys like mysql's DATEDIFF.
+func dateDiff(xs, ys []types.Date, length int, rs []int64) []int64 {
+	for i := 0; i < length; i++ {
+		x, y := broadcast.Value(xs, i), broadcast.Value(ys, i)
+		rs[i] = types.DateDiff(x, y)
+	}
+	return rs
+}
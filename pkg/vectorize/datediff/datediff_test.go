@@ -0,0 +1,34 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datediff
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateDiff(t *testing.T) {
+	d := func(s string) types.Date {
+		v, err := types.ParseDate(s)
+		require.NoError(t, err)
+		return v
+	}
+	xs := []types.Date{d("2007-12-31"), d("2010-11-30"), d("2010-12-31")}
+	ys := []types.Date{d("2007-12-30"), d("2010-12-31"), d("2010-12-31")}
+	require.Equal(t, []int64{1, -31, 0}, dateDiff(xs, ys, 3, make([]int64, 3)))
+	require.Equal(t, []int64{366, 0, -31}, dateDiff([]types.Date{d("2008-12-30")}, []types.Date{d("2007-12-30"), d("2008-12-30"), d("2009-01-30")}, 3, make([]int64, 3)))
+}
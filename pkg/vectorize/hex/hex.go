@@ -0,0 +1,105 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	HexString func(*types.Bytes, *types.Bytes) *types.Bytes
+	HexInt64  func([]int64, *types.Bytes) *types.Bytes
+	HexUint64 func([]uint64, *types.Bytes) *types.Bytes
+	Unhex     func(*types.Bytes, *nulls.Nulls, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	HexString = hexString
+	HexInt64 = hexInt64
+	HexUint64 = hexUint64
+	Unhex = unhex
+}
+
+const upperDigits = "0123456789ABCDEF"
+
+// hexString appends the hexadecimal representation of each string in xs to rs,
+// each byte is converted to two uppercase hexadecimal digits.
+func hexString(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		x := xs.Get(int64(i))
+		o := uint32(len(rs.Data))
+		for _, c := range x {
+			rs.Data = append(rs.Data, upperDigits[c>>4], upperDigits[c&0x0f])
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(x)*2))
+	}
+	return rs
+}
+
+// hexInt64 appends the hexadecimal representation of each number in xs to rs,
+// negative numbers are treated as 64-bit unsigned numbers.
+func hexInt64(xs []int64, rs *types.Bytes) *types.Bytes {
+	for _, x := range xs {
+		appendUint64(rs, uint64(x))
+	}
+	return rs
+}
+
+func hexUint64(xs []uint64, rs *types.Bytes) *types.Bytes {
+	for _, x := range xs {
+		appendUint64(rs, x)
+	}
+	return rs
+}
+
+func appendUint64(rs *types.Bytes, x uint64) {
+	o := uint32(len(rs.Data))
+	s := strconv.FormatUint(x, 16)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' {
+			c -= 'a' - 'A'
+		}
+		rs.Data = append(rs.Data, c)
+	}
+	rs.Offsets = append(rs.Offsets, o)
+	rs.Lengths = append(rs.Lengths, uint32(len(s)))
+}
+
+// unhex interprets each pair of hexadecimal digits of the strings in xs as a byte,
+// a string with an odd number of digits is treated as if it has a leading '0'.
+// The result is NULL if a string contains any non-hexadecimal character.
+func unhex(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		x := xs.Get(int64(i))
+		if len(x)%2 == 1 {
+			x = append([]byte{'0'}, x...)
+		}
+		o := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, hex.DecodedLen(len(x)))...)
+		if _, err := hex.Decode(rs.Data[o:], x); err != nil {
+			rs.Data = rs.Data[:o]
+			nulls.Add(nsp, uint64(i))
+		}
+		rs.Offsets = append(rs.Offsets, uint32(o))
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data)-o))
+	}
+	return rs
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHex(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"abc", "", "\xff\x00"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := hexString(xs, &types.Bytes{})
+	require.Equal(t, "616263", string(rs.Get(0)))
+	require.Equal(t, "", string(rs.Get(1)))
+	require.Equal(t, "FF00", string(rs.Get(2)))
+
+	rs = hexInt64([]int64{255, 0, -1}, &types.Bytes{})
+	require.Equal(t, "FF", string(rs.Get(0)))
+	require.Equal(t, "0", string(rs.Get(1)))
+	require.Equal(t, "FFFFFFFFFFFFFFFF", string(rs.Get(2)))
+}
+
+func TestUnhex(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"4D7953514C", "f", "GG", "6a6B"} {
+		xs.AppendOnce([]byte(s))
+	}
+	nsp := new(nulls.Nulls)
+	rs := unhex(xs, nsp, &types.Bytes{})
+	require.Equal(t, "MySQL", string(rs.Get(0)))
+	require.Equal(t, "\x0f", string(rs.Get(1)))
+	require.True(t, nulls.Contains(nsp, 2))
+	require.Equal(t, "jk", string(rs.Get(3)))
+	require.False(t, nulls.Contains(nsp, 3))
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package broadcast reads the row values of the arguments of the vectorized
+// functions. A constant argument is passed with a single value, which is
+// broadcast to all the rows.
+package broadcast
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// Bytes returns the string of row i, or the only string of xs if it is constant.
+func Bytes(xs *types.Bytes, i int) []byte {
+	if len(xs.Offsets) == 1 {
+		return xs.Get(0)
+	}
+	return xs.Get(int64(i))
+}
+
+// Value returns the value of row i, or the only value of xs if it is constant.
+func Value[T any](xs []T, i int) T {
+	if len(xs) == 1 {
+		return xs[0]
+	}
+	return xs[i]
+}
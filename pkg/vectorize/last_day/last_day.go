@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package last_day
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	DateLastDay     func([]types.Date, []types.Date) []types.Date
+	DatetimeLastDay func([]types.Datetime, []types.Date) []types.Date
+)
+
+func init() {
+	DateLastDay = dateLastDay
+	DatetimeLastDay = datetimeLastDay
+}
+
+func dateLastDay(xs []types.Date, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.LastDay()
+	}
+	return rs
+}
+
+func datetimeLastDay(xs []types.Datetime, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.ToDate().LastDay()
+	}
+	return rs
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package last_day
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastDay(t *testing.T) {
+	var xs []types.Date
+	for _, s := range []string{"2003-02-05", "2004-02-05", "2004-01-01"} {
+		d, err := types.ParseDate(s)
+		require.NoError(t, err)
+		xs = append(xs, d)
+	}
+	rs := dateLastDay(xs, make([]types.Date, 3))
+	require.Equal(t, "2003-02-28", rs[0].String())
+	require.Equal(t, "2004-02-29", rs[1].String())
+	require.Equal(t, "2004-01-31", rs[2].String())
+
+	dt, err := types.ParseDatetime("2003-03-05 01:02:03", 0)
+	require.NoError(t, err)
+	require.Equal(t, "2003-03-31", datetimeLastDay([]types.Datetime{dt}, make([]types.Date, 1))[0].String())
+}
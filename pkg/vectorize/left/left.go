@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Left func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Left = left
+}
+
+// left returns the leftmost ns[i] characters of xs[i]. The length is counted in
+// utf8 characters instead of bytes, and a non-positive length gives an empty
+// string. The length of LEFT(s, 3) is passed as a single value for all rows.
+func left(xs *types.Bytes, ns []int64, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, n := broadcast.Bytes(xs, i), broadcast.Value(ns, i)
+		end := 0
+		for ; n > 0 && end < len(x); n-- {
+			_, size := utf8.DecodeRune(x[end:])
+			end += size
+		}
+		rs.AppendOnce(x[:end])
+	}
+	return rs
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestLeft(t *testing.T) {
+	xs := makeBytes("foobarbar", "你好世界", "abc", "abc")
+	rs := left(xs, []int64{5, 2, 10, -1}, new(nulls.Nulls), 4, &types.Bytes{})
+	require.Equal(t, "fooba", string(rs.Get(0)))
+	require.Equal(t, "你好", string(rs.Get(1)))
+	require.Equal(t, "abc", string(rs.Get(2)))
+	require.Equal(t, "", string(rs.Get(3)))
+
+	// constant length
+	rs = left(xs, []int64{1}, new(nulls.Nulls), 4, &types.Bytes{})
+	require.Equal(t, "你", string(rs.Get(1)))
+}
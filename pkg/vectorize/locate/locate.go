@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locate
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Locate func(*types.Bytes, *types.Bytes, []int64, *nulls.Nulls, int, []int64) []int64
+)
+
+func init() {
+	Locate = locate
+}
+
+// locate returns the position of the first occurrence of substrs[i] in strs[i],
+// starting the search at character poss[i]. Positions are 1-based and counted in
+// utf8 characters, 0 means not found. poss is empty for the two-argument LOCATE,
+// which searches from the first character.
+func locate(substrs, strs *types.Bytes, poss []int64, nsp *nulls.Nulls, length int, rs []int64) []int64 {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		pos := int64(1)
+		if len(poss) > 0 {
+			pos = broadcast.Value(poss, i)
+		}
+		rs[i] = locateOne(broadcast.Bytes(substrs, i), broadcast.Bytes(strs, i), pos)
+	}
+	return rs
+}
+
+func locateOne(substr, str []byte, pos int64) int64 {
+	if pos < 1 {
+		return 0
+	}
+	// skip the first pos-1 characters
+	start := 0
+	for n := pos - 1; n > 0; n-- {
+		if start >= len(str) {
+			return 0
+		}
+		_, size := utf8.DecodeRune(str[start:])
+		start += size
+	}
+	idx := bytes.Index(str[start:], substr)
+	if idx < 0 {
+		return 0
+	}
+	return pos + int64(utf8.RuneCount(str[start:start+idx]))
+}
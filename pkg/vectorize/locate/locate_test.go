@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locate
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestLocate(t *testing.T) {
+	cases := []struct {
+		substr string
+		str    string
+		pos    int64
+		want   int64
+	}{
+		{"bar", "foobarbar", 1, 4},
+		{"xbar", "foobar", 1, 0},
+		{"bar", "foobarbar", 5, 7},
+		{"界", "你好世界", 1, 4},
+		{"", "abc", 1, 1},
+		{"", "abc", 4, 4},
+		{"", "abc", 5, 0},
+		{"a", "abc", 0, 0},
+		{"a", "abc", -1, 0},
+	}
+	for _, c := range cases {
+		rs := locate(makeBytes(c.substr), makeBytes(c.str), []int64{c.pos}, new(nulls.Nulls), 1, make([]int64, 1))
+		require.Equal(t, c.want, rs[0], "locate(%q, %q, %d)", c.substr, c.str, c.pos)
+	}
+
+	// without position
+	rs := locate(makeBytes("o"), makeBytes("foo", "bar"), nil, new(nulls.Nulls), 2, make([]int64, 2))
+	require.Equal(t, []int64{2, 0}, rs)
+}
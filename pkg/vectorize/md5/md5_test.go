@@ -0,0 +1,33 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package md5
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMd5(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"", "abc", "MatrixOne"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := md5Bytes(xs, &types.Bytes{})
+	require.Equal(t, "d41d8cd98f00b204e9800998ecf8427e", string(rs.Get(0)))
+	require.Equal(t, "900150983cd24fb0d6963f7d28e17f72", string(rs.Get(1)))
+	require.Equal(t, 32, len(rs.Get(2)))
+}
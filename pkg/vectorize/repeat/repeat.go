@@ -0,0 +1,59 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+// MaxResultLength is the maximum byte length of a repeated string, it is the same as
+// the default max_allowed_packet of MySQL. A longer result is NULL.
+var MaxResultLength = int64(64 << 20)
+
+var (
+	Repeat func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Repeat = repeat
+}
+
+// repeat concatenates ns[i] copies of xs[i], a count less than 1 gives an empty
+// string. Rows whose result would exceed MaxResultLength are added to nsp, so
+// a huge count gives NULL instead of exhausting the memory.
+func repeat(xs *types.Bytes, ns []int64, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, n := broadcast.Bytes(xs, i), broadcast.Value(ns, i)
+		if n < 1 || len(x) == 0 {
+			rs.AppendOnce(nil)
+			continue
+		}
+		if n > MaxResultLength/int64(len(x)) {
+			nulls.Add(nsp, uint64(i))
+			rs.AppendOnce(nil)
+			continue
+		}
+		rs.AppendOnce(bytes.Repeat(x, int(n)))
+	}
+	return rs
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Replace func(*types.Bytes, *types.Bytes, *types.Bytes, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Replace = replace
+}
+
+// replace substitutes all occurrences of froms[i] in xs[i] with tos[i], the
+// comparison is case-sensitive like MySQL's REPLACE. An empty search string
+// leaves the input unchanged.
+func replace(xs, froms, tos *types.Bytes, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, from := broadcast.Bytes(xs, i), broadcast.Bytes(froms, i)
+		if len(from) == 0 {
+			rs.AppendOnce(x)
+			continue
+		}
+		rs.AppendOnce(bytes.ReplaceAll(x, from, broadcast.Bytes(tos, i)))
+	}
+	return rs
+}
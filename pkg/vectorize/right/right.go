@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Right func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Right = right
+}
+
+// right returns the rightmost ns[i] characters of xs[i]. The length is counted in
+// utf8 characters instead of bytes, and a non-positive length gives an empty
+// string, e.g. RIGHT('héllo', 4) is 'éllo'.
+func right(xs *types.Bytes, ns []int64, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, n := broadcast.Bytes(xs, i), broadcast.Value(ns, i)
+		start := len(x)
+		for ; n > 0 && start > 0; n-- {
+			_, size := utf8.DecodeLastRune(x[:start])
+			start -= size
+		}
+		rs.AppendOnce(x[start:])
+	}
+	return rs
+}
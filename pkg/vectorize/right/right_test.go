@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestRight(t *testing.T) {
+	xs := makeBytes("foobarbar", "你好世界", "abc", "abc")
+	rs := right(xs, []int64{4, 2, 10, 0}, new(nulls.Nulls), 4, &types.Bytes{})
+	require.Equal(t, "rbar", string(rs.Get(0)))
+	require.Equal(t, "世界", string(rs.Get(1)))
+	require.Equal(t, "abc", string(rs.Get(2)))
+	require.Equal(t, "", string(rs.Get(3)))
+
+	// constant string
+	rs = right(makeBytes("hello"), []int64{1, 3}, new(nulls.Nulls), 2, &types.Bytes{})
+	require.Equal(t, "o", string(rs.Get(0)))
+	require.Equal(t, "llo", string(rs.Get(1)))
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sha1
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Sha1 func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Sha1 = sha1Bytes
+}
+
+// sha1Bytes appends the SHA-1 digest of each string in xs to rs,
+// the digest is formatted as a string of 40 lowercase hexadecimal digits.
+func sha1Bytes(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	buf := make([]byte, hex.EncodedLen(sha1.Size))
+	for i := range xs.Offsets {
+		sum := sha1.Sum(xs.Get(int64(i)))
+		hex.Encode(buf, sum[:])
+		rs.AppendOnce(buf)
+	}
+	return rs
+}
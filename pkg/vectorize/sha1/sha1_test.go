@@ -0,0 +1,32 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sha1
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSha1(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"", "abc"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := sha1Bytes(xs, &types.Bytes{})
+	require.Equal(t, "da39a3ee5e6b4b0d3255bfef95601890afd80709", string(rs.Get(0)))
+	require.Equal(t, "a9993e364706816aba3e25717850c26c9cd0d89d", string(rs.Get(1)))
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sha2
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Sha2 func(*types.Bytes, []int64, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Sha2 = sha2
+}
+
+// sha2 appends the SHA-2 digest of each string in xs to rs as lowercase hexadecimal digits.
+// The hash length can be 224, 256, 384, 512 or 0 (which is equivalent to 256),
+// the result is NULL for any other hash length, and the row is added to nsp.
+func sha2(xs *types.Bytes, lens []int64, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, n := broadcast.Bytes(xs, i), broadcast.Value(lens, i)
+		var sum []byte
+		switch n {
+		case 0, 256:
+			s := sha256.Sum256(x)
+			sum = s[:]
+		case 224:
+			s := sha256.Sum224(x)
+			sum = s[:]
+		case 384:
+			s := sha512.Sum384(x)
+			sum = s[:]
+		case 512:
+			s := sha512.Sum512(x)
+			sum = s[:]
+		default:
+			nulls.Add(nsp, uint64(i))
+			rs.AppendOnce(nil)
+			continue
+		}
+		buf := make([]byte, hex.EncodedLen(len(sum)))
+		hex.Encode(buf, sum)
+		rs.AppendOnce(buf)
+	}
+	return rs
+}
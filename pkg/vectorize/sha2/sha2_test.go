@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sha2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSha2(t *testing.T) {
+	xs := &types.Bytes{}
+	xs.AppendOnce([]byte("abc"))
+	nsp := new(nulls.Nulls)
+	rs := sha2(xs, []int64{0, 224, 384, 512, 100}, nsp, 5, &types.Bytes{})
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", string(rs.Get(0)))
+	require.Equal(t, "23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7", string(rs.Get(1)))
+	require.Equal(t, 96, len(rs.Get(2)))
+	require.Equal(t, 128, len(rs.Get(3)))
+	require.False(t, nulls.Contains(nsp, 3))
+	require.True(t, nulls.Contains(nsp, 4))
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package str_to_date
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	StrToDate func(*types.Bytes, *types.Bytes, *nulls.Nulls, int, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	StrToDate = strToDate
+}
+
+// strToDate parses each string of xs by the format of mysql's STR_TO_DATE,
+// the result is null if the string cannot be parsed, and the row is added to nsp.
+func strToDate(xs *types.Bytes, formats *types.Bytes, nsp *nulls.Nulls, length int, rs []types.Datetime) []types.Datetime {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x, format := broadcast.Bytes(xs, i), broadcast.Bytes(formats, i)
+		r, err := types.ParseDatetimeWithFormat(string(x), string(format))
+		if err != nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = r
+	}
+	return rs
+}
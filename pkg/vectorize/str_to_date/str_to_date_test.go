@@ -0,0 +1,39 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package str_to_date
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrToDate(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"01,5,2013", "", "31,2,2013", "15,12,1999"} {
+		xs.AppendOnce([]byte(s))
+	}
+	formats := &types.Bytes{}
+	formats.AppendOnce([]byte("%d,%m,%Y"))
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := strToDate(xs, formats, nsp, 4, make([]types.Datetime, 4))
+	require.Equal(t, "2013-05-01 00:00:00", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+	require.True(t, nulls.Contains(nsp, 2))
+	require.Equal(t, "1999-12-15 00:00:00", rs[3].String())
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timestampadd
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	DateAdd     func(types.IntervalType, []int64, []types.Date, *nulls.Nulls, int, []types.Date) []types.Date
+	DatetimeAdd func(types.IntervalType, []int64, []types.Datetime, *nulls.Nulls, int, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	DateAdd = dateAdd
+	DatetimeAdd = datetimeAdd
+}
+
+// dateAdd adds ns intervals of its to xs, the unit should be day or larger.
+// The result is null if it is out of the range of date.
+func dateAdd(its types.IntervalType, ns []int64, xs []types.Date, nsp *nulls.Nulls, length int, rs []types.Date) []types.Date {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		n, x := broadcast.Value(ns, i), broadcast.Value(xs, i)
+		if r, ok := x.ToTime().AddInterval(n, its, true); ok {
+			rs[i] = r.ToDate()
+		} else {
+			nulls.Add(nsp, uint64(i))
+		}
+	}
+	return rs
+}
+
+// datetimeAdd adds ns intervals of its to xs.
+// The result is null if it is out of the range of datetime.
+func datetimeAdd(its types.IntervalType, ns []int64, xs []types.Datetime, nsp *nulls.Nulls, length int, rs []types.Datetime) []types.Datetime {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		n, x := broadcast.Value(ns, i), broadcast.Value(xs, i)
+		if r, ok := x.AddInterval(n, its, false); ok {
+			rs[i] = r
+		} else {
+			nulls.Add(nsp, uint64(i))
+		}
+	}
+	return rs
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timestampadd
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateAdd(t *testing.T) {
+	d := func(s string) types.Date {
+		v, err := types.ParseDate(s)
+		require.NoError(t, err)
+		return v
+	}
+	nsp := new(nulls.Nulls)
+	rs := dateAdd(types.Week, []int64{1, -1, 1000000}, []types.Date{d("2003-01-02")}, nsp, 3, make([]types.Date, 3))
+	require.Equal(t, "2003-01-09", rs[0].String())
+	require.Equal(t, "2002-12-26", rs[1].String())
+	require.True(t, nulls.Contains(nsp, 2))
+
+	rs = dateAdd(types.Month, []int64{1}, []types.Date{d("2022-01-31"), d("2022-02-28")}, new(nulls.Nulls), 2, make([]types.Date, 2))
+	require.Equal(t, "2022-02-28", rs[0].String())
+	require.Equal(t, "2022-03-28", rs[1].String())
+}
+
+func TestDatetimeAdd(t *testing.T) {
+	dt := func(s string) types.Datetime {
+		v, err := types.ParseDatetime(s, 6)
+		require.NoError(t, err)
+		return v
+	}
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := datetimeAdd(types.Minute, []int64{1, 2}, []types.Datetime{dt("2003-01-02 23:59:00"), dt("2003-01-02")}, nsp, 2, make([]types.Datetime, 2))
+	require.Equal(t, "2003-01-03 00:00:00", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timestampdiff
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	TimestampDiff func(types.IntervalType, []types.Datetime, []types.Datetime, *nulls.Nulls, int, []int64) ([]int64, error)
+)
+
+func init() {
+	TimestampDiff = timestampDiff
+}
+
+// timestampDiff returns ys - xs in the unit of its for each row which is not null,
+// the partial unit is truncated toward zero.
+func timestampDiff(its types.IntervalType, xs, ys []types.Datetime, nsp *nulls.Nulls, length int, rs []int64) ([]int64, error) {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x, y := broadcast.Value(xs, i), broadcast.Value(ys, i)
+		r, err := types.DatetimeDiff(its, x, y)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timestampdiff
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampDiff(t *testing.T) {
+	dt := func(s string) types.Datetime {
+		v, err := types.ParseDatetime(s, 6)
+		require.NoError(t, err)
+		return v
+	}
+	xs := []types.Datetime{dt("2003-02-01"), dt("2003-02-01"), dt("2003-05-01")}
+	ys := []types.Datetime{dt("2003-05-01"), dt("2003-05-01 12:05:55"), dt("2003-02-01")}
+
+	rs, err := timestampDiff(types.Month, xs, ys, new(nulls.Nulls), 3, make([]int64, 3))
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 3, -3}, rs)
+
+	rs, err = timestampDiff(types.Minute, xs[:1], ys[1:2], new(nulls.Nulls), 1, make([]int64, 1))
+	require.NoError(t, err)
+	require.Equal(t, []int64{128885}, rs)
+
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs, err = timestampDiff(types.Day, xs[:1], ys, nsp, 3, make([]int64, 3))
+	require.NoError(t, err)
+	require.Equal(t, []int64{89, 0, 0}, rs)
+
+	_, err = timestampDiff(types.Year_Month, xs, ys, new(nulls.Nulls), 3, make([]int64, 3))
+	require.Error(t, err)
+}
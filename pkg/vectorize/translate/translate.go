@@ -0,0 +1,87 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+var (
+	Translate func(*types.Bytes, *types.Bytes, *types.Bytes, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Translate = translate
+}
+
+// translate replaces each character of xs[i] which appears in froms[i] with the
+// character at the same position of tos[i]. If froms[i] is longer than tos[i], the
+// extra characters are removed from the result, and if a character appears more
+// than once in froms[i], only the first one is used. All positions are counted in
+// utf8 characters. The mapping is built once if both froms and tos are constant,
+// which is the usual TRANSLATE(col, 'abc', 'xyz').
+func translate(xs, froms, tos *types.Bytes, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	var mapping map[rune]rune
+	constMapping := len(froms.Offsets) == 1 && len(tos.Offsets) == 1
+	if constMapping {
+		mapping = buildMapping(froms.Get(0), tos.Get(0))
+	}
+
+	for i := 0; i < length; i++ {
+		offset := uint32(len(rs.Data))
+		if !nulls.Contains(nsp, uint64(i)) {
+			if !constMapping {
+				mapping = buildMapping(broadcast.Bytes(froms, i), broadcast.Bytes(tos, i))
+			}
+			x := broadcast.Bytes(xs, i)
+			for len(x) > 0 {
+				r, size := utf8.DecodeRune(x)
+				if to, ok := mapping[r]; !ok {
+					rs.Data = append(rs.Data, x[:size]...)
+				} else if to >= 0 {
+					rs.Data = utf8.AppendRune(rs.Data, to)
+				}
+				x = x[size:]
+			}
+		}
+		rs.Offsets = append(rs.Offsets, offset)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-offset)
+	}
+	return rs
+}
+
+// buildMapping returns the character mapping from from to to, a character
+// mapped to -1 should be removed.
+func buildMapping(from, to []byte) map[rune]rune {
+	mapping := make(map[rune]rune)
+	for len(from) > 0 {
+		r, size := utf8.DecodeRune(from)
+		from = from[size:]
+		target := rune(-1)
+		if len(to) > 0 {
+			var n int
+			target, n = utf8.DecodeRune(to)
+			to = to[n:]
+		}
+		if _, ok := mapping[r]; !ok {
+			mapping[r] = target
+		}
+	}
+	return mapping
+}
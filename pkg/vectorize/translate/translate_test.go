@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestTranslate(t *testing.T) {
+	rs := translate(makeBytes("12345", "abcabc", "你好世界"), makeBytes("143"), makeBytes("ax"),
+		new(nulls.Nulls), 3, &types.Bytes{})
+	require.Equal(t, "a2x5", string(rs.Get(0)))
+	require.Equal(t, "abcabc", string(rs.Get(1)))
+	require.Equal(t, "你好世界", string(rs.Get(2)))
+
+	// non-constant mapping and utf8 characters
+	rs = translate(makeBytes("你好世界", "aab"), makeBytes("世界", "aa"), makeBytes("天下", "xy"),
+		new(nulls.Nulls), 2, &types.Bytes{})
+	require.Equal(t, "你好天下", string(rs.Get(0)))
+	require.Equal(t, "xxb", string(rs.Get(1)))
+}
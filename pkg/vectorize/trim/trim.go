@@ -0,0 +1,82 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/internal/broadcast"
+)
+
+// Mode tells which side of a string should be trimmed.
+type Mode int
+
+const (
+	Both Mode = iota
+	Leading
+	Trailing
+)
+
+var (
+	Trim func(Mode, *types.Bytes, *types.Bytes, *nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Trim = trim
+}
+
+// ParseMode converts the direction keyword of TRIM([BOTH | LEADING | TRAILING] ...)
+// to a Mode, it is case-insensitive.
+func ParseMode(direction []byte) (Mode, bool) {
+	switch string(bytes.ToLower(direction)) {
+	case "both":
+		return Both, true
+	case "leading":
+		return Leading, true
+	case "trailing":
+		return Trailing, true
+	}
+	return Both, false
+}
+
+// trim removes all the repeated prefixes and/or suffixes equal to remstrs[i] from
+// xs[i]. Unlike ltrim and rtrim, the removed string can be longer than one byte,
+// e.g. TRIM(LEADING 'xy' FROM 'xyxyz') is 'z'. An empty remstr leaves xs[i]
+// unchanged, and rows contained in nsp get an empty result.
+func trim(mode Mode, xs, remstrs *types.Bytes, nsp *nulls.Nulls, length int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < length; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.AppendOnce(nil)
+			continue
+		}
+		x, remstr := broadcast.Bytes(xs, i), broadcast.Bytes(remstrs, i)
+		if len(remstr) > 0 {
+			if mode != Trailing {
+				for bytes.HasPrefix(x, remstr) {
+					x = x[len(remstr):]
+				}
+			}
+			if mode != Leading {
+				for bytes.HasSuffix(x, remstr) {
+					x = x[:len(x)-len(remstr)]
+				}
+			}
+		}
+		rs.AppendOnce(x)
+	}
+	return rs
+}
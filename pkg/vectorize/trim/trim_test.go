@@ -0,0 +1,60 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(values ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, v := range values {
+		xs.AppendOnce([]byte(v))
+	}
+	return xs
+}
+
+func TestTrim(t *testing.T) {
+	cases := []struct {
+		mode   Mode
+		remstr string
+		input  string
+		want   string
+	}{
+		{Both, " ", "  bar   ", "bar"},
+		{Leading, "x", "xxxbarxxx", "barxxx"},
+		{Trailing, "xyz", "barxxyz", "barx"},
+		{Both, "xy", "xyxyzxy", "z"},
+		{Both, "你", "你好你", "好"},
+		{Both, "", " a ", " a "},
+		{Both, "a", "aaa", ""},
+	}
+	for _, c := range cases {
+		rs := trim(c.mode, makeBytes(c.input), makeBytes(c.remstr), new(nulls.Nulls), 1, &types.Bytes{})
+		require.Equal(t, c.want, string(rs.Get(0)))
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	mode, ok := ParseMode([]byte("LEADING"))
+	require.True(t, ok)
+	require.Equal(t, Leading, mode)
+	_, ok = ParseMode([]byte("middle"))
+	require.False(t, ok)
+}
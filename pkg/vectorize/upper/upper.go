@@ -0,0 +1,41 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upper
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	UpperChar    func(*types.Bytes, *types.Bytes) *types.Bytes
+	UpperVarChar func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	UpperChar = upper
+	UpperVarChar = upper
+}
+
+// upper maps every string of xs to its upper case and appends it to rs.
+// The case mapping is done rune by rune, so the byte length of a result
+// may differ from its input for some non-ASCII characters.
+func upper(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		rs.AppendOnce(bytes.ToUpper(xs.Get(int64(i))))
+	}
+	return rs
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upper
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpper(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{"abc", "Hello World", "", "ñandú", "你好 ab"} {
+		xs.AppendOnce([]byte(s))
+	}
+	rs := upper(xs, &types.Bytes{})
+	want := []string{"ABC", "HELLO WORLD", "", "ÑANDÚ", "你好 AB"}
+	for i, w := range want {
+		require.Equal(t, w, string(rs.Get(int64(i))))
+	}
+}
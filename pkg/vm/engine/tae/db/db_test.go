@@ -34,6 +34,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/txnentries"
@@ -2170,3 +2171,98 @@ func TestNull2(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, txn.Commit())
 }
+
+func TestBlockMayMatch(t *testing.T) {
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockData(schema, schema.BlockMaxRows*4)
+	tae.createRelAndAppend(bat, true)
+
+	countMatched := func(filter *handle.Filter) (cnt int) {
+		txn, rel := tae.getRelation()
+		forEachBlock(rel, func(blk handle.Block) error {
+			ok, err := blk.MayMatch(filter)
+			assert.NoError(t, err)
+			if ok {
+				cnt++
+			}
+			return nil
+		})
+		assert.NoError(t, txn.Commit())
+		return
+	}
+	check := func() {
+		v5 := getSingleSortKeyValue(bat, schema, 5)
+		v35 := getSingleSortKeyValue(bat, schema, 35)
+		v39 := getSingleSortKeyValue(bat, schema, 39)
+		assert.Equal(t, 1, countMatched(handle.NewEQFilter(v5)))
+		assert.Equal(t, 4, countMatched(&handle.Filter{Op: handle.FilterLe, Val: v39}))
+		assert.Equal(t, 0, countMatched(&handle.Filter{Op: handle.FilterGt, Val: v39}))
+		assert.Equal(t, 1, countMatched(&handle.Filter{Op: handle.FilterGe, Val: v35}))
+		keys := compute.MockVec(schema.GetSingleSortKey().Type, 0, 0)
+		compute.AppendValue(keys, v5)
+		compute.AppendValue(keys, v35)
+		assert.Equal(t, 2, countMatched(&handle.Filter{Op: handle.FilterBatchEq, Col: keys}))
+	}
+	// check by the zonemap of appendable blocks
+	check()
+	// check by the zonemap and bloomfilter of non-appendable blocks
+	tae.compactBlocks(false)
+	check()
+}
+
+func TestBlockSummary(t *testing.T) {
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockData(schema, schema.BlockMaxRows*4)
+	tae.createRelAndAppend(bat, true)
+
+	summaries := func() (ss []*model.BlockSummary) {
+		txn, rel := tae.getRelation()
+		forEachBlock(rel, func(blk handle.Block) error {
+			ss = append(ss, blk.GetSummary())
+			return nil
+		})
+		assert.NoError(t, txn.Commit())
+		return
+	}
+	// appendable blocks have no summary
+	for _, summary := range summaries() {
+		assert.Nil(t, summary)
+	}
+
+	tae.compactBlocks(false)
+	ss := summaries()
+	assert.Equal(t, 4, len(ss))
+	rows := 0
+	for _, summary := range ss {
+		assert.NotNil(t, summary)
+		rows += int(summary.Rows)
+		assert.NotNil(t, summary.SortKeyMin)
+		assert.NotNil(t, summary.SortKeyMax)
+	}
+	assert.Equal(t, 40, rows)
+
+	// a block with deletes has no summary
+	txn, rel := tae.getRelation()
+	filter := handle.NewEQFilter(getSingleSortKeyValue(bat, schema, 5))
+	assert.NoError(t, rel.DeleteByFilter(filter))
+	assert.NoError(t, txn.Commit())
+	cnt := 0
+	for _, summary := range summaries() {
+		if summary == nil {
+			cnt++
+		}
+	}
+	assert.Equal(t, 1, cnt)
+}
@@ -71,6 +71,8 @@ type Block interface {
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector, rowmask *roaring.Bitmap) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	MayMatch(filter *handle.Filter) (bool, error)
+	GetSummary() *model.BlockSummary
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (any, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
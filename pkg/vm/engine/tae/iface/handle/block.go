@@ -36,6 +36,10 @@ const (
 	FilterEq FilterOp = iota
 	FilterBatchEq
 	FilterBtw
+	FilterLt
+	FilterLe
+	FilterGt
+	FilterGe
 )
 
 type Filter struct {
@@ -57,6 +61,12 @@ type BlockReader interface {
 	String() string
 	IsUncommitted() bool
 	GetByFilter(filter *Filter) (uint32, error)
+	// MayMatch returns false only if no row of the block can satisfy the
+	// filter. It is used to skip blocks before reading any column data
+	MayMatch(filter *Filter) (bool, error)
+	// GetSummary returns the summary of the block from the persisted
+	// metadata, or nil if the block has any change only in memory
+	GetSummary() *model.BlockSummary
 	GetColumnDataByName(string, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(int, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
 	GetMeta() any
@@ -105,6 +105,26 @@ func (zm *ZoneMap) ContainsAny(keys *vector.Vector) (visibility *roaring.Bitmap,
 	return
 }
 
+// AnyLT returns whether any key in the zonemap may be less than key
+func (zm *ZoneMap) AnyLT(key any) bool {
+	return zm.inited && compute.CompareGeneric(zm.min, key, zm.typ) < 0
+}
+
+// AnyLE returns whether any key in the zonemap may be less than or equal to key
+func (zm *ZoneMap) AnyLE(key any) bool {
+	return zm.inited && compute.CompareGeneric(zm.min, key, zm.typ) <= 0
+}
+
+// AnyGT returns whether any key in the zonemap may be greater than key
+func (zm *ZoneMap) AnyGT(key any) bool {
+	return zm.inited && compute.CompareGeneric(zm.max, key, zm.typ) > 0
+}
+
+// AnyGE returns whether any key in the zonemap may be greater than or equal to key
+func (zm *ZoneMap) AnyGE(key any) bool {
+	return zm.inited && compute.CompareGeneric(zm.max, key, zm.typ) >= 0
+}
+
 func (zm *ZoneMap) SetMax(v any) {
 	if !zm.inited {
 		zm.min = v
@@ -137,6 +157,10 @@ func (zm *ZoneMap) GetMin() any {
 	return zm.min
 }
 
+func (zm *ZoneMap) IsInited() bool {
+	return zm.inited
+}
+
 // func (zm *ZoneMap) Print() string {
 // 	// default int32
 // 	s := "<ZM>\n["
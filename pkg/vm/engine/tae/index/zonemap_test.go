@@ -120,6 +120,29 @@ func TestZoneMapNumeric(t *testing.T) {
 	require.True(t, yes)
 }
 
+func TestZoneMapRange(t *testing.T) {
+	typ := types.Type{Oid: types.Type_INT32}
+	zm := NewZoneMap(typ)
+	require.False(t, zm.AnyLT(int32(0)))
+	require.False(t, zm.AnyGE(int32(0)))
+
+	ctx := new(KeysCtx)
+	ctx.Keys = compute.MockVec(typ, 100, 100)
+	ctx.SelectAll()
+	err := zm.BatchUpdate(ctx)
+	require.NoError(t, err)
+
+	require.False(t, zm.AnyLT(int32(100)))
+	require.True(t, zm.AnyLT(int32(101)))
+	require.False(t, zm.AnyLE(int32(99)))
+	require.True(t, zm.AnyLE(int32(100)))
+
+	require.False(t, zm.AnyGT(int32(199)))
+	require.True(t, zm.AnyGT(int32(198)))
+	require.False(t, zm.AnyGE(int32(200)))
+	require.True(t, zm.AnyGE(int32(199)))
+}
+
 func TestZoneMapString(t *testing.T) {
 	typ := types.Type{Oid: types.Type_CHAR}
 	zm := NewZoneMap(typ)
@@ -0,0 +1,23 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// BlockSummary is the summary of a persisted block without any in-memory
+// change. It can answer aggregates without reading the column data
+type BlockSummary struct {
+	Rows uint32
+	// Min and max of the sort key from the zonemap. Nil if not available
+	SortKeyMin, SortKeyMax any
+}
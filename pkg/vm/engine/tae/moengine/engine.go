@@ -18,6 +18,7 @@ import (
 	"runtime"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
@@ -37,6 +38,9 @@ func (e *txnEngine) Delete(_ uint64, name string, ctx engine.Snapshot) (err erro
 	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
 		panic(err)
 	}
+	if name == InfoSchemaName {
+		return catalog.ErrNotPermitted
+	}
 	_, err = txn.DropDatabase(name)
 	return
 }
@@ -64,6 +68,10 @@ func (e *txnEngine) Database(name string, ctx engine.Snapshot) (db engine.Databa
 	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
 		panic(err)
 	}
+	// the tables of the information_schema are computed from the catalog
+	if name == InfoSchemaName {
+		return newInfoSchemaDatabase(txn), nil
+	}
 	h, err := txn.GetDatabase(name)
 	if err != nil {
 		return nil, err
@@ -1,13 +1,21 @@
 package moengine
 
 import (
+	"errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"math"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
@@ -297,3 +305,258 @@ func TestTxnRelation_Update(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, data.ErrUpdateUniqueKey, err)
 }
+
+func TestTxnReader_PushDownFilter(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	err = h.Append(catalog.MockData(schema, 40))
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	pk := &extend.Attribute{Name: schema.ColDefs[2].Name, Type: types.T_int32}
+	value := func(v int64) *extend.ValueExtend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.NoError(t, vector.Append(vec, []int64{v}))
+		return &extend.ValueExtend{V: vec}
+	}
+	kases := []struct {
+		filter             extend.Extend
+		rows, read, pruned int
+	}{
+		{
+			filter: nil,
+			rows:   40, read: 4, pruned: 0,
+		},
+		{
+			filter: &extend.BinaryExtend{Op: overload.LT, Left: pk, Right: value(15)},
+			rows:   20, read: 2, pruned: 2,
+		},
+		{
+			filter: &extend.BinaryExtend{Op: overload.LE, Left: value(30), Right: pk},
+			rows:   10, read: 1, pruned: 3,
+		},
+		{
+			filter: &extend.BinaryExtend{
+				Op:    overload.And,
+				Left:  &extend.BinaryExtend{Op: overload.GE, Left: pk, Right: value(12)},
+				Right: &extend.BinaryExtend{Op: overload.LE, Left: pk, Right: value(18)},
+			},
+			rows: 10, read: 1, pruned: 3,
+		},
+		{
+			filter: &extend.BinaryExtend{
+				Op:    overload.Or,
+				Left:  &extend.BinaryExtend{Op: overload.EQ, Left: pk, Right: value(5)},
+				Right: &extend.BinaryExtend{Op: overload.EQ, Left: pk, Right: value(35)},
+			},
+			rows: 20, read: 2, pruned: 2,
+		},
+		{
+			filter: &extend.BinaryExtend{Op: overload.GT, Left: pk, Right: value(100)},
+			rows:   0, read: 0, pruned: 4,
+		},
+	}
+
+	e := NewEngine(tae)
+	etxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", etxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, etxn.GetCtx())
+	assert.Nil(t, err)
+	for i, kase := range kases {
+		reader := rel.NewReader(1, kase.filter, nil, nil)[0]
+		rows := 0
+		for {
+			bat, err := reader.Read([]uint64{uint64(1)}, []string{schema.ColDefs[2].Name})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+		read, pruned := reader.(*txnReader).Stats()
+		assert.Equal(t, kase.rows, rows, "kase %d", i)
+		assert.Equal(t, kase.read, read, "kase %d", i)
+		assert.Equal(t, kase.pruned, pruned, "kase %d", i)
+	}
+	assert.Nil(t, etxn.Commit())
+}
+
+func TestTxnSummarizer(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockData(schema, 40)
+	bats := compute.SplitBatch(bat, 2)
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	err = h.Append(bats[0])
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae)
+	etxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", etxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, etxn.GetCtx())
+	assert.Nil(t, err)
+	// delete the min key and append the rest uncommitted
+	pk := schema.ColDefs[2].Name
+	keys := compute.MockVec(schema.ColDefs[2].Type, 0, 0)
+	compute.AppendValue(keys, compute.GetValue(bat.Vecs[2], 0))
+	assert.Nil(t, rel.Delete(0, keys, pk, nil))
+	assert.Nil(t, rel.Write(0, bats[1], nil))
+
+	summarizer := rel.NewReader(1, nil, nil, nil)[0].(engine.SummarizerReader).NewSummarizer()
+	cnt, err := summarizer.Count(pk, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(39), cnt)
+	cnt, err = summarizer.Count(schema.HiddenKey.Name, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(39), cnt)
+	cnt, err = summarizer.NullCount(schema.ColDefs[0].Name, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), cnt)
+	min, err := summarizer.Min(pk, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, compute.GetValue(bat.Vecs[2], 1), min)
+	max, err := summarizer.Max(pk, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, compute.GetValue(bat.Vecs[2], 39), max)
+	sum, cnt, err := summarizer.Sum(pk, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(39), cnt)
+	expect := int64(0)
+	for i := 1; i < 40; i++ {
+		expect += int64(compute.GetValue(bat.Vecs[2], uint32(i)).(int32))
+	}
+	assert.Equal(t, expect, sum)
+
+	_, err = summarizer.Count("xxx", nil)
+	assert.Error(t, err)
+	assert.Nil(t, etxn.Commit())
+}
+
+func TestTxnSummarizerSumNotSupported(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(8, 3)
+	bat := catalog.MockData(schema, 4)
+	// the sum of the int64 keys overflows
+	keys := bat.Vecs[3].Col.([]int64)
+	for i := range keys {
+		keys[i] = math.MaxInt64 - int64(i)
+	}
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae)
+	etxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", etxn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, etxn.GetCtx())
+	assert.Nil(t, err)
+	summarizer := rel.NewReader(1, nil, nil, nil)[0].(engine.SummarizerReader).NewSummarizer()
+	_, _, err = summarizer.Sum(schema.ColDefs[3].Name, nil)
+	assert.True(t, errors.Is(err, ErrSummaryNotSupported))
+	// unsigned
+	_, _, err = summarizer.Sum(schema.ColDefs[7].Name, nil)
+	assert.True(t, errors.Is(err, ErrSummaryNotSupported))
+	sum, cnt, err := summarizer.Sum(schema.ColDefs[0].Name, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), cnt)
+	expect := int64(0)
+	for _, v := range bat.Vecs[0].Col.([]int8) {
+		expect += int64(v)
+	}
+	assert.Equal(t, expect, sum)
+	assert.Nil(t, etxn.Commit())
+}
+
+func TestInfoSchema(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	txn, err := tae.StartTxn(nil)
+	assert.Nil(t, err)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	h, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, h.Append(catalog.MockData(schema, 20)))
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae)
+	etxn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database(InfoSchemaName, etxn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(dbase.Relations(etxn.GetCtx())))
+	_, err = dbase.Relation("xxx", etxn.GetCtx())
+	assert.Equal(t, catalog.ErrNotFound, err)
+	assert.Equal(t, catalog.ErrNotPermitted, e.Delete(0, InfoSchemaName, etxn.GetCtx()))
+
+	read := func(table string, attrs ...string) *batch.Batch {
+		rel, err := dbase.Relation(table, etxn.GetCtx())
+		assert.Nil(t, err)
+		refs := make([]uint64, len(attrs))
+		bat, err := rel.NewReader(1, nil, nil, etxn.GetCtx())[0].Read(refs, attrs)
+		assert.Nil(t, err)
+		return bat
+	}
+
+	str := func(vec *vector.Vector, row int) string {
+		return string(vec.Col.(*types.Bytes).Get(int64(row)))
+	}
+
+	bat := read(InfoSchemaSchemata, "schema_name")
+	assert.Equal(t, "db", str(bat.Vecs[0], 0))
+	assert.Equal(t, InfoSchemaName, str(bat.Vecs[0], 1))
+	assert.Equal(t, catalog.SystemDBName, str(bat.Vecs[0], 2))
+
+	bat = read("TABLES", "table_schema", "table_name", "table_rows")
+	assert.Equal(t, "db", str(bat.Vecs[0], 0))
+	assert.Equal(t, schema.Name, str(bat.Vecs[1], 0))
+	assert.Equal(t, uint64(20), bat.Vecs[2].Col.([]uint64)[0])
+
+	bat = read(InfoSchemaColumns, "table_name", "column_name", "ordinal_position", "column_key", "data_type")
+	for i := range schema.ColDefs[:3] {
+		assert.Equal(t, schema.Name, str(bat.Vecs[0], i))
+		assert.Equal(t, schema.ColDefs[i].Name, str(bat.Vecs[1], i))
+		assert.Equal(t, uint64(i+1), bat.Vecs[2].Col.([]uint64)[i])
+		assert.Equal(t, "int", str(bat.Vecs[4], i))
+	}
+	assert.Equal(t, "PRI", str(bat.Vecs[3], 2))
+
+	bat = read(InfoSchemaKeyColumnUsage, "table_name", "column_name", "referenced_table_name")
+	assert.Equal(t, schema.Name, str(bat.Vecs[0], 0))
+	assert.Equal(t, schema.ColDefs[2].Name, str(bat.Vecs[1], 0))
+	assert.True(t, nulls.Contains(bat.Vecs[2].Nsp, 0))
+	assert.Nil(t, etxn.Commit())
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+var filterOpMap = map[int]handle.FilterOp{
+	overload.EQ: handle.FilterEq,
+	overload.LT: handle.FilterLt,
+	overload.LE: handle.FilterLe,
+	overload.GT: handle.FilterGt,
+	overload.GE: handle.FilterGe,
+}
+
+// reversedFilterOpMap is used when the value is on the left: v < a => a > v
+var reversedFilterOpMap = map[handle.FilterOp]handle.FilterOp{
+	handle.FilterEq: handle.FilterEq,
+	handle.FilterLt: handle.FilterGt,
+	handle.FilterLe: handle.FilterGe,
+	handle.FilterGt: handle.FilterLt,
+	handle.FilterGe: handle.FilterLe,
+}
+
+// blockFilter is a conjunction of disjunctions of filters on the sort key.
+// A block can be skipped if any of the disjunctions cannot be matched
+type blockFilter [][]*handle.Filter
+
+// newBlockFilter extracts the predicates on the single sort key column
+// that can be pushed down to the block index, e.g. "a > 1",
+// "a between 1 and 10", "a = 1 or a = 2" and "a > 1 and b < 2"
+func newBlockFilter(schema *catalog.Schema, e extend.Extend) blockFilter {
+	if e == nil || !schema.IsSingleSortKey() {
+		return nil
+	}
+	def := schema.GetSingleSortKey()
+	conjuncts := extend.AndExtends(e, nil)
+	// AndExtends does not split a single disjunction
+	if len(conjuncts) == 0 {
+		conjuncts = []extend.Extend{e}
+	}
+	var bf blockFilter
+	for _, conjunct := range conjuncts {
+		if filters := getDisjunctFilters(def, conjunct, nil); len(filters) > 0 {
+			bf = append(bf, mergeEqFilters(def, filters))
+		}
+	}
+	return bf
+}
+
+// MayMatch returns false if the block cannot contain any row satisfying
+// the pushed down predicates
+func (bf blockFilter) MayMatch(blk handle.Block) (bool, error) {
+	for _, filters := range bf {
+		matched := false
+		for _, filter := range filters {
+			ok, err := blk.MayMatch(filter)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getDisjunctFilters returns nil if any branch of the disjunction cannot be
+// pushed down, as the whole disjunction is unknown then
+func getDisjunctFilters(def *catalog.ColDef, e extend.Extend, filters []*handle.Filter) []*handle.Filter {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return getDisjunctFilters(def, v.E, filters)
+	case *extend.BinaryExtend:
+		if v.Op == overload.Or {
+			if filters = getDisjunctFilters(def, v.Left, filters); filters == nil {
+				return nil
+			}
+			return getDisjunctFilters(def, v.Right, filters)
+		}
+		filter := newFilter(def, v)
+		if filter == nil {
+			return nil
+		}
+		return append(filters, filter)
+	}
+	return nil
+}
+
+func newFilter(def *catalog.ColDef, e *extend.BinaryExtend) *handle.Filter {
+	op, ok := filterOpMap[e.Op]
+	if !ok {
+		return nil
+	}
+	attr, ok := e.Left.(*extend.Attribute)
+	val, ok2 := e.Right.(*extend.ValueExtend)
+	if !ok || !ok2 {
+		if attr, ok = e.Right.(*extend.Attribute); !ok {
+			return nil
+		}
+		if val, ok = e.Left.(*extend.ValueExtend); !ok {
+			return nil
+		}
+		op = reversedFilterOpMap[op]
+	}
+	if attr.Name != def.Name {
+		return nil
+	}
+	v, ok := castValue(val.V, def.Type)
+	if !ok {
+		return nil
+	}
+	return &handle.Filter{Op: op, Val: v}
+}
+
+// mergeEqFilters merges "a = 1 or a = 2 or ..." into a batch filter so that
+// the keys can be checked against the index at once
+func mergeEqFilters(def *catalog.ColDef, filters []*handle.Filter) []*handle.Filter {
+	if len(filters) < 2 {
+		return filters
+	}
+	for _, filter := range filters {
+		if filter.Op != handle.FilterEq {
+			return filters
+		}
+	}
+	col := vector.New(def.Type)
+	for _, filter := range filters {
+		compute.AppendValue(col, filter.Val)
+	}
+	return []*handle.Filter{{Op: handle.FilterBatchEq, Col: col}}
+}
+
+// castValue converts the constant to the type of the column. Only lossless
+// conversions are done, otherwise a block might be skipped wrongly
+func castValue(vec *vector.Vector, typ types.Type) (any, bool) {
+	if vector.Length(vec) != 1 || nulls.Contains(vec.Nsp, 0) {
+		return nil, false
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return castInteger(vec, typ)
+	case types.T_float32, types.T_float64:
+		var v float64
+		switch vec.Typ.Oid {
+		case types.T_float32:
+			v = float64(vec.Col.([]float32)[0])
+		case types.T_float64:
+			v = vec.Col.([]float64)[0]
+		case types.T_int64:
+			v = float64(vec.Col.([]int64)[0])
+		default:
+			return nil, false
+		}
+		if typ.Oid == types.T_float64 {
+			return v, true
+		}
+		if float64(float32(v)) != v {
+			return nil, false
+		}
+		return float32(v), true
+	case types.T_char, types.T_varchar:
+		if vec.Typ.Oid != types.T_char && vec.Typ.Oid != types.T_varchar {
+			return nil, false
+		}
+		return vec.Col.(*types.Bytes).Get(0), true
+	case types.T_date:
+		switch vec.Typ.Oid {
+		case types.T_date:
+			return vec.Col.([]types.Date)[0], true
+		case types.T_char, types.T_varchar:
+			v, err := types.ParseDate(string(vec.Col.(*types.Bytes).Get(0)))
+			return v, err == nil
+		}
+	case types.T_datetime:
+		switch vec.Typ.Oid {
+		case types.T_datetime:
+			return vec.Col.([]types.Datetime)[0], true
+		case types.T_char, types.T_varchar:
+			v, err := types.ParseDatetime(string(vec.Col.(*types.Bytes).Get(0)), typ.Precision)
+			return v, err == nil
+		}
+	}
+	return nil, false
+}
+
+func castInteger(vec *vector.Vector, typ types.Type) (any, bool) {
+	var v int64
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		v = int64(vec.Col.([]int8)[0])
+	case types.T_int16:
+		v = int64(vec.Col.([]int16)[0])
+	case types.T_int32:
+		v = int64(vec.Col.([]int32)[0])
+	case types.T_int64:
+		v = vec.Col.([]int64)[0]
+	case types.T_uint8:
+		v = int64(vec.Col.([]uint8)[0])
+	case types.T_uint16:
+		v = int64(vec.Col.([]uint16)[0])
+	case types.T_uint32:
+		v = int64(vec.Col.([]uint32)[0])
+	case types.T_uint64:
+		u := vec.Col.([]uint64)[0]
+		if typ.Oid == types.T_uint64 {
+			return u, true
+		}
+		if u > math.MaxInt64 {
+			return nil, false
+		}
+		v = int64(u)
+	default:
+		return nil, false
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(v), v >= math.MinInt8 && v <= math.MaxInt8
+	case types.T_int16:
+		return int16(v), v >= math.MinInt16 && v <= math.MaxInt16
+	case types.T_int32:
+		return int32(v), v >= math.MinInt32 && v <= math.MaxInt32
+	case types.T_int64:
+		return v, true
+	case types.T_uint8:
+		return uint8(v), v >= 0 && v <= math.MaxUint8
+	case types.T_uint16:
+		return uint16(v), v >= 0 && v <= math.MaxUint16
+	case types.T_uint32:
+		return uint32(v), v >= 0 && v <= math.MaxUint32
+	case types.T_uint64:
+		return uint64(v), v >= 0
+	}
+	return nil, false
+}
@@ -0,0 +1,412 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+const (
+	InfoSchemaName = "information_schema"
+
+	InfoSchemaSchemata       = "schemata"
+	InfoSchemaTables         = "tables"
+	InfoSchemaColumns        = "columns"
+	InfoSchemaStatistics     = "statistics"
+	InfoSchemaKeyColumnUsage = "key_column_usage"
+)
+
+const (
+	infoSchemaCatalogName   = "def"
+	infoSchemaCharset       = "utf8mb4"
+	infoSchemaCollation     = "utf8mb4_bin"
+	infoSchemaEngine        = "TAE"
+	infoSchemaBaseTable     = "BASE TABLE"
+	infoSchemaSystemView    = "SYSTEM VIEW"
+	infoSchemaPrimaryKey    = "PRIMARY"
+	infoSchemaTableVersion  = uint64(10)
+	infoSchemaTableRowFmt   = "Dynamic"
+	infoSchemaNameWidth     = 64
+	infoSchemaTypeWidth     = 256
+	infoSchemaCommentWidth  = 2048
+	infoSchemaDefaultsWidth = 1024
+)
+
+// infoSchemaView is a read-only table of the information_schema. Its rows
+// are computed from the catalog visible to the transaction every time the
+// view is read, so they are never stored in TAE.
+type infoSchemaView struct {
+	name  string
+	attrs []engine.Attribute
+	rows  func(txn txnif.TxnHandle) ([][]any, error)
+}
+
+// infoSchemaTable is what the views need to know about a table
+type infoSchemaTable struct {
+	db      string
+	name    string
+	typ     string
+	engine  any
+	comment string
+	rows    uint64
+	cols    []*catalog.ColDef
+	pk      []string
+}
+
+var infoSchemaViews []*infoSchemaView
+
+func init() {
+	infoSchemaViews = []*infoSchemaView{
+		{
+			name: InfoSchemaSchemata,
+			attrs: []engine.Attribute{
+				stringAttr("catalog_name", infoSchemaNameWidth),
+				stringAttr("schema_name", infoSchemaNameWidth),
+				stringAttr("default_character_set_name", infoSchemaNameWidth),
+				stringAttr("default_collation_name", infoSchemaNameWidth),
+				stringAttr("sql_path", infoSchemaNameWidth),
+			},
+			rows: schemataRows,
+		},
+		{
+			name: InfoSchemaTables,
+			attrs: []engine.Attribute{
+				stringAttr("table_catalog", infoSchemaNameWidth),
+				stringAttr("table_schema", infoSchemaNameWidth),
+				stringAttr("table_name", infoSchemaNameWidth),
+				stringAttr("table_type", infoSchemaNameWidth),
+				stringAttr("engine", infoSchemaNameWidth),
+				uint64Attr("version"),
+				stringAttr("row_format", infoSchemaNameWidth),
+				uint64Attr("table_rows"),
+				uint64Attr("auto_increment"),
+				stringAttr("table_collation", infoSchemaNameWidth),
+				stringAttr("table_comment", infoSchemaCommentWidth),
+			},
+			rows: tablesRows,
+		},
+		{
+			name: InfoSchemaColumns,
+			attrs: []engine.Attribute{
+				stringAttr("table_catalog", infoSchemaNameWidth),
+				stringAttr("table_schema", infoSchemaNameWidth),
+				stringAttr("table_name", infoSchemaNameWidth),
+				stringAttr("column_name", infoSchemaNameWidth),
+				uint64Attr("ordinal_position"),
+				stringAttr("column_default", infoSchemaDefaultsWidth),
+				stringAttr("is_nullable", 3),
+				stringAttr("data_type", infoSchemaNameWidth),
+				uint64Attr("character_maximum_length"),
+				uint64Attr("numeric_precision"),
+				uint64Attr("numeric_scale"),
+				uint64Attr("datetime_precision"),
+				stringAttr("character_set_name", infoSchemaNameWidth),
+				stringAttr("collation_name", infoSchemaNameWidth),
+				stringAttr("column_type", infoSchemaTypeWidth),
+				stringAttr("column_key", 3),
+				stringAttr("extra", infoSchemaNameWidth),
+				stringAttr("column_comment", infoSchemaCommentWidth),
+			},
+			rows: columnsRows,
+		},
+		{
+			name: InfoSchemaStatistics,
+			attrs: []engine.Attribute{
+				stringAttr("table_catalog", infoSchemaNameWidth),
+				stringAttr("table_schema", infoSchemaNameWidth),
+				stringAttr("table_name", infoSchemaNameWidth),
+				uint64Attr("non_unique"),
+				stringAttr("index_schema", infoSchemaNameWidth),
+				stringAttr("index_name", infoSchemaNameWidth),
+				uint64Attr("seq_in_index"),
+				stringAttr("column_name", infoSchemaNameWidth),
+				stringAttr("collation", 1),
+				uint64Attr("cardinality"),
+				uint64Attr("sub_part"),
+				stringAttr("nullable", 3),
+				stringAttr("index_type", 16),
+				stringAttr("comment", 16),
+				stringAttr("index_comment", infoSchemaCommentWidth),
+			},
+			rows: statisticsRows,
+		},
+		{
+			name: InfoSchemaKeyColumnUsage,
+			attrs: []engine.Attribute{
+				stringAttr("constraint_catalog", infoSchemaNameWidth),
+				stringAttr("constraint_schema", infoSchemaNameWidth),
+				stringAttr("constraint_name", infoSchemaNameWidth),
+				stringAttr("table_catalog", infoSchemaNameWidth),
+				stringAttr("table_schema", infoSchemaNameWidth),
+				stringAttr("table_name", infoSchemaNameWidth),
+				stringAttr("column_name", infoSchemaNameWidth),
+				uint64Attr("ordinal_position"),
+				uint64Attr("position_in_unique_constraint"),
+				stringAttr("referenced_table_schema", infoSchemaNameWidth),
+				stringAttr("referenced_table_name", infoSchemaNameWidth),
+				stringAttr("referenced_column_name", infoSchemaNameWidth),
+			},
+			rows: keyColumnUsageRows,
+		},
+	}
+}
+
+func stringAttr(name string, width int32) engine.Attribute {
+	typ := types.T_varchar.ToType()
+	typ.Width = width
+	return engine.Attribute{Name: name, Type: typ}
+}
+
+func uint64Attr(name string) engine.Attribute {
+	return engine.Attribute{Name: name, Type: types.T_uint64.ToType()}
+}
+
+func getInfoSchemaView(name string) *infoSchemaView {
+	name = strings.ToLower(name)
+	for _, view := range infoSchemaViews {
+		if view.name == name {
+			return view
+		}
+	}
+	return nil
+}
+
+func schemataRows(txn txnif.TxnHandle) (rows [][]any, err error) {
+	for _, name := range infoSchemaDatabaseNames(txn) {
+		rows = append(rows, []any{
+			infoSchemaCatalogName, name, infoSchemaCharset, infoSchemaCollation, nil,
+		})
+	}
+	return
+}
+
+func tablesRows(txn txnif.TxnHandle) (rows [][]any, err error) {
+	err = forEachInfoSchemaTable(txn, func(table *infoSchemaTable) error {
+		var rowFormat any
+		if table.typ == infoSchemaBaseTable {
+			rowFormat = infoSchemaTableRowFmt
+		}
+		rows = append(rows, []any{
+			infoSchemaCatalogName, table.db, table.name, table.typ, table.engine,
+			infoSchemaTableVersion, rowFormat, table.rows, nil, infoSchemaCollation,
+			table.comment,
+		})
+		return nil
+	})
+	return
+}
+
+func columnsRows(txn txnif.TxnHandle) (rows [][]any, err error) {
+	err = forEachInfoSchemaTable(txn, func(table *infoSchemaTable) error {
+		for i, col := range table.cols {
+			key, nullable, extra := "", "YES", ""
+			if table.isPrimaryKey(col.Name) {
+				key, nullable = "PRI", "NO"
+			}
+			if col.AutoIncrement == int8(1) {
+				extra = "auto_increment"
+			}
+			var def any
+			if col.Default.Set && !col.Default.Null {
+				def = fmt.Sprintf("%v", col.Default.Value)
+			}
+			dataType, columnType := mysqlColumnType(col.Type)
+			var charLength, precision, scale, dtPrecision, charset, collation any
+			switch col.Type.Oid {
+			case types.T_char, types.T_varchar:
+				charLength = uint64(col.Type.Width)
+				charset, collation = infoSchemaCharset, infoSchemaCollation
+			case types.T_datetime, types.T_timestamp:
+				dtPrecision = uint64(col.Type.Precision)
+			case types.T_date:
+			default:
+				precision, scale = mysqlNumericPrecision(col.Type)
+			}
+			rows = append(rows, []any{
+				infoSchemaCatalogName, table.db, table.name, col.Name, uint64(i + 1),
+				def, nullable, dataType, charLength, precision, scale, dtPrecision,
+				charset, collation, columnType, key, extra, col.Comment,
+			})
+		}
+		return nil
+	})
+	return
+}
+
+func statisticsRows(txn txnif.TxnHandle) (rows [][]any, err error) {
+	err = forEachInfoSchemaTable(txn, func(table *infoSchemaTable) error {
+		for i, name := range table.pk {
+			rows = append(rows, []any{
+				infoSchemaCatalogName, table.db, table.name, uint64(0), table.db,
+				infoSchemaPrimaryKey, uint64(i + 1), name, "A", table.rows, nil, "",
+				"BTREE", "", "",
+			})
+		}
+		return nil
+	})
+	return
+}
+
+func keyColumnUsageRows(txn txnif.TxnHandle) (rows [][]any, err error) {
+	err = forEachInfoSchemaTable(txn, func(table *infoSchemaTable) error {
+		for i, name := range table.pk {
+			rows = append(rows, []any{
+				infoSchemaCatalogName, table.db, infoSchemaPrimaryKey,
+				infoSchemaCatalogName, table.db, table.name, name, uint64(i + 1),
+				nil, nil, nil, nil,
+			})
+		}
+		return nil
+	})
+	return
+}
+
+func (table *infoSchemaTable) isPrimaryKey(name string) bool {
+	for _, pk := range table.pk {
+		if pk == name {
+			return true
+		}
+	}
+	return false
+}
+
+// infoSchemaDatabaseNames returns the sorted names of the databases visible
+// to the txn, the information_schema always included
+func infoSchemaDatabaseNames(txn txnif.TxnHandle) []string {
+	names := txn.DatabaseNames()
+	found := false
+	for _, name := range names {
+		if name == InfoSchemaName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		names = append(names, InfoSchemaName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// forEachInfoSchemaTable calls fn on each table visible to the txn, and on
+// each view of the information_schema
+func forEachInfoSchemaTable(txn txnif.TxnHandle, fn func(*infoSchemaTable) error) error {
+	for _, dbName := range infoSchemaDatabaseNames(txn) {
+		var tables []*infoSchemaTable
+		if dbName == InfoSchemaName {
+			for _, view := range infoSchemaViews {
+				tables = append(tables, view.toInfoSchemaTable())
+			}
+		} else {
+			db, err := txn.GetDatabase(dbName)
+			if err != nil {
+				return err
+			}
+			it := db.MakeRelationIt()
+			for it.Valid() {
+				rel := it.GetRelation()
+				schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+				table := &infoSchemaTable{
+					db:      dbName,
+					name:    schema.Name,
+					typ:     infoSchemaBaseTable,
+					engine:  infoSchemaEngine,
+					comment: schema.Comment,
+					rows:    uint64(rel.Rows()),
+				}
+				for _, def := range schema.ColDefs {
+					if !def.IsHidden() {
+						table.cols = append(table.cols, def)
+					}
+				}
+				if schema.HasPK() {
+					for _, def := range schema.SortKey.Defs {
+						table.pk = append(table.pk, def.Name)
+					}
+				}
+				tables = append(tables, table)
+				it.Next()
+			}
+			sort.Slice(tables, func(i, j int) bool { return tables[i].name < tables[j].name })
+		}
+		for _, table := range tables {
+			if err := fn(table); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (view *infoSchemaView) toInfoSchemaTable() *infoSchemaTable {
+	table := &infoSchemaTable{
+		db:   InfoSchemaName,
+		name: view.name,
+		typ:  infoSchemaSystemView,
+	}
+	for i, attr := range view.attrs {
+		table.cols = append(table.cols, &catalog.ColDef{
+			Name: attr.Name,
+			Idx:  i,
+			Type: attr.Type,
+		})
+	}
+	return table
+}
+
+// mysqlColumnType returns the DATA_TYPE and the COLUMN_TYPE of a column
+func mysqlColumnType(typ types.Type) (dataType, columnType string) {
+	columnType = strings.ToLower(typ.Oid.String())
+	dataType = strings.TrimSuffix(columnType, " unsigned")
+	switch typ.Oid {
+	case types.T_char, types.T_varchar:
+		columnType = fmt.Sprintf("%s(%d)", dataType, typ.Width)
+	case types.T_decimal64, types.T_decimal128:
+		dataType = "decimal"
+		columnType = fmt.Sprintf("decimal(%d,%d)", typ.Width, typ.Scale)
+	}
+	return
+}
+
+// mysqlNumericPrecision returns the NUMERIC_PRECISION and the NUMERIC_SCALE
+// of a numeric column, or nil if the column is not numeric
+func mysqlNumericPrecision(typ types.Type) (precision, scale any) {
+	switch typ.Oid {
+	case types.T_int8, types.T_uint8:
+		return uint64(3), uint64(0)
+	case types.T_int16, types.T_uint16:
+		return uint64(5), uint64(0)
+	case types.T_int32, types.T_uint32:
+		return uint64(10), uint64(0)
+	case types.T_int64:
+		return uint64(19), uint64(0)
+	case types.T_uint64:
+		return uint64(20), uint64(0)
+	case types.T_float32:
+		return uint64(12), nil
+	case types.T_float64:
+		return uint64(22), nil
+	case types.T_decimal64, types.T_decimal128:
+		return uint64(typ.Width), uint64(typ.Scale)
+	}
+	return nil, nil
+}
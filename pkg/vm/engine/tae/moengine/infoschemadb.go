@@ -0,0 +1,216 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+var (
+	_ engine.Database = (*infoSchemaDatabase)(nil)
+	_ engine.Relation = (*infoSchemaRelation)(nil)
+	_ engine.Reader   = (*infoSchemaReader)(nil)
+)
+
+func newInfoSchemaDatabase(txn txnif.TxnHandle) *infoSchemaDatabase {
+	return &infoSchemaDatabase{
+		txn: txn,
+	}
+}
+
+func (db *infoSchemaDatabase) Relations(_ engine.Snapshot) (names []string) {
+	for _, view := range infoSchemaViews {
+		names = append(names, view.name)
+	}
+	return
+}
+
+func (db *infoSchemaDatabase) Relation(name string, _ engine.Snapshot) (engine.Relation, error) {
+	view := getInfoSchemaView(name)
+	if view == nil {
+		return nil, catalog.ErrNotFound
+	}
+	return newInfoSchemaRelation(db.txn, view), nil
+}
+
+func (db *infoSchemaDatabase) Create(_ uint64, _ string, _ []engine.TableDef, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func (db *infoSchemaDatabase) Delete(_ uint64, _ string, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func newInfoSchemaRelation(txn txnif.TxnHandle, view *infoSchemaView) *infoSchemaRelation {
+	r := &infoSchemaRelation{
+		txn:  txn,
+		view: view,
+	}
+	r.nodes = append(r.nodes, engine.Node{
+		Addr: ADDR,
+	})
+	return r
+}
+
+func (rel *infoSchemaRelation) ID(_ engine.Snapshot) string {
+	return rel.view.name
+}
+
+func (rel *infoSchemaRelation) Close(_ engine.Snapshot) {}
+
+func (rel *infoSchemaRelation) Nodes(_ engine.Snapshot) engine.Nodes {
+	return rel.nodes
+}
+
+func (rel *infoSchemaRelation) Rows() int64 {
+	rows, err := rel.view.rows(rel.txn)
+	if err != nil {
+		return 0
+	}
+	return int64(len(rows))
+}
+
+func (rel *infoSchemaRelation) Size(_ string) int64 {
+	return 0
+}
+
+func (rel *infoSchemaRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
+	defs := make([]engine.TableDef, len(rel.view.attrs))
+	for i, attr := range rel.view.attrs {
+		defs[i] = &engine.AttributeDef{Attr: attr}
+	}
+	return defs
+}
+
+func (rel *infoSchemaRelation) GetPrimaryKeys(_ engine.Snapshot) []*engine.Attribute {
+	return nil
+}
+
+func (rel *infoSchemaRelation) GetHideKey(_ engine.Snapshot) *engine.Attribute {
+	return &engine.Attribute{
+		Name: catalog.HiddenColumnName,
+		Type: catalog.HiddenColumnType,
+	}
+}
+
+func (rel *infoSchemaRelation) GetPriKeyOrHideKey(snap engine.Snapshot) ([]engine.Attribute, bool) {
+	return []engine.Attribute{*rel.GetHideKey(snap)}, false
+}
+
+func (rel *infoSchemaRelation) Write(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func (rel *infoSchemaRelation) Update(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func (rel *infoSchemaRelation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func (rel *infoSchemaRelation) AddTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+func (rel *infoSchemaRelation) DelTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return catalog.ErrNotPermitted
+}
+
+// NewReader returns num readers of the view. The view is small, so the first
+// reader returns all the rows in one batch and the others return nothing.
+func (rel *infoSchemaRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
+	for i := 0; i < num; i++ {
+		reader := &infoSchemaReader{view: rel.view}
+		if i == 0 {
+			reader.rows, reader.err = rel.view.rows(rel.txn)
+		}
+		rds = append(rds, reader)
+	}
+	return
+}
+
+func (r *infoSchemaReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if len(r.rows) == 0 {
+		return nil, nil
+	}
+	rows := r.rows
+	r.rows = nil
+	bat := batch.New(true, attrs)
+	bat.Vecs = make([]*vector.Vector, len(attrs))
+	for i, name := range attrs {
+		idx := -1
+		for j, attr := range r.view.attrs {
+			if attr.Name == name {
+				idx = j
+				break
+			}
+		}
+		if idx == -1 {
+			return nil, catalog.ErrNotFound
+		}
+		vec, err := newInfoSchemaVector(r.view.attrs[idx].Type, rows, idx)
+		if err != nil {
+			return nil, err
+		}
+		vec.Ref = refCount[i]
+		bat.Vecs[i] = vec
+	}
+	r.zs = make([]int64, len(rows))
+	for i := range r.zs {
+		r.zs[i] = 1
+	}
+	bat.Zs = r.zs
+	return bat, nil
+}
+
+// newInfoSchemaVector builds the vector of the col-th column of the rows.
+// A nil value is a null.
+func newInfoSchemaVector(typ types.Type, rows [][]any, col int) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	switch typ.Oid {
+	case types.T_varchar:
+		vs := make([][]byte, len(rows))
+		for i, row := range rows {
+			if row[col] == nil {
+				nulls.Add(vec.Nsp, uint64(i))
+				continue
+			}
+			vs[i] = []byte(row[col].(string))
+		}
+		return vec, vector.Append(vec, vs)
+	case types.T_uint64:
+		vs := make([]uint64, len(rows))
+		for i, row := range rows {
+			if row[col] == nil {
+				nulls.Add(vec.Nsp, uint64(i))
+				continue
+			}
+			vs[i] = row[col].(uint64)
+		}
+		return vec, vector.Append(vec, vs)
+	}
+	panic("unexpected information_schema column type")
+}
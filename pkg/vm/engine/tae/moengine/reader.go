@@ -18,25 +18,59 @@ import (
 	"bytes"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ engine.SummarizerReader = (*txnReader)(nil)
+	_ engine.StatsReader      = (*txnReader)(nil)
 )
 
-func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
+func newReader(rel handle.Relation, it handle.BlockIt, filter blockFilter) *txnReader {
 	return &txnReader{
 		handle: rel,
 		it:     it,
+		filter: filter,
 	}
 }
 
-func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+// nextBlock returns the next block that may contain rows satisfying the
+// pushed down filter, or nil if all blocks have been read
+func (r *txnReader) nextBlock() (handle.Block, error) {
 	r.it.Lock()
-	if !r.it.Valid() {
-		r.it.Unlock()
+	defer r.it.Unlock()
+	for r.it.Valid() {
+		h := r.it.GetBlock()
+		r.it.Next()
+		ok, err := r.filter.MayMatch(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			r.readBlocks++
+			return h, nil
+		}
+		r.prunedBlocks++
+	}
+	return nil, nil
+}
+
+// Stats returns the number of blocks read and skipped by the reader
+func (r *txnReader) Stats() (readBlocks, prunedBlocks int) {
+	return r.readBlocks, r.prunedBlocks
+}
+
+func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+	h, err := r.nextBlock()
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		if r.prunedBlocks > 0 {
+			logutil.Debugf("txnReader: %d blocks read, %d blocks pruned", r.readBlocks, r.prunedBlocks)
+		}
 		return nil, nil
 	}
 	if r.compressed == nil {
@@ -49,9 +83,6 @@ func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error
 			r.decompressed[i] = new(bytes.Buffer)
 		}
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
 	block := newBlock(h)
 	bat, err := block.Read(refCount, attrs, r.compressed, r.decompressed)
 	if err != nil {
@@ -73,7 +104,7 @@ func (r *txnReader) NewFilter() engine.Filter {
 }
 
 func (r *txnReader) NewSummarizer() engine.Summarizer {
-	return nil
+	return newSummarizer(r.handle)
 }
 
 func (r *txnReader) NewSparseFilter() engine.SparseFilter {
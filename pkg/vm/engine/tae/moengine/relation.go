@@ -178,10 +178,12 @@ func (rel *txnRelation) Delete(_ uint64, data *vector.Vector, col string, _ engi
 	panic(any("Key not found"))
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
+func (rel *txnRelation) NewReader(num int, e extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	filter := newBlockFilter(schema, e)
 	it := rel.handle.MakeBlockIt()
 	for i := 0; i < num; i++ {
-		reader := newReader(rel.handle, it)
+		reader := newReader(rel.handle, it, filter)
 		rds = append(rds, reader)
 	}
 	return
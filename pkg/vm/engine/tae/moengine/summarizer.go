@@ -0,0 +1,214 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+	"math"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+)
+
+var (
+	_ engine.Summarizer = (*txnSummarizer)(nil)
+)
+
+// txnSummarizer answers aggregates over all the blocks visible to the txn.
+// A persisted block without any in-memory change is summarized by its
+// metadata and the zonemap of the sort key. Other blocks, e.g. those with
+// deletes or uncommitted appends, are summarized by reading the column
+func newSummarizer(rel handle.Relation) *txnSummarizer {
+	return &txnSummarizer{
+		handle: rel,
+		schema: rel.GetMeta().(*catalog.TableEntry).GetSchema(),
+	}
+}
+
+// Count returns the number of non-null values of the column
+func (s *txnSummarizer) Count(attr string, filter *roaring.Bitmap) (cnt uint64, err error) {
+	def, err := s.getColDef(attr, filter)
+	if err != nil {
+		return
+	}
+	err = s.forEachBlock(func(blk handle.Block, summary *model.BlockSummary) error {
+		if summary != nil && s.isNotNull(def) {
+			cnt += uint64(summary.Rows)
+			return nil
+		}
+		return s.forEachValue(blk, def, func(vec *vector.Vector, rows int) error {
+			cnt += uint64(rows - nulls.Length(vec.Nsp))
+			return nil
+		})
+	})
+	return
+}
+
+// NullCount returns the number of null values of the column
+func (s *txnSummarizer) NullCount(attr string, filter *roaring.Bitmap) (cnt uint64, err error) {
+	def, err := s.getColDef(attr, filter)
+	if err != nil {
+		return
+	}
+	if s.isNotNull(def) {
+		return
+	}
+	err = s.forEachBlock(func(blk handle.Block, _ *model.BlockSummary) error {
+		return s.forEachValue(blk, def, func(vec *vector.Vector, _ int) error {
+			cnt += uint64(nulls.Length(vec.Nsp))
+			return nil
+		})
+	})
+	return
+}
+
+// Max returns nil if there is no non-null value
+func (s *txnSummarizer) Max(attr string, filter *roaring.Bitmap) (any, error) {
+	return s.extreme(attr, filter, 1)
+}
+
+// Min returns nil if there is no non-null value
+func (s *txnSummarizer) Min(attr string, filter *roaring.Bitmap) (any, error) {
+	return s.extreme(attr, filter, -1)
+}
+
+// Sum returns the sum and the number of non-null values of a signed integer
+// column. No block metadata keeps the sum, so the column of every block is read.
+// The unsigned columns are not supported as the sum may not fit in int64, and
+// ErrSummaryNotSupported is returned if the sum overflows int64.
+func (s *txnSummarizer) Sum(attr string, filter *roaring.Bitmap) (sum int64, cnt uint64, err error) {
+	def, err := s.getColDef(attr, filter)
+	if err != nil {
+		return
+	}
+	switch def.Type.Oid {
+	case types.Type_INT8, types.Type_INT16, types.Type_INT32, types.Type_INT64:
+	default:
+		err = fmt.Errorf("%w: sum of %s", ErrSummaryNotSupported, def.Type)
+		return
+	}
+	err = s.forEachBlock(func(blk handle.Block, _ *model.BlockSummary) error {
+		return s.forEachValue(blk, def, func(vec *vector.Vector, rows int) error {
+			for i := 0; i < rows; i++ {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					continue
+				}
+				v := toInt64(compute.GetValue(vec, uint32(i)))
+				if (v > 0 && sum > math.MaxInt64-v) || (v < 0 && sum < math.MinInt64-v) {
+					return fmt.Errorf("%w: sum of %s overflows", ErrSummaryNotSupported, def.Name)
+				}
+				sum += v
+				cnt++
+			}
+			return nil
+		})
+	})
+	return
+}
+
+// extreme returns the max value if sign is 1, otherwise the min value
+func (s *txnSummarizer) extreme(attr string, filter *roaring.Bitmap, sign int64) (v any, err error) {
+	def, err := s.getColDef(attr, filter)
+	if err != nil {
+		return
+	}
+	update := func(val any) {
+		if v == nil || compute.CompareGeneric(val, v, def.Type)*sign > 0 {
+			// The value of a varchar column shares the memory of the block
+			if bs, ok := val.([]byte); ok {
+				val = append([]byte(nil), bs...)
+			}
+			v = val
+		}
+	}
+	err = s.forEachBlock(func(blk handle.Block, summary *model.BlockSummary) error {
+		if summary != nil && summary.SortKeyMin != nil && def.IsSortKey() {
+			if sign > 0 {
+				update(summary.SortKeyMax)
+			} else {
+				update(summary.SortKeyMin)
+			}
+			return nil
+		}
+		return s.forEachValue(blk, def, func(vec *vector.Vector, rows int) error {
+			for i := 0; i < rows; i++ {
+				if !nulls.Contains(vec.Nsp, uint64(i)) {
+					update(compute.GetValue(vec, uint32(i)))
+				}
+			}
+			return nil
+		})
+	})
+	return
+}
+
+func (s *txnSummarizer) getColDef(attr string, filter *roaring.Bitmap) (*catalog.ColDef, error) {
+	if filter != nil {
+		return nil, fmt.Errorf("%w: row filter", ErrSummaryNotSupported)
+	}
+	idx, ok := s.schema.NameIndex[attr]
+	if !ok {
+		return nil, fmt.Errorf("column %s not found", attr)
+	}
+	return s.schema.ColDefs[idx], nil
+}
+
+// isNotNull returns true if the column is known to have no null value
+func (s *txnSummarizer) isNotNull(def *catalog.ColDef) bool {
+	return def.IsHidden() || def.IsPrimary()
+}
+
+func (s *txnSummarizer) forEachBlock(fn func(handle.Block, *model.BlockSummary) error) error {
+	it := s.handle.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		if err := fn(blk, blk.GetSummary()); err != nil {
+			return err
+		}
+		it.Next()
+	}
+	return it.GetError()
+}
+
+func (s *txnSummarizer) forEachValue(blk handle.Block, def *catalog.ColDef, fn func(*vector.Vector, int) error) error {
+	view, err := blk.GetColumnDataById(def.Idx, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer view.Free()
+	vec := view.ApplyDeletes()
+	return fn(vec, vector.Length(vec))
+}
+
+func toInt64(v any) int64 {
+	switch val := v.(type) {
+	case int8:
+		return int64(val)
+	case int16:
+		return int64(val)
+	case int32:
+		return int64(val)
+	case int64:
+		return val
+	}
+	panic(fmt.Sprintf("unexpected type %T", v))
+}
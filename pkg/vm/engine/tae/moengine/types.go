@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 type Txn interface {
@@ -56,10 +58,37 @@ type txnBlock struct {
 	handle handle.Block
 }
 
+type txnSummarizer struct {
+	handle handle.Relation
+	schema *catalog.Schema
+}
+
+type infoSchemaDatabase struct {
+	txn txnif.TxnHandle
+}
+
+type infoSchemaRelation struct {
+	txn   txnif.TxnHandle
+	view  *infoSchemaView
+	nodes engine.Nodes
+}
+
+type infoSchemaReader struct {
+	view *infoSchemaView
+	rows [][]any
+	err  error
+	zs   []int64
+}
+
 type txnReader struct {
 	handle       handle.Relation
 	it           handle.BlockIt
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
 	zs           []int64
+
+	// filter is used to skip blocks by the index of the sort key
+	filter       blockFilter
+	readBlocks   int
+	prunedBlocks int
 }
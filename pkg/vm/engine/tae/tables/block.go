@@ -864,6 +864,40 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(txn.GetStartTS(), filter)
 }
 
+func (blk *dataBlock) MayMatch(filter *handle.Filter) (ok bool, err error) {
+	if blk.index == nil || !blk.meta.GetSchema().IsSingleSortKey() {
+		ok = true
+		return
+	}
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		defer blk.mvcc.RUnlock()
+	}
+	return blk.index.MayMatch(filter)
+}
+
+// GetSummary returns nil if the block is appendable or has any delete, in
+// which case the summary cannot be derived from the persisted metadata
+func (blk *dataBlock) GetSummary() *model.BlockSummary {
+	if blk.meta.IsAppendable() {
+		return nil
+	}
+	blk.mvcc.RLock()
+	depth := blk.mvcc.GetDeleteChain().DepthLocked()
+	blk.mvcc.RUnlock()
+	if depth != 0 {
+		return nil
+	}
+	summary := &model.BlockSummary{
+		Rows: blk.file.ReadRows(),
+	}
+	// The sort key of a primary key is never updated in place
+	if blk.index != nil && blk.meta.GetSchema().IsSinglePK() {
+		summary.SortKeyMin, summary.SortKeyMax = blk.index.GetMinMax()
+	}
+	return summary
+}
+
 func (blk *dataBlock) BlkApplyDelete(deleted uint64, gen common.RowGen, ts uint64) (err error) {
 	blk.meta.GetSegment().GetTable().RemoveRows(deleted)
 	return
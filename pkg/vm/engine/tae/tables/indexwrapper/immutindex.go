@@ -5,6 +5,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 )
 
@@ -68,6 +69,34 @@ func (index *immutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bi
 	return
 }
 
+func (index *immutableIndex) MayMatch(filter *handle.Filter) (ok bool, err error) {
+	if index.zmReader == nil {
+		ok = true
+		return
+	}
+	ok, keyselects := zoneMapMayMatch(index.zmReader, filter)
+	// 1. no key in [min, max] can satisfy the filter. definitely not
+	if !ok || index.bfReader == nil {
+		return
+	}
+	// 2. point lookups are further checked by the bloomfilter
+	switch filter.Op {
+	case handle.FilterEq:
+		ok, err = index.bfReader.MayContainsKey(filter.Val)
+	case handle.FilterBatchEq:
+		ok, _, err = index.bfReader.MayContainsAnyKeys(filter.Col, keyselects)
+	}
+	err = TranslateError(err)
+	return
+}
+
+func (index *immutableIndex) GetMinMax() (min, max any) {
+	if index.zmReader == nil {
+		return
+	}
+	return index.zmReader.GetMinMax()
+}
+
 func (index *immutableIndex) Close() (err error) {
 	// TODO
 	return
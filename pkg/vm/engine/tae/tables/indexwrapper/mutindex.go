@@ -5,6 +5,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
 )
@@ -105,6 +106,18 @@ func (idx *mutableIndex) BatchDedup(keys *vector.Vector, rowmask *roaring.Bitmap
 	return
 }
 
+func (idx *mutableIndex) MayMatch(filter *handle.Filter) (ok bool, err error) {
+	ok, _ = zoneMapMayMatch(idx.zonemap, filter)
+	return
+}
+
+func (idx *mutableIndex) GetMinMax() (min, max any) {
+	if !idx.zonemap.IsInited() {
+		return
+	}
+	return idx.zonemap.GetMin(), idx.zonemap.GetMax()
+}
+
 func (idx *mutableIndex) Destroy() error {
 	return idx.Close()
 }
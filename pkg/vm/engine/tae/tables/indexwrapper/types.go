@@ -6,6 +6,7 @@ import (
 	"github.com/RoaringBitmap/roaring"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 )
 
@@ -37,6 +38,14 @@ type Index interface {
 
 	BatchDedup(keys *movec.Vector, rowmask *roaring.Bitmap) (keyselects *roaring.Bitmap, err error)
 
+	// MayMatch returns false if no key in the index can satisfy the filter
+	// If any other unknown error happens, return error
+	MayMatch(filter *handle.Filter) (bool, error)
+
+	// GetMinMax returns the min and max key of the zonemap
+	// If the zonemap is not available or empty, return nil
+	GetMinMax() (min, max any)
+
 	// BatchUpsert batch insert the specific keys
 	// If any deduplication, it will fetch the old value first, fill the active map with new value, insert the old value into delete map
 	// If any other unknown error hanppens, return error
@@ -57,3 +66,35 @@ type Index interface {
 	ReadFrom(data.Block) error
 	WriteTo(data.Block) error
 }
+
+type zoneMapReader interface {
+	Contains(key any) bool
+	ContainsAny(keys *movec.Vector) (*roaring.Bitmap, bool)
+	AnyLT(key any) bool
+	AnyLE(key any) bool
+	AnyGT(key any) bool
+	AnyGE(key any) bool
+}
+
+// zoneMapMayMatch checks the filter against [min, max] of the zonemap.
+// keyselects is the selection of filter.Col within [min, max] and is only
+// set for FilterBatchEq
+func zoneMapMayMatch(zm zoneMapReader, filter *handle.Filter) (ok bool, keyselects *roaring.Bitmap) {
+	switch filter.Op {
+	case handle.FilterEq:
+		ok = zm.Contains(filter.Val)
+	case handle.FilterBatchEq:
+		keyselects, ok = zm.ContainsAny(filter.Col)
+	case handle.FilterLt:
+		ok = zm.AnyLT(filter.Val)
+	case handle.FilterLe:
+		ok = zm.AnyLE(filter.Val)
+	case handle.FilterGt:
+		ok = zm.AnyGT(filter.Val)
+	case handle.FilterGe:
+		ok = zm.AnyGE(filter.Val)
+	default:
+		ok = true
+	}
+	return
+}
@@ -103,6 +103,40 @@ func (reader *ZMReader) Contains(key any) bool {
 	return reader.node.zonemap.Contains(key)
 }
 
+func (reader *ZMReader) AnyLT(key any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.AnyLT(key)
+}
+
+func (reader *ZMReader) AnyLE(key any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.AnyLE(key)
+}
+
+func (reader *ZMReader) AnyGT(key any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.AnyGT(key)
+}
+
+func (reader *ZMReader) AnyGE(key any) bool {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.zonemap.AnyGE(key)
+}
+
+// GetMinMax returns nil if the zonemap is empty
+func (reader *ZMReader) GetMinMax() (min, max any) {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	if !reader.node.zonemap.IsInited() {
+		return
+	}
+	return reader.node.zonemap.GetMin(), reader.node.zonemap.GetMax()
+}
+
 type ZMWriter struct {
 	cType       CompressType
 	file        common.IRWFile
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
 type TxnDatabase struct {
@@ -121,6 +122,8 @@ func (blk *TxnBlock) String() string                                        { re
 func (blk *TxnBlock) Close() error                                          { return nil }
 func (blk *TxnBlock) GetMeta() any                                          { return nil }
 func (blk *TxnBlock) GetByFilter(*handle.Filter) (offset uint32, err error) { return }
+func (blk *TxnBlock) MayMatch(*handle.Filter) (bool, error)                 { return true, nil }
+func (blk *TxnBlock) GetSummary() *model.BlockSummary                       { return nil }
 
 func (blk *TxnBlock) GetColumnDataById(colIdx int, compressed, decompressed *bytes.Buffer) (vec *vector.Vector, deletes *roaring.Bitmap, err error) {
 	return
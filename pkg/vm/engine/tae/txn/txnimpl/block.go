@@ -211,6 +211,21 @@ func (blk *txnBlock) GetByFilter(filter *handle.Filter) (offset uint32, err erro
 	return blk.entry.GetBlockData().GetByFilter(blk.table.store.txn, filter)
 }
 
+func (blk *txnBlock) MayMatch(filter *handle.Filter) (bool, error) {
+	// Uncommitted blocks have no index built yet
+	if blk.isUncommitted {
+		return true, nil
+	}
+	return blk.entry.GetBlockData().MayMatch(filter)
+}
+
+func (blk *txnBlock) GetSummary() *model.BlockSummary {
+	if blk.isUncommitted {
+		return nil
+	}
+	return blk.entry.GetBlockData().GetSummary()
+}
+
 // TODO: segmentit or tableit
 func newRelationBlockIt(rel handle.Relation) *relBlockIt {
 	it := new(relBlockIt)
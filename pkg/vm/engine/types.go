@@ -167,6 +167,20 @@ type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
 
+// StatsReader is a Reader that reports the number of blocks it read and
+// skipped by the filter pushed down to it
+type StatsReader interface {
+	Reader
+	Stats() (readBlocks, prunedBlocks int)
+}
+
+// SummarizerReader is a Reader that can also answer aggregates from the
+// metadata of the storage without reading every row
+type SummarizerReader interface {
+	Reader
+	NewSummarizer() Summarizer
+}
+
 type Filter interface {
 	Eq(string, interface{}) (*roaring.Bitmap, error)
 	Ne(string, interface{}) (*roaring.Bitmap, error)
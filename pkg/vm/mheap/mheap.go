@@ -16,6 +16,10 @@ func Size(m *Mheap) int64 {
 	return m.Gm.Size()
 }
 
+func Peak(m *Mheap) int64 {
+	return m.Gm.Peak()
+}
+
 func HostSize(m *Mheap) int64 {
 	return m.Gm.HostSize()
 }
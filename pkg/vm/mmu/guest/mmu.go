@@ -28,10 +28,52 @@ func New(limit int64, mmu *host.Mmu) *Mmu {
 	}
 }
 
+// NewWithParent returns a named mmu of a lower level of the memory tracking
+// hierarchy, e.g. global -> user -> session -> query
+func NewWithParent(name string, limit int64, parent *Mmu) *Mmu {
+	return &Mmu{
+		Mmu:    parent.Mmu,
+		Name:   name,
+		Limit:  limit,
+		parent: parent,
+	}
+}
+
+// Parent returns the mmu of the upper level, or nil if the mmu is accounted
+// to the host directly
+func (m *Mmu) Parent() *Mmu {
+	return m.parent
+}
+
+// SetParent makes the mmu a lower level of parent, it must be called before
+// any allocation of the mmu
+func (m *Mmu) SetParent(parent *Mmu) {
+	m.parent = parent
+	m.Mmu = parent.Mmu
+}
+
+func (m *Mmu) GetLimit() int64 {
+	return atomic.LoadInt64(&m.Limit)
+}
+
+func (m *Mmu) SetLimit(limit int64) {
+	atomic.StoreInt64(&m.Limit, limit)
+}
+
 func (m *Mmu) Size() int64 {
 	return atomic.LoadInt64(&m.size)
 }
 
+// Peak returns the maximum usage of memory since the last ResetPeak
+func (m *Mmu) Peak() int64 {
+	return atomic.LoadInt64(&m.peak)
+}
+
+// ResetPeak starts tracking the peak from the current usage
+func (m *Mmu) ResetPeak() {
+	atomic.StoreInt64(&m.peak, m.Size())
+}
+
 func (m *Mmu) HostSize() int64 {
 	return m.Mmu.Size()
 }
@@ -41,17 +83,41 @@ func (m *Mmu) Free(size int64) {
 		return
 	}
 	atomic.AddInt64(&m.size, size*-1)
+	if m.parent != nil {
+		m.parent.Free(size)
+		return
+	}
 	m.Mmu.Free(size)
 }
 
+// Release returns the memory still accounted to the mmu to the upper levels,
+// it is called when the owner of the mmu, such as a query, ends.
+func (m *Mmu) Release() {
+	if size := atomic.SwapInt64(&m.size, 0); size > 0 {
+		if m.parent != nil {
+			m.parent.Free(size)
+			return
+		}
+		m.Mmu.Free(size)
+	}
+}
+
 func (m *Mmu) Alloc(size int64) error {
-	if atomic.LoadInt64(&m.size)+size > m.Limit {
-		return mmu.OutOfMemory
+	if limit := m.GetLimit(); atomic.LoadInt64(&m.size)+size > limit {
+		if m.Name == "" {
+			return mmu.OutOfMemory
+		}
+		return &mmu.LimitError{Name: m.Name, Limit: limit}
 	}
-	if err := m.Mmu.Alloc(size); err != nil {
+	if m.parent != nil {
+		if err := m.parent.Alloc(size); err != nil {
+			return err
+		}
+	} else if err := m.Mmu.Alloc(size); err != nil {
 		return err
 	}
-	for v := atomic.LoadInt64(&m.size); !atomic.CompareAndSwapInt64(&m.size, v, v+size); v = atomic.LoadInt64(&m.size) {
+	v := atomic.AddInt64(&m.size, size)
+	for p := atomic.LoadInt64(&m.peak); v > p && !atomic.CompareAndSwapInt64(&m.peak, p, v); p = atomic.LoadInt64(&m.peak) {
 	}
 	return nil
 }
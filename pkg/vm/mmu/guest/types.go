@@ -20,8 +20,15 @@ import "github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 type Mmu struct {
 	// size, current usage of memory
 	size int64
+	// peak, maximum usage of memory since the last ResetPeak
+	peak int64
 	// Limit, maximum memory can be used in this query execution
 	Limit int64
+	// Name, the level of the memory tracking hierarchy accounted by the mmu,
+	// an allocation exceeding the limit of a named mmu fails with mmu.LimitError
+	Name string
+	// parent, the mmu of the upper level, which the allocations are also accounted to
+	parent *Mmu
 	// Mmu,
 	Mmu *host.Mmu
 }
@@ -16,8 +16,22 @@ package mmu
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
 	OutOfMemory = errors.New("out of memory")
 )
+
+// LimitError is returned when an allocation exceeds the memory limit of a
+// level of the memory tracking hierarchy, such as global, user, session or query.
+type LimitError struct {
+	// Name, the name of the level
+	Name string
+	// Limit, the memory limit of the level in bytes
+	Limit int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("memory of %s exceeds the limit of %d bytes", e.Name, e.Limit)
+}
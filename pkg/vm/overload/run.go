@@ -16,9 +16,11 @@ package overload
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -51,7 +53,12 @@ func Run(ins vm.Instructions, proc *process.Process) (end bool, err error) {
 		}
 	}()
 	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+		if anal := proc.GetAnalyze(in.Idx); anal != nil && Analyzable(in.Op) {
+			ok, err = analyzeRun(in, proc, anal)
+		} else {
+			ok, err = execFunc[in.Op](proc, in.Arg)
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work
@@ -60,3 +67,29 @@ func Run(ins vm.Instructions, proc *process.Process) (end bool, err error) {
 	}
 	return end, err
 }
+
+// Analyzable returns false for the operators which only move batches between
+// pipelines, they do no work of any plan node and are not analyzed.
+func Analyzable(op int) bool {
+	switch op {
+	case Merge, Output, Dispatch, Connector:
+		return false
+	}
+	return true
+}
+
+// analyzeRun calls the operator and records its runtime statistics
+func analyzeRun(in vm.Instruction, proc *process.Process, anal *process.AnalyzeInfo) (bool, error) {
+	if in.IsFirst {
+		anal.Input(proc.Reg.InputBatch)
+	}
+	size := mheap.Size(proc.Mp)
+	start := time.Now()
+	ok, err := execFunc[in.Op](proc, in.Arg)
+	anal.AddTime(time.Since(start))
+	anal.Alloc(mheap.Size(proc.Mp) - size)
+	if err == nil && in.IsLast {
+		anal.Output(proc.Reg.InputBatch)
+	}
+	return ok, err
+}
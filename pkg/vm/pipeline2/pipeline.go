@@ -66,10 +66,17 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 	}
 	refCnts := make([]uint64, len(p.attrs))
 	for {
+		// stop reading once the query is killed
+		if err = proc.GetContext().Err(); err != nil {
+			return false, err
+		}
 		// read data from storage engine
 		if bat, err = r.Read(refCnts, p.attrs); err != nil {
 			return false, err
 		}
+		if bat != nil {
+			proc.AddBytesRead(int64(bat.Size()))
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = overload.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -126,6 +133,9 @@ func (p *Pipeline) MergeRun(proc *process.Process) (bool, error) {
 		return false, err
 	}
 	for {
+		if err = proc.GetContext().Err(); err != nil {
+			return false, err
+		}
 		proc.Reg.InputBatch = nil
 		if end, err = overload.Run(p.instructions, proc); err != nil || end {
 			return end, err
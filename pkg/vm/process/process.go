@@ -16,6 +16,8 @@ package process
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -28,18 +30,21 @@ import (
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:    m,
+		Stats: &Statistics{},
 	}
 }
 
 // NewFromProc create a new Process based on another process.
 func NewFromProc(m *mheap.Mheap, p *Process, regNumber int) *Process {
 	proc := &Process{Mp: m}
-	ctx, cancel := context.WithCancel(context.Background())
+	proc.Ctx = p.GetContext()
+	ctx, cancel := context.WithCancel(proc.Ctx)
 	proc.Id = p.Id
 	proc.Lim = p.Lim
 	proc.UnixTime = p.UnixTime
 	proc.Snapshot = p.Snapshot
+	proc.Stats = p.Stats
 	// reg and cancel
 	proc.Cancel = cancel
 	proc.Reg.MergeReceivers = make([]*WaitRegister, regNumber)
@@ -52,6 +57,68 @@ func NewFromProc(m *mheap.Mheap, p *Process, regNumber int) *Process {
 	return proc
 }
 
+// GetContext returns the context of the query, a process built without one
+// is never canceled.
+func (proc *Process) GetContext() context.Context {
+	if proc.Ctx == nil {
+		return context.Background()
+	}
+	return proc.Ctx
+}
+
+// AddBytesRead records the size of the data read from the storage engine
+func (proc *Process) AddBytesRead(n int64) {
+	if proc.Stats != nil {
+		atomic.AddInt64(&proc.Stats.BytesRead, n)
+	}
+}
+
+// GetAnalyze returns the runtime statistics of the idx-th plan node,
+// or nil if the query is not analyzed.
+func (proc *Process) GetAnalyze(idx int) *AnalyzeInfo {
+	if proc.Stats == nil || idx < 0 || idx >= len(proc.Stats.Analyze) {
+		return nil
+	}
+	return &proc.Stats.Analyze[idx]
+}
+
+// Input records a batch received by the plan node
+func (a *AnalyzeInfo) Input(bat *batch.Batch) {
+	if bat != nil {
+		atomic.AddInt64(&a.InputBatches, 1)
+		atomic.AddInt64(&a.InputRows, int64(len(bat.Zs)))
+	}
+}
+
+// Output records a batch sent by the plan node
+func (a *AnalyzeInfo) Output(bat *batch.Batch) {
+	if bat != nil {
+		atomic.AddInt64(&a.OutputBatches, 1)
+		atomic.AddInt64(&a.OutputRows, int64(len(bat.Zs)))
+	}
+}
+
+// Alloc records the memory allocated in one call of an operator
+func (a *AnalyzeInfo) Alloc(size int64) {
+	for {
+		old := atomic.LoadInt64(&a.MemorySize)
+		if size <= old || atomic.CompareAndSwapInt64(&a.MemorySize, old, size) {
+			return
+		}
+	}
+}
+
+// AddTime records the time consumed by one call of an operator
+func (a *AnalyzeInfo) AddTime(d time.Duration) {
+	atomic.AddInt64(&a.TimeConsumed, int64(d))
+}
+
+// AddBlocks records the blocks read and skipped by a reader of the plan node
+func (a *AnalyzeInfo) AddBlocks(read, pruned int) {
+	atomic.AddInt64(&a.ReadBlocks, int64(read))
+	atomic.AddInt64(&a.PrunedBlocks, int64(pruned))
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
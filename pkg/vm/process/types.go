@@ -55,6 +55,36 @@ type Limitation struct {
 	PartitionRows int64
 }
 
+// Statistics is shared by all processes of a query
+type Statistics struct {
+	// BytesRead, size of the data read from the storage engine.
+	BytesRead int64
+	// Analyze, runtime statistics of each plan node indexed by the node id,
+	// it is nil unless the query is run by explain analyze.
+	Analyze []AnalyzeInfo
+}
+
+// AnalyzeInfo contains the runtime statistics of the operators of a plan node,
+// which are summed up over all the pipelines running the node in parallel.
+type AnalyzeInfo struct {
+	// InputRows, number of rows received by the node.
+	InputRows int64
+	// OutputRows, number of rows sent by the node.
+	OutputRows int64
+	// InputBatches, number of batches received by the node.
+	InputBatches int64
+	// OutputBatches, number of batches sent by the node.
+	OutputBatches int64
+	// TimeConsumed, wall time spent in the operators of the node, in nanoseconds.
+	TimeConsumed int64
+	// MemorySize, the largest memory growth of the query in one call of an operator of the node.
+	MemorySize int64
+	// ReadBlocks, number of blocks read from the storage engine by the node.
+	ReadBlocks int64
+	// PrunedBlocks, number of blocks skipped by the filters pushed down to the storage engine.
+	PrunedBlocks int64
+}
+
 // Process contains context used in query execution
 // one or more pipeline will be generated for one query,
 // and one pipeline has one process instance.
@@ -73,4 +103,10 @@ type Process struct {
 
 	// snapshot is transaction context
 	Cancel context.CancelFunc
+
+	// Ctx, context of the query, it is canceled when the query is killed.
+	Ctx context.Context
+
+	// Stats, execution statistics of the query.
+	Stats *Statistics
 }
@@ -46,6 +46,14 @@ const (
 type Instruction struct {
 	// Op specified the operator code of an instruction.
 	Op int
+	// Idx specified the index of the plan node which the instruction is generated from,
+	// it is used to gather the runtime statistics of the node.
+	Idx int
+	// IsFirst and IsLast specified whether the instruction is the first or the last
+	// one that processes the data of its plan node, the input rows of the node are
+	// counted at the first instruction and the output rows at the last one.
+	IsFirst bool
+	IsLast  bool
 	// Arg contains the operand of this instruction.
 	Arg interface{}
 }